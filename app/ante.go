@@ -0,0 +1,71 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/desmos-labs/desmos/x/magpie"
+	magpieKeeper "github.com/desmos-labs/desmos/x/magpie/keeper"
+	postsTypes "github.com/desmos-labs/desmos/x/posts/types"
+	profilesTypes "github.com/desmos-labs/desmos/x/profiles/types"
+	relationshipsTypes "github.com/desmos-labs/desmos/x/relationships/types"
+	"github.com/desmos-labs/desmos/x/sponsorships"
+	sponsorshipsKeeper "github.com/desmos-labs/desmos/x/sponsorships/keeper"
+)
+
+// sessionAllowedMsgs contains the messages that can be signed using the external key of a magpie session
+var sessionAllowedMsgs = []sdk.Msg{
+	// Posts
+	postsTypes.MsgCreatePost{},
+	postsTypes.MsgEditPost{},
+	postsTypes.MsgAnswerPoll{},
+
+	// Reactions
+	postsTypes.MsgAddPostReaction{},
+	postsTypes.MsgRemovePostReaction{},
+	postsTypes.MsgRegisterReaction{},
+
+	// Relationships
+	relationshipsTypes.MsgCreateRelationship{},
+	relationshipsTypes.MsgDeleteRelationship{},
+	relationshipsTypes.MsgRequestRelationship{},
+	relationshipsTypes.MsgAcceptRelationshipRequest{},
+	relationshipsTypes.MsgDeclineRelationshipRequest{},
+	relationshipsTypes.MsgCancelRelationshipRequest{},
+	relationshipsTypes.MsgBlockUser{},
+	relationshipsTypes.MsgUnblockUser{},
+}
+
+// sponsoredMsgs contains the messages whose fees can be paid using the fee allowance granted inside
+// a subspace sponsorship
+var sponsoredMsgs = append([]sdk.Msg{
+	// Profiles
+	profilesTypes.MsgSaveProfile{},
+	profilesTypes.MsgDeleteProfile{},
+}, sessionAllowedMsgs...)
+
+// NewAnteHandler returns the AnteHandler of the application.
+// Along with the standard checks, it authorizes the transactions signed using a magpie session,
+// which are handled by the session decorator before any standard signature is verified,
+// and lets the fees of the Desmos messages be paid by a sponsorship fee allowance
+func NewAnteHandler(
+	ak auth.AccountKeeper, supplyKeeper supply.Keeper, mk magpieKeeper.Keeper, sk sponsorshipsKeeper.Keeper,
+	sigGasConsumer ante.SignatureVerificationGasConsumer,
+) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		ante.NewMempoolFeeDecorator(),
+		ante.NewValidateBasicDecorator(),
+		ante.NewValidateMemoDecorator(ak),
+		magpie.NewSessionTxDecorator(mk, ak, supplyKeeper, sessionAllowedMsgs...), // session txs stop here
+		ante.NewConsumeGasForTxSizeDecorator(ak),
+		ante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		ante.NewValidateSigCountDecorator(ak),
+		sponsorships.NewSponsoredFeeDecorator(sk, ak, supplyKeeper, sponsoredMsgs...), // replaces the DeductFeeDecorator
+		ante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		ante.NewSigVerificationDecorator(ak),
+		ante.NewIncrementSequenceDecorator(ak), // innermost AnteDecorator
+	)
+}
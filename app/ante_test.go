@@ -0,0 +1,161 @@
+package app
+
+import (
+	"encoding/base64"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	magpieTypes "github.com/desmos-labs/desmos/x/magpie/types"
+	postsTypes "github.com/desmos-labs/desmos/x/posts/types"
+	profilesTypes "github.com/desmos-labs/desmos/x/profiles/types"
+	sponsorshipsTypes "github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+func TestNewAnteHandler_SessionTx(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewDesmosApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0)
+
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey().(secp256k1.PubKeySecp256k1)
+	session := magpieTypes.Session{
+		SessionID:     magpieTypes.SessionID(1),
+		Owner:         owner,
+		Created:       0,
+		Expiry:        100,
+		Namespace:     "cosmos",
+		ExternalOwner: "cosmos1njrqah832yfdv8yhxnrskerzxhj5zj9e563uge",
+		PubKey:        base64.StdEncoding.EncodeToString(pubKey[:]),
+		SpendLimit:    sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)),
+	}
+
+	// Setup the genesis with the owner account and its session
+	genesisState := NewDefaultGenesisState()
+	genesisState[auth.ModuleName] = app.cdc.MustMarshalJSON(auth.NewGenesisState(auth.DefaultParams(), []authexported.GenesisAccount{
+		auth.NewBaseAccount(owner, sdk.NewCoins(sdk.NewInt64Coin("udaric", 1000)), nil, 0, 0),
+	}))
+	genesisState[magpieTypes.ModuleName] = app.cdc.MustMarshalJSON(
+		magpieTypes.NewGenesisState(magpieTypes.DefaultParams(), magpieTypes.Sessions{session}),
+	)
+	stateBytes, err := codec.MarshalJSONIndent(app.cdc, genesisState)
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{ChainId: "desmos", Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	// Sign a post creation using the session key
+	msg := postsTypes.NewMsgCreatePost("Session post", "", false,
+		"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e", nil, owner, nil, nil)
+	tx := magpieTypes.NewSessionTx(
+		[]sdk.Msg{msg},
+		auth.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("udaric", 10))),
+		"",
+		session.SessionID,
+		"",
+	)
+	sig, err := privKey.Sign(tx.GetSignBytes("desmos", 0))
+	require.NoError(t, err)
+	tx.Signature = base64.StdEncoding.EncodeToString(sig)
+
+	txBytes, err := auth.DefaultTxEncoder(app.cdc)(tx)
+	require.NoError(t, err)
+
+	header := abci.Header{ChainID: "desmos", Height: 2, Time: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+
+	// Replaying the same transaction fails as the session sequence has been incremented
+	res = app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.False(t, res.IsOK())
+	app.EndBlock(abci.RequestEndBlock{Height: 2})
+	app.Commit()
+
+	ctx := app.BaseApp.NewContext(true, header)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udaric", 990)), app.AccountKeeper.GetAccount(ctx, owner).GetCoins())
+
+	stored, found := app.magpieKeeper.GetSession(ctx, session.SessionID)
+	require.True(t, found)
+	require.Equal(t, uint64(1), stored.Sequence)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udaric", 10)), stored.Spent)
+	require.Len(t, app.postsKeeper.GetPosts(ctx), 1)
+}
+
+func TestNewAnteHandler_SponsoredTx(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewDesmosApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0)
+
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	privKey := secp256k1.GenPrivKey()
+	grantee := sdk.AccAddress(privKey.PubKey().Address())
+
+	sponsorship := sponsorshipsTypes.NewSponsorship(subspace, owner, sdk.NewCoins(sdk.NewInt64Coin("udaric", 1000)))
+	allowance := sponsorshipsTypes.NewFeeAllowance(subspace, owner, grantee, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)),
+		time.Date(2020, 1, 2, 12, 0, 0, 0, time.UTC))
+
+	// Setup the genesis with a grantee without any coin and a funded sponsorship
+	moduleAcc := supply.NewEmptyModuleAccount(sponsorshipsTypes.ModuleName)
+	require.NoError(t, moduleAcc.SetCoins(sponsorship.Balance))
+
+	genesisState := NewDefaultGenesisState()
+	genesisState[auth.ModuleName] = app.cdc.MustMarshalJSON(auth.NewGenesisState(auth.DefaultParams(), []authexported.GenesisAccount{
+		auth.NewBaseAccount(owner, nil, nil, 0, 0),
+		auth.NewBaseAccount(grantee, nil, nil, 1, 0),
+		moduleAcc,
+	}))
+	genesisState[sponsorshipsTypes.ModuleName] = app.cdc.MustMarshalJSON(sponsorshipsTypes.NewGenesisState(
+		sponsorshipsTypes.Sponsorships{sponsorship},
+		sponsorshipsTypes.FeeAllowances{allowance},
+	))
+	stateBytes, err := codec.MarshalJSONIndent(app.cdc, genesisState)
+	require.NoError(t, err)
+
+	app.InitChain(abci.RequestInitChain{ChainId: "desmos", Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	header := abci.Header{ChainID: "desmos", Height: 2, Time: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)}
+	accNumber := app.AccountKeeper.GetAccount(app.BaseApp.NewContext(true, header), grantee).GetAccountNumber()
+
+	// Create a profile paying the fees with the allowance
+	msg := profilesTypes.NewMsgSaveProfile("grantee", nil, nil, nil, nil, grantee)
+	fee := auth.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("udaric", 10)))
+	sig, err := privKey.Sign(auth.StdSignBytes("desmos", accNumber, 0, fee, []sdk.Msg{msg}, ""))
+	require.NoError(t, err)
+	tx := auth.NewStdTx([]sdk.Msg{msg}, fee, []auth.StdSignature{{PubKey: privKey.PubKey(), Signature: sig}}, "")
+
+	txBytes, err := auth.DefaultTxEncoder(app.cdc)(tx)
+	require.NoError(t, err)
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	res := app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	require.True(t, res.IsOK(), res.Log)
+	app.EndBlock(abci.RequestEndBlock{Height: 2})
+	app.Commit()
+
+	ctx := app.BaseApp.NewContext(true, header)
+	require.True(t, app.AccountKeeper.GetAccount(ctx, grantee).GetCoins().IsZero())
+
+	stored, found := app.sponsorshipsKeeper.GetSponsorship(ctx, subspace)
+	require.True(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udaric", 990)), stored.Balance)
+
+	storedAllowance, found := app.sponsorshipsKeeper.GetFeeAllowance(ctx, subspace, grantee)
+	require.True(t, found)
+	require.Equal(t, fee.Amount, storedAllowance.Spent)
+
+	_, found = app.profileKeeper.GetProfile(ctx, grantee)
+	require.True(t, found)
+}
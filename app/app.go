@@ -10,7 +10,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
-	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
@@ -46,6 +45,12 @@ import (
 	"github.com/desmos-labs/desmos/x/reports"
 	reportsKeeper "github.com/desmos-labs/desmos/x/reports/keeper"
 	reportsTypes "github.com/desmos-labs/desmos/x/reports/types"
+	"github.com/desmos-labs/desmos/x/sponsorships"
+	sponsorshipsKeeper "github.com/desmos-labs/desmos/x/sponsorships/keeper"
+	sponsorshipsTypes "github.com/desmos-labs/desmos/x/sponsorships/types"
+	"github.com/desmos-labs/desmos/x/subscriptions"
+	subscriptionsKeeper "github.com/desmos-labs/desmos/x/subscriptions/keeper"
+	subscriptionsTypes "github.com/desmos-labs/desmos/x/subscriptions/types"
 )
 
 const (
@@ -85,15 +90,20 @@ var (
 		profiles.AppModuleBasic{},
 		reports.AppModuleBasic{},
 		relationships.AppModuleBasic{},
+		sponsorships.AppModuleBasic{},
+		subscriptions.AppModuleBasic{},
 	)
 
 	// Module account permissions
 	maccPerms = map[string][]string{
-		auth.FeeCollectorName:     nil,
-		distr.ModuleName:          nil,
-		staking.BondedPoolName:    {supply.Burner, supply.Staking},
-		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
-		gov.ModuleName:            {supply.Burner},
+		auth.FeeCollectorName:         nil,
+		distr.ModuleName:              nil,
+		staking.BondedPoolName:        {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName:     {supply.Burner, supply.Staking},
+		gov.ModuleName:                {supply.Burner},
+		reportsTypes.ModuleName:       {supply.Burner},
+		sponsorshipsTypes.ModuleName:  nil,
+		subscriptionsTypes.ModuleName: nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -150,6 +160,8 @@ type DesmosApp struct {
 	profileKeeper       profilesKeeper.Keeper
 	reportsKeeper       reportsKeeper.Keeper
 	relationshipsKeeper relationships.Keeper
+	sponsorshipsKeeper  sponsorshipsKeeper.Keeper
+	subscriptionsKeeper subscriptionsKeeper.Keeper
 
 	// Module Manager
 	mm *module.Manager
@@ -166,7 +178,7 @@ func NewDesmosApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	cdc := MakeCodec()
 
 	// BaseApp handles interactions with Tendermint through the ABCI protocol
-	bApp := bam.NewBaseApp(appName, logger, db, auth.DefaultTxDecoder(cdc), baseAppOptions...)
+	bApp := bam.NewBaseApp(appName, logger, db, NewTxDecoder(cdc), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
 	bApp.SetAppVersion(version.Version)
 	keys := sdk.NewKVStoreKeys(
@@ -176,7 +188,7 @@ func NewDesmosApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 
 		// Custom modules
 		magpieTypes.StoreKey, postsTypes.StoreKey, profilesTypes.StoreKey, reportsTypes.StoreKey,
-		relationshipsTypes.StoreKey,
+		relationshipsTypes.StoreKey, sponsorshipsTypes.StoreKey, subscriptionsTypes.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
 
@@ -202,6 +214,9 @@ func NewDesmosApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	app.subspaces[crisis.ModuleName] = app.paramsKeeper.Subspace(crisis.DefaultParamspace)
 	app.subspaces[postsTypes.ModuleName] = app.paramsKeeper.Subspace(postsTypes.DefaultParamspace)
 	app.subspaces[profilesTypes.ModuleName] = app.paramsKeeper.Subspace(profilesTypes.DefaultParamspace)
+	app.subspaces[reportsTypes.ModuleName] = app.paramsKeeper.Subspace(reportsTypes.DefaultParamspace)
+	app.subspaces[magpieTypes.ModuleName] = app.paramsKeeper.Subspace(magpieTypes.DefaultParamspace)
+	app.subspaces[subscriptionsTypes.ModuleName] = app.paramsKeeper.Subspace(subscriptionsTypes.DefaultParamspace)
 
 	// Add keepers
 	app.AccountKeeper = auth.NewAccountKeeper(
@@ -288,26 +303,52 @@ func NewDesmosApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	app.magpieKeeper = magpieKeeper.NewKeeper(
 		app.cdc,
 		keys[magpieTypes.StoreKey],
-	)
-	app.postsKeeper = postsKeeper.NewKeeper(
-		app.cdc,
-		keys[postsTypes.StoreKey],
-		app.subspaces[postsTypes.ModuleName],
+		app.subspaces[magpieTypes.ModuleName],
 	)
 	app.profileKeeper = profilesKeeper.NewKeeper(
 		app.cdc,
 		keys[profilesTypes.StoreKey],
 		app.subspaces[profilesTypes.ModuleName],
 	)
+	app.relationshipsKeeper = relationshipsKeeper.NewKeeper(
+		app.profileKeeper,
+		app.cdc,
+		keys[relationshipsTypes.StoreKey],
+	)
+	app.subscriptionsKeeper = subscriptionsKeeper.NewKeeper(
+		app.relationshipsKeeper,
+		app.SupplyKeeper,
+		app.cdc,
+		keys[subscriptionsTypes.StoreKey],
+		app.subspaces[subscriptionsTypes.ModuleName],
+	)
+
+	// Register the relationships hooks
+	app.relationshipsKeeper.SetHooks(app.subscriptionsKeeper.Hooks())
+
+	app.postsKeeper = postsKeeper.NewKeeper(
+		app.profileKeeper,
+		app.relationshipsKeeper,
+		app.subscriptionsKeeper,
+		app.BankKeeper,
+		app.cdc,
+		keys[postsTypes.StoreKey],
+		app.subspaces[postsTypes.ModuleName],
+	)
 	app.reportsKeeper = reportsKeeper.NewKeeper(
 		app.postsKeeper,
+		app.profileKeeper,
+		stakingKeeper,
+		app.SupplyKeeper,
 		app.cdc,
 		keys[reportsTypes.StoreKey],
+		app.subspaces[reportsTypes.ModuleName],
 	)
-
-	app.relationshipsKeeper = relationshipsKeeper.NewKeeper(
+	app.sponsorshipsKeeper = sponsorshipsKeeper.NewKeeper(
+		app.AccountKeeper,
+		app.SupplyKeeper,
 		app.cdc,
-		keys[relationshipsTypes.StoreKey],
+		keys[sponsorshipsTypes.StoreKey],
 	)
 
 	// Register the staking hooks
@@ -340,6 +381,8 @@ func NewDesmosApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		profiles.NewAppModule(app.profileKeeper, app.AccountKeeper),
 		reports.NewAppModule(app.reportsKeeper, app.AccountKeeper, app.postsKeeper),
 		relationships.NewAppModule(app.relationshipsKeeper, app.AccountKeeper),
+		sponsorships.NewAppModule(app.sponsorshipsKeeper, app.AccountKeeper),
+		subscriptions.NewAppModule(app.subscriptionsKeeper, app.AccountKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -349,7 +392,9 @@ func NewDesmosApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		upgrade.ModuleName, distr.ModuleName, slashing.ModuleName,
 		evidence.ModuleName, staking.ModuleName,
 	)
-	app.mm.SetOrderEndBlockers(crisis.ModuleName, gov.ModuleName, staking.ModuleName)
+	app.mm.SetOrderEndBlockers(
+		crisis.ModuleName, gov.ModuleName, staking.ModuleName, magpieTypes.ModuleName, subscriptionsTypes.ModuleName,
+	)
 
 	app.mm.SetOrderInitGenesis(
 		auth.ModuleName, // loads all accounts - should run before any module with a module account
@@ -358,7 +403,7 @@ func NewDesmosApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		gov.ModuleName, evidence.ModuleName,
 
 		magpieTypes.ModuleName, postsTypes.ModuleName, profilesTypes.ModuleName, reportsTypes.ModuleName,
-		relationshipsTypes.ModuleName, // custom modules
+		relationshipsTypes.ModuleName, sponsorshipsTypes.ModuleName, subscriptionsTypes.ModuleName, // custom modules
 
 		supply.ModuleName,  // calculates the total supply from account - should run after modules that modify accounts in genesis
 		crisis.ModuleName,  // runs the invariants at genesis - should run after other modules
@@ -387,6 +432,8 @@ func NewDesmosApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		profiles.NewAppModule(app.profileKeeper, app.AccountKeeper),
 		reports.NewAppModule(app.reportsKeeper, app.AccountKeeper, app.postsKeeper),
 		relationships.NewAppModule(app.relationshipsKeeper, app.AccountKeeper),
+		sponsorships.NewAppModule(app.sponsorshipsKeeper, app.AccountKeeper),
+		subscriptions.NewAppModule(app.subscriptionsKeeper, app.AccountKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -398,7 +445,9 @@ func NewDesmosApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	// Initialize BaseApp
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
-	app.SetAnteHandler(ante.NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, auth.DefaultSigVerificationGasConsumer))
+	app.SetAnteHandler(NewAnteHandler(app.AccountKeeper, app.SupplyKeeper, app.magpieKeeper, app.sponsorshipsKeeper,
+		auth.DefaultSigVerificationGasConsumer,
+	))
 	app.SetEndBlocker(app.EndBlocker)
 
 	if loadLatest {
@@ -2,15 +2,31 @@ package params
 
 // Default simulation operation weights for messages
 const (
-	DefaultWeightMsgCreatePost         int = 100
-	DefaultWeightMsgEditPost           int = 100
-	DefaultWeightMsgAddReaction        int = 100
-	DefaultWeightMsgRemoveReaction     int = 100
-	DefaultWeightMsgAnswerPoll         int = 100
-	DefaultWeightMsgRegisterReaction   int = 100
-	DefaultWeightMsgSaveAccount        int = 100
-	DefaultWeightMsgDeleteAccount      int = 100
-	DefaultWeightMsgReportPost         int = 100
-	DefaultWeightMsgCreateRelationship int = 100
-	DefaultWeightMsgDeleteRelationship int = 100
+	DefaultWeightMsgCreatePost                 int = 100
+	DefaultWeightMsgEditPost                   int = 100
+	DefaultWeightMsgAddReaction                int = 100
+	DefaultWeightMsgRemoveReaction             int = 100
+	DefaultWeightMsgAnswerPoll                 int = 100
+	DefaultWeightMsgRegisterReaction           int = 100
+	DefaultWeightMsgTipPost                    int = 50
+	DefaultWeightMsgSetSubscriberOnly          int = 30
+	DefaultWeightMsgSaveAccount                int = 100
+	DefaultWeightMsgDeleteAccount              int = 100
+	DefaultWeightMsgReportPost                 int = 100
+	DefaultWeightMsgReport                     int = 50
+	DefaultWeightMsgResolveReport              int = 50
+	DefaultWeightMsgUnhidePost                 int = 30
+	DefaultWeightMsgCreateRelationship         int = 100
+	DefaultWeightMsgDeleteRelationship         int = 100
+	DefaultWeightMsgRequestRelationship        int = 100
+	DefaultWeightMsgAcceptRelationshipRequest  int = 100
+	DefaultWeightMsgDeclineRelationshipRequest int = 50
+	DefaultWeightMsgCancelRelationshipRequest  int = 50
+	DefaultWeightMsgBlockUser                  int = 30
+	DefaultWeightMsgUnblockUser                int = 30
+	DefaultWeightMsgDepositSponsorship         int = 30
+	DefaultWeightMsgGrantFeeAllowance          int = 50
+	DefaultWeightMsgCreateTier                 int = 30
+	DefaultWeightMsgSubscribe                  int = 50
+	DefaultWeightMsgCancelSubscription         int = 20
 )
@@ -20,6 +20,8 @@ import (
 	profilesTypes "github.com/desmos-labs/desmos/x/profiles/types"
 	relationshipsTypes "github.com/desmos-labs/desmos/x/relationships/types"
 	reportsTypes "github.com/desmos-labs/desmos/x/reports/types"
+	sponsorshipsTypes "github.com/desmos-labs/desmos/x/sponsorships/types"
+	subscriptionsTypes "github.com/desmos-labs/desmos/x/subscriptions/types"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
@@ -196,6 +198,8 @@ func TestAppImportExport(t *testing.T) {
 		{app.keys[profilesTypes.StoreKey], newApp.keys[profilesTypes.StoreKey], [][]byte{}},
 		{app.keys[reportsTypes.StoreKey], newApp.keys[reportsTypes.StoreKey], [][]byte{}},
 		{app.keys[relationshipsTypes.StoreKey], newApp.keys[relationshipsTypes.StoreKey], [][]byte{}},
+		{app.keys[sponsorshipsTypes.StoreKey], newApp.keys[sponsorshipsTypes.StoreKey], [][]byte{}},
+		{app.keys[subscriptionsTypes.StoreKey], newApp.keys[subscriptionsTypes.StoreKey], [][]byte{}},
 	}
 
 	for _, skp := range storeKeysPrefixes {
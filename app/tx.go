@@ -0,0 +1,25 @@
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewTxDecoder returns the TxDecoder of the application.
+// Unlike the default one, which only decodes standard transactions, it decodes any registered transaction type,
+// including the transactions signed using a magpie session
+func NewTxDecoder(cdc *codec.Codec) sdk.TxDecoder {
+	return func(txBytes []byte) (sdk.Tx, error) {
+		if len(txBytes) == 0 {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "tx bytes are empty")
+		}
+
+		var tx sdk.Tx
+		if err := cdc.UnmarshalBinaryLengthPrefixed(txBytes, &tx); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		return tx, nil
+	}
+}
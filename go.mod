@@ -14,4 +14,5 @@ require (
 	github.com/tendermint/go-amino v0.15.1
 	github.com/tendermint/tendermint v0.33.7
 	github.com/tendermint/tm-db v0.5.1
+	golang.org/x/crypto v0.0.0-20200429183012-4b2356b1ed79
 )
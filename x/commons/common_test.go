@@ -139,3 +139,44 @@ func TestPostMedia_ParseURI(t *testing.T) {
 		})
 	}
 }
+
+func TestIsURIValidWithSchemes(t *testing.T) {
+	tests := []struct {
+		uri      string
+		schemes  []string
+		expValid bool
+	}{
+		{
+			uri:      "ipfs://QmXoypizjW3WknFiJnKLwHCnL72vedxjQkDDP1mXWo6uco",
+			schemes:  []string{"http", "https"},
+			expValid: false,
+		},
+		{
+			uri:      "ipfs://QmXoypizjW3WknFiJnKLwHCnL72vedxjQkDDP1mXWo6uco",
+			schemes:  []string{"http", "https", "ipfs"},
+			expValid: true,
+		},
+		{
+			uri:      "ipfs://QmXoypizjW3WknFiJnKLwHCnL72vedxjQkDDP1mXWo6uco",
+			schemes:  nil,
+			expValid: true,
+		},
+		{
+			uri:      "ipfs://",
+			schemes:  []string{"ipfs"},
+			expValid: false,
+		},
+		{
+			uri:      "HTTPS://example.com",
+			schemes:  []string{"https"},
+			expValid: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.uri, func(t *testing.T) {
+			require.Equal(t, test.expValid, commons.IsURIValidWithSchemes(test.uri, test.schemes))
+		})
+	}
+}
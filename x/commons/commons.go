@@ -2,6 +2,7 @@ package commons
 
 import (
 	"net/url"
+	"strings"
 )
 
 // Unique returns the given input slice without any duplicated value inside it
@@ -30,6 +31,12 @@ func StringPtrsEqual(first, second *string) bool {
 
 // IsURIValid tells whether the given uri is valid or not
 func IsURIValid(uri string) bool {
+	return IsURIValidWithSchemes(uri, []string{"http", "https"})
+}
+
+// IsURIValidWithSchemes tells whether the given uri is valid and uses one of the given schemes.
+// If no scheme is given, any scheme is accepted as long as the rest of the uri is valid
+func IsURIValidWithSchemes(uri string, schemes []string) bool {
 	_, err := url.ParseRequestURI(uri)
 	if err != nil {
 		return false
@@ -40,9 +47,15 @@ func IsURIValid(uri string) bool {
 		return false
 	}
 
-	if u.Scheme != "http" && u.Scheme != "https" {
-		return false
+	if len(schemes) == 0 {
+		return true
+	}
+
+	for _, scheme := range schemes {
+		if strings.EqualFold(u.Scheme, scheme) {
+			return true
+		}
 	}
 
-	return true
+	return false
 }
@@ -14,8 +14,11 @@ import (
 	tm "github.com/tendermint/tendermint/types"
 
 	v0100 "github.com/desmos-labs/desmos/x/genutil/legacy/v0.10.0"
+	v0110 "github.com/desmos-labs/desmos/x/genutil/legacy/v0.11.0"
 	v080 "github.com/desmos-labs/desmos/x/genutil/legacy/v0.8.0"
 	"github.com/desmos-labs/desmos/x/genutil/types"
+	v0110profiles "github.com/desmos-labs/desmos/x/profiles/legacy/v0.11.0"
+	v0110relationships "github.com/desmos-labs/desmos/x/relationships/legacy/v0.11.0"
 )
 
 // migrationMap contains the list of migrations that should be performed when migrating
@@ -24,12 +27,16 @@ import (
 var migrationMap = map[string]types.MigrationCallback{
 	"v0.8.0":  v080.Migrate,
 	"v0.10.0": v0100.Migrate,
+	"v0.11.0": v0110.Migrate,
 }
 
 const (
 	flagGenesisTime   = "genesis-time"
 	flagChainID       = "chain-id"
 	flagBlockInterval = "block-interval"
+
+	flagRelationshipsSubspace = "relationships-subspace"
+	flagReportsModerators     = "reports-moderators"
 )
 
 func MigrationsListCmd() *cobra.Command {
@@ -116,6 +123,31 @@ $ %s migrate v0.2.0 /path/to/genesis.json --chain-id=morpheus-XXXXX --genesis-ti
 					panic(err)
 				}
 				newGenState = migration(newGenState, genDoc.GenesisTime, blockInterval)
+			} else if target == "v0.11.0" {
+				// v0.11.0 migration needs to know the subspace inside which the legacy relationships are migrated
+				// and the users allowed to resolve the reports
+				relationshipsSubspace := cmd.Flag(flagRelationshipsSubspace).Value.String()
+
+				moderatorsStr, err := cmd.Flags().GetStringSlice(flagReportsModerators)
+				if err != nil {
+					return err
+				}
+
+				moderators := make([]sdk.AccAddress, len(moderatorsStr))
+				for index, moderator := range moderatorsStr {
+					address, err := sdk.AccAddressFromBech32(moderator)
+					if err != nil {
+						return fmt.Errorf("invalid reports moderator %s: %s", moderator, err)
+					}
+					moderators[index] = address
+				}
+
+				// Report the profiles whose dtag has been renamed to resolve a collision
+				var renames []v0110profiles.DtagRename
+				newGenState, renames = v0110.MigrateWithReport(newGenState, genesisTime, relationshipsSubspace, moderators)
+				for _, rename := range renames {
+					fmt.Fprintln(cmd.ErrOrStderr(), rename)
+				}
 			} else {
 				newGenState = migration(newGenState, genesisTime)
 			}
@@ -145,6 +177,10 @@ $ %s migrate v0.2.0 /path/to/genesis.json --chain-id=morpheus-XXXXX --genesis-ti
 	cmd.Flags().String(flagGenesisTime, "", "Override genesis_time with this flag")
 	cmd.Flags().String(flagChainID, "", "Override chain_id with this flag")
 	cmd.Flags().Int(flagBlockInterval, 0, "Block interval of seconds to consider while computing timestamps dates")
+	cmd.Flags().String(flagRelationshipsSubspace, v0110relationships.DefaultSubspace,
+		"Subspace inside which the legacy relationships are migrated")
+	cmd.Flags().StringSlice(flagReportsModerators, nil,
+		"Comma separated addresses of the users allowed to resolve the reports")
 
 	return cmd
 }
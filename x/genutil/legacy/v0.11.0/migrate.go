@@ -0,0 +1,93 @@
+package v0110
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+
+	v0110magpie "github.com/desmos-labs/desmos/x/magpie/legacy/v0.11.0"
+	v030magpie "github.com/desmos-labs/desmos/x/magpie/legacy/v0.3.0"
+	v0110profiles "github.com/desmos-labs/desmos/x/profiles/legacy/v0.11.0"
+	v080profiles "github.com/desmos-labs/desmos/x/profiles/legacy/v0.8.0"
+	v0100relationships "github.com/desmos-labs/desmos/x/relationships/legacy/v0.10.0"
+	v0110relationships "github.com/desmos-labs/desmos/x/relationships/legacy/v0.11.0"
+	v0100reports "github.com/desmos-labs/desmos/x/reports/legacy/v0.10.0"
+	v0110reports "github.com/desmos-labs/desmos/x/reports/legacy/v0.11.0"
+)
+
+// Migrate migrates exported state from v0.10.0 to a v0.11.0 genesis state.
+// Along with the genesis time, it optionally accepts the subspace inside which the legacy relationships
+// are migrated, that by default is the one of Desmos, and the users that are set as the reports moderators.
+func Migrate(appState genutil.AppMap, values ...interface{}) genutil.AppMap {
+	migrated, _ := MigrateWithReport(appState, values...)
+	return migrated
+}
+
+// MigrateWithReport performs the same migration of Migrate, returning also the dtags that have been renamed
+// to resolve the collisions between the profiles, so that they can be reported to the users
+func MigrateWithReport(
+	appState genutil.AppMap, values ...interface{},
+) (genutil.AppMap, []v0110profiles.DtagRename) {
+	relationshipsSubspace := v0110relationships.DefaultSubspace
+	if len(values) > 1 {
+		if subspace, ok := values[1].(string); ok && subspace != "" {
+			relationshipsSubspace = subspace
+		}
+	}
+
+	var reportsModerators []sdk.AccAddress
+	if len(values) > 2 {
+		if moderators, ok := values[2].([]sdk.AccAddress); ok {
+			reportsModerators = moderators
+		}
+	}
+
+	v0100Codec := codec.New()
+	codec.RegisterCrypto(v0100Codec)
+
+	v0110Codec := codec.New()
+	codec.RegisterCrypto(v0110Codec)
+
+	// Migrate reports state
+	if appState[v0100reports.ModuleName] != nil {
+		var genDocs v0100reports.GenesisState
+		v0100Codec.MustUnmarshalJSON(appState[v0100reports.ModuleName], &genDocs)
+
+		appState[v0100reports.ModuleName] = v0110Codec.MustMarshalJSON(
+			v0110reports.Migrate(genDocs, reportsModerators),
+		)
+	}
+
+	// Migrate magpie state
+	if appState[v030magpie.ModuleName] != nil {
+		var genDocs v030magpie.GenesisState
+		v0100Codec.MustUnmarshalJSON(appState[v030magpie.ModuleName], &genDocs)
+
+		appState[v030magpie.ModuleName] = v0110Codec.MustMarshalJSON(
+			v0110magpie.Migrate(genDocs),
+		)
+	}
+
+	// Migrate profiles state
+	var renames []v0110profiles.DtagRename
+	if appState[v080profiles.ModuleName] != nil {
+		var genDocs v080profiles.GenesisState
+		v0100Codec.MustUnmarshalJSON(appState[v080profiles.ModuleName], &genDocs)
+
+		var profilesGenState v0110profiles.GenesisState
+		profilesGenState, renames = v0110profiles.Migrate(genDocs)
+		appState[v080profiles.ModuleName] = v0110Codec.MustMarshalJSON(profilesGenState)
+	}
+
+	// Migrate relationships state
+	if appState[v0100relationships.ModuleName] != nil {
+		var genDocs v0100relationships.GenesisState
+		v0100Codec.MustUnmarshalJSON(appState[v0100relationships.ModuleName], &genDocs)
+
+		appState[v0100relationships.ModuleName] = v0110Codec.MustMarshalJSON(
+			v0110relationships.Migrate(genDocs, relationshipsSubspace),
+		)
+	}
+
+	return appState, renames
+}
@@ -0,0 +1,105 @@
+package v0110_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/stretchr/testify/require"
+
+	v0110 "github.com/desmos-labs/desmos/x/genutil/legacy/v0.11.0"
+	v0110profiles "github.com/desmos-labs/desmos/x/profiles/legacy/v0.11.0"
+	profilesTypes "github.com/desmos-labs/desmos/x/profiles/types"
+	relationshipsTypes "github.com/desmos-labs/desmos/x/relationships/types"
+	reportsTypes "github.com/desmos-labs/desmos/x/reports/types"
+)
+
+const v0100State = `{
+  "profiles": {
+    "profiles": [
+      {
+        "dtag": "leonardo",
+        "moniker": "Leonardo",
+        "creator": "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+        "creation_date": "2020-01-01T15:00:00Z"
+      },
+      {
+        "dtag": "Leonardo",
+        "creator": "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+        "creation_date": "2020-01-02T15:00:00Z"
+      }
+    ],
+    "params": {
+      "moniker_params": {"min_length": "2", "max_length": "1000"},
+      "dtag_params": {"reg_ex": "^[A-Za-z0-9_]+$", "min_length": "3", "max_length": "30"},
+      "max_bio_length": "1000"
+    },
+    "users_relationships": null
+  },
+  "reports": {
+    "reports": {
+      "19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af": [
+        {"type": "Spam", "message": "message", "user": "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"}
+      ]
+    }
+  },
+  "relationships": {
+    "users_relationships": {
+      "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns": [
+        "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+        "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"
+      ]
+    }
+  }
+}`
+
+func TestMigrate(t *testing.T) {
+	otherUser, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	require.NoError(t, err)
+
+	var appState genutil.AppMap
+	require.NoError(t, json.Unmarshal([]byte(v0100State), &appState))
+
+	genesisTime := time.Date(2020, 10, 1, 0, 0, 0, 0, time.UTC)
+	moderators := []sdk.AccAddress{otherUser}
+	migrated, renames := v0110.MigrateWithReport(appState, genesisTime, "", moderators)
+	require.Equal(t, []v0110profiles.DtagRename{
+		{Creator: otherUser, OldDtag: "Leonardo", NewDtag: "Leonardo_1"},
+	}, renames)
+
+	// Migrate performs the same migration without reporting the renames
+	var otherAppState genutil.AppMap
+	require.NoError(t, json.Unmarshal([]byte(v0100State), &otherAppState))
+	require.Equal(t, migrated, v0110.Migrate(otherAppState, genesisTime, "", moderators))
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+
+	var profilesState profilesTypes.GenesisState
+	cdc.MustUnmarshalJSON(migrated[profilesTypes.ModuleName], &profilesState)
+	require.NoError(t, profilesTypes.ValidateGenesis(profilesState))
+	require.Equal(t, profilesTypes.DefaultParams(), profilesState.Params)
+	require.Equal(t, "leonardo", profilesState.Profiles[0].DTag)
+	require.Equal(t, "Leonardo_1", profilesState.Profiles[1].DTag)
+
+	var reportsState reportsTypes.GenesisState
+	cdc.MustUnmarshalJSON(migrated[reportsTypes.ModuleName], &reportsState)
+	require.NoError(t, reportsTypes.ValidateGenesis(reportsState))
+	require.Equal(t, moderators, reportsState.Params.Moderators)
+
+	var relationshipsState relationshipsTypes.GenesisState
+	cdc.MustUnmarshalJSON(migrated[relationshipsTypes.ModuleName], &relationshipsState)
+	require.NoError(t, relationshipsTypes.ValidateGenesis(relationshipsState))
+	require.Equal(t, map[string]relationshipsTypes.Relationships{
+		"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns": {
+			relationshipsTypes.NewRelationship(
+				otherUser,
+				"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e",
+				relationshipsTypes.RelationshipTypeFollow,
+			),
+		},
+	}, relationshipsState.UsersRelationships)
+}
@@ -0,0 +1,24 @@
+package magpie
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/x/magpie/keeper"
+	"github.com/desmos-labs/desmos/x/magpie/types"
+)
+
+// EndBlocker deletes the sessions that expire at the current block height, emitting an event for each of them
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	for _, session := range k.PruneExpiredSessions(ctx, ctx.BlockHeight()) {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSessionExpired,
+			sdk.NewAttribute(types.AttributeKeySessionID, session.SessionID.String()),
+			sdk.NewAttribute(types.AttributeKeyOwner, session.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyNamespace, session.Namespace),
+			sdk.NewAttribute(types.AttributeKeyExternalOwner, session.ExternalOwner),
+			sdk.NewAttribute(types.AttributeKeyExpiry, strconv.FormatInt(session.Expiry, 10)),
+		))
+	}
+}
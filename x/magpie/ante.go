@@ -0,0 +1,123 @@
+package magpie
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/desmos-labs/desmos/x/magpie/keeper"
+	"github.com/desmos-labs/desmos/x/magpie/types"
+)
+
+// SessionTxDecorator authorizes the transactions signed using the external key of a session.
+// It checks that the session is active, that all the messages are allowed to be signed using a session
+// and that the signature matches the session key, then it charges the fees to the session owner within
+// the session spend limit.
+// Session transactions are fully authorized by this decorator, so the following decorators, which rely on the
+// standard signatures, are not called for them. Any other transaction is passed to the next decorator instead
+type SessionTxDecorator struct {
+	keeper       keeper.Keeper
+	ak           auth.AccountKeeper
+	supplyKeeper supply.Keeper
+	allowedMsgs  map[string]bool
+}
+
+// NewSessionTxDecorator returns a new SessionTxDecorator allowing sessions to sign the messages
+// having the same route and type of the given ones
+func NewSessionTxDecorator(
+	k keeper.Keeper, ak auth.AccountKeeper, sk supply.Keeper, allowedMsgs ...sdk.Msg,
+) SessionTxDecorator {
+	allowed := make(map[string]bool, len(allowedMsgs))
+	for _, msg := range allowedMsgs {
+		allowed[msgKey(msg)] = true
+	}
+
+	return SessionTxDecorator{
+		keeper:       k,
+		ak:           ak,
+		supplyKeeper: sk,
+		allowedMsgs:  allowed,
+	}
+}
+
+// msgKey returns the key identifying the kind of the given message
+func msgKey(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+func (sd SessionTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sessionTx, ok := tx.(types.SessionTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	session, found := sd.keeper.GetSession(ctx, sessionTx.SessionID)
+	if !found {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("session with id %s not found", sessionTx.SessionID))
+	}
+
+	if !session.IsActive(ctx.BlockHeight()) {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("session with id %s has expired", session.SessionID))
+	}
+
+	if !session.Owner.Equals(sessionTx.FeePayer()) {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("%s is not the owner of the session with id %s", sessionTx.FeePayer(), session.SessionID))
+	}
+
+	for _, msg := range sessionTx.GetMsgs() {
+		if !sd.allowedMsgs[msgKey(msg)] {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				fmt.Sprintf("%s messages cannot be signed using a session", msgKey(msg)))
+		}
+	}
+
+	// Consume the same gas of a standard transaction signed using the session key
+	params := sd.ak.GetParams(ctx)
+	ctx.GasMeter().ConsumeGas(params.TxSizeCostPerByte*sdk.Gas(len(ctx.TxBytes())), "txSize")
+	if session.KeyType.OrDefault() == types.KeyTypeEd25519 {
+		ctx.GasMeter().ConsumeGas(params.SigVerifyCostED25519, "ante verify: ed25519")
+	} else {
+		ctx.GasMeter().ConsumeGas(params.SigVerifyCostSecp256k1, "ante verify: secp256k1")
+	}
+
+	// Signatures are not verified when simulating, as for standard transactions
+	if !simulate {
+		if err := keeper.VerifySessionTxSignature(ctx, session, sessionTx); err != nil {
+			return ctx, err
+		}
+	}
+
+	// Charge the fees to the session owner
+	fees := sessionTx.GetFee()
+	if !fees.IsZero() {
+		if !session.CanSpend(fees) {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds,
+				fmt.Sprintf("fees exceed the spend limit of the session with id %s", session.SessionID))
+		}
+
+		ownerAcc := sd.ak.GetAccount(ctx, session.Owner)
+		if ownerAcc == nil {
+			return ctx, sdkerrors.Wrap(sdkerrors.ErrUnknownAddress,
+				fmt.Sprintf("fee payer address: %s does not exist", session.Owner))
+		}
+
+		if err := ante.DeductFees(sd.supplyKeeper, ctx, ownerAcc, fees); err != nil {
+			return ctx, err
+		}
+
+		session.Spent = session.Spent.Add(fees...)
+	}
+
+	// Increment the sequence so that the same signature cannot be replayed
+	session.Sequence++
+	sd.keeper.SaveSession(ctx, session)
+
+	return ctx, nil
+}
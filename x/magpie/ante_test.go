@@ -0,0 +1,248 @@
+package magpie_test
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/desmos-labs/desmos/x/magpie"
+	"github.com/desmos-labs/desmos/x/magpie/keeper"
+	"github.com/desmos-labs/desmos/x/magpie/types"
+)
+
+type anteTestData struct {
+	ctx          sdk.Context
+	keeper       keeper.Keeper
+	ak           auth.AccountKeeper
+	supplyKeeper supply.Keeper
+}
+
+func setupAnteTest(t *testing.T) anteTestData {
+	magpieKey := sdk.NewKVStoreKey(types.StoreKey)
+	authKey := sdk.NewKVStoreKey(auth.StoreKey)
+	supplyKey := sdk.NewKVStoreKey(supply.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	memDB := db.NewMemDB()
+	ms := store.NewCommitMultiStore(memDB)
+	ms.MountStoreWithDB(magpieKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, memDB)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	cdc.Seal()
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id", Height: 10}, false, log.NewNopLogger())
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, paramsTKey)
+	ak := auth.NewAccountKeeper(cdc, authKey, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	ak.SetParams(ctx, auth.DefaultParams())
+	bankKeeper := bank.NewBaseKeeper(ak, paramsKeeper.Subspace(bank.DefaultParamspace), nil)
+	supplyKeeper := supply.NewKeeper(cdc, supplyKey, ak, bankKeeper, map[string][]string{
+		auth.FeeCollectorName: nil,
+	})
+	supplyKeeper.SetModuleAccount(ctx, supply.NewEmptyModuleAccount(auth.FeeCollectorName))
+
+	return anteTestData{
+		ctx:          ctx,
+		keeper:       keeper.NewKeeper(cdc, magpieKey, paramsKeeper.Subspace(types.DefaultParamspace)),
+		ak:           ak,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+type nextCalledKey struct{}
+
+// terminalAnteHandler marks the context so that the tests can tell whether the next decorator has been called
+func terminalAnteHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx.WithValue(nextCalledKey{}, true), nil
+}
+
+func TestSessionTxDecorator_AnteHandle(t *testing.T) {
+	owner, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	other, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	require.NoError(t, err)
+
+	privKey := secp256k1.GenPrivKey()
+	pubKey := privKey.PubKey().(secp256k1.PubKeySecp256k1)
+	otherPrivKey := secp256k1.GenPrivKey()
+
+	session := types.Session{
+		SessionID:     types.SessionID(1),
+		Owner:         owner,
+		Created:       5,
+		Expiry:        20,
+		Namespace:     "cosmos",
+		ExternalOwner: "cosmos1njrqah832yfdv8yhxnrskerzxhj5zj9e563uge",
+		PubKey:        base64.StdEncoding.EncodeToString(pubKey[:]),
+		SpendLimit:    sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)),
+		Spent:         sdk.NewCoins(sdk.NewInt64Coin("udaric", 40)),
+		Sequence:      3,
+	}
+
+	allowedMsg := types.NewMsgRevokeSession(types.SessionID(2), owner)
+	fee := auth.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("udaric", 10)))
+
+	// signTx signs the given transaction using the given key and the current session sequence
+	signTx := func(tx types.SessionTx, key secp256k1.PrivKeySecp256k1, sequence uint64) types.SessionTx {
+		sig, err := key.Sign(tx.GetSignBytes("test-chain-id", sequence))
+		require.NoError(t, err)
+		tx.Signature = base64.StdEncoding.EncodeToString(sig)
+		return tx
+	}
+
+	tests := []struct {
+		name        string
+		tx          sdk.Tx
+		storedSess  *types.Session
+		height      int64
+		expErr      error
+		expNext     bool
+		expSession  *types.Session
+		expOwnerBal sdk.Coins
+	}{
+		{
+			name:    "Standard transactions are passed to the next decorator",
+			tx:      auth.NewStdTx([]sdk.Msg{allowedMsg}, fee, nil, ""),
+			expNext: true,
+		},
+		{
+			name:   "Not found session returns error",
+			tx:     signTx(types.NewSessionTx([]sdk.Msg{allowedMsg}, fee, "", types.SessionID(2), ""), privKey, 0),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "session with id 2 not found"),
+		},
+		{
+			name:       "Expired session returns error",
+			tx:         signTx(types.NewSessionTx([]sdk.Msg{allowedMsg}, fee, "", session.SessionID, ""), privKey, 3),
+			storedSess: &session,
+			height:     20,
+			expErr:     sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "session with id 1 has expired"),
+		},
+		{
+			name: "Messages not signed by the owner return error",
+			tx: signTx(types.NewSessionTx(
+				[]sdk.Msg{types.NewMsgRevokeSession(types.SessionID(2), other)}, fee, "", session.SessionID, "",
+			), privKey, 3),
+			storedSess: &session,
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn is not the owner of the session with id 1"),
+		},
+		{
+			name: "Not allowed messages return error",
+			tx: signTx(types.NewSessionTx(
+				[]sdk.Msg{types.NewMsgCreateSession(owner, "cosmos", "cosmos1", "", "")}, fee, "", session.SessionID, "",
+			), privKey, 3),
+			storedSess: &session,
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				"magpie/create_session messages cannot be signed using a session"),
+		},
+		{
+			name:       "Signature of a different key returns error",
+			tx:         signTx(types.NewSessionTx([]sdk.Msg{allowedMsg}, fee, "", session.SessionID, ""), otherPrivKey, 3),
+			storedSess: &session,
+			expErr:     sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid"),
+		},
+		{
+			name:       "Replayed signature returns error",
+			tx:         signTx(types.NewSessionTx([]sdk.Msg{allowedMsg}, fee, "", session.SessionID, ""), privKey, 2),
+			storedSess: &session,
+			expErr:     sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid"),
+		},
+		{
+			name: "Fees exceeding the spend limit return error",
+			tx: signTx(types.NewSessionTx(
+				[]sdk.Msg{allowedMsg},
+				auth.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("udaric", 61))),
+				"", session.SessionID, "",
+			), privKey, 3),
+			storedSess: &session,
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds,
+				"fees exceed the spend limit of the session with id 1"),
+		},
+		{
+			name:       "Valid transaction charges the owner and increments the sequence",
+			tx:         signTx(types.NewSessionTx([]sdk.Msg{allowedMsg}, fee, "", session.SessionID, ""), privKey, 3),
+			storedSess: &session,
+			expSession: &types.Session{
+				SessionID:     session.SessionID,
+				Owner:         session.Owner,
+				Created:       session.Created,
+				Expiry:        session.Expiry,
+				Namespace:     session.Namespace,
+				ExternalOwner: session.ExternalOwner,
+				PubKey:        session.PubKey,
+				SpendLimit:    session.SpendLimit,
+				Spent:         sdk.NewCoins(sdk.NewInt64Coin("udaric", 50)),
+				Sequence:      4,
+			},
+			expOwnerBal: sdk.NewCoins(sdk.NewInt64Coin("udaric", 990)),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			data := setupAnteTest(t)
+			ctx := data.ctx
+			if test.height != 0 {
+				ctx = ctx.WithBlockHeight(test.height)
+			}
+
+			ownerAcc := data.ak.NewAccountWithAddress(ctx, owner)
+			require.NoError(t, ownerAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("udaric", 1000))))
+			data.ak.SetAccount(ctx, ownerAcc)
+
+			if test.storedSess != nil {
+				data.keeper.SaveSession(ctx, *test.storedSess)
+			}
+
+			decorator := magpie.NewSessionTxDecorator(data.keeper, data.ak, data.supplyKeeper, types.MsgRevokeSession{})
+			newCtx, err := decorator.AnteHandle(ctx, test.tx, false, terminalAnteHandler)
+
+			if test.expErr != nil {
+				require.Error(t, err)
+				require.Equal(t, test.expErr.Error(), err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expNext, newCtx.Value(nextCalledKey{}) != nil)
+
+			if test.expSession != nil {
+				stored, found := data.keeper.GetSession(ctx, test.expSession.SessionID)
+				require.True(t, found)
+				require.True(t, test.expSession.Equals(stored))
+			}
+
+			if test.expOwnerBal != nil {
+				require.Equal(t, test.expOwnerBal, data.ak.GetAccount(ctx, owner).GetCoins())
+				feeCollector := data.supplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+				require.Equal(t, fee.Amount, feeCollector.GetCoins())
+			}
+		})
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/desmos-labs/desmos/x/magpie/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // GetQueryCmd adds the query commands
@@ -22,6 +24,11 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	}
 	magpieQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdSession(storeKey, cdc),
+		GetCmdOwnerSessions(storeKey, cdc),
+		GetCmdNamespaceSessions(storeKey, cdc),
+		GetCmdExternalOwnerSessions(storeKey, cdc),
+		GetCmdParams(storeKey, cdc),
+		GetCmdNextSessionID(storeKey, cdc),
 	)...)
 	return magpieQueryCmd
 }
@@ -36,7 +43,7 @@ func GetCmdSession(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			sessionsID := args[0]
 
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/session/%s", queryRoute, sessionsID), nil)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QuerySessions, sessionsID), nil)
 
 			if err != nil {
 				fmt.Printf("Could not find session with id %s \n", sessionsID)
@@ -49,3 +56,151 @@ func GetCmdSession(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdOwnerSessions queries the sessions of an owner
+func GetCmdOwnerSessions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner-sessions [address]",
+		Short: "Returns the sessions of the given owner",
+		Long: fmt.Sprintf(`
+Returns the sessions of the given owner, sorted by id.
+The sessions can be filtered to return only the active ones, and are returned using pagination.
+
+E.g.
+%s query magpie owner-sessions desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud --active-only --page=2 --limit=50
+`, version.ClientName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryOwnerSessions, args[0])
+			return querySessions(cdc, route)
+		},
+	}
+
+	addSessionsFlags(cmd)
+	return cmd
+}
+
+// GetCmdNamespaceSessions queries the sessions created inside a namespace
+func GetCmdNamespaceSessions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "namespace-sessions [namespace]",
+		Short: "Returns the sessions created inside the given namespace",
+		Long: fmt.Sprintf(`
+Returns the sessions created inside the given namespace, sorted by id.
+The sessions can be filtered to return only the active ones, and are returned using pagination.
+
+E.g.
+%s query magpie namespace-sessions cosmos-hub-3 --active-only --page=2 --limit=50
+`, version.ClientName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryNamespaceSessions, args[0])
+			return querySessions(cdc, route)
+		},
+	}
+
+	addSessionsFlags(cmd)
+	return cmd
+}
+
+// GetCmdExternalOwnerSessions queries the sessions of an external owner inside a namespace
+func GetCmdExternalOwnerSessions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "external-sessions [namespace] [external-owner]",
+		Short: "Returns the sessions of the given external owner inside the given namespace",
+		Long: fmt.Sprintf(`
+Returns the sessions of the given external owner inside the given namespace, sorted by id.
+The sessions can be filtered to return only the active ones, and are returned using pagination.
+
+E.g.
+%s query magpie external-sessions cosmos-hub-3 cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns --active-only
+`, version.ClientName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s/%s/%s", queryRoute, types.QueryExternalOwnerSessions, args[0], args[1])
+			return querySessions(cdc, route)
+		},
+	}
+
+	addSessionsFlags(cmd)
+	return cmd
+}
+
+// querySessions queries the sessions using the given route, filtering and paginating them
+// based on the command flags
+func querySessions(cdc *codec.Codec, route string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	params := types.NewQuerySessionsParams(
+		viper.GetBool(flagActiveOnly),
+		viper.GetInt(flagPage),
+		viper.GetInt(flagNumLimit),
+	)
+
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	var out types.Sessions
+	cdc.MustUnmarshalJSON(res, &out)
+	return cliCtx.PrintOutput(out)
+}
+
+// addSessionsFlags adds the flags used to filter and paginate the sessions to the given command
+func addSessionsFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagActiveOnly, false, "Return only the sessions that have not expired yet")
+	cmd.Flags().Int(flagPage, 1, "Page of the sessions to be returned")
+	cmd.Flags().Int(flagNumLimit, 100, "Number of sessions to be returned per page")
+}
+
+// GetCmdParams queries all the magpie module params
+func GetCmdParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "parameters",
+		Short: "Retrieve all the magpie module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParams)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf("Could not find magpie parameters")
+				return nil
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdNextSessionID queries the id that will be assigned to the next session
+func GetCmdNextSessionID(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "next-session-id",
+		Short: "Retrieve the id that will be assigned to the next session, which must be signed by the external key",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryNextSessionID)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf("Could not find the next session id")
+				return nil
+			}
+
+			var out types.SessionID
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
@@ -2,9 +2,11 @@ package cli
 
 import (
 	"bufio"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -15,6 +17,14 @@ import (
 	"github.com/desmos-labs/desmos/x/magpie/types"
 )
 
+const (
+	flagKeyType    = "key-type"
+	flagSpendLimit = "spend-limit"
+	flagActiveOnly = "active-only"
+	flagPage       = "page"
+	flagNumLimit   = "limit"
+)
+
 // GetTxCmd set the tx commands
 func GetTxCmd(_ string, cdc *codec.Codec) *cobra.Command {
 	magpieTxCmd := &cobra.Command{
@@ -27,6 +37,7 @@ func GetTxCmd(_ string, cdc *codec.Codec) *cobra.Command {
 
 	magpieTxCmd.AddCommand(flags.PostCommands(
 		GetCmdCreateSession(cdc),
+		GetCmdRevokeSession(cdc),
 	)...)
 
 	return magpieTxCmd
@@ -34,16 +45,58 @@ func GetTxCmd(_ string, cdc *codec.Codec) *cobra.Command {
 
 // GetCmdCreateSession is the CLI command for creating a session for create post
 func GetCmdCreateSession(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "create-session [namespace] [external address] [pubkey] [external signer signature]",
 		Short: "Creates a session for an external service to post",
-		Args:  cobra.ExactArgs(4),
+		Long: fmt.Sprintf(`Creates a session for an external service to post.
+The external signer key type can be specified using the --%s flag, and can be one of the following:
+- %s (default)
+- %s
+- %s, in which case the public key is recovered from the signature and can be left empty
+
+The --%s flag allows the session to pay the fees of the transactions it signs using your coins,
+up to the given amount (e.g. 1000udaric). By default the session cannot pay any fee.`,
+			flagKeyType, types.KeyTypeSecp256k1, types.KeyTypeEd25519, types.KeyTypeEthereum, flagSpendLimit),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			spendLimit, err := sdk.ParseCoins(viper.GetString(flagSpendLimit))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSession(cliCtx.FromAddress, args[0], args[1], args[2], args[3]).
+				WithKeyType(types.KeyType(viper.GetString(flagKeyType))).
+				WithSpendLimit(spendLimit)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagKeyType, string(types.KeyTypeSecp256k1), "Type of the key that has signed the session")
+	cmd.Flags().String(flagSpendLimit, "", "Max amount of fees that the session can pay on your behalf")
+	return cmd
+}
+
+// GetCmdRevokeSession is the CLI command for revoking a session before its expiration
+func GetCmdRevokeSession(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke-session [id]",
+		Short: "Revokes the session having the given id, which must be owned by the signer",
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
 			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
 
-			msg := types.NewMsgCreateSession(cliCtx.FromAddress, args[0], args[1], args[2], args[3])
+			id, err := types.ParseSessionID(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid session id: %s", args[0])
+			}
+
+			msg := types.NewMsgRevokeSession(id, cliCtx.FromAddress)
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
@@ -3,6 +3,8 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/desmos-labs/desmos/x/magpie/types"
@@ -17,7 +19,13 @@ import (
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
 	r.HandleFunc("/sessions", createSessionHander(cliCtx)).Methods("POST")
+	r.HandleFunc("/sessions/owner/{address}", getSessionsHandler(cliCtx, storeName, types.QueryOwnerSessions, "address")).Methods("GET")
+	r.HandleFunc("/sessions/namespace/{namespace}", getSessionsHandler(cliCtx, storeName, types.QueryNamespaceSessions, "namespace")).Methods("GET")
+	r.HandleFunc("/sessions/namespace/{namespace}/{externalOwner}", getSessionsHandler(cliCtx, storeName, types.QueryExternalOwnerSessions, "namespace", "externalOwner")).Methods("GET")
+	r.HandleFunc("/sessions/parameters", getParamsHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc("/sessions/next-id", getNextSessionIDHandler(cliCtx, storeName)).Methods("GET")
 	r.HandleFunc("/sessions/{sessionID}", getSessionHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc("/sessions/{sessionID}/revoke", revokeSessionHandler(cliCtx)).Methods("POST")
 }
 
 // --------------------------------------------------------------------------------------
@@ -31,6 +39,8 @@ type createSessionReq struct {
 	ExternalOwner string       `json:"external_owner"`
 	Pubkey        string       `json:"pubkey"`
 	Signature     string       `json:"signature"`
+	KeyType       string       `json:"key_type"`
+	SpendLimit    sdk.Coins    `json:"spend_limit"`
 }
 
 func createSessionHander(cliCtx context.CLIContext) http.HandlerFunc {
@@ -54,7 +64,51 @@ func createSessionHander(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// create the session
-		msg := types.NewMsgCreateSession(addr, req.Namespace, req.ExternalOwner, req.Pubkey, req.Signature)
+		msg := types.NewMsgCreateSession(addr, req.Namespace, req.ExternalOwner, req.Pubkey, req.Signature).
+			WithKeyType(types.KeyType(req.KeyType)).
+			WithSpendLimit(req.SpendLimit)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type revokeSessionReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Owner   string       `json:"owner"`
+}
+
+func revokeSessionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revokeSessionReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		sessionID, err := types.ParseSessionID(mux.Vars(r)["sessionID"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(req.Owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRevokeSession(sessionID, addr)
 		err = msg.ValidateBasic()
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -70,7 +124,74 @@ func getSessionHandler(cliCtx context.CLIContext, storeName string) http.Handler
 		vars := mux.Vars(r)
 		sessionID := vars["sessionID"]
 
-		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/session/%s", storeName, sessionID), nil)
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", storeName, types.QuerySessions, sessionID), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getParamsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, types.QueryParams), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func getNextSessionIDHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", storeName, types.QueryNextSessionID), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// getSessionsHandler returns the handler used to query the paginated list of sessions using the given query path,
+// whose parameters are read from the given route variables
+func getSessionsHandler(cliCtx context.CLIContext, storeName, query string, variables ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		activeOnly := false
+		if active := r.URL.Query().Get("active"); active != "" {
+			activeOnly, err = strconv.ParseBool(active)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQuerySessionsParams(activeOnly, page, limit))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		values := make([]string, len(variables))
+		for index, variable := range variables {
+			values[index] = vars[variable]
+		}
+
+		route := fmt.Sprintf("custom/%s/%s/%s", storeName, query, strings.Join(values, "/"))
+		res, _, err := cliCtx.QueryWithData(route, bz)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
 			return
@@ -10,17 +10,15 @@ import (
 // ExportGenesis returns the GenesisState associated with the given context
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		DefaultSessionLength: k.GetDefaultSessionLength(ctx),
-		Sessions:             k.GetSessions(ctx),
+		Params:   k.GetParams(ctx),
+		Sessions: k.GetSessions(ctx),
 	}
 }
 
 // InitGenesis initializes the chain state based on the given GenesisState
 // noinspection GoUnhandledErrorResult
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) []abci.ValidatorUpdate {
-	if err := keeper.SetDefaultSessionLength(ctx, data.DefaultSessionLength); err != nil {
-		panic(err)
-	}
+	keeper.SetParams(ctx, data.Params)
 
 	for _, session := range data.Sessions {
 		keeper.SaveSession(ctx, session)
@@ -1,17 +1,21 @@
 package keeper_test
 
 import (
+	"encoding/base64"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/desmos-labs/desmos/x/magpie/keeper"
 	"github.com/desmos-labs/desmos/x/magpie/types"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/bech32"
 	"github.com/tendermint/tendermint/libs/log"
 	db "github.com/tendermint/tm-db"
 )
@@ -19,11 +23,12 @@ import (
 type KeeperTestSuite struct {
 	suite.Suite
 
-	cdc      *codec.Codec
-	ctx      sdk.Context
-	keeper   keeper.Keeper
-	ms       store.CommitMultiStore
-	testData TestData
+	cdc          *codec.Codec
+	ctx          sdk.Context
+	keeper       keeper.Keeper
+	paramsKeeper params.Keeper
+	ms           store.CommitMultiStore
+	testData     TestData
 }
 
 type TestData struct {
@@ -34,18 +39,24 @@ type TestData struct {
 func (suite *KeeperTestSuite) SetupTest() {
 	// define store store keys
 	magpieKey := sdk.NewKVStoreKey("magpie")
+	paramsKey := sdk.NewKVStoreKey("params")
+	paramsTKey := sdk.NewTransientStoreKey("transient_params")
 
 	// create an in-memory db
 	memDB := db.NewMemDB()
 	suite.ms = store.NewCommitMultiStore(memDB)
 	suite.ms.MountStoreWithDB(magpieKey, sdk.StoreTypeIAVL, memDB)
+	suite.ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, memDB)
+	suite.ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, memDB)
 	if err := suite.ms.LoadLatestVersion(); err != nil {
 		panic(err)
 	}
 
 	suite.ctx = sdk.NewContext(suite.ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
 	suite.cdc = testCodec()
-	suite.keeper = keeper.NewKeeper(suite.cdc, magpieKey)
+	suite.paramsKeeper = params.NewKeeper(suite.cdc, paramsKey, paramsTKey)
+	suite.keeper = keeper.NewKeeper(suite.cdc, magpieKey, suite.paramsKeeper.Subspace(types.DefaultParamspace))
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
 
 	// setup Data
 	// nolint - errcheck
@@ -58,6 +69,24 @@ func (suite *KeeperTestSuite) SetupTest() {
 	}
 }
 
+// signedSessionMsg returns a message creating a session of the given owner inside the given namespace,
+// signed by a secp256k1 external key to create the session having the given id
+func (suite *KeeperTestSuite) signedSessionMsg(
+	owner sdk.AccAddress, namespace string, sessionID types.SessionID,
+) types.MsgCreateSession {
+	privKey := secp256k1.GenPrivKeySecp256k1([]byte("external key"))
+	pubKey := privKey.PubKey().(secp256k1.PubKeySecp256k1)
+	externalOwner, err := bech32.ConvertAndEncode("cosmos", pubKey.Address())
+	suite.NoError(err)
+
+	msg := types.NewMsgCreateSession(owner, namespace, externalOwner, base64.StdEncoding.EncodeToString(pubKey[:]), "")
+	signature, err := privKey.Sign(msg.GetSessionSignBytes(suite.ctx.ChainID(), sessionID))
+	suite.NoError(err)
+
+	msg.Signature = base64.StdEncoding.EncodeToString(signature)
+	return msg
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -1,14 +1,11 @@
 package keeper
 
 import (
-	"encoding/base64"
 	"fmt"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/cosmos-sdk/x/auth"
-	"github.com/tendermint/tendermint/crypto/secp256k1"
 
 	"github.com/desmos-labs/desmos/x/magpie/types"
 )
@@ -21,6 +18,8 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgCreateSession:
 			return handleMsgCreateSession(ctx, keeper, msg)
+		case types.MsgRevokeSession:
+			return handleMsgRevokeSession(ctx, keeper, msg)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
 				fmt.Sprintf("unrecognized magpie message type: %v", msg.Type()))
@@ -36,42 +35,32 @@ func handleMsgCreateSession(ctx sdk.Context, keeper Keeper, msg types.MsgCreateS
 	// if yes, then continue and emit event
 	// else return error
 
-	// Get the public key used to sign the message
-	pkBytes, err := base64.StdEncoding.DecodeString(msg.PubKey)
+	// Verify the signature using the key type of the message and the id of the session to be created
+	sessionID := keeper.GetLastSessionID(ctx).Next()
+	pubKey, err := verifySessionSignature(ctx, msg, sessionID)
 	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot decode base64 public key")
+		return nil, err
 	}
 
-	var pkBytes33 = [33]byte{}
-	copy(pkBytes33[:], pkBytes)
-	pubkey := secp256k1.PubKeySecp256k1(pkBytes33)
-
-	// Create the signature bytes using the given message  with an empty signature
-	clearMsg := msg
-	clearMsg.Signature = ""
-	signedBytes := auth.StdSignBytes(msg.Namespace, 0, 0, auth.NewStdFee(200000, nil), []sdk.Msg{clearMsg}, "")
-
-	// Decode the signature
-	sig, err := base64.StdEncoding.DecodeString(msg.Signature)
-	if err != nil {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot decode base64 signature")
-	}
-
-	// Verify the signature
-	if !pubkey.VerifyBytes(signedBytes, sig) {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid")
+	// Make sure the owner has not reached the max number of active sessions
+	params := keeper.GetParams(ctx)
+	if uint64(len(keeper.GetOwnerSessions(ctx, msg.Owner, true))) >= params.MaxSessionsPerOwner {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("%s has reached the max number of active sessions: %d", msg.Owner, params.MaxSessionsPerOwner))
 	}
 
 	// Create the session
 	session := types.Session{
-		SessionID:     keeper.GetLastSessionID(ctx).Next(),
+		SessionID:     sessionID,
 		Created:       ctx.BlockHeight(),
-		Expiry:        ctx.BlockHeight() + keeper.GetDefaultSessionLength(ctx),
+		Expiry:        ctx.BlockHeight() + params.SessionLength(msg.Namespace),
 		Owner:         msg.Owner,
 		Namespace:     msg.Namespace,
 		ExternalOwner: msg.ExternalOwner,
-		PubKey:        msg.PubKey,
+		PubKey:        pubKey,
 		Signature:     msg.Signature,
+		KeyType:       msg.KeyType,
+		SpendLimit:    msg.SpendLimit,
 	}
 
 	// Check for any previously existing session
@@ -97,3 +86,33 @@ func handleMsgCreateSession(ctx sdk.Context, keeper Keeper, msg types.MsgCreateS
 	}
 	return &result, nil
 }
+
+// handleMsgRevokeSession handles the revocation of a session performed by its owner
+func handleMsgRevokeSession(ctx sdk.Context, keeper Keeper, msg types.MsgRevokeSession) (*sdk.Result, error) {
+	session, found := keeper.GetSession(ctx, msg.SessionID)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("session with id %s not found", msg.SessionID))
+	}
+
+	if !session.Owner.Equals(msg.Owner) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("%s is not the owner of the session with id %s", msg.Owner, msg.SessionID))
+	}
+
+	keeper.DeleteSession(ctx, session)
+
+	revokeSessionEvent := sdk.NewEvent(
+		types.EventTypeRevokeSession,
+		sdk.NewAttribute(types.AttributeKeySessionID, session.SessionID.String()),
+		sdk.NewAttribute(types.AttributeKeyOwner, session.Owner.String()),
+		sdk.NewAttribute(types.AttributeKeyNamespace, session.Namespace),
+		sdk.NewAttribute(types.AttributeKeyExternalOwner, session.ExternalOwner),
+	)
+	ctx.EventManager().EmitEvent(revokeSessionEvent)
+
+	result := sdk.Result{
+		Data:   []byte(fmt.Sprintf("session with id %s revoked correctly", session.SessionID)),
+		Events: ctx.EventManager().Events(),
+	}
+	return &result, nil
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -40,13 +41,7 @@ func (suite *KeeperTestSuite) Test_handleMsgCreateSession() {
 		},
 		{
 			name: "Valid signature works properly",
-			msg: types.MsgCreateSession{
-				Owner:         owner,
-				Namespace:     "cosmoshub-2",
-				ExternalOwner: "cosmos1m5gfj4t5ddksytl65mmv7lfg5nef3etmrnl8a0",
-				PubKey:        "ArDhBMh0X/3Akfc58oF1zFE00L/rLpgMMVvmcj0QlaN1",
-				Signature:     "3KXX5DmlsDAyO0pmgDT3pTyyuTfGr9ocJCOcaPwZDilAiwAp6U9egpHr1qOtx4dLLrtIVWE8npHK49BKKyyacg==",
-			},
+			msg:  suite.signedSessionMsg(owner, "cosmoshub-2", types.SessionID(1)),
 		},
 	}
 
@@ -54,8 +49,7 @@ func (suite *KeeperTestSuite) Test_handleMsgCreateSession() {
 		test := test
 		suite.Run(test.name, func() {
 			sessionLength := int64(240)
-			err := suite.keeper.SetDefaultSessionLength(suite.ctx, sessionLength)
-			suite.NoError(err)
+			suite.keeper.SetParams(suite.ctx, types.NewParams(sessionLength, nil, 10))
 
 			handler := keeper.NewHandler(suite.keeper)
 			res, err := handler(suite.ctx, test.msg)
@@ -101,3 +95,146 @@ func (suite *KeeperTestSuite) Test_handleMsgCreateSession() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) Test_handleMsgCreateSession_Params() {
+	owner, err := sdk.AccAddressFromBech32("cosmos1m5gfj4t5ddksytl65mmv7lfg5nef3etmrnl8a0")
+	suite.NoError(err)
+
+	tests := []struct {
+		name           string
+		params         types.Params
+		storedSessions types.Sessions
+		expExpiry      int64
+		expErr         error
+	}{
+		{
+			name:      "Default session length is used when no namespace length is set",
+			params:    types.NewParams(240, types.NamespaceSessionLengths{types.NewNamespaceSessionLength("cosmos", 10)}, 1),
+			expExpiry: 250,
+		},
+		{
+			name:      "Namespace session length overrides the default one",
+			params:    types.NewParams(240, types.NamespaceSessionLengths{types.NewNamespaceSessionLength("cosmoshub-2", 50)}, 1),
+			expExpiry: 60,
+		},
+		{
+			name:   "Max sessions per owner reached returns error",
+			params: types.NewParams(240, nil, 1),
+			storedSessions: types.Sessions{
+				types.Session{SessionID: types.SessionID(1), Owner: owner, Created: 5, Expiry: 20},
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("%s has reached the max number of active sessions: 1", owner)),
+		},
+		{
+			name:   "Expired sessions are not counted against the max sessions per owner",
+			params: types.NewParams(240, nil, 1),
+			storedSessions: types.Sessions{
+				types.Session{SessionID: types.SessionID(1), Owner: owner, Created: 5, Expiry: 10},
+			},
+			expExpiry: 250,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.ctx = suite.ctx.WithBlockHeight(10)
+			suite.keeper.SetParams(suite.ctx, test.params)
+			for _, session := range test.storedSessions {
+				suite.keeper.SaveSession(suite.ctx, session)
+			}
+
+			msg := suite.signedSessionMsg(owner, "cosmoshub-2", suite.keeper.GetLastSessionID(suite.ctx).Next())
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, msg)
+
+			if test.expErr != nil {
+				suite.Nil(res)
+				suite.Equal(test.expErr.Error(), err.Error())
+				return
+			}
+
+			suite.NoError(err)
+			session, found := suite.keeper.GetSession(suite.ctx, suite.keeper.GetLastSessionID(suite.ctx))
+			suite.True(found)
+			suite.Equal(test.expExpiry, session.Expiry)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgRevokeSession() {
+	otherOwner, err := sdk.AccAddressFromBech32("cosmos1m5gfj4t5ddksytl65mmv7lfg5nef3etmrnl8a0")
+	suite.NoError(err)
+
+	session := types.NewSession(
+		types.SessionID(1),
+		suite.testData.owner,
+		10,
+		15,
+		"cosmos",
+		"cosmos1m5gfj4t5ddksytl65mmv7lfg5nef3etmrnl8a0",
+		"ArDhBMh0X/3Akfc58oF1zFE00L/rLpgMMVvmcj0QlaN1",
+		"3KXX5DmlsDAyO0pmgDT3pTyyuTfGr9ocJCOcaPwZDilAiwAp6U9egpHr1qOtx4dLLrtIVWE8npHK49BKKyyacg==",
+	)
+
+	tests := []struct {
+		name   string
+		msg    types.MsgRevokeSession
+		expErr error
+	}{
+		{
+			name:   "Non existing session returns error",
+			msg:    types.NewMsgRevokeSession(types.SessionID(2), suite.testData.owner),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "session with id 2 not found"),
+		},
+		{
+			name: "Session of another owner returns error",
+			msg:  types.NewMsgRevokeSession(types.SessionID(1), otherOwner),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				fmt.Sprintf("%s is not the owner of the session with id 1", otherOwner)),
+		},
+		{
+			name: "Session is revoked properly",
+			msg:  types.NewMsgRevokeSession(types.SessionID(1), suite.testData.owner),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.keeper.SaveSession(suite.ctx, session)
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expErr != nil {
+				suite.Nil(res)
+				suite.Equal(test.expErr.Error(), err.Error())
+
+				_, found := suite.keeper.GetSession(suite.ctx, session.SessionID)
+				suite.True(found)
+				return
+			}
+
+			suite.NoError(err)
+			suite.Equal([]byte("session with id 1 revoked correctly"), res.Data)
+
+			revokeEvent := sdk.NewEvent(
+				types.EventTypeRevokeSession,
+				sdk.NewAttribute(types.AttributeKeySessionID, session.SessionID.String()),
+				sdk.NewAttribute(types.AttributeKeyOwner, session.Owner.String()),
+				sdk.NewAttribute(types.AttributeKeyNamespace, session.Namespace),
+				sdk.NewAttribute(types.AttributeKeyExternalOwner, session.ExternalOwner),
+			)
+			suite.Len(res.Events, 1)
+			suite.Contains(res.Events, revokeEvent)
+
+			_, found := suite.keeper.GetSession(suite.ctx, session.SessionID)
+			suite.False(found)
+			suite.Empty(suite.keeper.GetExpiredSessions(suite.ctx, session.Expiry))
+		})
+	}
+}
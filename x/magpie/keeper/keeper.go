@@ -1,10 +1,9 @@
 package keeper
 
 import (
-	"fmt"
-
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/subspace"
 	"github.com/desmos-labs/desmos/x/magpie/types"
 )
 
@@ -12,42 +11,22 @@ import (
 type Keeper struct {
 	StoreKey sdk.StoreKey // Unexposed key to access store from sdk.Context
 	Cdc      *codec.Codec // The wire codec for binary encoding/decoding.
-}
 
-// NewKeeper creates new instances of the magpie Keeper
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey) Keeper {
-	return Keeper{
-		StoreKey: storeKey,
-		Cdc:      cdc,
-	}
+	// The reference to the ParamsStore to get and set magpie specific params
+	paramSubspace params.Subspace
 }
 
-// -------------
-// --- Params
-// -------------
-
-// SetDefaultSessionLength allows to set a default session length for new magpie sessions.
-// The specified length is intended to be in number of blocks.
-func (k Keeper) SetDefaultSessionLength(ctx sdk.Context, length int64) error {
-	if length < 1 {
-		return fmt.Errorf("cannot set %d as default session length", length)
+// NewKeeper creates new instances of the magpie Keeper
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
-	store := ctx.KVStore(k.StoreKey)
-	store.Set(types.SessionLengthKey, k.Cdc.MustMarshalBinaryBare(length))
-	return nil
-}
-
-// GetDefaultSessionLength returns the default session length in number of blocks.
-func (k Keeper) GetDefaultSessionLength(ctx sdk.Context) int64 {
-	store := ctx.KVStore(k.StoreKey)
-
-	length := int64(0)
-	if store.Has(types.SessionLengthKey) {
-		k.Cdc.MustUnmarshalBinaryBare(store.Get(types.SessionLengthKey), &length)
+	return Keeper{
+		StoreKey:      storeKey,
+		Cdc:           cdc,
+		paramSubspace: paramSpace,
 	}
-
-	return length
 }
 
 // -------------
@@ -72,15 +51,39 @@ func (k Keeper) SetLastSessionID(ctx sdk.Context, id types.SessionID) {
 	store.Set(types.LastSessionIDStoreKey, k.Cdc.MustMarshalBinaryBare(&id))
 }
 
-// SaveSession allows to save a session inside the given context.
+// SaveSession allows to save a session inside the given context, inserting it inside the expiry queue
+// and the owner, namespace and external owner indexes.
 // It assumes the given session has already been validated.
 func (k Keeper) SaveSession(ctx sdk.Context, session types.Session) {
-	// Save the session
 	store := ctx.KVStore(k.StoreKey)
+
+	// Remove the existing session from the expiry queue and the indexes, as its data might have changed
+	if existing, found := k.GetSession(ctx, session.SessionID); found {
+		k.deleteSessionIndexes(ctx, existing)
+	}
+
+	// Save the session
 	store.Set(types.SessionStoreKey(session.SessionID), k.Cdc.MustMarshalBinaryBare(session))
 
-	// Update the last used session id
-	k.SetLastSessionID(ctx, session.SessionID)
+	idBz := k.Cdc.MustMarshalBinaryBare(session.SessionID)
+	store.Set(types.ExpiryQueueStoreKey(session.Expiry, session.SessionID), idBz)
+	store.Set(types.OwnerSessionStoreKey(session.Owner, session.SessionID), idBz)
+	store.Set(types.NamespaceSessionStoreKey(session.Namespace, session.SessionID), idBz)
+	store.Set(types.ExternalOwnerSessionStoreKey(session.Namespace, session.ExternalOwner, session.SessionID), idBz)
+
+	// Update the last used session id, making sure that re-saving an older session does not move it back
+	if session.SessionID > k.GetLastSessionID(ctx) {
+		k.SetLastSessionID(ctx, session.SessionID)
+	}
+}
+
+// deleteSessionIndexes removes the expiry queue entry and the index entries of the given session
+func (k Keeper) deleteSessionIndexes(ctx sdk.Context, session types.Session) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Delete(types.ExpiryQueueStoreKey(session.Expiry, session.SessionID))
+	store.Delete(types.OwnerSessionStoreKey(session.Owner, session.SessionID))
+	store.Delete(types.NamespaceSessionStoreKey(session.Namespace, session.SessionID))
+	store.Delete(types.ExternalOwnerSessionStoreKey(session.Namespace, session.ExternalOwner, session.SessionID))
 }
 
 // GetSession returns the session having the specified id
@@ -112,3 +115,82 @@ func (k Keeper) GetSessions(ctx sdk.Context) types.Sessions {
 
 	return sessions
 }
+
+// DeleteSession removes the given session from the given context, along with its expiry queue and index entries
+func (k Keeper) DeleteSession(ctx sdk.Context, session types.Session) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Delete(types.SessionStoreKey(session.SessionID))
+	k.deleteSessionIndexes(ctx, session)
+}
+
+// GetExpiredSessions returns the sessions that expire at or before the given block height, sorted by expiration
+func (k Keeper) GetExpiredSessions(ctx sdk.Context, height int64) types.Sessions {
+	store := ctx.KVStore(k.StoreKey)
+	end := sdk.PrefixEndBytes(types.ExpiryQueueHeightPrefix(height))
+	iterator := store.Iterator(types.ExpiryQueuePrefix, end)
+	defer iterator.Close()
+
+	sessions := make(types.Sessions, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var id types.SessionID
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &id)
+
+		if session, found := k.GetSession(ctx, id); found {
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions
+}
+
+// PruneExpiredSessions deletes all the sessions that expire at or before the given block height,
+// returning the deleted ones
+func (k Keeper) PruneExpiredSessions(ctx sdk.Context, height int64) types.Sessions {
+	expired := k.GetExpiredSessions(ctx, height)
+	for _, session := range expired {
+		k.DeleteSession(ctx, session)
+	}
+	return expired
+}
+
+// getIndexedSessions returns the sessions referenced by the index entries having the given prefix, sorted by id.
+// If activeOnly is true, only the sessions that are still active at the current block height are returned
+func (k Keeper) getIndexedSessions(ctx sdk.Context, prefix []byte, activeOnly bool) types.Sessions {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	sessions := make(types.Sessions, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var id types.SessionID
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &id)
+
+		session, found := k.GetSession(ctx, id)
+		if !found || (activeOnly && !session.IsActive(ctx.BlockHeight())) {
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions
+}
+
+// GetOwnerSessions returns the sessions of the given owner, sorted by id.
+// If activeOnly is true, only the active sessions are returned
+func (k Keeper) GetOwnerSessions(ctx sdk.Context, owner sdk.AccAddress, activeOnly bool) types.Sessions {
+	return k.getIndexedSessions(ctx, types.OwnerSessionsPrefix(owner), activeOnly)
+}
+
+// GetNamespaceSessions returns the sessions created inside the given namespace, sorted by id.
+// If activeOnly is true, only the active sessions are returned
+func (k Keeper) GetNamespaceSessions(ctx sdk.Context, namespace string, activeOnly bool) types.Sessions {
+	return k.getIndexedSessions(ctx, types.NamespaceSessionsPrefix(namespace), activeOnly)
+}
+
+// GetExternalOwnerSessions returns the sessions of the given external owner inside the given namespace, sorted by id.
+// If activeOnly is true, only the active sessions are returned
+func (k Keeper) GetExternalOwnerSessions(
+	ctx sdk.Context, namespace, externalOwner string, activeOnly bool,
+) types.Sessions {
+	return k.getIndexedSessions(ctx, types.ExternalOwnerSessionsPrefix(namespace, externalOwner), activeOnly)
+}
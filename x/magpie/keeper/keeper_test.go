@@ -2,74 +2,12 @@ package keeper_test
 
 import (
 	"fmt"
-	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/desmos-labs/desmos/x/magpie/types"
 )
 
-func (suite *KeeperTestSuite) TestKeeper_SetDefaultSessionLength() {
-	tests := []struct {
-		length int64
-		expErr error
-	}{
-		{
-			length: -1,
-			expErr: fmt.Errorf("cannot set -1 as default session length"),
-		},
-		{
-			length: 0,
-			expErr: fmt.Errorf("cannot set 0 as default session length"),
-		},
-		{
-			length: 1,
-			expErr: nil,
-		},
-		{
-			length: math.MaxInt64,
-			expErr: nil,
-		},
-	}
-
-	for _, test := range tests {
-		test := test
-
-		suite.Run(fmt.Sprintf("Default session length: %d", test.length), func() {
-			suite.SetupTest() // reset
-			err := suite.keeper.SetDefaultSessionLength(suite.ctx, test.length)
-
-			if test.expErr == nil {
-				suite.NoError(err)
-				var stored int64
-				store := suite.ctx.KVStore(suite.keeper.StoreKey)
-				suite.keeper.Cdc.MustUnmarshalBinaryBare(store.Get(types.SessionLengthKey), &stored)
-				suite.Equal(test.length, stored)
-			}
-
-			if test.expErr != nil {
-				suite.Equal(test.expErr, err)
-			}
-		})
-	}
-}
-
-func (suite *KeeperTestSuite) TestKeeper_GetDefaultSessionLength() {
-	tests := []int64{0, 1, 2, math.MaxInt64}
-
-	for _, length := range tests {
-		length := length
-		suite.Run(fmt.Sprintf("Get default session length: %d", length), func() {
-			suite.SetupTest() // reset
-			store := suite.ctx.KVStore(suite.keeper.StoreKey)
-			if length != 0 {
-				store.Set(types.SessionLengthKey, suite.keeper.Cdc.MustMarshalBinaryBare(&length))
-			}
-
-			recovered := suite.keeper.GetDefaultSessionLength(suite.ctx)
-			suite.Equal(length, recovered)
-		})
-	}
-}
-
 func (suite *KeeperTestSuite) TestKeeper_GetLastSessionID() {
 	tests := []struct {
 		name       string
@@ -141,6 +79,120 @@ func (suite *KeeperTestSuite) TestKeeper_SaveSession() {
 	var storedLastID types.SessionID
 	suite.keeper.Cdc.MustUnmarshalBinaryBare(store.Get(types.LastSessionIDStoreKey), &storedLastID)
 	suite.Equal(session.SessionID, storedLastID)
+
+	suite.True(store.Has(types.ExpiryQueueStoreKey(session.Expiry, session.SessionID)))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SaveSession_olderSessionKeepsLastID() {
+	first := types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(1), Created: 10, Expiry: 15}
+	second := types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(2), Created: 10, Expiry: 15}
+	suite.keeper.SaveSession(suite.ctx, first)
+	suite.keeper.SaveSession(suite.ctx, second)
+
+	// Re-saving an older session, as done when a session transaction increments its sequence,
+	// must not move the last session id back
+	first.Sequence++
+	suite.keeper.SaveSession(suite.ctx, first)
+
+	suite.Equal(types.SessionID(2), suite.keeper.GetLastSessionID(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SaveSession_updatesExpiryQueue() {
+	session := types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(1), Created: 10, Expiry: 15}
+	suite.keeper.SaveSession(suite.ctx, session)
+
+	session.Expiry = 20
+	suite.keeper.SaveSession(suite.ctx, session)
+
+	store := suite.ctx.KVStore(suite.keeper.StoreKey)
+	suite.False(store.Has(types.ExpiryQueueStoreKey(15, session.SessionID)))
+	suite.True(store.Has(types.ExpiryQueueStoreKey(20, session.SessionID)))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SaveSession_updatesIndexes() {
+	otherOwner, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	suite.NoError(err)
+
+	session := types.Session{
+		Owner:         suite.testData.owner,
+		SessionID:     types.SessionID(1),
+		Created:       10,
+		Expiry:        15,
+		Namespace:     "cosmos",
+		ExternalOwner: "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+	}
+	suite.keeper.SaveSession(suite.ctx, session)
+
+	updated := session
+	updated.Owner = otherOwner
+	updated.Namespace = "desmos"
+	suite.keeper.SaveSession(suite.ctx, updated)
+
+	store := suite.ctx.KVStore(suite.keeper.StoreKey)
+	suite.False(store.Has(types.OwnerSessionStoreKey(session.Owner, session.SessionID)))
+	suite.False(store.Has(types.NamespaceSessionStoreKey(session.Namespace, session.SessionID)))
+	suite.False(store.Has(types.ExternalOwnerSessionStoreKey(session.Namespace, session.ExternalOwner, session.SessionID)))
+
+	suite.True(store.Has(types.OwnerSessionStoreKey(updated.Owner, updated.SessionID)))
+	suite.True(store.Has(types.NamespaceSessionStoreKey(updated.Namespace, updated.SessionID)))
+	suite.True(store.Has(types.ExternalOwnerSessionStoreKey(updated.Namespace, updated.ExternalOwner, updated.SessionID)))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteSession() {
+	session := types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(1), Created: 10, Expiry: 15}
+	suite.keeper.SaveSession(suite.ctx, session)
+
+	suite.keeper.DeleteSession(suite.ctx, session)
+
+	_, found := suite.keeper.GetSession(suite.ctx, session.SessionID)
+	suite.False(found)
+
+	store := suite.ctx.KVStore(suite.keeper.StoreKey)
+	suite.False(store.Has(types.ExpiryQueueStoreKey(session.Expiry, session.SessionID)))
+	suite.False(store.Has(types.OwnerSessionStoreKey(session.Owner, session.SessionID)))
+	suite.False(store.Has(types.NamespaceSessionStoreKey(session.Namespace, session.SessionID)))
+	suite.False(store.Has(types.ExternalOwnerSessionStoreKey(session.Namespace, session.ExternalOwner, session.SessionID)))
+	suite.Equal(session.SessionID, suite.keeper.GetLastSessionID(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetExpiredSessions() {
+	sessions := types.Sessions{
+		types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(1), Created: 10, Expiry: 300},
+		types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(2), Created: 10, Expiry: 15},
+		types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(3), Created: 10, Expiry: 20},
+		types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(4), Created: 10, Expiry: 15},
+	}
+	for _, session := range sessions {
+		suite.keeper.SaveSession(suite.ctx, session)
+	}
+
+	tests := []struct {
+		height      int64
+		expSessions types.Sessions
+	}{
+		{height: 14, expSessions: types.Sessions{}},
+		{height: 15, expSessions: types.Sessions{sessions[1], sessions[3]}},
+		{height: 299, expSessions: types.Sessions{sessions[1], sessions[3], sessions[2]}},
+		{height: 300, expSessions: types.Sessions{sessions[1], sessions[3], sessions[2], sessions[0]}},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(fmt.Sprintf("Expired sessions at height %d", test.height), func() {
+			suite.Equal(test.expSessions, suite.keeper.GetExpiredSessions(suite.ctx, test.height))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_PruneExpiredSessions() {
+	expired := types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(1), Created: 10, Expiry: 15}
+	active := types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(2), Created: 10, Expiry: 16}
+	suite.keeper.SaveSession(suite.ctx, expired)
+	suite.keeper.SaveSession(suite.ctx, active)
+
+	suite.Equal(types.Sessions{expired}, suite.keeper.PruneExpiredSessions(suite.ctx, 15))
+	suite.Equal(types.Sessions{active}, suite.keeper.GetSessions(suite.ctx))
+	suite.Equal(types.Sessions{}, suite.keeper.GetExpiredSessions(suite.ctx, 15))
 }
 
 func (suite *KeeperTestSuite) TestKeeper_GetSession() {
@@ -230,3 +282,56 @@ func (suite *KeeperTestSuite) TestKeeper_GetSessions() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestKeeper_GetIndexedSessions() {
+	otherOwner, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	suite.NoError(err)
+
+	sessions := types.Sessions{
+		types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(1), Created: 10, Expiry: 15,
+			Namespace: "cosmos", ExternalOwner: "cosmos1a"},
+		types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(2), Created: 10, Expiry: 300,
+			Namespace: "cosmos", ExternalOwner: "cosmos1b"},
+		types.Session{Owner: otherOwner, SessionID: types.SessionID(3), Created: 10, Expiry: 300,
+			Namespace: "cosmos", ExternalOwner: "cosmos1a"},
+		types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(4), Created: 10, Expiry: 300,
+			Namespace: "cosmo", ExternalOwner: "scosmos1a"},
+	}
+	for _, session := range sessions {
+		suite.keeper.SaveSession(suite.ctx, session)
+	}
+
+	ctx := suite.ctx.WithBlockHeight(20)
+
+	suite.Run("owner sessions", func() {
+		suite.Equal(
+			types.Sessions{sessions[0], sessions[1], sessions[3]},
+			suite.keeper.GetOwnerSessions(ctx, suite.testData.owner, false),
+		)
+		suite.Equal(
+			types.Sessions{sessions[1], sessions[3]},
+			suite.keeper.GetOwnerSessions(ctx, suite.testData.owner, true),
+		)
+	})
+
+	suite.Run("namespace sessions", func() {
+		suite.Equal(types.Sessions{sessions[0], sessions[1], sessions[2]}, suite.keeper.GetNamespaceSessions(ctx, "cosmos", false))
+		suite.Equal(types.Sessions{sessions[1], sessions[2]}, suite.keeper.GetNamespaceSessions(ctx, "cosmos", true))
+		suite.Equal(types.Sessions{}, suite.keeper.GetNamespaceSessions(ctx, "desmos", false))
+	})
+
+	suite.Run("external owner sessions", func() {
+		suite.Equal(
+			types.Sessions{sessions[0], sessions[2]},
+			suite.keeper.GetExternalOwnerSessions(ctx, "cosmos", "cosmos1a", false),
+		)
+		suite.Equal(
+			types.Sessions{sessions[2]},
+			suite.keeper.GetExternalOwnerSessions(ctx, "cosmos", "cosmos1a", true),
+		)
+		suite.Equal(
+			types.Sessions{sessions[3]},
+			suite.keeper.GetExternalOwnerSessions(ctx, "cosmo", "scosmos1a", false),
+		)
+	})
+}
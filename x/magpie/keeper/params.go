@@ -0,0 +1,22 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/magpie/types"
+)
+
+// SetParams sets params on the store
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSubspace.SetParamSet(ctx, &params)
+}
+
+// GetParams returns the params from the store
+func (k Keeper) GetParams(ctx sdk.Context) (p types.Params) {
+	k.paramSubspace.GetParamSet(ctx, &p)
+	return p
+}
+
+// GetSessionLength returns the length, in number of blocks, of the sessions created inside the given namespace
+func (k Keeper) GetSessionLength(ctx sdk.Context, namespace string) int64 {
+	return k.GetParams(ctx).SessionLength(namespace)
+}
@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	"github.com/desmos-labs/desmos/x/magpie/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SetParams() {
+	params := types.NewParams(100, types.NamespaceSessionLengths{
+		types.NewNamespaceSessionLength("cosmos", 50),
+		types.NewNamespaceSessionLength("ethereum", 200),
+	}, 5)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	actualParams := suite.keeper.GetParams(suite.ctx)
+
+	suite.Equal(params, actualParams)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetParams() {
+	params := types.DefaultParams()
+	suite.keeper.SetParams(suite.ctx, params)
+
+	actualParams := suite.keeper.GetParams(suite.ctx)
+
+	suite.Equal(params, actualParams)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetSessionLength() {
+	suite.keeper.SetParams(suite.ctx, types.NewParams(100, types.NamespaceSessionLengths{
+		types.NewNamespaceSessionLength("cosmos", 50),
+	}, 5))
+
+	suite.Equal(int64(50), suite.keeper.GetSessionLength(suite.ctx, "cosmos"))
+	suite.Equal(int64(100), suite.keeper.GetSessionLength(suite.ctx, "ethereum"))
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,20 +12,22 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-// query endpoints supported by the magpie Querier
-const (
-	QuerySessions = "sessions"
-)
-
-// Params for queries:
-// - 'custom/magpie/sessions'
-
 // NewQuerier is the module level router for state queries
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err error) {
 		switch path[0] {
-		case QuerySessions:
+		case types.QuerySessions:
 			return querySession(ctx, path[1:], req, keeper)
+		case types.QueryOwnerSessions:
+			return queryOwnerSessions(ctx, path[1:], req, keeper)
+		case types.QueryNamespaceSessions:
+			return queryNamespaceSessions(ctx, path[1:], req, keeper)
+		case types.QueryExternalOwnerSessions:
+			return queryExternalOwnerSessions(ctx, path[1:], req, keeper)
+		case types.QueryParams:
+			return queryParams(ctx, req, keeper)
+		case types.QueryNextSessionID:
+			return queryNextSessionID(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown magpie query endpoint")
 		}
@@ -50,3 +54,120 @@ func querySession(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Ke
 
 	return res, nil
 }
+
+// parseSessionsParams reads the params used to filter and paginate the sessions from the given request
+func parseSessionsParams(req abci.RequestQuery, keeper Keeper) (types.QuerySessionsParams, error) {
+	var params types.QuerySessionsParams
+	if len(req.Data) != 0 {
+		if err := keeper.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return params, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	// Default page
+	if params.Page == 0 {
+		params.Page = 1
+	}
+
+	return params, nil
+}
+
+// marshalSessions paginates the given sessions using the given params and marshals them
+func marshalSessions(sessions types.Sessions, params types.QuerySessionsParams, keeper Keeper) ([]byte, error) {
+	start, end := client.Paginate(len(sessions), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		sessions = types.Sessions{}
+	} else {
+		sessions = sessions[start:end]
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc, &sessions)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryOwnerSessions allows to return the sessions of the given owner
+// Query path: custom/magpie/owner_sessions/{address}
+func queryOwnerSessions(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	owner, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid owner address: %s", path[0]))
+	}
+
+	params, err := parseSessionsParams(req, keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalSessions(keeper.GetOwnerSessions(ctx, owner, params.ActiveOnly), params, keeper)
+}
+
+// queryNamespaceSessions allows to return the sessions created inside the given namespace
+// Query path: custom/magpie/namespace_sessions/{namespace}
+func queryNamespaceSessions(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	namespace := strings.TrimSpace(path[0])
+	if len(namespace) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid namespace")
+	}
+
+	params, err := parseSessionsParams(req, keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalSessions(keeper.GetNamespaceSessions(ctx, namespace, params.ActiveOnly), params, keeper)
+}
+
+// queryExternalOwnerSessions allows to return the sessions of the given external owner inside the given namespace
+// Query path: custom/magpie/external_owner_sessions/{namespace}/{external_owner}
+func queryExternalOwnerSessions(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing namespace or external owner")
+	}
+
+	namespace, externalOwner := strings.TrimSpace(path[0]), strings.TrimSpace(path[1])
+	if len(namespace) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid namespace")
+	}
+	if len(externalOwner) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid external owner")
+	}
+
+	params, err := parseSessionsParams(req, keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := keeper.GetExternalOwnerSessions(ctx, namespace, externalOwner, params.ActiveOnly)
+	return marshalSessions(sessions, params, keeper)
+}
+
+// queryNextSessionID handles the request of the id that will be assigned to the next session,
+// which must be signed by the external key when creating it
+// Query path: custom/magpie/next_session_id
+func queryNextSessionID(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	nextID := keeper.GetLastSessionID(ctx).Next()
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc, &nextID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryParams handles the request of listing the magpie params
+// Query path: custom/magpie/params
+func queryParams(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	params := keeper.GetParams(ctx)
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
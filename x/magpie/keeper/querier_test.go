@@ -24,18 +24,18 @@ func (suite *KeeperTestSuite) Test_querySession_InvalidIdReturnsError() {
 	}{
 		{
 			name:   "Not found session returns error",
-			query:  []string{keeper.QuerySessions, types.SessionID(50).String()},
+			query:  []string{types.QuerySessions, types.SessionID(50).String()},
 			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "session with id 50 not found"),
 		},
 		{
 			name:          "Existing session is returned",
 			storedSession: suite.testData.session,
-			query:         []string{keeper.QuerySessions, suite.testData.session.SessionID.String()},
+			query:         []string{types.QuerySessions, suite.testData.session.SessionID.String()},
 			expRes:        suite.testData.session,
 		},
 		{
 			name:   "Invalid id",
-			query:  []string{keeper.QuerySessions, "invalid-id"},
+			query:  []string{types.QuerySessions, "invalid-id"},
 			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid session id: invalid-id"),
 		},
 		{
@@ -73,3 +73,132 @@ func (suite *KeeperTestSuite) Test_querySession_InvalidIdReturnsError() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) Test_queryIndexedSessions() {
+	sessions := types.Sessions{
+		types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(1), Created: 10, Expiry: 15,
+			Namespace: "cosmos", ExternalOwner: "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"},
+		types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(2), Created: 10, Expiry: 300,
+			Namespace: "cosmos", ExternalOwner: "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"},
+		types.Session{Owner: suite.testData.owner, SessionID: types.SessionID(3), Created: 10, Expiry: 300,
+			Namespace: "desmos", ExternalOwner: "desmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"},
+	}
+
+	tests := []struct {
+		name   string
+		query  []string
+		params *types.QuerySessionsParams
+		expErr error
+		expRes types.Sessions
+	}{
+		{
+			name:   "Invalid owner address returns error",
+			query:  []string{types.QueryOwnerSessions, "cosmos1"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid owner address: cosmos1"),
+		},
+		{
+			name:   "Owner sessions are returned",
+			query:  []string{types.QueryOwnerSessions, suite.testData.owner.String()},
+			expRes: sessions,
+		},
+		{
+			name:   "Active owner sessions are paginated",
+			query:  []string{types.QueryOwnerSessions, suite.testData.owner.String()},
+			params: &types.QuerySessionsParams{ActiveOnly: true, Page: 2, Limit: 1},
+			expRes: types.Sessions{sessions[2]},
+		},
+		{
+			name:   "Page out of range returns empty sessions",
+			query:  []string{types.QueryOwnerSessions, suite.testData.owner.String()},
+			params: &types.QuerySessionsParams{Page: 5, Limit: 1},
+			expRes: types.Sessions{},
+		},
+		{
+			name:   "Empty namespace returns error",
+			query:  []string{types.QueryNamespaceSessions, " "},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid namespace"),
+		},
+		{
+			name:   "Active namespace sessions are returned",
+			query:  []string{types.QueryNamespaceSessions, "cosmos"},
+			params: &types.QuerySessionsParams{ActiveOnly: true},
+			expRes: types.Sessions{sessions[1]},
+		},
+		{
+			name:   "Missing external owner returns error",
+			query:  []string{types.QueryExternalOwnerSessions, "cosmos"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing namespace or external owner"),
+		},
+		{
+			name:   "Empty external owner returns error",
+			query:  []string{types.QueryExternalOwnerSessions, "cosmos", ""},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid external owner"),
+		},
+		{
+			name:   "External owner sessions are returned",
+			query:  []string{types.QueryExternalOwnerSessions, "desmos", "desmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"},
+			expRes: types.Sessions{sessions[2]},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			for _, session := range sessions {
+				suite.keeper.SaveSession(suite.ctx, session)
+			}
+
+			req := abci.RequestQuery{}
+			if test.params != nil {
+				req.Data = suite.keeper.Cdc.MustMarshalJSON(test.params)
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx.WithBlockHeight(20), test.query, req)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
+			} else {
+				suite.NoError(err)
+
+				expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expRes)
+				suite.NoError(err)
+				suite.Equal(string(expectedIndented), string(result))
+			}
+		})
+	}
+}
+
+// ----------------------------------
+// --- Params
+// ----------------------------------
+
+func (suite *KeeperTestSuite) Test_queryNextSessionID() {
+	querier := keeper.NewQuerier(suite.keeper)
+	result, err := querier(suite.ctx, []string{types.QueryNextSessionID}, request)
+	suite.NoError(err)
+	suite.Equal(`"1"`, string(result))
+
+	suite.keeper.SaveSession(suite.ctx, suite.testData.session)
+	result, err = querier(suite.ctx, []string{types.QueryNextSessionID}, request)
+	suite.NoError(err)
+	suite.Equal(`"2"`, string(result))
+}
+
+func (suite *KeeperTestSuite) Test_queryParams() {
+	params := types.NewParams(100, types.NamespaceSessionLengths{
+		types.NewNamespaceSessionLength("cosmos", 50),
+	}, 5)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	querier := keeper.NewQuerier(suite.keeper)
+	result, err := querier(suite.ctx, []string{types.QueryParams}, request)
+	suite.NoError(err)
+
+	expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &params)
+	suite.NoError(err)
+	suite.Equal(string(expectedIndented), string(result))
+}
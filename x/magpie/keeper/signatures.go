@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/bech32"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/desmos-labs/desmos/x/magpie/types"
+)
+
+// verifySessionSignature checks that the signature of the given message has been created by its external owner
+// to create the session having the given id, returning the base64 encoded public key associated to the session
+func verifySessionSignature(ctx sdk.Context, msg types.MsgCreateSession, sessionID types.SessionID) (string, error) {
+	signBytes := msg.GetSessionSignBytes(ctx.ChainID(), sessionID)
+	pubKey, err := verifySignature(msg.KeyType, msg.PubKey, msg.ExternalOwner, signBytes, msg.Signature)
+	if err != nil {
+		return "", err
+	}
+
+	return pubKey, verifyExternalOwner(msg.KeyType, pubKey, msg.ExternalOwner)
+}
+
+// VerifySessionTxSignature checks that the given transaction has been signed by the external key of the given session,
+// using its current sequence
+func VerifySessionTxSignature(ctx sdk.Context, session types.Session, tx types.SessionTx) error {
+	signBytes := tx.GetSignBytes(ctx.ChainID(), session.Sequence)
+	_, err := verifySignature(session.KeyType, session.PubKey, session.ExternalOwner, signBytes, tx.Signature)
+	return err
+}
+
+// verifySignature checks that the given signature of the given bytes has been created by the key having the given type,
+// public key and external address, returning the base64 encoded public key of the signer
+func verifySignature(keyType types.KeyType, pubKey, externalOwner string, signBytes []byte, signature string) (string, error) {
+	switch keyType.OrDefault() {
+	case types.KeyTypeEd25519:
+		return pubKey, verifyEd25519Signature(pubKey, signBytes, signature)
+	case types.KeyTypeEthereum:
+		return verifyEthereumSignature(pubKey, externalOwner, signBytes, signature)
+	default:
+		return pubKey, verifySecp256k1Signature(pubKey, signBytes, signature)
+	}
+}
+
+// verifyExternalOwner checks that the given external owner is the address of the given public key having the given type.
+// Secp256k1 and ed25519 external owners must be bech32 encoded: any human readable part is accepted, so that
+// the address can use either the prefix of the session namespace or the Desmos one.
+// Ethereum external owners are already checked while recovering the public key from the signature
+func verifyExternalOwner(keyType types.KeyType, pubKey, externalOwner string) error {
+	var pubkey crypto.PubKey
+	var err error
+	switch keyType.OrDefault() {
+	case types.KeyTypeEthereum:
+		return nil
+	case types.KeyTypeEd25519:
+		pubkey, err = decodeEd25519PubKey(pubKey)
+	default:
+		pubkey, err = decodeSecp256k1PubKey(pubKey)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, address, err := bech32.DecodeAndConvert(externalOwner)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid external owner: %s", externalOwner))
+	}
+
+	if !bytes.Equal(address, pubkey.Address()) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the public key does not belong to the external owner")
+	}
+
+	return nil
+}
+
+// decodeSecp256k1PubKey returns the secp256k1 public key having the given base64 encoding
+func decodeSecp256k1PubKey(pubKey string) (secp256k1.PubKeySecp256k1, error) {
+	pkBytes, err := base64.StdEncoding.DecodeString(pubKey)
+	if err != nil {
+		return secp256k1.PubKeySecp256k1{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot decode base64 public key")
+	}
+
+	var pkBytes33 = [33]byte{}
+	copy(pkBytes33[:], pkBytes)
+	return secp256k1.PubKeySecp256k1(pkBytes33), nil
+}
+
+// decodeEd25519PubKey returns the ed25519 public key having the given base64 encoding
+func decodeEd25519PubKey(pubKey string) (ed25519.PubKeyEd25519, error) {
+	pkBytes, err := base64.StdEncoding.DecodeString(pubKey)
+	if err != nil {
+		return ed25519.PubKeyEd25519{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot decode base64 public key")
+	}
+
+	if len(pkBytes) != ed25519.PubKeyEd25519Size {
+		return ed25519.PubKeyEd25519{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("invalid ed25519 public key length: %d", len(pkBytes)))
+	}
+
+	var pubkey ed25519.PubKeyEd25519
+	copy(pubkey[:], pkBytes)
+	return pubkey, nil
+}
+
+// verifySecp256k1Signature verifies the signature of the given bytes created using a secp256k1 key
+func verifySecp256k1Signature(pubKey string, signBytes []byte, signature string) error {
+	// Get the public key used to sign the message
+	pubkey, err := decodeSecp256k1PubKey(pubKey)
+	if err != nil {
+		return err
+	}
+
+	// Decode the signature
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot decode base64 signature")
+	}
+
+	// Verify the signature
+	if !pubkey.VerifyBytes(signBytes, sig) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid")
+	}
+
+	return nil
+}
+
+// verifyEd25519Signature verifies the signature of the given bytes created using an ed25519 key
+func verifyEd25519Signature(pubKey string, signBytes []byte, signature string) error {
+	pubkey, err := decodeEd25519PubKey(pubKey)
+	if err != nil {
+		return err
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot decode base64 signature")
+	}
+
+	if !pubkey.VerifyBytes(signBytes, sig) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid")
+	}
+
+	return nil
+}
+
+// verifyEthereumSignature verifies the signature of the given bytes created by an Ethereum account using personal_sign.
+// The signer public key is recovered from the hex encoded signature, and the derived address must be the given
+// external owner. If a public key is given, it must be the recovered one
+func verifyEthereumSignature(pubKey, externalOwner string, signBytes []byte, signature string) (string, error) {
+	sig, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot decode hex signature")
+	}
+
+	if len(sig) != 65 {
+		return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid ethereum signature length: %d", len(sig)))
+	}
+
+	// Ethereum signatures are made of r, s and the recovery id, which can be either 0/1 or 27/28.
+	// Compact signatures instead are made of 27 plus the recovery id, followed by r and s
+	recoveryID := sig[64]
+	if recoveryID < 27 {
+		recoveryID += 27
+	}
+	compactSig := append([]byte{recoveryID}, sig[:64]...)
+
+	recovered, _, err := btcec.RecoverCompact(btcec.S256(), compactSig, EthereumMessageHash(signBytes))
+	if err != nil {
+		return "", sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid")
+	}
+
+	address := EthereumAddress(recovered)
+	if !strings.EqualFold(strings.TrimPrefix(externalOwner, "0x"), hex.EncodeToString(address)) {
+		return "", sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid")
+	}
+
+	recoveredPubKey := recovered.SerializeCompressed()
+	if len(strings.TrimSpace(pubKey)) != 0 {
+		pkBytes, err := base64.StdEncoding.DecodeString(pubKey)
+		if err != nil {
+			return "", sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot decode base64 public key")
+		}
+
+		if !bytes.Equal(pkBytes, recoveredPubKey) {
+			return "", sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the public key is not the one that signed the session")
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(recoveredPubKey), nil
+}
+
+// EthereumMessageHash returns the hash signed by Ethereum wallets when using personal_sign on the given message
+func EthereumMessageHash(message []byte) []byte {
+	return keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+}
+
+// EthereumAddress returns the Ethereum address associated to the given public key
+func EthereumAddress(pubKey *btcec.PublicKey) []byte {
+	// The address is made of the last 20 bytes of the hash of the uncompressed key, without its 0x04 prefix
+	return keccak256(pubKey.SerializeUncompressed()[1:])[12:]
+}
+
+// keccak256 returns the Keccak-256 hash of the given data, as used by Ethereum
+func keccak256(data []byte) []byte {
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(data)
+	return hasher.Sum(nil)
+}
@@ -0,0 +1,306 @@
+package keeper_test
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/bech32"
+
+	"github.com/desmos-labs/desmos/x/magpie/keeper"
+	"github.com/desmos-labs/desmos/x/magpie/types"
+)
+
+func (suite *KeeperTestSuite) TestEthereumAddress() {
+	// Well known address of the private key having value 1
+	privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), []byte{1})
+	suite.Equal("7e5f4552091a69125d5dfcb7b8c2659029395bdf", hex.EncodeToString(keeper.EthereumAddress(privKey.PubKey())))
+}
+
+func (suite *KeeperTestSuite) TestEthereumMessageHash() {
+	// Hash signed by personal_sign for the "hello" message
+	suite.Equal(
+		"50b2c43fd39106bafbba0da34fc430e1f91e3c96ea2acee2bc34119f92b37750",
+		hex.EncodeToString(keeper.EthereumMessageHash([]byte("hello"))),
+	)
+}
+
+// signEthereumSession signs the given message for the given chain and session as an Ethereum wallet would do
+// using personal_sign, returning the hex encoded signature made of r, s and v
+func signEthereumSession(
+	privKey *btcec.PrivateKey, msg types.MsgCreateSession, chainID string, sessionID types.SessionID, legacyV bool,
+) string {
+	signBytes := msg.GetSessionSignBytes(chainID, sessionID)
+	compactSig, err := btcec.SignCompact(btcec.S256(), privKey, keeper.EthereumMessageHash(signBytes), false)
+	if err != nil {
+		panic(err)
+	}
+
+	// Compact signatures are made of v, r and s, while Ethereum ones are made of r, s and v
+	v := compactSig[0]
+	if !legacyV {
+		v -= 27
+	}
+	return "0x" + hex.EncodeToString(append(compactSig[1:], v))
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgCreateSession_keyTypes() {
+	owner, err := sdk.AccAddressFromBech32("cosmos1m5gfj4t5ddksytl65mmv7lfg5nef3etmrnl8a0")
+	suite.NoError(err)
+	chainID := suite.ctx.ChainID()
+	sessionID := types.SessionID(1)
+
+	// Secp256k1 session
+	secpKey := secp256k1.GenPrivKey()
+	secpPubKey := secpKey.PubKey().(secp256k1.PubKeySecp256k1)
+	secpOwner, err := bech32.ConvertAndEncode("cosmos", secpPubKey.Address())
+	suite.NoError(err)
+	secpMsg := types.NewMsgCreateSession(owner, "cosmoshub-3", secpOwner,
+		base64.StdEncoding.EncodeToString(secpPubKey[:]), "")
+	secpSig, err := secpKey.Sign(secpMsg.GetSessionSignBytes(chainID, sessionID))
+	suite.NoError(err)
+	secpMsg.Signature = base64.StdEncoding.EncodeToString(secpSig)
+
+	otherChainSecpSig, err := secpKey.Sign(secpMsg.GetSessionSignBytes("other-chain-id", sessionID))
+	suite.NoError(err)
+
+	otherSessionSecpSig, err := secpKey.Sign(secpMsg.GetSessionSignBytes(chainID, sessionID.Next()))
+	suite.NoError(err)
+
+	// Secp256k1 session of an external owner that is not the one associated with the public key
+	secpOtherOwnerMsg := types.NewMsgCreateSession(owner, "cosmoshub-3", "cosmos1m5gfj4t5ddksytl65mmv7lfg5nef3etmrnl8a0",
+		base64.StdEncoding.EncodeToString(secpPubKey[:]), "")
+	secpOtherOwnerSig, err := secpKey.Sign(secpOtherOwnerMsg.GetSessionSignBytes(chainID, sessionID))
+	suite.NoError(err)
+	secpOtherOwnerMsg.Signature = base64.StdEncoding.EncodeToString(secpOtherOwnerSig)
+
+	// Ed25519 session
+	edKey := ed25519.GenPrivKey()
+	edPubKey := edKey.PubKey().(ed25519.PubKeyEd25519)
+	edOwner, err := bech32.ConvertAndEncode("desmos", edPubKey.Address())
+	suite.NoError(err)
+	edMsg := types.NewMsgCreateSession(owner, "desmos", edOwner,
+		base64.StdEncoding.EncodeToString(edPubKey[:]), "").WithKeyType(types.KeyTypeEd25519)
+	edSig, err := edKey.Sign(edMsg.GetSessionSignBytes(chainID, sessionID))
+	suite.NoError(err)
+	edMsg.Signature = base64.StdEncoding.EncodeToString(edSig)
+
+	otherEdKey := ed25519.GenPrivKey()
+	otherEdSig, err := otherEdKey.Sign(edMsg.GetSessionSignBytes(chainID, sessionID))
+	suite.NoError(err)
+
+	otherChainEdSig, err := edKey.Sign(edMsg.GetSessionSignBytes("other-chain-id", sessionID))
+	suite.NoError(err)
+
+	otherSessionEdSig, err := edKey.Sign(edMsg.GetSessionSignBytes(chainID, sessionID.Next()))
+	suite.NoError(err)
+
+	// Ed25519 session of an external owner that is not a bech32 address
+	edInvalidOwnerMsg := types.NewMsgCreateSession(owner, "solana", "4Nd1mBQtrMJVYVfKf2PJy9NZUZdTAsp7D4xWLs4gDB4T",
+		base64.StdEncoding.EncodeToString(edPubKey[:]), "").WithKeyType(types.KeyTypeEd25519)
+	edInvalidOwnerSig, err := edKey.Sign(edInvalidOwnerMsg.GetSessionSignBytes(chainID, sessionID))
+	suite.NoError(err)
+	edInvalidOwnerMsg.Signature = base64.StdEncoding.EncodeToString(edInvalidOwnerSig)
+
+	// Ethereum session
+	ethKey, err := btcec.NewPrivateKey(btcec.S256())
+	suite.NoError(err)
+	ethAddress := "0x" + hex.EncodeToString(keeper.EthereumAddress(ethKey.PubKey()))
+	ethPubKey := base64.StdEncoding.EncodeToString(ethKey.PubKey().SerializeCompressed())
+	ethMsg := types.NewMsgCreateSession(owner, "ethereum", ethAddress, "", "").WithKeyType(types.KeyTypeEthereum)
+	ethMsg.Signature = signEthereumSession(ethKey, ethMsg, chainID, sessionID, true)
+
+	ethMsgWithPubKey := ethMsg
+	ethMsgWithPubKey.PubKey = ethPubKey
+	ethMsgWithPubKey.Signature = signEthereumSession(ethKey, ethMsgWithPubKey, chainID, sessionID, false)
+
+	otherEthKey, err := btcec.NewPrivateKey(btcec.S256())
+	suite.NoError(err)
+
+	tests := []struct {
+		name      string
+		msg       func() types.MsgCreateSession
+		expPubKey string
+		expErr    error
+	}{
+		{
+			name:      "Valid secp256k1 signature works properly",
+			msg:       func() types.MsgCreateSession { return secpMsg },
+			expPubKey: secpMsg.PubKey,
+		},
+		{
+			name: "Secp256k1 signature for another chain returns error",
+			msg: func() types.MsgCreateSession {
+				msg := secpMsg
+				msg.Signature = base64.StdEncoding.EncodeToString(otherChainSecpSig)
+				return msg
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid"),
+		},
+		{
+			name: "Secp256k1 signature for another session returns error",
+			msg: func() types.MsgCreateSession {
+				msg := secpMsg
+				msg.Signature = base64.StdEncoding.EncodeToString(otherSessionSecpSig)
+				return msg
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid"),
+		},
+		{
+			name:   "Secp256k1 signature of a key not belonging to the external owner returns error",
+			msg:    func() types.MsgCreateSession { return secpOtherOwnerMsg },
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the public key does not belong to the external owner"),
+		},
+		{
+			name:      "Valid ed25519 signature works properly",
+			msg:       func() types.MsgCreateSession { return edMsg },
+			expPubKey: edMsg.PubKey,
+		},
+		{
+			name: "Ed25519 signature of another key returns error",
+			msg: func() types.MsgCreateSession {
+				msg := edMsg
+				msg.Signature = base64.StdEncoding.EncodeToString(otherEdSig)
+				return msg
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid"),
+		},
+		{
+			name: "Ed25519 signature for another chain returns error",
+			msg: func() types.MsgCreateSession {
+				msg := edMsg
+				msg.Signature = base64.StdEncoding.EncodeToString(otherChainEdSig)
+				return msg
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid"),
+		},
+		{
+			name: "Ed25519 signature for another session returns error",
+			msg: func() types.MsgCreateSession {
+				msg := edMsg
+				msg.Signature = base64.StdEncoding.EncodeToString(otherSessionEdSig)
+				return msg
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid"),
+		},
+		{
+			name:   "Ed25519 signature with an invalid external owner returns error",
+			msg:    func() types.MsgCreateSession { return edInvalidOwnerMsg },
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid external owner: 4Nd1mBQtrMJVYVfKf2PJy9NZUZdTAsp7D4xWLs4gDB4T"),
+		},
+		{
+			name: "Ed25519 signature verified as secp256k1 returns error",
+			msg: func() types.MsgCreateSession {
+				return edMsg.WithKeyType(types.KeyTypeSecp256k1)
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid"),
+		},
+		{
+			name: "Invalid ed25519 public key returns error",
+			msg: func() types.MsgCreateSession {
+				msg := edMsg
+				msg.PubKey = secpMsg.PubKey
+				return msg
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid ed25519 public key length: 33"),
+		},
+		{
+			name:      "Valid ethereum signature works properly",
+			msg:       func() types.MsgCreateSession { return ethMsg },
+			expPubKey: ethPubKey,
+		},
+		{
+			name:      "Valid ethereum signature with public key and 0/1 recovery id works properly",
+			msg:       func() types.MsgCreateSession { return ethMsgWithPubKey },
+			expPubKey: ethPubKey,
+		},
+		{
+			name: "Ethereum signature of another account returns error",
+			msg: func() types.MsgCreateSession {
+				msg := ethMsg
+				msg.Signature = signEthereumSession(otherEthKey, msg, chainID, sessionID, true)
+				return msg
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid"),
+		},
+		{
+			name: "Ethereum signature for another chain returns error",
+			msg: func() types.MsgCreateSession {
+				msg := ethMsg
+				msg.Signature = signEthereumSession(ethKey, msg, "other-chain-id", sessionID, true)
+				return msg
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid"),
+		},
+		{
+			name: "Ethereum signature for another session returns error",
+			msg: func() types.MsgCreateSession {
+				msg := ethMsg
+				msg.Signature = signEthereumSession(ethKey, msg, chainID, sessionID.Next(), true)
+				return msg
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid"),
+		},
+		{
+			name: "Ethereum signature with a different public key returns error",
+			msg: func() types.MsgCreateSession {
+				msg := ethMsg
+				msg.PubKey = base64.StdEncoding.EncodeToString(otherEthKey.PubKey().SerializeCompressed())
+				msg.Signature = signEthereumSession(ethKey, msg, chainID, sessionID, true)
+				return msg
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the public key is not the one that signed the session"),
+		},
+		{
+			name: "Invalid ethereum signature encoding returns error",
+			msg: func() types.MsgCreateSession {
+				msg := ethMsg
+				msg.Signature = base64.StdEncoding.EncodeToString(edSig)
+				return msg
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot decode hex signature"),
+		},
+		{
+			name: "Invalid ethereum signature length returns error",
+			msg: func() types.MsgCreateSession {
+				msg := ethMsg
+				msg.Signature = "0x1234"
+				return msg
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid ethereum signature length: 2"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+
+			msg := test.msg()
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, msg)
+
+			if test.expErr != nil {
+				suite.Nil(res)
+				suite.Equal(test.expErr.Error(), err.Error())
+				return
+			}
+
+			suite.NoError(err, fmt.Sprintf("%s signature should be valid", msg.KeyType.OrDefault()))
+			session, found := suite.keeper.GetSession(suite.ctx, types.SessionID(1))
+			suite.True(found)
+			suite.Equal(test.expPubKey, session.PubKey)
+			suite.Equal(msg.KeyType, session.KeyType)
+			suite.Equal(msg.ExternalOwner, session.ExternalOwner)
+
+			// Replaying the same signature must not create another session
+			_, err = handler(suite.ctx, msg)
+			suite.Equal(sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "the session signature is not valid").Error(), err.Error())
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package v0110
+
+import (
+	v030magpie "github.com/desmos-labs/desmos/x/magpie/legacy/v0.3.0"
+)
+
+// Migrate accepts exported genesis state from v0.3.0 and migrates it to v0.11.0
+// genesis state. This migration moves the default session length inside the module params,
+// which now also contain the namespace session lengths and the max number of sessions per owner.
+// Sessions are left untouched.
+func Migrate(oldGenState v030magpie.GenesisState) GenesisState {
+	return GenesisState{
+		Params: Params{
+			DefaultSessionLength:    oldGenState.DefaultSessionLength,
+			NamespaceSessionLengths: nil,
+			MaxSessionsPerOwner:     DefaultMaxSessionsPerOwner,
+		},
+		Sessions: oldGenState.Sessions,
+	}
+}
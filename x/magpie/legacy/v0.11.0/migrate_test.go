@@ -0,0 +1,36 @@
+package v0110_test
+
+import (
+	"testing"
+
+	v0110magpie "github.com/desmos-labs/desmos/x/magpie/legacy/v0.11.0"
+	v030magpie "github.com/desmos-labs/desmos/x/magpie/legacy/v0.3.0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	sessions := []v030magpie.Session{
+		{
+			SessionID:     1,
+			Created:       50,
+			Expiry:        58,
+			Namespace:     "cosmos",
+			ExternalOwner: "cosmos16udxavk6lapwzvpjy9e3f7dxdsdzhnf7aj0q3c",
+			PubKey:        "cosmospub1addwnpepqg9hwc5cxnv56hgwruaa69a6mp2ua39hwv94n2qk93tc9652jpju50mhu8s",
+			Signature:     "<Bech32>",
+		},
+	}
+
+	v030GenesisState := v030magpie.NewGenesisState(100, sessions)
+
+	expected := v0110magpie.GenesisState{
+		Params: v0110magpie.Params{
+			DefaultSessionLength:    100,
+			NamespaceSessionLengths: nil,
+			MaxSessionsPerOwner:     v0110magpie.DefaultMaxSessionsPerOwner,
+		},
+		Sessions: sessions,
+	}
+
+	require.Equal(t, expected, v0110magpie.Migrate(v030GenesisState))
+}
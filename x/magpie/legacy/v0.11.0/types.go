@@ -0,0 +1,31 @@
+package v0110
+
+// DONTCOVER
+
+import (
+	v030magpie "github.com/desmos-labs/desmos/x/magpie/legacy/v0.3.0"
+)
+
+const (
+	ModuleName                 = "magpie"
+	DefaultMaxSessionsPerOwner = 50
+)
+
+// GenesisState represents the genesis state for the magpie module
+type GenesisState struct {
+	Params   Params               `json:"params"`
+	Sessions []v030magpie.Session `json:"sessions"`
+}
+
+// Params contains the parameters of the magpie module
+type Params struct {
+	DefaultSessionLength    int64                    `json:"default_session_length"`
+	NamespaceSessionLengths []NamespaceSessionLength `json:"namespace_session_lengths"`
+	MaxSessionsPerOwner     uint64                   `json:"max_sessions_per_owner"`
+}
+
+// NamespaceSessionLength overrides the default length of the sessions created inside a namespace
+type NamespaceSessionLength struct {
+	Namespace string `json:"namespace"`
+	Length    int64  `json:"length"`
+}
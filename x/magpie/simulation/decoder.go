@@ -12,11 +12,6 @@ import (
 // DecodeStore unmarshals the KVPair's Value to the corresponding magpie type
 func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 	switch {
-	case bytes.Equal(kvA.Key, types.SessionLengthKey):
-		var idA, idB int64
-		cdc.MustUnmarshalBinaryBare(kvA.Value, &idA)
-		cdc.MustUnmarshalBinaryBare(kvB.Value, &idB)
-		return fmt.Sprintf("DefaultSessionLengthA: %d\nDefaultSessionLengthB: %d\n", idA, idB)
 	case bytes.Equal(kvA.Key, types.LastSessionIDStoreKey):
 		var postA, postB types.SessionID
 		cdc.MustUnmarshalBinaryBare(kvA.Value, &postA)
@@ -27,6 +22,18 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		cdc.MustUnmarshalBinaryBare(kvA.Value, &commentsA)
 		cdc.MustUnmarshalBinaryBare(kvB.Value, &commentsB)
 		return fmt.Sprintf("SessionA: %s\nSessionB: %s\n", commentsA, commentsB)
+	case bytes.HasPrefix(kvA.Key, types.ExpiryQueuePrefix):
+		var idA, idB types.SessionID
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &idA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &idB)
+		return fmt.Sprintf("ExpiringSessionIDA: %s\nExpiringSessionIDB: %s\n", idA, idB)
+	case bytes.HasPrefix(kvA.Key, types.OwnerSessionsStorePrefix),
+		bytes.HasPrefix(kvA.Key, types.NamespaceSessionsStorePrefix),
+		bytes.HasPrefix(kvA.Key, types.ExternalOwnerSessionsStorePrefix):
+		var idA, idB types.SessionID
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &idA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &idB)
+		return fmt.Sprintf("IndexedSessionIDA: %s\nIndexedSessionIDB: %s\n", idA, idB)
 	default:
 		panic(fmt.Sprintf("invalid magpie key %X", kvA.Key))
 	}
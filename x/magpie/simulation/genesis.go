@@ -10,9 +10,8 @@ import (
 
 // RandomizedGenState generates a random GenesisState for auth
 func RandomizedGenState(simState *module.SimulationState) {
-	sessionLength := simState.Rand.Int63n(1000) + 1 // Session length cannot be zero
 	sessions := randomSessions(simState)
-	genState := types.NewGenesisState(sessionLength, sessions)
+	genState := types.NewGenesisState(RandomParams(simState.Rand), sessions)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genState)
 }
 
@@ -25,7 +24,7 @@ func randomSessions(simState *module.SimulationState) types.Sessions {
 
 		simAccount, _ := sim.RandomAcc(simState.Rand, simState.Accounts)
 		created := simState.Rand.Int63n(20000)
-		data := RandomSessionData(simAccount, simState.Rand)
+		data := RandomSessionData(simAccount, simState.Rand, "", types.SessionID(i))
 
 		// Create the session
 		sessions[i] = types.NewSession(
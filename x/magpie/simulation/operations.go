@@ -36,7 +36,7 @@ func WeightedOperations(appParams sim.AppParams, cdc *codec.Codec, k keeper.Keep
 	return sim.WeightedOperations{
 		sim.NewWeightedOperation(
 			weightMsgCreatePost,
-			SimulateMsgCreateSession(ak),
+			SimulateMsgCreateSession(k, ak),
 		),
 	}
 }
@@ -44,13 +44,13 @@ func WeightedOperations(appParams sim.AppParams, cdc *codec.Codec, k keeper.Keep
 // SimulateMsgCreateSession tests and runs a single msg create session where the post creator
 // account already exists
 // nolint: funlen
-func SimulateMsgCreateSession(ak auth.AccountKeeper) sim.Operation {
+func SimulateMsgCreateSession(k keeper.Keeper, ak auth.AccountKeeper) sim.Operation {
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []sim.Account, chainID string,
 	) (sim.OperationMsg, []sim.FutureOperation, error) {
 
-		data, skip := randomSessionFields(r, ctx, accs, ak)
+		data, skip := randomSessionFields(r, ctx, accs, chainID, k, ak)
 		if skip {
 			return sim.NoOpMsg(types.ModuleName), nil, nil
 		}
@@ -106,7 +106,7 @@ func sendMsgCreateSession(
 
 // randomSessionFields returns all the random fields that are needed to create a MsgCreateSession
 func randomSessionFields(
-	r *rand.Rand, ctx sdk.Context, accs []sim.Account, ak auth.AccountKeeper,
+	r *rand.Rand, ctx sdk.Context, accs []sim.Account, chainID string, k keeper.Keeper, ak auth.AccountKeeper,
 ) (*SessionData, bool) {
 
 	simAccount, _ := sim.RandomAcc(r, accs)
@@ -115,6 +115,6 @@ func randomSessionFields(
 		return nil, true
 	}
 
-	sessionData := RandomSessionData(simAccount, r)
+	sessionData := RandomSessionData(simAccount, r, chainID, k.GetLastSessionID(ctx).Next())
 	return &sessionData, false
 }
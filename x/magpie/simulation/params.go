@@ -0,0 +1,31 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/desmos-labs/desmos/x/magpie/types"
+)
+
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.DefaultSessionLengthKey),
+			func(r *rand.Rand) string {
+				return string(types.ModuleCdc.MustMarshalJSON(RandomSessionLength(r)))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.NamespaceSessionLengthsKey),
+			func(r *rand.Rand) string {
+				return string(types.ModuleCdc.MustMarshalJSON(RandomNamespaceSessionLengths(r)))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.MaxSessionsPerOwnerKey),
+			func(r *rand.Rand) string {
+				return string(types.ModuleCdc.MustMarshalJSON(RandomMaxSessionsPerOwner(r)))
+			},
+		),
+	}
+}
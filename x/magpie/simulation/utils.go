@@ -7,8 +7,6 @@ import (
 	"math/rand"
 
 	secp256k1 "github.com/btcsuite/btcd/btcec"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	"github.com/tendermint/tendermint/libs/bech32"
 
@@ -27,7 +25,9 @@ type SessionData struct {
 	Signature     string
 }
 
-func RandomSessionData(simAccount simulation.Account, r *rand.Rand) SessionData {
+func RandomSessionData(
+	simAccount simulation.Account, r *rand.Rand, chainID string, sessionID types.SessionID,
+) SessionData {
 	namespace := RandomNamespaces[r.Intn(len(RandomNamespaces))]
 
 	extOwner, err := bech32.ConvertAndEncode(namespace, simAccount.Address.Bytes())
@@ -41,7 +41,7 @@ func RandomSessionData(simAccount simulation.Account, r *rand.Rand) SessionData
 
 	// Create the signature data
 	msg := types.NewMsgCreateSession(simAccount.Address, namespace, extOwner, extPubKey, "")
-	signBytes := auth.StdSignBytes(namespace, 0, 0, auth.NewStdFee(200000, nil), []sdk.Msg{msg}, "")
+	signBytes := msg.GetSessionSignBytes(chainID, sessionID)
 
 	// Create the signature
 	signedBytes, err := simAccount.PrivKey.Sign(signBytes)
@@ -58,3 +58,33 @@ func RandomSessionData(simAccount simulation.Account, r *rand.Rand) SessionData
 		Signature:     base64.StdEncoding.EncodeToString(signedBytes),
 	}
 }
+
+// RandomSessionLength returns a random session length, which is never zero
+func RandomSessionLength(r *rand.Rand) int64 {
+	return r.Int63n(1000) + 1
+}
+
+// RandomNamespaceSessionLengths returns a random set of session lengths for the namespaces
+func RandomNamespaceSessionLengths(r *rand.Rand) types.NamespaceSessionLengths {
+	var lengths types.NamespaceSessionLengths
+	for _, namespace := range RandomNamespaces {
+		if r.Intn(2) == 0 {
+			lengths = append(lengths, types.NewNamespaceSessionLength(namespace, RandomSessionLength(r)))
+		}
+	}
+	return lengths
+}
+
+// RandomMaxSessionsPerOwner returns a random max number of sessions per owner, which is never zero
+func RandomMaxSessionsPerOwner(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100) + 1)
+}
+
+// RandomParams returns a random set of magpie params
+func RandomParams(r *rand.Rand) types.Params {
+	return types.NewParams(
+		RandomSessionLength(r),
+		RandomNamespaceSessionLengths(r),
+		RandomMaxSessionsPerOwner(r),
+	)
+}
@@ -14,4 +14,6 @@ func init() {
 // RegisterCodec registers concrete types on the Amino codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateSession{}, "desmos/MsgCreateSession", nil)
+	cdc.RegisterConcrete(MsgRevokeSession{}, "desmos/MsgRevokeSession", nil)
+	cdc.RegisterConcrete(SessionTx{}, "desmos/SessionTx", nil)
 }
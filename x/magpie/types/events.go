@@ -2,9 +2,12 @@ package types
 
 // Magpie module event types
 const (
-	EventTypeCreateSession = "create_session"
+	EventTypeCreateSession  = "create_session"
+	EventTypeRevokeSession  = "revoke_session"
+	EventTypeSessionExpired = "session_expired"
 
 	AttributeKeySessionID     = "session_id"
+	AttributeKeyOwner         = "owner"
 	AttributeKeyNamespace     = "namespace"
 	AttributeKeyExternalOwner = "external_owner"
 	AttributeKeyExpiry        = "expiry"
@@ -1,35 +1,27 @@
 package types
 
-import (
-	"fmt"
-)
-
 // GenesisState represents the genesis state for the magpie module
 type GenesisState struct {
-	DefaultSessionLength int64    `json:"default_session_length"`
-	Sessions             Sessions `json:"sessions"`
+	Params   Params   `json:"params"`
+	Sessions Sessions `json:"sessions"`
 }
 
-// NewGenesisState allows to create a new genesis state containing the given default session length and sessions
-func NewGenesisState(defaultSessionLength int64, sessions Sessions) GenesisState {
+// NewGenesisState allows to create a new genesis state containing the given params and sessions
+func NewGenesisState(params Params, sessions Sessions) GenesisState {
 	return GenesisState{
-		DefaultSessionLength: defaultSessionLength,
-		Sessions:             sessions,
+		Params:   params,
+		Sessions: sessions,
 	}
 }
 
 // DefaultGenesisState returns a default GenesisState
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		DefaultSessionLength: 240, // 24 hours, counting a 6 secs block interval
+		Params: DefaultParams(),
 	}
 }
 
 // ValidateGenesis validates the given genesis state and returns an error if something is invalid
 func ValidateGenesis(state GenesisState) error {
-	if state.DefaultSessionLength <= 0 {
-		return fmt.Errorf("invalid default session length: %d", state.DefaultSessionLength)
-	}
-
-	return nil
+	return state.Params.Validate()
 }
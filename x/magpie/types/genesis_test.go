@@ -14,15 +14,21 @@ func TestValidateGenesis(t *testing.T) {
 		ExpError error
 	}{
 		{
-			Genesis:  types.GenesisState{DefaultSessionLength: -1},
-			ExpError: fmt.Errorf("invalid default session length: -1"),
+			Genesis:  types.NewGenesisState(types.NewParams(0, nil, 10), nil),
+			ExpError: fmt.Errorf("invalid default session length param: 0"),
 		},
 		{
-			Genesis:  types.GenesisState{DefaultSessionLength: 0},
-			ExpError: fmt.Errorf("invalid default session length: 0"),
+			Genesis: types.NewGenesisState(types.NewParams(240, types.NamespaceSessionLengths{
+				types.NewNamespaceSessionLength("cosmos", -1),
+			}, 10), nil),
+			ExpError: fmt.Errorf("invalid namespace session lengths param: invalid session length for namespace cosmos: -1"),
 		},
 		{
-			Genesis:  types.GenesisState{DefaultSessionLength: 1},
+			Genesis:  types.NewGenesisState(types.NewParams(240, nil, 0), nil),
+			ExpError: fmt.Errorf("invalid max sessions per owner param: 0"),
+		},
+		{
+			Genesis:  types.NewGenesisState(types.NewParams(1, nil, 1), nil),
 			ExpError: nil,
 		},
 		{
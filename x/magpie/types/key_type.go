@@ -0,0 +1,34 @@
+package types
+
+// KeyType represents the type of the external key used to sign a session
+type KeyType string
+
+const (
+	// KeyTypeSecp256k1 identifies the secp256k1 keys used by Cosmos SDK based wallets.
+	// Sessions not specifying a key type are considered to be signed with this kind of key
+	KeyTypeSecp256k1 KeyType = "secp256k1"
+
+	// KeyTypeEd25519 identifies the ed25519 keys
+	KeyTypeEd25519 KeyType = "ed25519"
+
+	// KeyTypeEthereum identifies the Ethereum accounts signing using the personal_sign method
+	KeyTypeEthereum KeyType = "ethereum"
+)
+
+// IsValid tells whether the key type is one of the supported ones
+func (t KeyType) IsValid() bool {
+	switch t {
+	case KeyTypeSecp256k1, KeyTypeEd25519, KeyTypeEthereum:
+		return true
+	default:
+		return false
+	}
+}
+
+// OrDefault returns the key type itself, or the secp256k1 one if no key type has been specified
+func (t KeyType) OrDefault() KeyType {
+	if t == "" {
+		return KeyTypeSecp256k1
+	}
+	return t
+}
@@ -0,0 +1,23 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/magpie/types"
+)
+
+func TestKeyType_IsValid(t *testing.T) {
+	require.True(t, types.KeyTypeSecp256k1.IsValid())
+	require.True(t, types.KeyTypeEd25519.IsValid())
+	require.True(t, types.KeyTypeEthereum.IsValid())
+	require.False(t, types.KeyType("").IsValid())
+	require.False(t, types.KeyType("sr25519").IsValid())
+}
+
+func TestKeyType_OrDefault(t *testing.T) {
+	require.Equal(t, types.KeyTypeSecp256k1, types.KeyType("").OrDefault())
+	require.Equal(t, types.KeyTypeEd25519, types.KeyTypeEd25519.OrDefault())
+	require.Equal(t, types.KeyTypeEthereum, types.KeyTypeEthereum.OrDefault())
+}
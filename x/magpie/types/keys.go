@@ -1,17 +1,26 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	ModuleName = "magpie"
 	RouterKey  = ModuleName
 	StoreKey   = ModuleName
 
 	ActionCreationSession = "create_session"
+	ActionRevokeSession   = "revoke_session"
 )
 
 var (
-	SessionLengthKey      = []byte("default_session_length")
 	LastSessionIDStoreKey = []byte("last_session_id")
 	SessionStorePrefix    = []byte("session")
+	ExpiryQueuePrefix     = []byte("expiry_queue")
+
+	OwnerSessionsStorePrefix         = []byte("owner_sessions")
+	NamespaceSessionsStorePrefix     = []byte("namespace_sessions")
+	ExternalOwnerSessionsStorePrefix = []byte("external_owner_sessions")
 )
 
 // SessionStoreKey turns a session id to a key used to store a session into the sessions store
@@ -19,3 +28,52 @@ var (
 func SessionStoreKey(id SessionID) []byte {
 	return append(SessionStorePrefix, []byte(id.String())...)
 }
+
+// ExpiryQueueHeightPrefix returns the prefix of the expiry queue entries of the sessions expiring at the given height
+func ExpiryQueueHeightPrefix(height int64) []byte {
+	return append(ExpiryQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// ExpiryQueueStoreKey returns the key used to store the expiry queue entry of the session having
+// the given id and expiring at the given height
+func ExpiryQueueStoreKey(height int64, id SessionID) []byte {
+	return append(ExpiryQueueHeightPrefix(height), sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// lengthPrefixed returns the given value preceded by its length, so that values having
+// different lengths never share the same prefix
+func lengthPrefixed(value string) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(len(value))), []byte(value)...)
+}
+
+// OwnerSessionsPrefix returns the prefix used to index all the sessions of the given owner
+func OwnerSessionsPrefix(owner sdk.AccAddress) []byte {
+	return append(OwnerSessionsStorePrefix, owner...)
+}
+
+// OwnerSessionStoreKey returns the key used to index the session having the given id and owner
+func OwnerSessionStoreKey(owner sdk.AccAddress, id SessionID) []byte {
+	return append(OwnerSessionsPrefix(owner), sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// NamespaceSessionsPrefix returns the prefix used to index all the sessions of the given namespace
+func NamespaceSessionsPrefix(namespace string) []byte {
+	return append(NamespaceSessionsStorePrefix, lengthPrefixed(namespace)...)
+}
+
+// NamespaceSessionStoreKey returns the key used to index the session having the given id inside the given namespace
+func NamespaceSessionStoreKey(namespace string, id SessionID) []byte {
+	return append(NamespaceSessionsPrefix(namespace), sdk.Uint64ToBigEndian(uint64(id))...)
+}
+
+// ExternalOwnerSessionsPrefix returns the prefix used to index all the sessions of the given external owner
+// inside the given namespace
+func ExternalOwnerSessionsPrefix(namespace, externalOwner string) []byte {
+	return append(append(ExternalOwnerSessionsStorePrefix, lengthPrefixed(namespace)...), lengthPrefixed(externalOwner)...)
+}
+
+// ExternalOwnerSessionStoreKey returns the key used to index the session having the given id,
+// namespace and external owner
+func ExternalOwnerSessionStoreKey(namespace, externalOwner string, id SessionID) []byte {
+	return append(ExternalOwnerSessionsPrefix(namespace, externalOwner), sdk.Uint64ToBigEndian(uint64(id))...)
+}
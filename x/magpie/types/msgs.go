@@ -1,13 +1,19 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
+// ----------------------
+// --- MsgCreateSession
+// ----------------------
+
 // MsgCreateSession defines the MsgCreateSession message
 type MsgCreateSession struct {
 	Owner         sdk.AccAddress `json:"owner" yaml:"owner"`
@@ -15,6 +21,8 @@ type MsgCreateSession struct {
 	ExternalOwner string         `json:"external_owner" yaml:"external_owner"`
 	PubKey        string         `json:"pub_key" yaml:"pub_key"`
 	Signature     string         `json:"signature" yaml:"signature"`
+	KeyType       KeyType        `json:"key_type,omitempty" yaml:"key_type,omitempty"`
+	SpendLimit    sdk.Coins      `json:"spend_limit,omitempty" yaml:"spend_limit,omitempty"`
 }
 
 // NewMsgCreateSession is the constructor of MsgCreateSession
@@ -28,6 +36,19 @@ func NewMsgCreateSession(owner sdk.AccAddress, namespace string, externalOwner s
 	}
 }
 
+// WithKeyType returns a copy of msg that has been signed using a key of the given type
+func (msg MsgCreateSession) WithKeyType(keyType KeyType) MsgCreateSession {
+	msg.KeyType = keyType
+	return msg
+}
+
+// WithSpendLimit returns a copy of msg allowing the session to spend at most the given amount
+// of the owner's coins to pay the fees of the transactions it signs
+func (msg MsgCreateSession) WithSpendLimit(spendLimit sdk.Coins) MsgCreateSession {
+	msg.SpendLimit = spendLimit
+	return msg
+}
+
 // Route should return the name of the module
 func (msg MsgCreateSession) Route() string { return RouterKey }
 
@@ -44,7 +65,13 @@ func (msg MsgCreateSession) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "session namespace cannot be empty")
 	}
 
-	if len(strings.TrimSpace(msg.PubKey)) == 0 {
+	keyType := msg.KeyType.OrDefault()
+	if !keyType.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("invalid session key type: %s", msg.KeyType))
+	}
+
+	// Ethereum public keys are recovered from the signature
+	if keyType != KeyTypeEthereum && len(strings.TrimSpace(msg.PubKey)) == 0 {
 		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "signer's public key cannot be empty")
 	}
 
@@ -57,6 +84,10 @@ func (msg MsgCreateSession) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "session signature cannot be empty")
 	}
 
+	if msg.SpendLimit != nil && !msg.SpendLimit.IsValid() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, fmt.Sprintf("invalid session spend limit: %s", msg.SpendLimit))
+	}
+
 	return nil
 }
 
@@ -65,7 +96,101 @@ func (msg MsgCreateSession) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
 }
 
+// CreateSessionSignDoc is the document signed by an ed25519 external key to create a session.
+// The Desmos chain id and the id of the session to be created prevent the same signature from being replayed
+type CreateSessionSignDoc struct {
+	ChainID   string          `json:"chain_id" yaml:"chain_id"`
+	Msg       json.RawMessage `json:"msg" yaml:"msg"`
+	SessionID SessionID       `json:"session_id,string" yaml:"session_id"`
+}
+
+// GetSessionSignBytes returns the bytes that must be signed by the external key to create the session,
+// given the id of the Desmos chain inside which the session is created and the id that the session will have.
+// The signed payload depends on the key type:
+// - secp256k1 keys sign a standard transaction containing the message, with the namespace as chain id,
+// the session id as sequence and the Desmos chain id as memo;
+// - ed25519 keys sign the sorted JSON representation of a CreateSessionSignDoc;
+// - Ethereum accounts sign a text message containing the Desmos chain id and the session id using personal_sign.
+// In all cases, the message is considered without its signature and its spend limit, which is
+// authorized by the owner signing the transaction
+func (msg MsgCreateSession) GetSessionSignBytes(chainID string, sessionID SessionID) []byte {
+	clearMsg := msg
+	clearMsg.Signature = ""
+	clearMsg.SpendLimit = nil
+
+	switch msg.KeyType.OrDefault() {
+	case KeyTypeEd25519:
+		bz, err := ModuleCdc.MarshalJSON(CreateSessionSignDoc{
+			ChainID:   chainID,
+			Msg:       json.RawMessage(clearMsg.GetSignBytes()),
+			SessionID: sessionID,
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		return sdk.MustSortJSON(bz)
+
+	case KeyTypeEthereum:
+		return []byte(fmt.Sprintf(
+			"Desmos session\nChain ID: %s\nSession ID: %s\nNamespace: %s\nExternal owner: %s\nOwner: %s",
+			chainID, sessionID, msg.Namespace, msg.ExternalOwner, msg.Owner,
+		))
+
+	default:
+		return auth.StdSignBytes(msg.Namespace, 0, uint64(sessionID), auth.NewStdFee(200000, nil),
+			[]sdk.Msg{clearMsg}, chainID)
+	}
+}
+
 // GetSigners defines whose signature is required
 func (msg MsgCreateSession) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
 }
+
+// ----------------------
+// --- MsgRevokeSession
+// ----------------------
+
+// MsgRevokeSession defines the message used by the owner of a session to revoke it before its expiration
+type MsgRevokeSession struct {
+	SessionID SessionID      `json:"session_id,string" yaml:"session_id"`
+	Owner     sdk.AccAddress `json:"owner" yaml:"owner"`
+}
+
+// NewMsgRevokeSession is the constructor of MsgRevokeSession
+func NewMsgRevokeSession(id SessionID, owner sdk.AccAddress) MsgRevokeSession {
+	return MsgRevokeSession{
+		SessionID: id,
+		Owner:     owner,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRevokeSession) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRevokeSession) Type() string { return ActionRevokeSession }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRevokeSession) ValidateBasic() error {
+	if !msg.SessionID.Valid() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("invalid session id: %s", msg.SessionID))
+	}
+
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid session owner: %s", msg.Owner))
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRevokeSession) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRevokeSession) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
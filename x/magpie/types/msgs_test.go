@@ -94,6 +94,29 @@ func TestMsgCreateSession_ValidateBasic(t *testing.T) {
 			),
 			expErr: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "session signature cannot be empty"),
 		},
+		{
+			name:   "Invalid key type",
+			msg:    msgShareDocumentSchema.WithKeyType("sr25519"),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "invalid session key type: sr25519"),
+		},
+		{
+			name: "Ed25519 session without public key",
+			msg: types.NewMsgCreateSession(
+				testOwner,
+				"solana",
+				"4Nd1mBQtrMJVYVfKf2PJy9NZUZdTAsp7D4xWLs4gDB4T",
+				"",
+				"QmZh...===",
+			).WithKeyType(types.KeyTypeEd25519),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "signer's public key cannot be empty"),
+		},
+		{
+			name: "Invalid spend limit",
+			msg: msgShareDocumentSchema.WithSpendLimit(sdk.Coins{
+				sdk.Coin{Denom: "udaric", Amount: sdk.NewInt(-1)},
+			}),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid session spend limit: -1udaric"),
+		},
 	}
 
 	for _, test := range tests {
@@ -102,6 +125,16 @@ func TestMsgCreateSession_ValidateBasic(t *testing.T) {
 			require.Equal(t, test.expErr.Error(), test.msg.ValidateBasic().Error())
 		})
 	}
+
+	// Ethereum public keys are recovered from the signature
+	msg := types.NewMsgCreateSession(
+		testOwner,
+		"ethereum",
+		"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf",
+		"",
+		"0x1234",
+	).WithKeyType(types.KeyTypeEthereum)
+	require.NoError(t, msg.ValidateBasic())
 }
 
 func TestMsgCreateSession_GetSignBytes(t *testing.T) {
@@ -110,8 +143,115 @@ func TestMsgCreateSession_GetSignBytes(t *testing.T) {
 	require.Equal(t, expected, string(actual))
 }
 
+func TestMsgCreateSession_GetSessionSignBytes(t *testing.T) {
+	msg := types.NewMsgCreateSession(
+		testOwner,
+		"ethereum",
+		"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf",
+		"",
+		"0x1234",
+	)
+
+	tests := []struct {
+		name     string
+		keyType  types.KeyType
+		expected string
+	}{
+		{
+			name:     "Default key type signs a standard transaction",
+			keyType:  "",
+			expected: `{"account_number":"0","chain_id":"ethereum","fee":{"amount":[],"gas":"200000"},"memo":"morpheus-10000","msgs":[{"type":"desmos/MsgCreateSession","value":{"external_owner":"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf","namespace":"ethereum","owner":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","pub_key":"","signature":""}}],"sequence":"7"}`,
+		},
+		{
+			name:     "Ed25519 key type signs the message along with the chain id and the session id",
+			keyType:  types.KeyTypeEd25519,
+			expected: `{"chain_id":"morpheus-10000","msg":{"type":"desmos/MsgCreateSession","value":{"external_owner":"0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf","key_type":"ed25519","namespace":"ethereum","owner":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","pub_key":"","signature":""}},"session_id":"7"}`,
+		},
+		{
+			name:    "Ethereum key type signs a text message",
+			keyType: types.KeyTypeEthereum,
+			expected: "Desmos session\n" +
+				"Chain ID: morpheus-10000\n" +
+				"Session ID: 7\n" +
+				"Namespace: ethereum\n" +
+				"External owner: 0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf\n" +
+				"Owner: cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, string(msg.WithKeyType(test.keyType).GetSessionSignBytes("morpheus-10000", 7)))
+
+			// The spend limit is authorized by the owner, so it is not signed by the external key
+			withSpendLimit := msg.WithKeyType(test.keyType).WithSpendLimit(sdk.NewCoins(sdk.NewInt64Coin("udaric", 1000)))
+			require.Equal(t, test.expected, string(withSpendLimit.GetSessionSignBytes("morpheus-10000", 7)))
+		})
+	}
+}
+
 func TestMsgCreateSession_GetSigners(t *testing.T) {
 	actual := msgShareDocumentSchema.GetSigners()
 	require.Equal(t, 1, len(actual))
 	require.Equal(t, msgShareDocumentSchema.Owner, actual[0])
 }
+
+// ----------------------
+// --- MsgRevokeSession
+// ----------------------
+
+var msgRevokeSession = types.NewMsgRevokeSession(types.SessionID(1), testOwner)
+
+func TestMsgRevokeSession_Route(t *testing.T) {
+	require.Equal(t, "magpie", msgRevokeSession.Route())
+}
+
+func TestMsgRevokeSession_Type(t *testing.T) {
+	require.Equal(t, "revoke_session", msgRevokeSession.Type())
+}
+
+func TestMsgRevokeSession_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    types.MsgRevokeSession
+		expErr error
+	}{
+		{
+			name:   "Invalid session id",
+			msg:    types.NewMsgRevokeSession(types.SessionID(0), testOwner),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "invalid session id: 0"),
+		},
+		{
+			name:   "Invalid owner",
+			msg:    types.NewMsgRevokeSession(types.SessionID(1), nil),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid session owner: "),
+		},
+		{
+			name:   "Valid message",
+			msg:    msgRevokeSession,
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Equal(t, test.expErr.Error(), err.Error())
+			}
+		})
+	}
+}
+
+func TestMsgRevokeSession_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgRevokeSession","value":{"owner":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","session_id":"1"}}`
+	require.Equal(t, expected, string(msgRevokeSession.GetSignBytes()))
+}
+
+func TestMsgRevokeSession_GetSigners(t *testing.T) {
+	require.Equal(t, []sdk.AccAddress{testOwner}, msgRevokeSession.GetSigners())
+}
@@ -0,0 +1,211 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	paramsModule "github.com/cosmos/cosmos-sdk/x/params/subspace"
+)
+
+const (
+	// default paramspace for paramsModule keeper
+	DefaultParamspace = ModuleName
+)
+
+// Default magpie params
+var (
+	DefaultSessionLength       = int64(240) // 24 hours, counting a 6 secs block interval
+	DefaultMaxSessionsPerOwner = uint64(50)
+)
+
+// Parameters store keys
+var (
+	DefaultSessionLengthKey    = []byte("DefaultSessionLength")
+	NamespaceSessionLengthsKey = []byte("NamespaceSessionLengths")
+	MaxSessionsPerOwnerKey     = []byte("MaxSessionsPerOwner")
+)
+
+// ParamKeyTable Key declaration for parameters
+func ParamKeyTable() paramsModule.KeyTable {
+	return paramsModule.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params contains the parameters of the magpie module
+type Params struct {
+	DefaultSessionLength    int64                   `json:"default_session_length" yaml:"default_session_length"`
+	NamespaceSessionLengths NamespaceSessionLengths `json:"namespace_session_lengths" yaml:"namespace_session_lengths"`
+	MaxSessionsPerOwner     uint64                  `json:"max_sessions_per_owner" yaml:"max_sessions_per_owner"`
+}
+
+// NewParams creates a new Params obj
+func NewParams(
+	defaultSessionLength int64, namespaceSessionLengths NamespaceSessionLengths, maxSessionsPerOwner uint64,
+) Params {
+	return Params{
+		DefaultSessionLength:    defaultSessionLength,
+		NamespaceSessionLengths: namespaceSessionLengths,
+		MaxSessionsPerOwner:     maxSessionsPerOwner,
+	}
+}
+
+// DefaultParams return default params object
+func DefaultParams() Params {
+	return Params{
+		DefaultSessionLength:    DefaultSessionLength,
+		NamespaceSessionLengths: nil,
+		MaxSessionsPerOwner:     DefaultMaxSessionsPerOwner,
+	}
+}
+
+// SessionLength returns the length, in number of blocks, of the sessions created inside the given namespace
+func (params Params) SessionLength(namespace string) int64 {
+	if length, found := params.NamespaceSessionLengths.Find(namespace); found {
+		return length.Length
+	}
+	return params.DefaultSessionLength
+}
+
+// String implements Stringer
+func (params Params) String() string {
+	out := "Magpie parameters:\n"
+	out += fmt.Sprintf("Default session length: %d\nNamespace session lengths:\n%s\nMax sessions per owner: %d\n",
+		params.DefaultSessionLength,
+		params.NamespaceSessionLengths,
+		params.MaxSessionsPerOwner,
+	)
+
+	return strings.TrimSpace(out)
+}
+
+// ParamSetPairs implements the ParamSet interface and returns the key/value pairs
+// of magpie module's parameters.
+func (params *Params) ParamSetPairs() paramsModule.ParamSetPairs {
+	return paramsModule.ParamSetPairs{
+		paramsModule.NewParamSetPair(DefaultSessionLengthKey, &params.DefaultSessionLength, ValidateDefaultSessionLengthParam),
+		paramsModule.NewParamSetPair(NamespaceSessionLengthsKey, &params.NamespaceSessionLengths, ValidateNamespaceSessionLengthsParam),
+		paramsModule.NewParamSetPair(MaxSessionsPerOwnerKey, &params.MaxSessionsPerOwner, ValidateMaxSessionsPerOwnerParam),
+	}
+}
+
+// Validate perform basic checks on all parameters to ensure they are correct
+func (params Params) Validate() error {
+	if err := ValidateDefaultSessionLengthParam(params.DefaultSessionLength); err != nil {
+		return err
+	}
+
+	if err := ValidateNamespaceSessionLengthsParam(params.NamespaceSessionLengths); err != nil {
+		return err
+	}
+
+	return ValidateMaxSessionsPerOwnerParam(params.MaxSessionsPerOwner)
+}
+
+func ValidateDefaultSessionLengthParam(i interface{}) error {
+	length, isCorrectParam := i.(int64)
+	if !isCorrectParam {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	if length <= 0 {
+		return fmt.Errorf("invalid default session length param: %d", length)
+	}
+
+	return nil
+}
+
+func ValidateNamespaceSessionLengthsParam(i interface{}) error {
+	lengths, isCorrectParam := i.(NamespaceSessionLengths)
+	if !isCorrectParam {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	if err := lengths.Validate(); err != nil {
+		return fmt.Errorf("invalid namespace session lengths param: %s", err)
+	}
+
+	return nil
+}
+
+func ValidateMaxSessionsPerOwnerParam(i interface{}) error {
+	maxSessions, isCorrectParam := i.(uint64)
+	if !isCorrectParam {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	if maxSessions == 0 {
+		return fmt.Errorf("invalid max sessions per owner param: %d", maxSessions)
+	}
+
+	return nil
+}
+
+// NamespaceSessionLength overrides the default length of the sessions created inside a namespace
+type NamespaceSessionLength struct {
+	Namespace string `json:"namespace" yaml:"namespace"`
+	Length    int64  `json:"length" yaml:"length"`
+}
+
+// NewNamespaceSessionLength is a constructor function for NamespaceSessionLength
+func NewNamespaceSessionLength(namespace string, length int64) NamespaceSessionLength {
+	return NamespaceSessionLength{
+		Namespace: namespace,
+		Length:    length,
+	}
+}
+
+// String implements Stringer
+func (length NamespaceSessionLength) String() string {
+	return fmt.Sprintf("%s - %d", length.Namespace, length.Length)
+}
+
+// Validate checks the validity of the NamespaceSessionLength
+func (length NamespaceSessionLength) Validate() error {
+	if len(strings.TrimSpace(length.Namespace)) == 0 {
+		return fmt.Errorf("session namespace cannot be empty")
+	}
+
+	if length.Length <= 0 {
+		return fmt.Errorf("invalid session length for namespace %s: %d", length.Namespace, length.Length)
+	}
+
+	return nil
+}
+
+// NamespaceSessionLengths represents a slice of NamespaceSessionLength objects
+type NamespaceSessionLengths []NamespaceSessionLength
+
+// String implements Stringer
+func (lengths NamespaceSessionLengths) String() string {
+	out := "Namespace - Length\n"
+	for _, length := range lengths {
+		out += length.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// Find returns the session length of the given namespace, and whether it has been found or not
+func (lengths NamespaceSessionLengths) Find(namespace string) (NamespaceSessionLength, bool) {
+	for _, length := range lengths {
+		if length.Namespace == namespace {
+			return length, true
+		}
+	}
+	return NamespaceSessionLength{}, false
+}
+
+// Validate checks the validity of all the session lengths, making sure each namespace appears only once
+func (lengths NamespaceSessionLengths) Validate() error {
+	namespaces := make(map[string]bool, len(lengths))
+	for _, length := range lengths {
+		if err := length.Validate(); err != nil {
+			return err
+		}
+
+		if namespaces[length.Namespace] {
+			return fmt.Errorf("duplicated session length for namespace %s", length.Namespace)
+		}
+		namespaces[length.Namespace] = true
+	}
+
+	return nil
+}
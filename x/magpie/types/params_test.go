@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/desmos-labs/desmos/x/magpie/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultParams(t *testing.T) {
+	params := types.NewParams(
+		types.DefaultSessionLength,
+		nil,
+		types.DefaultMaxSessionsPerOwner,
+	)
+	require.Equal(t, params, types.DefaultParams())
+}
+
+func TestParams_String(t *testing.T) {
+	params := types.NewParams(240, types.NamespaceSessionLengths{
+		types.NewNamespaceSessionLength("cosmos", 100),
+		types.NewNamespaceSessionLength("ethereum", 50),
+	}, 10)
+	require.Equal(t, "Magpie parameters:\nDefault session length: 240\n"+
+		"Namespace session lengths:\nNamespace - Length\ncosmos - 100\nethereum - 50\n"+
+		"Max sessions per owner: 10",
+		params.String())
+}
+
+func TestParams_SessionLength(t *testing.T) {
+	params := types.NewParams(240, types.NamespaceSessionLengths{
+		types.NewNamespaceSessionLength("cosmos", 100),
+	}, 10)
+	require.Equal(t, int64(100), params.SessionLength("cosmos"))
+	require.Equal(t, int64(240), params.SessionLength("ethereum"))
+}
+
+func TestValidateParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params types.Params
+		expErr error
+	}{
+		{
+			name:   "invalid default session length returns error",
+			params: types.NewParams(-1, nil, 10),
+			expErr: fmt.Errorf("invalid default session length param: -1"),
+		},
+		{
+			name: "empty namespace returns error",
+			params: types.NewParams(240, types.NamespaceSessionLengths{
+				types.NewNamespaceSessionLength(" ", 100),
+			}, 10),
+			expErr: fmt.Errorf("invalid namespace session lengths param: session namespace cannot be empty"),
+		},
+		{
+			name: "invalid namespace session length returns error",
+			params: types.NewParams(240, types.NamespaceSessionLengths{
+				types.NewNamespaceSessionLength("cosmos", 0),
+			}, 10),
+			expErr: fmt.Errorf("invalid namespace session lengths param: invalid session length for namespace cosmos: 0"),
+		},
+		{
+			name: "duplicated namespace returns error",
+			params: types.NewParams(240, types.NamespaceSessionLengths{
+				types.NewNamespaceSessionLength("cosmos", 100),
+				types.NewNamespaceSessionLength("cosmos", 50),
+			}, 10),
+			expErr: fmt.Errorf("invalid namespace session lengths param: duplicated session length for namespace cosmos"),
+		},
+		{
+			name:   "invalid max sessions per owner returns error",
+			params: types.NewParams(240, nil, 0),
+			expErr: fmt.Errorf("invalid max sessions per owner param: 0"),
+		},
+		{
+			name: "valid params returns no error",
+			params: types.NewParams(240, types.NamespaceSessionLengths{
+				types.NewNamespaceSessionLength("cosmos", 100),
+				types.NewNamespaceSessionLength("ethereum", 50),
+			}, 10),
+			expErr: nil,
+		},
+		{
+			name:   "default params returns no error",
+			params: types.DefaultParams(),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.params.Validate())
+		})
+	}
+}
+
+func TestValidateParamsTypes(t *testing.T) {
+	require.Equal(t, fmt.Errorf("invalid parameters type: %s", "string"),
+		types.ValidateDefaultSessionLengthParam("string"))
+	require.Equal(t, fmt.Errorf("invalid parameters type: %s", "string"),
+		types.ValidateNamespaceSessionLengthsParam("string"))
+	require.Equal(t, fmt.Errorf("invalid parameters type: %s", "string"),
+		types.ValidateMaxSessionsPerOwnerParam("string"))
+}
@@ -0,0 +1,28 @@
+package types
+
+// query endpoints supported by the magpie Querier
+const (
+	QuerySessions              = "sessions"
+	QueryOwnerSessions         = "owner_sessions"
+	QueryNamespaceSessions     = "namespace_sessions"
+	QueryExternalOwnerSessions = "external_owner_sessions"
+	QueryParams                = "params"
+	QueryNextSessionID         = "next_session_id"
+)
+
+// QuerySessionsParams contains the params used to filter and paginate the sessions returned by the
+// 'custom/magpie/owner_sessions', 'custom/magpie/namespace_sessions' and 'custom/magpie/external_owner_sessions' queries
+type QuerySessionsParams struct {
+	ActiveOnly bool `json:"active_only" yaml:"active_only"`
+	Page       int  `json:"page" yaml:"page"`
+	Limit      int  `json:"limit" yaml:"limit"`
+}
+
+// NewQuerySessionsParams is a constructor function for QuerySessionsParams
+func NewQuerySessionsParams(activeOnly bool, page, limit int) QuerySessionsParams {
+	return QuerySessionsParams{
+		ActiveOnly: activeOnly,
+		Page:       page,
+		Limit:      limit,
+	}
+}
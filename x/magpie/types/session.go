@@ -47,14 +47,18 @@ func ParseSessionID(value string) (SessionID, error) {
 
 // Session is a struct of a user session
 type Session struct {
-	SessionID     SessionID      `json:"id,string" yaml:"id,string"`                    // Id of the session
-	Owner         sdk.AccAddress `json:"owner" yaml:"owner"`                            // Desmos owner of this session
-	Created       int64          `json:"creation_time,string" yaml:"creation_time"`     // Block height at which the session has been created
-	Expiry        int64          `json:"expiration_time,string" yaml:"expiration_time"` // Block height at which the session will expire
-	Namespace     string         `json:"namespace" yaml:"namespace"`                    // External chain identifier
-	ExternalOwner string         `json:"external_owner" yaml:"external_owner"`          // External chain owner address
-	PubKey        string         `json:"pub_key" yaml:"pub_key"`                        // External chain owner public key
-	Signature     string         `json:"signature" yaml:"signature"`                    // Session signature
+	SessionID     SessionID      `json:"id,string" yaml:"id,string"`                         // Id of the session
+	Owner         sdk.AccAddress `json:"owner" yaml:"owner"`                                 // Desmos owner of this session
+	Created       int64          `json:"creation_time,string" yaml:"creation_time"`          // Block height at which the session has been created
+	Expiry        int64          `json:"expiration_time,string" yaml:"expiration_time"`      // Block height at which the session will expire
+	Namespace     string         `json:"namespace" yaml:"namespace"`                         // External chain identifier
+	ExternalOwner string         `json:"external_owner" yaml:"external_owner"`               // External chain owner address
+	PubKey        string         `json:"pub_key" yaml:"pub_key"`                             // External chain owner public key
+	Signature     string         `json:"signature" yaml:"signature"`                         // Session signature
+	KeyType       KeyType        `json:"key_type,omitempty" yaml:"key_type,omitempty"`       // External chain owner key type
+	SpendLimit    sdk.Coins      `json:"spend_limit,omitempty" yaml:"spend_limit,omitempty"` // Max fees payable by the session
+	Spent         sdk.Coins      `json:"spent,omitempty" yaml:"spent,omitempty"`             // Fees already paid by the session
+	Sequence      uint64         `json:"sequence,omitempty" yaml:"sequence,omitempty"`       // Number of transactions signed
 }
 
 // NewSession return a new session containing the given parameters
@@ -81,7 +85,21 @@ func (s Session) Equals(other Session) bool {
 		s.Namespace == other.Namespace &&
 		s.ExternalOwner == other.ExternalOwner &&
 		s.PubKey == other.PubKey &&
-		s.Signature == other.Signature
+		s.Signature == other.Signature &&
+		s.KeyType.OrDefault() == other.KeyType.OrDefault() &&
+		s.SpendLimit.String() == other.SpendLimit.String() &&
+		s.Spent.String() == other.Spent.String() &&
+		s.Sequence == other.Sequence
+}
+
+// CanSpend tells whether the session can pay the given fees without exceeding its spend limit
+func (s Session) CanSpend(fees sdk.Coins) bool {
+	return s.Spent.Add(fees...).IsAllLTE(s.SpendLimit)
+}
+
+// IsActive tells whether the session is still active at the given block height
+func (s Session) IsActive(height int64) bool {
+	return s.Expiry > height
 }
 
 // implement fmt.Stringer
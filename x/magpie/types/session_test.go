@@ -363,6 +363,24 @@ func TestSession_Equals(t *testing.T) {
 			},
 			expEquals: true,
 		},
+		{
+			name:      "Different spend limit",
+			first:     types.Session{SessionID: 1, SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))},
+			second:    types.Session{SessionID: 1, SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+			expEquals: false,
+		},
+		{
+			name:      "Different spent amount",
+			first:     types.Session{SessionID: 1, Spent: sdk.NewCoins(sdk.NewInt64Coin("udaric", 10))},
+			second:    types.Session{SessionID: 1},
+			expEquals: false,
+		},
+		{
+			name:      "Different sequence",
+			first:     types.Session{SessionID: 1, Sequence: 1},
+			second:    types.Session{SessionID: 1, Sequence: 2},
+			expEquals: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -411,6 +429,29 @@ func TestSession_String(t *testing.T) {
 	}
 }
 
+func TestSession_IsActive(t *testing.T) {
+	session := types.Session{SessionID: 1, Created: 10, Expiry: 15}
+	require.True(t, session.IsActive(14))
+	require.False(t, session.IsActive(15))
+	require.False(t, session.IsActive(16))
+}
+
+func TestSession_CanSpend(t *testing.T) {
+	session := types.Session{
+		SessionID:  1,
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)),
+		Spent:      sdk.NewCoins(sdk.NewInt64Coin("udaric", 60)),
+	}
+
+	require.True(t, session.CanSpend(nil))
+	require.True(t, session.CanSpend(sdk.NewCoins(sdk.NewInt64Coin("udaric", 40))))
+	require.False(t, session.CanSpend(sdk.NewCoins(sdk.NewInt64Coin("udaric", 41))))
+	require.False(t, session.CanSpend(sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+
+	// Sessions without a spend limit cannot pay any fee
+	require.False(t, types.Session{SessionID: 1}.CanSpend(sdk.NewCoins(sdk.NewInt64Coin("udaric", 1))))
+}
+
 // ---------------
 // --- Sessions
 // ---------------
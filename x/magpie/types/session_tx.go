@@ -0,0 +1,128 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+var _ sdk.Tx = SessionTx{}
+
+// maxGasWanted is the max gas that can be requested by a transaction, as for standard transactions
+const maxGasWanted = uint64((1 << 63) - 1)
+
+// SessionTx represents a transaction signed using the external key of a session on behalf of the session owner.
+// Its fees are paid by the session owner, within the session spend limit
+type SessionTx struct {
+	Msgs      []sdk.Msg   `json:"msg" yaml:"msg"`
+	Fee       auth.StdFee `json:"fee" yaml:"fee"`
+	Memo      string      `json:"memo" yaml:"memo"`
+	SessionID SessionID   `json:"session_id,string" yaml:"session_id"`
+	Signature string      `json:"signature" yaml:"signature"`
+}
+
+// NewSessionTx is a constructor function for SessionTx
+func NewSessionTx(msgs []sdk.Msg, fee auth.StdFee, memo string, id SessionID, signature string) SessionTx {
+	return SessionTx{
+		Msgs:      msgs,
+		Fee:       fee,
+		Memo:      memo,
+		SessionID: id,
+		Signature: signature,
+	}
+}
+
+// GetMsgs implements sdk.Tx
+func (tx SessionTx) GetMsgs() []sdk.Msg { return tx.Msgs }
+
+// ValidateBasic implements sdk.Tx, performing the stateless checks on the transaction.
+// All the messages must be signed by the same single account, which must be the session owner
+func (tx SessionTx) ValidateBasic() error {
+	if tx.Fee.Gas > maxGasWanted {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid gas supplied; %d > %d", tx.Fee.Gas, maxGasWanted))
+	}
+
+	if tx.Fee.Amount.IsAnyNegative() {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFee, fmt.Sprintf("invalid fee provided: %s", tx.Fee.Amount))
+	}
+
+	if !tx.SessionID.Valid() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("invalid session id: %s", tx.SessionID))
+	}
+
+	if len(strings.TrimSpace(tx.Signature)) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrNoSignatures, "session signature cannot be empty")
+	}
+
+	if len(tx.Msgs) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "session transactions must contain at least one message")
+	}
+
+	owner := tx.FeePayer()
+	for _, msg := range tx.Msgs {
+		signers := msg.GetSigners()
+		if len(signers) != 1 || !signers[0].Equals(owner) {
+			return sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				"session transactions messages must be signed by the same single account")
+		}
+	}
+
+	return nil
+}
+
+// GetGas returns the gas limit of the transaction
+func (tx SessionTx) GetGas() uint64 { return tx.Fee.Gas }
+
+// GetFee returns the fees of the transaction
+func (tx SessionTx) GetFee() sdk.Coins { return tx.Fee.Amount }
+
+// GetMemo returns the memo of the transaction
+func (tx SessionTx) GetMemo() string { return tx.Memo }
+
+// FeePayer returns the account that pays the transaction fees, which is the signer of its messages.
+// The session decorator makes sure that such account is the session owner
+func (tx SessionTx) FeePayer() sdk.AccAddress {
+	if len(tx.Msgs) == 0 || len(tx.Msgs[0].GetSigners()) == 0 {
+		return nil
+	}
+	return tx.Msgs[0].GetSigners()[0]
+}
+
+// SessionSignDoc is the document signed by the external key of a session to authorize a transaction.
+// The session sequence prevents the same signature from being replayed
+type SessionSignDoc struct {
+	ChainID   string            `json:"chain_id" yaml:"chain_id"`
+	Fee       json.RawMessage   `json:"fee" yaml:"fee"`
+	Memo      string            `json:"memo" yaml:"memo"`
+	Msgs      []json.RawMessage `json:"msgs" yaml:"msgs"`
+	Sequence  uint64            `json:"sequence,string" yaml:"sequence"`
+	SessionID SessionID         `json:"session_id,string" yaml:"session_id"`
+}
+
+// GetSignBytes returns the bytes that must be signed by the external key of the session, given the chain id
+// and the current session sequence.
+// Ethereum accounts sign such bytes using personal_sign
+func (tx SessionTx) GetSignBytes(chainID string, sequence uint64) []byte {
+	msgsBytes := make([]json.RawMessage, len(tx.Msgs))
+	for index, msg := range tx.Msgs {
+		msgsBytes[index] = json.RawMessage(msg.GetSignBytes())
+	}
+
+	bz, err := ModuleCdc.MarshalJSON(SessionSignDoc{
+		ChainID:   chainID,
+		Fee:       json.RawMessage(tx.Fee.Bytes()),
+		Memo:      tx.Memo,
+		Msgs:      msgsBytes,
+		Sequence:  sequence,
+		SessionID: tx.SessionID,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bz)
+}
@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/magpie/types"
+)
+
+func TestSessionTx_ValidateBasic(t *testing.T) {
+	otherOwner, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	require.NoError(t, err)
+
+	msg := types.NewMsgRevokeSession(types.SessionID(2), testOwner)
+	fee := auth.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("udaric", 10)))
+
+	tests := []struct {
+		name   string
+		tx     types.SessionTx
+		expErr error
+	}{
+		{
+			name:   "Invalid session id returns error",
+			tx:     types.NewSessionTx([]sdk.Msg{msg}, fee, "", types.SessionID(0), "c2lnbmF0dXJl"),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "invalid session id: 0"),
+		},
+		{
+			name:   "Empty signature returns error",
+			tx:     types.NewSessionTx([]sdk.Msg{msg}, fee, "", types.SessionID(1), " "),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrNoSignatures, "session signature cannot be empty"),
+		},
+		{
+			name:   "Empty messages returns error",
+			tx:     types.NewSessionTx(nil, fee, "", types.SessionID(1), "c2lnbmF0dXJl"),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "session transactions must contain at least one message"),
+		},
+		{
+			name: "Messages signed by different accounts returns error",
+			tx: types.NewSessionTx(
+				[]sdk.Msg{msg, types.NewMsgRevokeSession(types.SessionID(3), otherOwner)},
+				fee, "", types.SessionID(1), "c2lnbmF0dXJl",
+			),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				"session transactions messages must be signed by the same single account"),
+		},
+		{
+			name: "Valid transaction returns no error",
+			tx:   types.NewSessionTx([]sdk.Msg{msg, msg}, fee, "memo", types.SessionID(1), "c2lnbmF0dXJl"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.tx.ValidateBasic()
+			if test.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, test.expErr.Error(), err.Error())
+			}
+		})
+	}
+}
+
+func TestSessionTx_FeePayer(t *testing.T) {
+	msg := types.NewMsgRevokeSession(types.SessionID(2), testOwner)
+	tx := types.NewSessionTx([]sdk.Msg{msg}, auth.StdFee{}, "", types.SessionID(1), "")
+	require.Equal(t, testOwner, tx.FeePayer())
+
+	require.Nil(t, types.NewSessionTx(nil, auth.StdFee{}, "", types.SessionID(1), "").FeePayer())
+}
+
+func TestSessionTx_GetSignBytes(t *testing.T) {
+	msg := types.NewMsgRevokeSession(types.SessionID(2), testOwner)
+	fee := auth.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("udaric", 10)))
+	tx := types.NewSessionTx([]sdk.Msg{msg}, fee, "memo", types.SessionID(1), "c2lnbmF0dXJl")
+
+	expected := `{"chain_id":"desmos","fee":{"amount":[{"amount":"10","denom":"udaric"}],"gas":"200000"},"memo":"memo","msgs":[{"type":"desmos/MsgRevokeSession","value":{"owner":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","session_id":"2"}}],"sequence":"5","session_id":"1"}`
+	require.Equal(t, expected, string(tx.GetSignBytes("desmos", 5)))
+}
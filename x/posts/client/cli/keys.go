@@ -17,6 +17,10 @@ const (
 	flagSubspace       = "subspace"
 	flagHashtag        = "hashtag"
 	flagCreator        = "creator"
+	flagActingAs       = "acting-as"
+	flagCursor         = "cursor"
+	flagIncludeHidden  = "include-hidden"
+	flagViewer         = "viewer"
 
 	keyEndDate           = "end-date"
 	keyMultipleAnswers   = "multiple-answers"
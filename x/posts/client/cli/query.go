@@ -34,13 +34,15 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryPollAnswer(cdc),
 		GetCmdQueryRegisteredReactions(cdc),
 		GetCmdQueryPostsParams(cdc),
+		GetCmdQueryTimeline(cdc),
+		GetCmdQueryPostTips(cdc),
 	)...)
 	return postQueryCmd
 }
 
 // GetCmdQueryPost queries a post
 func GetCmdQueryPost(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "post [id]",
 		Short: "Retrieve the post having the given id, if any.",
 		Args:  cobra.ExactArgs(1),
@@ -49,6 +51,10 @@ func GetCmdQueryPost(cdc *codec.Codec) *cobra.Command {
 			postID := args[0]
 
 			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryPost, postID)
+			if viewer := viper.GetString(flagViewer); len(viewer) > 0 {
+				route = fmt.Sprintf("%s/%s", route, viewer)
+			}
+
 			res, _, err := cliCtx.QueryWithData(route, nil)
 			if err != nil {
 				fmt.Printf("Could not find post with id %s \n", postID)
@@ -60,6 +66,10 @@ func GetCmdQueryPost(cdc *codec.Codec) *cobra.Command {
 			return cliCtx.PrintOutput(out)
 		},
 	}
+
+	cmd.Flags().String(flagViewer, "", "(optional) address of the user viewing the post, used to unlock subscriber-only content")
+
+	return cmd
 }
 
 func GetCmdQueryPosts(cdc *codec.Codec) *cobra.Command {
@@ -131,6 +141,9 @@ $ %s query posts posts --page=2 --limit=100
 				params.Hashtags = hashtags
 			}
 
+			// Hidden posts
+			params.IncludeHidden = viper.GetBool(flagIncludeHidden)
+
 			// Creator
 			if bech32CreatorAddress := viper.GetString(flagCreator); len(bech32CreatorAddress) != 0 {
 				depositorAddr, err := sdk.AccAddressFromBech32(bech32CreatorAddress)
@@ -140,6 +153,15 @@ $ %s query posts posts --page=2 --limit=100
 				params.Creator = depositorAddr
 			}
 
+			// Viewer
+			if bech32ViewerAddress := viper.GetString(flagViewer); len(bech32ViewerAddress) != 0 {
+				viewerAddr, err := sdk.AccAddressFromBech32(bech32ViewerAddress)
+				if err != nil {
+					return err
+				}
+				params.Viewer = viewerAddr
+			}
+
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
@@ -180,6 +202,8 @@ $ %s query posts posts --page=2 --limit=100
 	cmd.Flags().String(flagSubspace, "", "(optional) filter the posts part of the subspace")
 	cmd.Flags().String(flagCreator, "", "(optional) filter the posts created by creator")
 	cmd.Flags().StringSlice(flagHashtag, []string{}, "(optional) filter the posts that contain the specified hashtags")
+	cmd.Flags().Bool(flagIncludeHidden, false, "(optional) include the posts that have been hidden after being reported")
+	cmd.Flags().String(flagViewer, "", "(optional) address of the user viewing the posts, used to unlock subscriber-only content")
 
 	return cmd
 }
@@ -251,3 +275,74 @@ func GetCmdQueryPostsParams(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryTimeline queries the posts created by the users followed by the given user
+func GetCmdQueryTimeline(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "timeline [address]",
+		Short: "Retrieve the posts created by the users followed by the given user, from the newest to the oldest",
+		Long: fmt.Sprintf(`Retrieve the posts created by the users followed by the given user, from the newest to the oldest.
+The posts can be filtered by subspace, and are returned in pages. To get the next page, use the returned
+next cursor as the value of the --%s flag.
+
+E.g.
+%s query posts timeline desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud --limit=20 --%s=a4469741bb0c0622627810082a5f2e4e54fbbb888f25a4771a5eebc697d30cfc
+`, flagCursor, version.ClientName, flagCursor),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryTimelineParams(
+				viper.GetString(flagSubspace),
+				types.PostID(viper.GetString(flagCursor)),
+				viper.GetInt(flagNumLimit),
+			)
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryTimeline, args[0])
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Printf("Could not get the timeline of the given address: %s", err)
+				return nil
+			}
+
+			var out types.TimelineQueryResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(flagSubspace, "", "(optional) filter the relationships and posts part of the subspace")
+	cmd.Flags().String(flagCursor, "", "(optional) id of the post after which the posts should be returned")
+	cmd.Flags().Int(flagNumLimit, 100, "number of posts to return, at most 100")
+
+	return cmd
+}
+
+// GetCmdQueryPostTips queries the tips received by a post
+func GetCmdQueryPostTips(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "post-tips [id]",
+		Short: "Retrieve the tips received by the post with given id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			postID := args[0]
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryPostTips, postID)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf("Could not find post with id %s \n", postID)
+				return nil
+			}
+
+			var out types.PostTipsQueryResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
@@ -41,6 +41,8 @@ func GetTxCmd(_ string, cdc *codec.Codec) *cobra.Command {
 		GetCmdRemovePostReaction(cdc),
 		GetCmdAnswerPoll(cdc),
 		GetCmdRegisterReaction(cdc),
+		GetCmdTipPost(cdc),
+		GetCmdSetSubscriberOnly(cdc),
 	)...)
 
 	return postsTxCmd
@@ -149,6 +151,17 @@ func getPollData(cmd *cobra.Command) (*types.PollData, error) {
 	return pollData, nil
 }
 
+// getActingAs parses the address of the profile on whose behalf the message should be performed.
+// If no address is specified, it returns `nil` instead.
+func getActingAs() (sdk.AccAddress, error) {
+	actingAs := viper.GetString(flagActingAs)
+	if len(strings.TrimSpace(actingAs)) == 0 {
+		return nil, nil
+	}
+
+	return sdk.AccAddressFromBech32(actingAs)
+}
+
 // GetCmdCreatePost is the CLI command for creating a post
 func GetCmdCreatePost(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -243,6 +256,12 @@ E.g.
 				pollData,
 			)
 
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
@@ -252,6 +271,7 @@ E.g.
 	cmd.Flags().StringArray(flagAttachment, []string{}, "Current post's attachment")
 	cmd.Flags().StringToString(flagPollDetails, map[string]string{}, "Current post's poll details")
 	cmd.Flags().StringSlice(flagPollAnswer, []string{}, "Current post's poll answer")
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
 
 	return cmd
 }
@@ -321,6 +341,13 @@ E.g.
 			}
 
 			msg := types.NewMsgEditPost(postID, text, attachments, pollData, cliCtx.GetFromAddress())
+
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
@@ -328,13 +355,14 @@ E.g.
 	cmd.Flags().StringArray(flagAttachment, []string{}, "Current post's attachment")
 	cmd.Flags().StringToString(flagPollDetails, map[string]string{}, "Current post's poll details")
 	cmd.Flags().StringSlice(flagPollAnswer, []string{}, "Current post's poll answer")
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
 
 	return cmd
 }
 
 // GetCmdAddPostReaction is the CLI command for adding a like to a post
 func GetCmdAddPostReaction(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "add-reaction [post-id] [value]",
 		Short: "Adds a reaction to a post",
 		Long: fmt.Sprintf(`
@@ -356,14 +384,25 @@ E.g.
 			}
 
 			msg := types.NewMsgAddPostReaction(postID, args[1], cliCtx.GetFromAddress())
+
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
+
+	return cmd
 }
 
 // GetCmdRemovePostReaction is the CLI command for removing a like from a post
 func GetCmdRemovePostReaction(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "remove-reaction [post-id] [value]",
 		Short: "Removes an existing reaction from a post",
 		Long: fmt.Sprintf(`
@@ -385,14 +424,25 @@ E.g.
 			}
 
 			msg := types.NewMsgRemovePostReaction(postID, cliCtx.GetFromAddress(), args[1])
+
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
+
+	return cmd
 }
 
 // GetCmdAnswerPoll is the CLI command for answering a post's poll
 func GetCmdAnswerPoll(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "answer-poll [post-id] [answer...]",
 		Short: "Answer a post's poll'",
 		Args:  cobra.MinimumNArgs(2),
@@ -417,9 +467,20 @@ func GetCmdAnswerPoll(cdc *codec.Codec) *cobra.Command {
 			}
 
 			msg := types.NewMsgAnswerPoll(postID, answers, cliCtx.FromAddress)
+
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
+
+	return cmd
 }
 
 // GetCmdRegisterReaction is the CLI command for registering a reaction
@@ -442,3 +503,77 @@ func GetCmdRegisterReaction(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdTipPost is the CLI command for tipping the creator of a post
+func GetCmdTipPost(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tip [post-id] [amount]",
+		Short: "Send a tip to the creator of the post having the given id",
+		Long: fmt.Sprintf(`Send the given amount of coins to the creator of the post having the given id.
+If the post subspace has a tip fee, part of the amount is paid to the subspace fee recipient.
+
+E.g.
+%s tx posts tip a4469741bb0c0622627810082a5f2e4e54fbbb888f25a4771a5eebc697d30cfc 1000udaric --from jack
+`, version.ClientName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			postID := types.PostID(args[0])
+			if !postID.Valid() {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid postID: %s", postID))
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTipPost(postID, amount, cliCtx.FromAddress)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetSubscriberOnly is the CLI command for reserving a post to the subscribers of one of its creator tiers
+func GetCmdSetSubscriberOnly(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-subscriber-only [post-id] [min-tier]",
+		Short: "Reserve the content of the post having the given id to the subscribers of the given tier or higher",
+		Long: fmt.Sprintf(`Reserve the content of the post having the given id to the users subscribed
+to the given tier of its creator, or to a higher one. Use a tier equal to 0 to make the post visible to everyone.
+
+E.g.
+%s tx posts set-subscriber-only a4469741bb0c0622627810082a5f2e4e54fbbb888f25a4771a5eebc697d30cfc 1 --from jack
+`, version.ClientName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			postID := types.PostID(args[0])
+			if !postID.Valid() {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid postID: %s", postID))
+			}
+
+			minTier, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid min tier: %s", args[1]))
+			}
+
+			msg := types.NewMsgSetSubscriberOnly(postID, uint32(minTier), cliCtx.FromAddress)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -26,6 +26,9 @@ const (
 	RestSubspace       = "subspace"
 	RestCreator        = "creator"
 	RestHashtags       = "hashtags"
+	RestIncludeHidden  = "include_hidden"
+	RestCursor         = "cursor"
+	RestViewer         = "viewer"
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
@@ -33,7 +36,9 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/posts/{postID}", queryPostHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/posts", queryPostsWithParameterHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/posts/{postID}/poll-answers", queryPostPollAnswersHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/posts/{postID}/tips", queryPostTipsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc("/registeredReactions", queryRegisteredReactions(cliCtx)).Methods("GET")
+	r.HandleFunc("/posts/timeline/{address}", queryTimelineHandlerFn(cliCtx)).Methods("GET")
 }
 
 // HTTP request handler to query a single post based on its ID
@@ -43,6 +48,10 @@ func queryPostHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		postID := vars["postID"]
 
 		route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryPost, postID)
+		if v := r.URL.Query().Get(RestViewer); len(v) != 0 {
+			route = fmt.Sprintf("%s/%s", route, v)
+		}
+
 		res, _, err := cliCtx.QueryWithData(route, nil)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
@@ -122,6 +131,24 @@ func queryPostsWithParameterHandlerFn(cliCtx context.CLIContext) http.HandlerFun
 			params.Hashtags = strings.Split(v, ",")
 		}
 
+		if v := r.URL.Query().Get(RestIncludeHidden); len(v) != 0 {
+			includeHidden, err := strconv.ParseBool(v)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.IncludeHidden = includeHidden
+		}
+
+		if v := r.URL.Query().Get(RestViewer); len(v) != 0 {
+			viewerAddr, err := sdk.AccAddressFromBech32(v)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.Viewer = viewerAddr
+		}
+
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
@@ -156,6 +183,22 @@ func queryPostPollAnswersHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func queryPostTipsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		postID := vars["postID"]
+
+		route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryPostTips, postID)
+		res, _, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func queryRegisteredReactions(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRegisteredReactions)
@@ -182,3 +225,38 @@ func queryPostsParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to query the posts created by the users followed by a user
+func queryTimelineHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		address := vars["address"]
+
+		_, _, limit, err := rest.ParseHTTPArgsWithLimit(r, 100)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryTimelineParams(
+			r.URL.Query().Get(RestSubspace),
+			types.PostID(r.URL.Query().Get(RestCursor)),
+			limit,
+		)
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryTimeline, address)
+		res, _, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/desmos-labs/desmos/x/posts/types"
 	"github.com/gorilla/mux"
@@ -26,25 +27,29 @@ type CreatePostReq struct {
 	CreationTime   time.Time         `json:"creation_time"`
 	Medias         types.Attachments `json:"attachments,omitempty"`
 	PollData       *types.PollData   `json:"poll_data,omitempty"`
+	ActingAs       sdk.AccAddress    `json:"acting_as,omitempty"`
 }
 
 // AddReactionReq defines the properties of a reaction adding request's body.
 type AddReactionReq struct {
-	BaseReq  rest.BaseReq `json:"base_req"`
-	PostID   string       `json:"post_id"`
-	Reaction string       `json:"reaction"`
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	PostID   string         `json:"post_id"`
+	Reaction string         `json:"reaction"`
+	ActingAs sdk.AccAddress `json:"acting_as,omitempty"`
 }
 
 // RemoveReactionReq defines the properties of a reaction removal request's body.
 type RemoveReactionReq struct {
-	BaseReq  rest.BaseReq `json:"base_req"`
-	PostID   string       `json:"post_id"`
-	Reaction string       `json:"reaction"`
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	PostID   string         `json:"post_id"`
+	Reaction string         `json:"reaction"`
+	ActingAs sdk.AccAddress `json:"acting_as,omitempty"`
 }
 
 type AnswerPollPostReq struct {
-	BaseReq rest.BaseReq `json:"base_req"`
-	Answers []string     `json:"answers"`
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Answers  []string       `json:"answers"`
+	ActingAs sdk.AccAddress `json:"acting_as,omitempty"`
 }
 
 type RegisterReactionReq struct {
@@ -53,3 +58,15 @@ type RegisterReactionReq struct {
 	Value     string       `json:"value"`
 	Subspace  string       `json:"subspace"`
 }
+
+// TipPostReq defines the properties of a post tipping request's body.
+type TipPostReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Amount  sdk.Coins    `json:"amount"`
+}
+
+// SetSubscriberOnlyReq defines the properties of a subscriber-only post request's body.
+type SetSubscriberOnlyReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	MinTier uint32       `json:"min_tier"`
+}
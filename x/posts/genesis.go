@@ -17,6 +17,9 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		PostReactions:       k.GetReactions(ctx),
 		RegisteredReactions: k.GetRegisteredReactions(ctx),
 		Params:              k.GetParams(ctx),
+		HiddenPosts:         k.GetHiddenPostsIDs(ctx),
+		PostsTips:           k.GetPostTipsMap(ctx),
+		PostsMinTiers:       k.GetPostsMinTiersMap(ctx),
 	}
 }
 
@@ -59,5 +62,28 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []ab
 		}
 	}
 
+	for _, postID := range data.HiddenPosts {
+		if _, found := k.GetPost(ctx, postID); !found {
+			panic(fmt.Errorf("hidden post with id %s doesn't exist", postID))
+		}
+		k.HidePost(ctx, postID)
+	}
+
+	for postID, postTips := range data.PostsTips {
+		postID := types.PostID(postID)
+		if _, found := k.GetPost(ctx, postID); !found {
+			panic(fmt.Errorf("tipped post with id %s doesn't exist", postID))
+		}
+		k.SavePostTips(ctx, postID, postTips)
+	}
+
+	for postID, minTier := range data.PostsMinTiers {
+		postID := types.PostID(postID)
+		if _, found := k.GetPost(ctx, postID); !found {
+			panic(fmt.Errorf("subscriber-only post with id %s doesn't exist", postID))
+		}
+		k.SetPostMinTier(ctx, postID, minTier)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
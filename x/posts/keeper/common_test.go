@@ -7,13 +7,21 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	"github.com/desmos-labs/desmos/x/posts/keeper"
 	"github.com/desmos-labs/desmos/x/posts/types"
 	"github.com/desmos-labs/desmos/x/posts/types/models/common"
+	profilesK "github.com/desmos-labs/desmos/x/profiles/keeper"
+	profilesT "github.com/desmos-labs/desmos/x/profiles/types"
+	relationshipsK "github.com/desmos-labs/desmos/x/relationships/keeper"
+	relationshipsT "github.com/desmos-labs/desmos/x/relationships/types"
+	subscriptionsK "github.com/desmos-labs/desmos/x/subscriptions/keeper"
+	subscriptionsT "github.com/desmos-labs/desmos/x/subscriptions/types"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 	db "github.com/tendermint/tm-db"
 )
@@ -21,11 +29,15 @@ import (
 type KeeperTestSuite struct {
 	suite.Suite
 
-	cdc          *codec.Codec
-	ctx          sdk.Context
-	keeper       keeper.Keeper
-	paramsKeeper params.Keeper
-	testData     TestData
+	cdc                 *codec.Codec
+	ctx                 sdk.Context
+	keeper              keeper.Keeper
+	profilesKeeper      profilesK.Keeper
+	relationshipsKeeper relationshipsK.Keeper
+	subscriptionsKeeper subscriptionsK.Keeper
+	paramsKeeper        params.Keeper
+	ak                  auth.AccountKeeper
+	testData            TestData
 }
 
 type TestData struct {
@@ -43,6 +55,11 @@ type TestData struct {
 func (suite *KeeperTestSuite) SetupTest() {
 	// define store keys
 	postKey := sdk.NewKVStoreKey(common.StoreKey)
+	profilesKey := sdk.NewKVStoreKey(profilesT.StoreKey)
+	relationshipsKey := sdk.NewKVStoreKey(relationshipsT.StoreKey)
+	subscriptionsKey := sdk.NewKVStoreKey(subscriptionsT.StoreKey)
+	authKey := sdk.NewKVStoreKey(auth.StoreKey)
+	supplyKey := sdk.NewKVStoreKey(supply.StoreKey)
 	paramsKey := sdk.NewKVStoreKey("params")
 	paramsTKey := sdk.NewTransientStoreKey("transient_params")
 
@@ -50,6 +67,11 @@ func (suite *KeeperTestSuite) SetupTest() {
 	memDB := db.NewMemDB()
 	ms := store.NewCommitMultiStore(memDB)
 	ms.MountStoreWithDB(postKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(profilesKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(relationshipsKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(subscriptionsKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, memDB)
 	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, memDB)
 	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, memDB)
 	if err := ms.LoadLatestVersion(); err != nil {
@@ -59,7 +81,18 @@ func (suite *KeeperTestSuite) SetupTest() {
 	suite.ctx = sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
 	suite.cdc = testCodec()
 	suite.paramsKeeper = params.NewKeeper(suite.cdc, paramsKey, paramsTKey)
-	suite.keeper = keeper.NewKeeper(suite.cdc, postKey, suite.paramsKeeper.Subspace(types.DefaultParamspace))
+	suite.profilesKeeper = profilesK.NewKeeper(suite.cdc, profilesKey, suite.paramsKeeper.Subspace(profilesT.DefaultParamspace))
+	suite.relationshipsKeeper = relationshipsK.NewKeeper(suite.profilesKeeper, suite.cdc, relationshipsKey)
+	suite.ak = auth.NewAccountKeeper(suite.cdc, authKey, suite.paramsKeeper.Subspace(auth.DefaultParamspace),
+		auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(suite.ak, suite.paramsKeeper.Subspace(bank.DefaultParamspace), nil)
+	supplyKeeper := supply.NewKeeper(suite.cdc, supplyKey, suite.ak, bankKeeper, map[string][]string{
+		subscriptionsT.ModuleName: nil,
+	})
+	suite.subscriptionsKeeper = subscriptionsK.NewKeeper(suite.relationshipsKeeper, supplyKeeper, suite.cdc,
+		subscriptionsKey, suite.paramsKeeper.Subspace(subscriptionsT.DefaultParamspace))
+	suite.keeper = keeper.NewKeeper(suite.profilesKeeper, suite.relationshipsKeeper, suite.subscriptionsKeeper,
+		bankKeeper, suite.cdc, postKey, suite.paramsKeeper.Subspace(types.DefaultParamspace))
 
 	// setup Data
 	suite.testData.postID = "19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af"
@@ -93,6 +126,13 @@ func (suite *KeeperTestSuite) SetupTest() {
 		"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e")
 }
 
+// setBalance sets the coins of the account having the given address, creating it if needed
+func (suite *KeeperTestSuite) setBalance(address sdk.AccAddress, coins sdk.Coins) {
+	acc := suite.ak.NewAccountWithAddress(suite.ctx, address)
+	suite.NoError(acc.SetCoins(coins))
+	suite.ak.SetAccount(suite.ctx, acc)
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
@@ -101,7 +141,8 @@ func testCodec() *codec.Codec {
 	var cdc = codec.New()
 
 	// register the different types
-	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	codec.RegisterCrypto(cdc)
+	auth.RegisterCodec(cdc)
 	types.RegisterCodec(cdc)
 
 	cdc.Seal()
@@ -30,6 +30,10 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgAnswerPollPost(ctx, keeper, msg)
 		case types.MsgRegisterReaction:
 			return handleMsgRegisterReaction(ctx, keeper, msg)
+		case types.MsgTipPost:
+			return handleMsgTipPost(ctx, keeper, msg)
+		case types.MsgSetSubscriberOnly:
+			return handleMsgSetSubscriberOnly(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized Posts message type: %v", msg.Type())
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -39,6 +43,13 @@ func NewHandler(keeper Keeper) sdk.Handler {
 
 // handleMsgCreatePost handles the creation of a new post
 func handleMsgCreatePost(ctx sdk.Context, keeper Keeper, msg types.MsgCreatePost) (*sdk.Result, error) {
+	// Resolve the account on whose behalf the message is performed
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, msg.Creator, msg.ActingAs, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	msg.Creator = actor
+
 	post := types.NewPost(
 		msg.ParentID,
 		msg.Message,
@@ -68,6 +79,11 @@ func handleMsgCreatePost(ctx sdk.Context, keeper Keeper, msg types.MsgCreatePost
 		if !parentPost.AllowsComments {
 			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("post with id %s does not allow comments", parentPost.PostID))
 		}
+
+		if keeper.RelationshipsKeeper.IsUserBlocked(ctx, parentPost.Creator, post.Creator) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				fmt.Sprintf("the creator of the post with id %s has blocked you", parentPost.PostID))
+		}
 	}
 
 	if err := ValidatePost(ctx, keeper, post); err != nil {
@@ -94,6 +110,12 @@ func handleMsgCreatePost(ctx sdk.Context, keeper Keeper, msg types.MsgCreatePost
 
 // handleMsgEditPost handles the edit of posts
 func handleMsgEditPost(ctx sdk.Context, keeper Keeper, msg types.MsgEditPost) (*sdk.Result, error) {
+	// Resolve the account on whose behalf the message is performed
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, msg.Editor, msg.ActingAs, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	msg.Editor = actor
 
 	// Get the existing post
 	existing, found := keeper.GetPost(ctx, msg.PostID)
@@ -170,6 +192,13 @@ func extractReactionValueAndShortcode(keeper Keeper, ctx sdk.Context, reaction s
 
 // handleMsgAddPostReaction handles the adding of a reaction to a post
 func handleMsgAddPostReaction(ctx sdk.Context, keeper Keeper, msg types.MsgAddPostReaction) (*sdk.Result, error) {
+	// Resolve the account on whose behalf the message is performed
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, msg.User, msg.ActingAs, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	msg.User = actor
+
 	// Get the post
 	post, found := keeper.GetPost(ctx, msg.PostID)
 	if !found {
@@ -205,6 +234,13 @@ func handleMsgAddPostReaction(ctx sdk.Context, keeper Keeper, msg types.MsgAddPo
 
 // handleMsgRemovePostReaction handles the removal of a reaction from a post
 func handleMsgRemovePostReaction(ctx sdk.Context, keeper Keeper, msg types.MsgRemovePostReaction) (*sdk.Result, error) {
+	// Resolve the account on whose behalf the message is performed
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, msg.User, msg.ActingAs, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	msg.User = actor
+
 	// Get the post
 	post, found := keeper.GetPost(ctx, msg.PostID)
 	if !found {
@@ -275,6 +311,12 @@ func answerExist(providedAnswers []types.AnswerID, answer types.AnswerID) bool {
 
 // handleMsgAnswerPollPost handles the answer to a poll post
 func handleMsgAnswerPollPost(ctx sdk.Context, keeper Keeper, msg types.MsgAnswerPoll) (*sdk.Result, error) {
+	// Resolve the account on whose behalf the message is performed
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, msg.Answerer, msg.ActingAs, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	msg.Answerer = actor
 
 	post, err := checkPostPollValid(ctx, msg.PostID, keeper)
 	if err != nil {
@@ -371,3 +413,93 @@ func handleMsgRegisterReaction(ctx sdk.Context, keeper Keeper, msg types.MsgRegi
 
 	return &result, nil
 }
+
+// handleMsgTipPost handles the transfer of a tip to the creator of a post,
+// paying the fee of the post subspace, if any, to its recipient
+func handleMsgTipPost(ctx sdk.Context, keeper Keeper, msg types.MsgTipPost) (*sdk.Result, error) {
+	post, found := keeper.GetPost(ctx, msg.PostID)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("post with id %s not found", msg.PostID))
+	}
+
+	if post.Creator.Equals(msg.Tipper) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "you cannot tip your own post")
+	}
+
+	if keeper.RelationshipsKeeper.IsUserBlocked(ctx, post.Creator, msg.Tipper) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("the creator of the post with id %s has blocked you", post.PostID))
+	}
+
+	// Pay the subspace fee, if any
+	fee := sdk.NewCoins()
+	if tipFee, found := keeper.GetParams(ctx).SubspaceTipFees.Find(post.Subspace); found {
+		fee = tipFee.Fee(msg.Amount)
+		if !fee.IsZero() {
+			if err := keeper.BankKeeper.SendCoins(ctx, msg.Tipper, tipFee.Recipient, fee); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Send the rest of the tip to the post creator
+	if creatorAmount := msg.Amount.Sub(fee); !creatorAmount.IsZero() {
+		if err := keeper.BankKeeper.SendCoins(ctx, msg.Tipper, post.Creator, creatorAmount); err != nil {
+			return nil, err
+		}
+	}
+
+	keeper.SavePostTips(ctx, post.PostID, keeper.GetPostTips(ctx, post.PostID).AddTip(msg.Tipper, msg.Amount))
+
+	tipEvent := sdk.NewEvent(
+		types.EventTypePostTipped,
+		sdk.NewAttribute(types.AttributeKeyPostID, post.PostID.String()),
+		sdk.NewAttribute(types.AttributeKeyTipper, msg.Tipper.String()),
+		sdk.NewAttribute(types.AttributeKeyTipAmount, msg.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyTipFee, fee.String()),
+	)
+	ctx.EventManager().EmitEvent(tipEvent)
+
+	result := sdk.Result{
+		Data:   keeper.Cdc.MustMarshalBinaryLengthPrefixed(post.PostID),
+		Events: ctx.EventManager().Events(),
+	}
+	return &result, nil
+}
+
+// handleMsgSetSubscriberOnly handles the setting of the minimum subscription tier that users must have
+// to see the content of a post
+func handleMsgSetSubscriberOnly(ctx sdk.Context, keeper Keeper, msg types.MsgSetSubscriberOnly) (*sdk.Result, error) {
+	post, found := keeper.GetPost(ctx, msg.PostID)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("post with id %s not found", msg.PostID))
+	}
+
+	if !post.Creator.Equals(msg.Creator) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the post creator can make it subscriber-only")
+	}
+
+	if msg.MinTier > 0 {
+		if _, found := keeper.SubscriptionsKeeper.GetTier(ctx, msg.Creator, msg.MinTier); !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("tier %d of creator %s not found", msg.MinTier, msg.Creator))
+		}
+	}
+
+	keeper.SetPostMinTier(ctx, post.PostID, msg.MinTier)
+
+	event := sdk.NewEvent(
+		types.EventTypeSubscriberOnlySet,
+		sdk.NewAttribute(types.AttributeKeyPostID, post.PostID.String()),
+		sdk.NewAttribute(types.AttributeKeyPostOwner, post.Creator.String()),
+		sdk.NewAttribute(types.AttributeKeyMinTier, strconv.FormatUint(uint64(msg.MinTier), 10)),
+	)
+	ctx.EventManager().EmitEvent(event)
+
+	result := sdk.Result{
+		Data:   keeper.Cdc.MustMarshalBinaryLengthPrefixed(post.PostID),
+		Events: ctx.EventManager().Events(),
+	}
+
+	return &result, nil
+}
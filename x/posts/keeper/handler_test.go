@@ -13,6 +13,9 @@ import (
 
 	"github.com/desmos-labs/desmos/x/posts/keeper"
 	"github.com/desmos-labs/desmos/x/posts/types"
+	profilesT "github.com/desmos-labs/desmos/x/profiles/types"
+	relationshipsT "github.com/desmos-labs/desmos/x/relationships/types"
+	subscriptionsT "github.com/desmos-labs/desmos/x/subscriptions/types"
 )
 
 func (suite *KeeperTestSuite) Test_handleMsgCreatePost() {
@@ -193,6 +196,119 @@ func (suite *KeeperTestSuite) Test_handleMsgCreatePost() {
 
 }
 
+func (suite *KeeperTestSuite) Test_handleMsgCreatePost_ActingAs() {
+	grantee, err := sdk.AccAddressFromBech32("cosmos1q4hx350dh0843wr3csctxr87at3zcvd9qehqvg")
+	suite.NoError(err)
+
+	owner := suite.testData.postOwner
+	msg := types.NewMsgCreatePost(
+		"Post created on behalf of the owner",
+		"",
+		true,
+		suite.testData.post.Subspace,
+		map[string]string{},
+		grantee,
+		nil,
+		nil,
+	).WithActingAs(owner)
+
+	tests := []struct {
+		name   string
+		grant  *profilesT.Grant
+		expErr error
+	}{
+		{
+			name: "Missing grant returns error",
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				fmt.Sprintf("%s has not been authorized to act on behalf of %s", grantee, owner)),
+		},
+		{
+			name: "Grant not allowing the message returns error",
+			grant: &profilesT.Grant{
+				Granter: owner, Grantee: grantee, MsgTypes: []string{types.ActionEditPost},
+				Expiration: suite.testData.postEndPollDate,
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				fmt.Sprintf("%s has not been authorized to perform %s messages on behalf of %s", grantee, types.ActionCreatePost, owner)),
+		},
+		{
+			name: "Valid grant creates the post on behalf of the owner",
+			grant: &profilesT.Grant{
+				Granter: owner, Grantee: grantee, MsgTypes: []string{types.ActionCreatePost},
+				Expiration: suite.testData.postEndPollDate,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
+			suite.keeper.SetParams(suite.ctx, types.DefaultParams())
+
+			if test.grant != nil {
+				suite.profilesKeeper.SaveGrant(suite.ctx, *test.grant)
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, msg)
+
+			if test.expErr != nil {
+				suite.Nil(res)
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Empty(suite.keeper.GetPosts(suite.ctx))
+				return
+			}
+
+			suite.NoError(err)
+			posts := suite.keeper.GetPosts(suite.ctx)
+			suite.Len(posts, 1)
+			suite.Equal(owner, posts[0].Creator)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgCreatePost_BlockedCommenter() {
+	commenter, err := sdk.AccAddressFromBech32("cosmos1q4hx350dh0843wr3csctxr87at3zcvd9qehqvg")
+	suite.NoError(err)
+
+	parent := suite.testData.post
+	parent.AllowsComments = true
+
+	msg := types.NewMsgCreatePost(
+		"Comment",
+		parent.PostID,
+		true,
+		parent.Subspace,
+		map[string]string{},
+		commenter,
+		nil,
+		nil,
+	)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
+	suite.keeper.SavePost(suite.ctx, parent)
+	suite.NoError(suite.relationshipsKeeper.SaveUserBlock(suite.ctx,
+		relationshipsT.NewUserBlock(parent.Creator, commenter, "harassment")))
+
+	handler := keeper.NewHandler(suite.keeper)
+	res, err := handler(suite.ctx, msg)
+	suite.Nil(res)
+	suite.Error(err)
+	suite.Equal(sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+		fmt.Sprintf("the creator of the post with id %s has blocked you", parent.PostID)).Error(), err.Error())
+	suite.Len(suite.keeper.GetPosts(suite.ctx), 1)
+
+	// Once unblocked, the user can comment again
+	suite.NoError(suite.relationshipsKeeper.DeleteUserBlock(suite.ctx, parent.Creator, commenter))
+	_, err = handler(suite.ctx, msg)
+	suite.NoError(err)
+	suite.Len(suite.keeper.GetPosts(suite.ctx), 2)
+}
+
 func (suite *KeeperTestSuite) Test_handleMsgEditPost() {
 	id := types.PostID("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af")
 	editor, err := sdk.AccAddressFromBech32("cosmos1z427v6xdc8jgn5yznfzhwuvetpzzcnusut3z63")
@@ -858,3 +974,199 @@ func (suite *KeeperTestSuite) Test_handleMsgRegisterReaction() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) Test_handleMsgTipPost() {
+	tipper, err := sdk.AccAddressFromBech32("cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4")
+	suite.NoError(err)
+
+	recipient, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	suite.NoError(err)
+
+	post := suite.testData.post
+	amount := sdk.NewCoins(sdk.NewInt64Coin("udaric", 1000))
+
+	tests := []struct {
+		name             string
+		storedPost       *types.Post
+		blocked          bool
+		tipFees          types.SubspaceTipFees
+		msg              types.MsgTipPost
+		expError         error
+		expCreatorCoins  sdk.Coins
+		expRecipientFees sdk.Coins
+	}{
+		{
+			name:     "Post not found returns error",
+			msg:      types.NewMsgTipPost(post.PostID, amount, tipper),
+			expError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("post with id %s not found", post.PostID)),
+		},
+		{
+			name:       "Tipping own post returns error",
+			storedPost: &post,
+			msg:        types.NewMsgTipPost(post.PostID, amount, post.Creator),
+			expError:   sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "you cannot tip your own post"),
+		},
+		{
+			name:       "Blocked tipper returns error",
+			storedPost: &post,
+			blocked:    true,
+			msg:        types.NewMsgTipPost(post.PostID, amount, tipper),
+			expError: sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				fmt.Sprintf("the creator of the post with id %s has blocked you", post.PostID)),
+		},
+		{
+			name:       "Insufficient funds returns error",
+			storedPost: &post,
+			msg:        types.NewMsgTipPost(post.PostID, sdk.NewCoins(sdk.NewInt64Coin("udaric", 10000)), tipper),
+			expError:   sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "insufficient account funds; 5000udaric < 10000udaric"),
+		},
+		{
+			name:             "Tip without subspace fee is sent to the creator",
+			storedPost:       &post,
+			msg:              types.NewMsgTipPost(post.PostID, amount, tipper),
+			expCreatorCoins:  amount,
+			expRecipientFees: sdk.NewCoins(),
+		},
+		{
+			name:       "Tip with subspace fee is split between creator and recipient",
+			storedPost: &post,
+			tipFees: types.SubspaceTipFees{
+				types.NewSubspaceTipFee(post.Subspace, recipient, sdk.NewDecWithPrec(1, 1)),
+			},
+			msg:              types.NewMsgTipPost(post.PostID, amount, tipper),
+			expCreatorCoins:  sdk.NewCoins(sdk.NewInt64Coin("udaric", 900)),
+			expRecipientFees: sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.setBalance(tipper, sdk.NewCoins(sdk.NewInt64Coin("udaric", 5000)))
+
+			params := types.DefaultParams()
+			params.SubspaceTipFees = test.tipFees
+			suite.keeper.SetParams(suite.ctx, params)
+
+			if test.storedPost != nil {
+				suite.keeper.SavePost(suite.ctx, *test.storedPost)
+			}
+
+			if test.blocked {
+				suite.NoError(suite.relationshipsKeeper.SaveUserBlock(suite.ctx,
+					relationshipsT.NewUserBlock(post.Creator, tipper, "spam")))
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expError != nil {
+				suite.Error(err)
+				suite.Nil(res)
+				suite.Equal(test.expError.Error(), err.Error())
+				suite.Equal(types.NewPostTips(), suite.keeper.GetPostTips(suite.ctx, post.PostID))
+				return
+			}
+
+			suite.NoError(err)
+			suite.Contains(res.Events, sdk.NewEvent(
+				types.EventTypePostTipped,
+				sdk.NewAttribute(types.AttributeKeyPostID, post.PostID.String()),
+				sdk.NewAttribute(types.AttributeKeyTipper, tipper.String()),
+				sdk.NewAttribute(types.AttributeKeyTipAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyTipFee, test.expRecipientFees.String()),
+			))
+
+			suite.Equal(test.expCreatorCoins, suite.keeper.BankKeeper.GetCoins(suite.ctx, post.Creator))
+			suite.Equal(test.expRecipientFees, suite.keeper.BankKeeper.GetCoins(suite.ctx, recipient))
+			suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("udaric", 4000)), suite.keeper.BankKeeper.GetCoins(suite.ctx, tipper))
+			suite.Equal(
+				types.NewPostTips(types.NewTip(tipper, amount)),
+				suite.keeper.GetPostTips(suite.ctx, post.PostID),
+			)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgSetSubscriberOnly() {
+	otherUser, err := sdk.AccAddressFromBech32("cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4")
+	suite.NoError(err)
+
+	post := suite.testData.post
+	tier := subscriptionsT.NewTier(post.Creator, 2, "Gold", sdk.NewInt64Coin("udaric", 100), 10)
+
+	tests := []struct {
+		name          string
+		storedPost    *types.Post
+		storedMinTier uint32
+		msg           types.MsgSetSubscriberOnly
+		expError      error
+		expMinTier    uint32
+	}{
+		{
+			name:     "Post not found returns error",
+			msg:      types.NewMsgSetSubscriberOnly(post.PostID, 2, post.Creator),
+			expError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("post with id %s not found", post.PostID)),
+		},
+		{
+			name:       "Different creator returns error",
+			storedPost: &post,
+			msg:        types.NewMsgSetSubscriberOnly(post.PostID, 2, otherUser),
+			expError:   sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "only the post creator can make it subscriber-only"),
+		},
+		{
+			name:       "Not found tier returns error",
+			storedPost: &post,
+			msg:        types.NewMsgSetSubscriberOnly(post.PostID, 3, post.Creator),
+			expError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("tier 3 of creator %s not found", post.Creator)),
+		},
+		{
+			name:       "Existing tier makes the post subscriber-only",
+			storedPost: &post,
+			msg:        types.NewMsgSetSubscriberOnly(post.PostID, 2, post.Creator),
+			expMinTier: 2,
+		},
+		{
+			name:          "Zero tier makes the post visible to everyone",
+			storedPost:    &post,
+			storedMinTier: 2,
+			msg:           types.NewMsgSetSubscriberOnly(post.PostID, 0, post.Creator),
+			expMinTier:    0,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.subscriptionsKeeper.SaveTier(suite.ctx, tier)
+
+			if test.storedPost != nil {
+				suite.keeper.SavePost(suite.ctx, *test.storedPost)
+				suite.keeper.SetPostMinTier(suite.ctx, test.storedPost.PostID, test.storedMinTier)
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expError != nil {
+				suite.Error(err)
+				suite.Nil(res)
+				suite.Equal(test.expError.Error(), err.Error())
+				suite.Equal(test.storedMinTier, suite.keeper.GetPostMinTier(suite.ctx, post.PostID))
+				return
+			}
+
+			suite.NoError(err)
+			suite.Contains(res.Events, sdk.NewEvent(
+				types.EventTypeSubscriberOnlySet,
+				sdk.NewAttribute(types.AttributeKeyPostID, post.PostID.String()),
+				sdk.NewAttribute(types.AttributeKeyPostOwner, post.Creator.String()),
+				sdk.NewAttribute(types.AttributeKeyMinTier, fmt.Sprint(test.expMinTier)),
+			))
+			suite.Equal(test.expMinTier, suite.keeper.GetPostMinTier(suite.ctx, post.PostID))
+		})
+	}
+}
@@ -6,8 +6,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	params "github.com/cosmos/cosmos-sdk/x/params/subspace"
 	"github.com/desmos-labs/desmos/x/posts/types"
+	profilesK "github.com/desmos-labs/desmos/x/profiles/keeper"
+	relationshipsK "github.com/desmos-labs/desmos/x/relationships/keeper"
+	subscriptionsK "github.com/desmos-labs/desmos/x/subscriptions/keeper"
 )
 
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
@@ -15,20 +19,32 @@ type Keeper struct {
 	// The reference to the ParamsStore to get and set posts specific params
 	paramSubspace params.Subspace
 
+	ProfilesKeeper      profilesK.Keeper      // Profiles' keeper to resolve the accounts acting on behalf of others
+	RelationshipsKeeper relationshipsK.Keeper // Relationships' keeper to check whether users have blocked each other
+	SubscriptionsKeeper subscriptionsK.Keeper // Subscriptions' keeper to gate the subscriber-only posts
+	BankKeeper          bank.Keeper           // Bank keeper to transfer the tips sent to the posts
+
 	StoreKey sdk.StoreKey // Unexposed key to access store from sdk.Context
 	Cdc      *codec.Codec // The wire codec for binary encoding/decoding.
 }
 
 // NewKeeper creates new instances of the posts Keeper
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace) Keeper {
+func NewKeeper(
+	pk profilesK.Keeper, rk relationshipsK.Keeper, sk subscriptionsK.Keeper, bk bank.Keeper,
+	cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace,
+) Keeper {
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
 	return Keeper{
-		StoreKey:      storeKey,
-		Cdc:           cdc,
-		paramSubspace: paramSpace,
+		ProfilesKeeper:      pk,
+		RelationshipsKeeper: rk,
+		SubscriptionsKeeper: sk,
+		BankKeeper:          bk,
+		StoreKey:            storeKey,
+		Cdc:                 cdc,
+		paramSubspace:       paramSpace,
 	}
 }
 
@@ -45,6 +61,9 @@ func (k Keeper) SavePost(ctx sdk.Context, post types.Post) {
 	// Save the post
 	store.Set(types.PostStoreKey(post.PostID), k.Cdc.MustMarshalBinaryBare(&post))
 
+	// Index the post inside the creator ones
+	store.Set(types.CreatorPostStoreKey(post.Creator, post.Created, post.PostID), []byte(post.PostID))
+
 	// Check if the postID got an associated post, if not, increment the number of posts
 	if !store.Has(types.PostIndexedIDStoreKey(post.PostID)) {
 		// Retrieve the total number of posts, if null it will be equal to 0
@@ -117,6 +136,11 @@ func (k Keeper) GetPosts(ctx sdk.Context) (posts types.Posts) {
 func (k Keeper) GetPostsFiltered(ctx sdk.Context, params types.QueryPostsParams) types.Posts {
 	filteredPosts := types.Posts{}
 	k.IteratePosts(ctx, func(_ int64, post types.Post) (stop bool) {
+		// skip hidden posts unless requested
+		if !params.IncludeHidden && k.IsPostHidden(ctx, post.PostID) {
+			return false
+		}
+
 		matchParentID, matchCreationTime, matchAllowsComments, matchSubspace, matchCreator, matchHashtags := true, true, true, true, true, true
 
 		// match parent id if valid
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/x/posts/types"
+)
+
+// HidePost marks the post having the given id as hidden, so that it is no longer
+// returned by default when filtering the posts.
+// It assumes that the post exists.
+func (k Keeper) HidePost(ctx sdk.Context, postID types.PostID) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.HiddenPostStoreKey(postID), []byte{0x01})
+}
+
+// UnhidePost removes the hidden mark from the post having the given id
+func (k Keeper) UnhidePost(ctx sdk.Context, postID types.PostID) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Delete(types.HiddenPostStoreKey(postID))
+}
+
+// IsPostHidden tells whether the post having the given id has been hidden or not
+func (k Keeper) IsPostHidden(ctx sdk.Context, postID types.PostID) bool {
+	store := ctx.KVStore(k.StoreKey)
+	return store.Has(types.HiddenPostStoreKey(postID))
+}
+
+// GetHiddenPostsIDs returns the ids of all the posts that have been hidden
+func (k Keeper) GetHiddenPostsIDs(ctx sdk.Context) types.PostIDs {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.HiddenPostsStorePrefix)
+	defer iterator.Close()
+
+	var ids types.PostIDs
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, types.PostID(bytes.TrimPrefix(iterator.Key(), types.HiddenPostsStorePrefix)))
+	}
+
+	return ids
+}
@@ -0,0 +1,23 @@
+package keeper_test
+
+import (
+	"github.com/desmos-labs/desmos/x/posts/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_HidePost() {
+	id := types.PostID("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af")
+	id2 := types.PostID("f1b909289cd23188c19da17ae5d5a05ad65623b0fad756e5e03c8c936ca876fd")
+
+	suite.False(suite.keeper.IsPostHidden(suite.ctx, id))
+	suite.Nil(suite.keeper.GetHiddenPostsIDs(suite.ctx))
+
+	suite.keeper.HidePost(suite.ctx, id)
+	suite.keeper.HidePost(suite.ctx, id2)
+	suite.True(suite.keeper.IsPostHidden(suite.ctx, id))
+	suite.True(suite.keeper.IsPostHidden(suite.ctx, id2))
+	suite.Equal(types.PostIDs{id, id2}, suite.keeper.GetHiddenPostsIDs(suite.ctx))
+
+	suite.keeper.UnhidePost(suite.ctx, id)
+	suite.False(suite.keeper.IsPostHidden(suite.ctx, id))
+	suite.Equal(types.PostIDs{id2}, suite.keeper.GetHiddenPostsIDs(suite.ctx))
+}
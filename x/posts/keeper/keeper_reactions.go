@@ -18,6 +18,12 @@ import (
 // If another reaction from the same user for the same post and with the same value exists, returns an expError.
 //nolint: interfacer
 func (k Keeper) SavePostReaction(ctx sdk.Context, postID types.PostID, reaction types.PostReaction) error {
+	// Check whether the post creator has blocked the reaction owner
+	if post, found := k.GetPost(ctx, postID); found &&
+		k.RelationshipsKeeper.IsUserBlocked(ctx, post.Creator, reaction.Owner) {
+		return fmt.Errorf("the creator of the post with id %s has blocked %s", postID, reaction.Owner)
+	}
+
 	store := ctx.KVStore(k.StoreKey)
 	key := types.PostReactionsStoreKey(postID)
 
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/desmos-labs/desmos/x/posts/types"
+	relationshipsT "github.com/desmos-labs/desmos/x/relationships/types"
 )
 
 // -------------
@@ -111,6 +112,19 @@ func (suite *KeeperTestSuite) TestKeeper_SaveReaction() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeper_SaveReaction_BlockedReactor() {
+	reactor, err := sdk.AccAddressFromBech32("cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4")
+	suite.NoError(err)
+
+	suite.keeper.SavePost(suite.ctx, suite.testData.post)
+	suite.NoError(suite.relationshipsKeeper.SaveUserBlock(suite.ctx,
+		relationshipsT.NewUserBlock(suite.testData.post.Creator, reactor, "spam")))
+
+	err = suite.keeper.SavePostReaction(suite.ctx, suite.testData.postID, types.NewPostReaction(":like:", "👍", reactor))
+	suite.Equal(fmt.Errorf("the creator of the post with id %s has blocked %s", suite.testData.postID, reactor), err)
+	suite.Empty(suite.keeper.GetPostReactions(suite.ctx, suite.testData.postID))
+}
+
 func (suite *KeeperTestSuite) TestKeeper_RemoveReaction() {
 	id := types.PostID("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af")
 	liker, err := sdk.AccAddressFromBech32("cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4")
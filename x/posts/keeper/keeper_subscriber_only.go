@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/x/posts/types"
+)
+
+// SetPostMinTier sets the minimum subscription tier that users must have to see the content
+// of the post having the given id. A tier equal to zero makes the post visible to everyone.
+// It assumes that the post exists.
+func (k Keeper) SetPostMinTier(ctx sdk.Context, postID types.PostID, minTier uint32) {
+	store := ctx.KVStore(k.StoreKey)
+	if minTier == 0 {
+		store.Delete(types.PostMinTierStoreKey(postID))
+		return
+	}
+
+	store.Set(types.PostMinTierStoreKey(postID), k.Cdc.MustMarshalBinaryBare(&minTier))
+}
+
+// GetPostMinTier returns the minimum subscription tier required to see the content of the post
+// having the given id. If the post is visible to everyone, zero is returned instead.
+func (k Keeper) GetPostMinTier(ctx sdk.Context, postID types.PostID) uint32 {
+	store := ctx.KVStore(k.StoreKey)
+
+	bz := store.Get(types.PostMinTierStoreKey(postID))
+	if bz == nil {
+		return 0
+	}
+
+	var minTier uint32
+	k.Cdc.MustUnmarshalBinaryBare(bz, &minTier)
+	return minTier
+}
+
+// GetPostsMinTiersMap returns the minimum subscription tiers of all the subscriber-only posts,
+// indexed by the posts ids
+func (k Keeper) GetPostsMinTiersMap(ctx sdk.Context) map[string]uint32 {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PostMinTierStorePrefix)
+	defer iterator.Close()
+
+	minTiers := map[string]uint32{}
+	for ; iterator.Valid(); iterator.Next() {
+		var minTier uint32
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &minTier)
+		idBytes := bytes.TrimPrefix(iterator.Key(), types.PostMinTierStorePrefix)
+		minTiers[string(idBytes)] = minTier
+	}
+
+	return minTiers
+}
+
+// CanViewPost tells whether the given viewer is allowed to see the content of the given post,
+// based on the minimum subscription tier that has been set for it
+func (k Keeper) CanViewPost(ctx sdk.Context, post types.Post, viewer sdk.AccAddress) bool {
+	return k.SubscriptionsKeeper.HasAccess(ctx, post.Creator, viewer, k.GetPostMinTier(ctx, post.PostID))
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/x/posts/types"
+	subscriptionsT "github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SetPostMinTier() {
+	id := types.PostID("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af")
+	id2 := types.PostID("f1b909289cd23188c19da17ae5d5a05ad65623b0fad756e5e03c8c936ca876fd")
+
+	suite.Equal(uint32(0), suite.keeper.GetPostMinTier(suite.ctx, id))
+	suite.Empty(suite.keeper.GetPostsMinTiersMap(suite.ctx))
+
+	suite.keeper.SetPostMinTier(suite.ctx, id, 1)
+	suite.keeper.SetPostMinTier(suite.ctx, id2, 3)
+
+	suite.Equal(uint32(1), suite.keeper.GetPostMinTier(suite.ctx, id))
+	suite.Equal(map[string]uint32{
+		id.String():  1,
+		id2.String(): 3,
+	}, suite.keeper.GetPostsMinTiersMap(suite.ctx))
+
+	// Setting a zero tier makes the post visible to everyone
+	suite.keeper.SetPostMinTier(suite.ctx, id, 0)
+	suite.Equal(uint32(0), suite.keeper.GetPostMinTier(suite.ctx, id))
+	suite.Equal(map[string]uint32{id2.String(): 3}, suite.keeper.GetPostsMinTiersMap(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_CanViewPost() {
+	subscriber, err := sdk.AccAddressFromBech32("cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4")
+	suite.NoError(err)
+
+	post := suite.testData.post
+	subscription := func(level uint32) *subscriptionsT.Subscription {
+		sub := subscriptionsT.NewSubscription(post.Creator, subscriber, level, sdk.NewInt64Coin("udaric", 100), 10, 1, 10)
+		return &sub
+	}
+
+	tests := []struct {
+		name         string
+		minTier      uint32
+		subscription *subscriptionsT.Subscription
+		viewer       sdk.AccAddress
+		expResult    bool
+	}{
+		{
+			name:      "Public post can be seen by anyone",
+			viewer:    nil,
+			expResult: true,
+		},
+		{
+			name:      "Subscriber-only post can be seen by its creator",
+			minTier:   2,
+			viewer:    post.Creator,
+			expResult: true,
+		},
+		{
+			name:      "Subscriber-only post cannot be seen without a viewer",
+			minTier:   2,
+			viewer:    nil,
+			expResult: false,
+		},
+		{
+			name:      "Subscriber-only post cannot be seen by non subscribers",
+			minTier:   2,
+			viewer:    subscriber,
+			expResult: false,
+		},
+		{
+			name:         "Subscriber-only post cannot be seen by lower tier subscribers",
+			minTier:      2,
+			subscription: subscription(1),
+			viewer:       subscriber,
+			expResult:    false,
+		},
+		{
+			name:         "Subscriber-only post can be seen by higher tier subscribers",
+			minTier:      2,
+			subscription: subscription(3),
+			viewer:       subscriber,
+			expResult:    true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.keeper.SavePost(suite.ctx, post)
+			suite.keeper.SetPostMinTier(suite.ctx, post.PostID, test.minTier)
+
+			if test.subscription != nil {
+				suite.subscriptionsKeeper.SaveSubscription(suite.ctx, *test.subscription)
+			}
+
+			suite.Equal(test.expResult, suite.keeper.CanViewPost(suite.ctx, post, test.viewer))
+		})
+	}
+}
@@ -429,6 +429,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetPostsFiltered() {
 
 	tests := []struct {
 		name     string
+		hidden   types.PostIDs
 		filter   types.QueryPostsParams
 		expected types.Posts
 	}{
@@ -497,6 +498,18 @@ func (suite *KeeperTestSuite) TestKeeper_GetPostsFiltered() {
 			filter:   types.QueryPostsParams{Page: 1, Limit: 5, Hashtags: []string{"desmos", "test"}},
 			expected: types.Posts{posts[0]},
 		},
+		{
+			name:     "Hidden posts are excluded by default",
+			hidden:   types.PostIDs{id3},
+			filter:   types.DefaultQueryPostsParams(1, 5),
+			expected: types.Posts{posts[0], posts[2]},
+		},
+		{
+			name:     "Hidden posts are returned when requested",
+			hidden:   types.PostIDs{id3},
+			filter:   types.QueryPostsParams{Page: 1, Limit: 5, IncludeHidden: true},
+			expected: types.Posts{posts[1], posts[2], posts[0]},
+		},
 	}
 
 	for _, test := range tests {
@@ -504,6 +517,10 @@ func (suite *KeeperTestSuite) TestKeeper_GetPostsFiltered() {
 		suite.Run(test.name, func() {
 			for _, post := range posts {
 				suite.keeper.SavePost(suite.ctx, post)
+				suite.keeper.UnhidePost(suite.ctx, post.PostID)
+			}
+			for _, postID := range test.hidden {
+				suite.keeper.HidePost(suite.ctx, postID)
 			}
 			result := suite.keeper.GetPostsFiltered(suite.ctx, test.filter)
 
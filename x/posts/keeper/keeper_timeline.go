@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/posts/types"
+	relationshipsTypes "github.com/desmos-labs/desmos/x/relationships/types"
+)
+
+// nextTimelinePost returns the post referenced by the current entry of the given creator posts iterator,
+// advancing it until a post belonging to the given subspace that has not been hidden is found.
+// If the subspace is empty, posts belonging to any subspace are returned.
+// If the iterator has no more entries, nil is returned instead
+func (k Keeper) nextTimelinePost(ctx sdk.Context, iterator sdk.Iterator, subspace string) *types.Post {
+	for ; iterator.Valid(); iterator.Next() {
+		postID := types.PostID(iterator.Value())
+		if k.IsPostHidden(ctx, postID) {
+			continue
+		}
+
+		post, found := k.GetPost(ctx, postID)
+		if found && (subspace == "" || post.Subspace == subspace) {
+			return &post
+		}
+	}
+	return nil
+}
+
+// isNewerPost tells whether the first post comes before the second one inside a timeline.
+// Posts are sorted by descending creation time and, when created at the same time, by descending id
+func isNewerPost(first, second types.Post) bool {
+	if !first.Created.Equal(second.Created) {
+		return first.Created.After(second.Created)
+	}
+	return first.PostID > second.PostID
+}
+
+// GetTimeline returns at most limit posts created by the users with which the given user has a relationship,
+// sorted from the newest to the oldest one. If a subspace is given, only the relationships and the posts
+// inside such subspace are considered. If a cursor is given, only the posts that come after it are returned.
+// Hidden posts are never returned, and at most relationshipsTypes.MaxGraphFanOut followed users are considered.
+// Along with the posts, the id of the post to be used as the cursor to get the next page is returned.
+// Such id is empty when no more posts are present
+func (k Keeper) GetTimeline(
+	ctx sdk.Context, user sdk.AccAddress, subspace string, cursor *types.Post, limit int,
+) (types.Posts, types.PostID) {
+	store := ctx.KVStore(k.StoreKey)
+
+	// Open an iterator over the posts of each creator, starting from the newest post that comes after the cursor
+	creators, _ := k.RelationshipsKeeper.GetFollowing(ctx, user, subspace, relationshipsTypes.MaxGraphFanOut)
+	iterators := make([]sdk.Iterator, len(creators))
+	heads := make([]*types.Post, len(creators))
+	for index, creator := range creators {
+		prefix := types.CreatorPostsPrefix(creator)
+		end := sdk.PrefixEndBytes(prefix)
+		if cursor != nil {
+			end = types.CreatorPostStoreKey(creator, cursor.Created, cursor.PostID)
+		}
+
+		iterators[index] = store.ReverseIterator(prefix, end)
+		heads[index] = k.nextTimelinePost(ctx, iterators[index], subspace)
+	}
+
+	defer func() {
+		for _, iterator := range iterators {
+			iterator.Close()
+		}
+	}()
+
+	// Merge the creators posts, taking the newest post among the iterators heads each time
+	posts := types.Posts{}
+	for {
+		newest := -1
+		for index, head := range heads {
+			if head != nil && (newest < 0 || isNewerPost(*head, *heads[newest])) {
+				newest = index
+			}
+		}
+
+		if newest < 0 {
+			return posts, ""
+		}
+
+		if len(posts) == limit {
+			return posts, posts[len(posts)-1].PostID
+		}
+
+		posts = append(posts, *heads[newest])
+		iterators[newest].Next()
+		heads[newest] = k.nextTimelinePost(ctx, iterators[newest], subspace)
+	}
+}
@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/posts/types"
+	relationshipsT "github.com/desmos-labs/desmos/x/relationships/types"
+)
+
+// timelineData contains the data used inside the timeline tests
+type timelineData struct {
+	user, followed, otherFollowed, notFollowed sdk.AccAddress
+	subspace, otherSubspace                    string
+	posts                                      types.Posts
+}
+
+// setupTimeline stores the relationships and posts used inside the timeline tests.
+// The user follows followed inside the subspace and otherFollowed inside the other subspace.
+// The stored posts are returned sorted from the oldest to the newest one
+func (suite *KeeperTestSuite) setupTimeline() timelineData {
+	var data timelineData
+	var err error
+	data.user = suite.testData.postOwner
+	data.followed, err = sdk.AccAddressFromBech32("cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4")
+	suite.Require().NoError(err)
+	data.otherFollowed, err = sdk.AccAddressFromBech32("cosmos1r2plnngkwnahajl3d2a7fvzcsxf6djlt380f3l")
+	suite.Require().NoError(err)
+	data.notFollowed, err = sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	suite.Require().NoError(err)
+	data.subspace = "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	data.otherSubspace = "2bdf5932925584b9a86470bea60adce69041608a447f84a3317723aa5678ec88"
+
+	suite.Require().NoError(suite.relationshipsKeeper.StoreRelationship(suite.ctx, data.user,
+		relationshipsT.NewRelationship(data.followed, data.subspace, relationshipsT.RelationshipTypeFollow)))
+	suite.Require().NoError(suite.relationshipsKeeper.StoreRelationship(suite.ctx, data.user,
+		relationshipsT.NewRelationship(data.otherFollowed, data.otherSubspace, relationshipsT.RelationshipTypeFollow)))
+
+	created := suite.testData.postCreationDate
+	newPost := func(id types.PostID, creator sdk.AccAddress, subspace string, created time.Time) types.Post {
+		return types.Post{
+			PostID:   id,
+			Message:  "Post message",
+			Created:  created,
+			Subspace: subspace,
+			Creator:  creator,
+		}
+	}
+
+	data.posts = types.Posts{
+		newPost("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af", data.followed, data.subspace, created),
+		newPost("f1b909289cd23188c19da17ae5d5a05ad65623b0fad756e5e03c8c936ca876fd", data.otherFollowed, data.otherSubspace, created.Add(time.Minute)),
+		newPost("a4469741bb0c0622627810082a5f2e4e54fbbb888f25a4771a5eebc697d30cfc", data.followed, data.subspace, created.Add(2*time.Minute)),
+		newPost("29de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af", data.otherFollowed, data.subspace, created.Add(3*time.Minute)),
+	}
+	for _, post := range data.posts {
+		suite.keeper.SavePost(suite.ctx, post)
+	}
+
+	// Posts of not followed users are never part of the timeline
+	suite.keeper.SavePost(suite.ctx, newPost(
+		"39de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af", data.notFollowed, data.subspace, created.Add(4*time.Minute),
+	))
+
+	return data
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetTimeline() {
+	data := suite.setupTimeline()
+	posts := data.posts
+
+	tests := []struct {
+		name          string
+		subspace      string
+		cursor        *types.Post
+		limit         int
+		expPosts      types.Posts
+		expNextCursor types.PostID
+	}{
+		{
+			name:     "All the followed users posts are returned from the newest",
+			limit:    10,
+			expPosts: types.Posts{posts[3], posts[2], posts[1], posts[0]},
+		},
+		{
+			name:          "Limit is respected and next cursor is returned",
+			limit:         2,
+			expPosts:      types.Posts{posts[3], posts[2]},
+			expNextCursor: posts[2].PostID,
+		},
+		{
+			name:     "Cursor is respected",
+			cursor:   &posts[2],
+			limit:    2,
+			expPosts: types.Posts{posts[1], posts[0]},
+		},
+		{
+			name:     "Subspace filters both relationships and posts",
+			subspace: data.subspace,
+			limit:    10,
+			expPosts: types.Posts{posts[2], posts[0]},
+		},
+		{
+			name:     "Other subspace filters both relationships and posts",
+			subspace: data.otherSubspace,
+			limit:    10,
+			expPosts: types.Posts{posts[1]},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			timeline, nextCursor := suite.keeper.GetTimeline(suite.ctx, data.user, test.subspace, test.cursor, test.limit)
+			suite.Equal(test.expPosts, timeline)
+			suite.Equal(test.expNextCursor, nextCursor)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetTimeline_NoRelationships() {
+	data := suite.setupTimeline()
+
+	timeline, nextCursor := suite.keeper.GetTimeline(suite.ctx, data.notFollowed, "", nil, 10)
+	suite.Equal(types.Posts{}, timeline)
+	suite.Empty(nextCursor)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetTimeline_HiddenPosts() {
+	data := suite.setupTimeline()
+	posts := data.posts
+
+	suite.keeper.HidePost(suite.ctx, posts[2].PostID)
+
+	timeline, nextCursor := suite.keeper.GetTimeline(suite.ctx, data.user, "", nil, 10)
+	suite.Equal(types.Posts{posts[3], posts[1], posts[0]}, timeline)
+	suite.Empty(nextCursor)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetTimeline_FanOutCap() {
+	user := suite.testData.postOwner
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+
+	// Follow more users than the fan-out cap, each one having created a post
+	for index := 0; index <= relationshipsT.MaxGraphFanOut; index++ {
+		hash := sha256.Sum256([]byte(fmt.Sprintf("followed-%d", index)))
+		followed := sdk.AccAddress(hash[:sdk.AddrLen])
+		suite.Require().NoError(suite.relationshipsKeeper.StoreRelationship(suite.ctx, user,
+			relationshipsT.NewRelationship(followed, subspace, relationshipsT.RelationshipTypeFollow)))
+
+		suite.keeper.SavePost(suite.ctx, types.Post{
+			PostID:   types.PostID(hex.EncodeToString(hash[:])),
+			Message:  "Post message",
+			Created:  suite.testData.postCreationDate.Add(time.Duration(index) * time.Minute),
+			Subspace: subspace,
+			Creator:  followed,
+		})
+	}
+
+	timeline, nextCursor := suite.keeper.GetTimeline(suite.ctx, user, "", nil, 2*relationshipsT.MaxGraphFanOut)
+	suite.Len(timeline, relationshipsT.MaxGraphFanOut)
+	suite.Empty(nextCursor)
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/x/posts/types"
+)
+
+// SavePostTips saves the tips received by the post having the given id, overriding the existing ones.
+// It assumes that the post exists.
+func (k Keeper) SavePostTips(ctx sdk.Context, postID types.PostID, postTips types.PostTips) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.PostTipsStoreKey(postID), k.Cdc.MustMarshalBinaryBare(&postTips))
+}
+
+// GetPostTips returns the tips received by the post having the given id.
+// If the post has not received any tip, an empty PostTips object is returned instead.
+func (k Keeper) GetPostTips(ctx sdk.Context, postID types.PostID) types.PostTips {
+	store := ctx.KVStore(k.StoreKey)
+
+	bz := store.Get(types.PostTipsStoreKey(postID))
+	if bz == nil {
+		return types.NewPostTips()
+	}
+
+	var postTips types.PostTips
+	k.Cdc.MustUnmarshalBinaryBare(bz, &postTips)
+	return postTips
+}
+
+// GetPostTipsMap returns the tips received by all the posts, indexed by the posts ids
+func (k Keeper) GetPostTipsMap(ctx sdk.Context) map[string]types.PostTips {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PostTipsStorePrefix)
+	defer iterator.Close()
+
+	tipsData := map[string]types.PostTips{}
+	for ; iterator.Valid(); iterator.Next() {
+		var postTips types.PostTips
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &postTips)
+		idBytes := bytes.TrimPrefix(iterator.Key(), types.PostTipsStorePrefix)
+		tipsData[string(idBytes)] = postTips
+	}
+
+	return tipsData
+}
@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/x/posts/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SavePostTips() {
+	tipper, err := sdk.AccAddressFromBech32("cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4")
+	suite.NoError(err)
+
+	id := types.PostID("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af")
+	id2 := types.PostID("f1b909289cd23188c19da17ae5d5a05ad65623b0fad756e5e03c8c936ca876fd")
+
+	suite.Equal(types.NewPostTips(), suite.keeper.GetPostTips(suite.ctx, id))
+	suite.Empty(suite.keeper.GetPostTipsMap(suite.ctx))
+
+	postTips := types.NewPostTips(types.NewTip(tipper, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))))
+	otherTips := types.NewPostTips(types.NewTip(tipper, sdk.NewCoins(sdk.NewInt64Coin("udesmos", 10))))
+	suite.keeper.SavePostTips(suite.ctx, id, postTips)
+	suite.keeper.SavePostTips(suite.ctx, id2, otherTips)
+
+	suite.Equal(postTips, suite.keeper.GetPostTips(suite.ctx, id))
+	suite.Equal(map[string]types.PostTips{
+		id.String():  postTips,
+		id2.String(): otherTips,
+	}, suite.keeper.GetPostTipsMap(suite.ctx))
+
+	// Saving the tips again replaces the existing ones
+	postTips = postTips.AddTip(tipper, sdk.NewCoins(sdk.NewInt64Coin("udaric", 50)))
+	suite.keeper.SavePostTips(suite.ctx, id, postTips)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("udaric", 150)), suite.keeper.GetPostTips(suite.ctx, id).Total)
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/desmos-labs/desmos/x/posts/types"
+	relationshipsK "github.com/desmos-labs/desmos/x/relationships/keeper"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -28,6 +29,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryRegisteredReactions(ctx, req, keeper)
 		case types.QueryParams:
 			return queryParams(ctx, req, keeper)
+		case types.QueryTimeline:
+			return relationshipsK.WithGraphGasLimit(ctx, func(ctx sdk.Context) ([]byte, error) {
+				return queryTimeline(ctx, path[1:], req, keeper)
+			})
+		case types.QueryPostTips:
+			return queryPostTips(ctx, path[1:], req, keeper)
 		default:
 			return nil, fmt.Errorf("unknown post query endpoint")
 		}
@@ -36,7 +43,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 
 // getPostResponse allows to get a PostQueryResponse from the given post retrieving the other information
 // using the given Context and Keeper.
-func getPostResponse(ctx sdk.Context, keeper Keeper, post types.Post) types.PostQueryResponse {
+// If the post is reserved to subscribers and the given viewer cannot access it, the response is locked.
+func getPostResponse(ctx sdk.Context, keeper Keeper, post types.Post, viewer sdk.AccAddress) types.PostQueryResponse {
 	// Get the reactions
 	postReactions := keeper.GetPostReactions(ctx, post.PostID)
 	if postReactions == nil {
@@ -56,22 +64,39 @@ func getPostResponse(ctx sdk.Context, keeper Keeper, post types.Post) types.Post
 	}
 
 	// Crete the response object
-	return types.NewPostResponse(post, answers, postReactions, childrenIDs)
+	response := types.NewPostResponse(post, answers, postReactions, childrenIDs)
+	response.Hidden = keeper.IsPostHidden(ctx, post.PostID)
+	response.Tips = keeper.GetPostTips(ctx, post.PostID).Total
+	response.SubscriptionTier = keeper.GetPostMinTier(ctx, post.PostID)
+	if !keeper.SubscriptionsKeeper.HasAccess(ctx, post.Creator, viewer, response.SubscriptionTier) {
+		response = response.Lock()
+	}
+	return response
 }
 
-// queryPost handles the request to get a post having a specific id
+// queryPost handles the request to get a post having a specific id.
+// The address of the user viewing the post can optionally follow the id to unlock the subscriber-only content
 func queryPost(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	id := types.PostID(path[0])
 	if !id.Valid() {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("invalid postID: %s", id))
 	}
 
+	var viewer sdk.AccAddress
+	if len(path) > 1 && path[1] != "" {
+		address, err := sdk.AccAddressFromBech32(path[1])
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("Invalid bech32 address: %s", path[1]))
+		}
+		viewer = address
+	}
+
 	post, found := keeper.GetPost(ctx, id)
 	if !found {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("Post with id %s not found", id))
 	}
 
-	postResponse := getPostResponse(ctx, keeper, post)
+	postResponse := getPostResponse(ctx, keeper, post, viewer)
 	bz, err2 := codec.MarshalJSONIndent(keeper.Cdc, &postResponse)
 	if err2 != nil {
 		panic("could not marshal result to JSON")
@@ -93,7 +118,7 @@ func queryPosts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 
 	postResponses := make([]types.PostQueryResponse, len(posts))
 	for index, post := range posts {
-		postResponses[index] = getPostResponse(ctx, keeper, post)
+		postResponses[index] = getPostResponse(ctx, keeper, post, params.Viewer)
 	}
 
 	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &postResponses)
@@ -157,3 +182,72 @@ func queryParams(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, e
 
 	return bz, nil
 }
+
+// queryTimeline handles the request of listing the posts created by the users followed by the given user
+func queryTimeline(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	user, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("Invalid bech32 address: %s", path[0]))
+	}
+
+	var params types.QueryTimelineParams
+	if len(req.Data) != 0 {
+		if err := keeper.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	var cursor *types.Post
+	if params.Cursor != "" {
+		if !params.Cursor.Valid() {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("invalid cursor: %s", params.Cursor))
+		}
+
+		post, found := keeper.GetPost(ctx, params.Cursor)
+		if !found {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("Post with id %s not found", params.Cursor))
+		}
+		cursor = &post
+	}
+
+	// Default and maximum limit
+	limit := params.Limit
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+
+	posts, nextCursor := keeper.GetTimeline(ctx, user, params.Subspace, cursor, limit)
+
+	postResponses := make([]types.PostQueryResponse, len(posts))
+	for index, post := range posts {
+		postResponses[index] = getPostResponse(ctx, keeper, post, user)
+	}
+
+	timeline := types.NewTimelineQueryResponse(postResponses, nextCursor)
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &timeline)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryPostTips handles the request to get the tips received by the post having the given id
+func queryPostTips(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	id := types.PostID(path[0])
+	if !id.Valid() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("invalid postID: %s", id))
+	}
+
+	if _, found := keeper.GetPost(ctx, id); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("Post with id %s not found", id))
+	}
+
+	response := types.NewPostTipsQueryResponse(id, keeper.GetPostTips(ctx, id))
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &response)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
@@ -7,6 +7,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/desmos-labs/desmos/x/posts/keeper"
 	"github.com/desmos-labs/desmos/x/posts/types"
+	subscriptionsT "github.com/desmos-labs/desmos/x/subscriptions/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -505,3 +506,271 @@ func (suite *KeeperTestSuite) Test_queryParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) Test_queryTimeline() {
+	tests := []struct {
+		name      string
+		path      func(data timelineData) []string
+		params    func(data timelineData) *types.QueryTimelineParams
+		expResult func(data timelineData) types.TimelineQueryResponse
+		expError  error
+	}{
+		{
+			name:     "Invalid bech32 address returns error",
+			path:     func(timelineData) []string { return []string{types.QueryTimeline, "invalidAddress"} },
+			expError: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid bech32 address: invalidAddress"),
+		},
+		{
+			name: "Invalid cursor returns error",
+			path: func(data timelineData) []string { return []string{types.QueryTimeline, data.user.String()} },
+			params: func(timelineData) *types.QueryTimelineParams {
+				params := types.NewQueryTimelineParams("", "cursor", 0)
+				return &params
+			},
+			expError: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "invalid cursor: cursor"),
+		},
+		{
+			name: "Not found cursor returns error",
+			path: func(data timelineData) []string { return []string{types.QueryTimeline, data.user.String()} },
+			params: func(timelineData) *types.QueryTimelineParams {
+				params := types.NewQueryTimelineParams("", "49de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af", 0)
+				return &params
+			},
+			expError: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
+				"Post with id 49de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af not found"),
+		},
+		{
+			name: "Timeline page is returned properly",
+			path: func(data timelineData) []string { return []string{types.QueryTimeline, data.user.String()} },
+			params: func(data timelineData) *types.QueryTimelineParams {
+				params := types.NewQueryTimelineParams("", data.posts[3].PostID, 1)
+				return &params
+			},
+			expResult: func(data timelineData) types.TimelineQueryResponse {
+				return types.NewTimelineQueryResponse([]types.PostQueryResponse{
+					types.NewPostResponse(data.posts[2], nil, types.PostReactions{}, types.PostIDs{}),
+				}, data.posts[2].PostID)
+			},
+		},
+		{
+			name: "Default limit is used without params",
+			path: func(data timelineData) []string { return []string{types.QueryTimeline, data.user.String()} },
+			expResult: func(data timelineData) types.TimelineQueryResponse {
+				responses := make([]types.PostQueryResponse, len(data.posts))
+				for index := range data.posts {
+					post := data.posts[len(data.posts)-1-index]
+					responses[index] = types.NewPostResponse(post, nil, types.PostReactions{}, types.PostIDs{})
+				}
+				return types.NewTimelineQueryResponse(responses, "")
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			data := suite.setupTimeline()
+
+			var reqData []byte
+			if test.params != nil {
+				reqData = suite.cdc.MustMarshalJSON(test.params(data))
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path(data), abci.RequestQuery{Data: reqData})
+
+			if test.expResult != nil {
+				suite.NoError(err)
+				expected := test.expResult(data)
+				expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &expected)
+				suite.NoError(err)
+				suite.Equal(string(expectedIndented), string(result))
+			} else {
+				suite.Error(err)
+				suite.Equal(test.expError.Error(), err.Error())
+				suite.Nil(result)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_queryPostTips() {
+	tipper, err := sdk.AccAddressFromBech32("cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4")
+	suite.NoError(err)
+
+	postID := suite.testData.post.PostID
+	postTips := types.NewPostTips(types.NewTip(tipper, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))))
+
+	tests := []struct {
+		name       string
+		path       []string
+		storedPost *types.Post
+		storedTips *types.PostTips
+		expResult  types.PostTipsQueryResponse
+		expError   error
+	}{
+		{
+			name:     "Invalid post id returns error",
+			path:     []string{types.QueryPostTips, "1"},
+			expError: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "invalid postID: 1"),
+		},
+		{
+			name:     "Post not found returns error",
+			path:     []string{types.QueryPostTips, postID.String()},
+			expError: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("Post with id %s not found", postID)),
+		},
+		{
+			name:       "Post without tips returns empty tips",
+			path:       []string{types.QueryPostTips, postID.String()},
+			storedPost: &suite.testData.post,
+			expResult:  types.NewPostTipsQueryResponse(postID, types.NewPostTips()),
+		},
+		{
+			name:       "Returns the post tips correctly",
+			path:       []string{types.QueryPostTips, postID.String()},
+			storedPost: &suite.testData.post,
+			storedTips: &postTips,
+			expResult:  types.NewPostTipsQueryResponse(postID, postTips),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			if test.storedPost != nil {
+				suite.keeper.SavePost(suite.ctx, *test.storedPost)
+			}
+
+			if test.storedTips != nil {
+				suite.keeper.SavePostTips(suite.ctx, postID, *test.storedTips)
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, abci.RequestQuery{})
+
+			if test.expError != nil {
+				suite.Error(err)
+				suite.Equal(test.expError.Error(), err.Error())
+				suite.Nil(result)
+				return
+			}
+
+			suite.NoError(err)
+			expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expResult)
+			suite.NoError(err)
+			suite.Equal(string(expectedIndented), string(result))
+
+			// The post response should contain the total of the tips
+			result, err = querier(suite.ctx, []string{types.QueryPost, postID.String()}, abci.RequestQuery{})
+			suite.NoError(err)
+
+			var postResponse types.PostQueryResponse
+			suite.NoError(suite.keeper.Cdc.UnmarshalJSON(result, &postResponse))
+			suite.True(test.expResult.Total.IsEqual(postResponse.Tips))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_querySubscriberOnlyPost() {
+	subscriber, err := sdk.AccAddressFromBech32("cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4")
+	suite.NoError(err)
+
+	post := suite.testData.post
+	postID := post.PostID.String()
+	subscription := subscriptionsT.NewSubscription(post.Creator, subscriber, 2, sdk.NewInt64Coin("udaric", 100), 10, 1, 10)
+
+	tests := []struct {
+		name      string
+		path      []string
+		params    *types.QueryPostsParams
+		expError  error
+		expLocked bool
+	}{
+		{
+			name:     "Invalid viewer returns error",
+			path:     []string{types.QueryPost, postID, "viewer"},
+			expError: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid bech32 address: viewer"),
+		},
+		{
+			name:      "Post without viewer is locked",
+			path:      []string{types.QueryPost, postID},
+			expLocked: true,
+		},
+		{
+			name:      "Post viewed by a non subscriber is locked",
+			path:      []string{types.QueryPost, postID, "cosmos1r2plnngkwnahajl3d2a7fvzcsxf6djlt380f3l"},
+			expLocked: true,
+		},
+		{
+			name:      "Post viewed by a subscriber is unlocked",
+			path:      []string{types.QueryPost, postID, subscriber.String()},
+			expLocked: false,
+		},
+		{
+			name:      "Post viewed by its creator is unlocked",
+			path:      []string{types.QueryPost, postID, post.Creator.String()},
+			expLocked: false,
+		},
+		{
+			name:      "Posts without viewer are locked",
+			path:      []string{types.QueryPosts},
+			params:    &types.QueryPostsParams{Page: 1, Limit: 10},
+			expLocked: true,
+		},
+		{
+			name:      "Posts viewed by a subscriber are unlocked",
+			path:      []string{types.QueryPosts},
+			params:    &types.QueryPostsParams{Page: 1, Limit: 10, Viewer: subscriber},
+			expLocked: false,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.keeper.SavePost(suite.ctx, post)
+			suite.keeper.SetPostMinTier(suite.ctx, post.PostID, 2)
+			suite.subscriptionsKeeper.SaveSubscription(suite.ctx, subscription)
+
+			var request abci.RequestQuery
+			if test.params != nil {
+				request.Data = suite.keeper.Cdc.MustMarshalJSON(test.params)
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, request)
+
+			if test.expError != nil {
+				suite.Error(err)
+				suite.Equal(test.expError.Error(), err.Error())
+				suite.Nil(result)
+				return
+			}
+			suite.NoError(err)
+
+			var response types.PostQueryResponse
+			if test.params != nil {
+				var responses []types.PostQueryResponse
+				suite.NoError(suite.keeper.Cdc.UnmarshalJSON(result, &responses))
+				suite.Len(responses, 1)
+				response = responses[0]
+			} else {
+				suite.NoError(suite.keeper.Cdc.UnmarshalJSON(result, &response))
+			}
+
+			suite.Equal(uint32(2), response.SubscriptionTier)
+			suite.Equal(test.expLocked, response.Locked)
+			if test.expLocked {
+				suite.Empty(response.Message)
+				suite.Nil(response.PollData)
+				suite.Nil(response.Attachments)
+			} else {
+				suite.Equal(post.Message, response.Message)
+				suite.Equal(post.PollData.Question, response.PollData.Question)
+			}
+		})
+	}
+}
@@ -43,6 +43,20 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		cdc.MustUnmarshalBinaryBare(kvA.Value, &totalPostsA)
 		cdc.MustUnmarshalBinaryBare(kvB.Value, &totalPostsB)
 		return fmt.Sprintf("TotalPostsA: %s\nTotalPostsB: %s\n", totalPostsA, totalPostsB)
+	case bytes.HasPrefix(kvA.Key, types.CreatorPostsStorePrefix):
+		return fmt.Sprintf("CreatorPostA: %s\nCreatorPostB: %s\n", kvA.Value, kvB.Value)
+	case bytes.HasPrefix(kvA.Key, types.PostTipsStorePrefix):
+		var postTipsA, postTipsB types.PostTips
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &postTipsA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &postTipsB)
+		return fmt.Sprintf("PostTipsA: %s\nPostTipsB: %s\n", postTipsA, postTipsB)
+	case bytes.HasPrefix(kvA.Key, types.PostMinTierStorePrefix):
+		var minTierA, minTierB uint32
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &minTierA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &minTierB)
+		return fmt.Sprintf("PostMinTierA: %d\nPostMinTierB: %d\n", minTierA, minTierB)
+	case bytes.HasPrefix(kvA.Key, types.HiddenPostsStorePrefix):
+		return fmt.Sprintf("HiddenPostA: %s\nHiddenPostB: %s\n", kvA.Key, kvB.Key)
 	default:
 		panic(fmt.Sprintf("invalid posts key %X", kvA.Key))
 	}
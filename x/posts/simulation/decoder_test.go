@@ -71,6 +71,9 @@ func TestDecodeStore(t *testing.T) {
 
 	totalPosts := sdk.NewInt(10)
 
+	postTips := types.NewPostTips(types.NewTip(postCreatorAddr, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))))
+	minTier := uint32(2)
+
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: types.PostStoreKey(testPost.PostID), Value: cdc.MustMarshalBinaryBare(&testPost)},
 		kv.Pair{Key: types.PostCommentsStoreKey(testPost.PostID), Value: cdc.MustMarshalBinaryBare(&comments)},
@@ -78,6 +81,9 @@ func TestDecodeStore(t *testing.T) {
 		kv.Pair{Key: types.ReactionsStoreKey(reaction.ShortCode, reaction.Subspace), Value: cdc.MustMarshalBinaryBare(&reaction)},
 		kv.Pair{Key: types.PostIndexedIDStoreKey(testPost.PostID), Value: cdc.MustMarshalBinaryBare(&totalPosts)},
 		kv.Pair{Key: types.PostTotalNumberPrefix, Value: cdc.MustMarshalBinaryBare(&totalPosts)},
+		kv.Pair{Key: types.CreatorPostStoreKey(testPost.Creator, testPost.Created, testPost.PostID), Value: []byte(testPost.PostID)},
+		kv.Pair{Key: types.PostTipsStoreKey(testPost.PostID), Value: cdc.MustMarshalBinaryBare(&postTips)},
+		kv.Pair{Key: types.PostMinTierStoreKey(testPost.PostID), Value: cdc.MustMarshalBinaryBare(&minTier)},
 	}
 
 	tests := []struct {
@@ -90,6 +96,9 @@ func TestDecodeStore(t *testing.T) {
 		{"Reactions", fmt.Sprintf("ReactionA: %s\nReactionB: %s\n", reaction, reaction)},
 		{"PostID", fmt.Sprintf("IndexedIDA: %s\nIndexedIDB: %s\n", totalPosts, totalPosts)},
 		{"TotalPots", fmt.Sprintf("TotalPostsA: %s\nTotalPostsB: %s\n", totalPosts, totalPosts)},
+		{"CreatorPost", fmt.Sprintf("CreatorPostA: %s\nCreatorPostB: %s\n", testPost.PostID, testPost.PostID)},
+		{"PostTips", fmt.Sprintf("PostTipsA: %s\nPostTipsB: %s\n", postTips, postTips)},
+		{"PostMinTier", "PostMinTierA: 2\nPostMinTierB: 2\n"},
 		{"other", ""},
 	}
 
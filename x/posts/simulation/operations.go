@@ -15,12 +15,14 @@ import (
 
 // Simulation operation weights constants
 const (
-	OpWeightMsgCreatePost       = "op_weight_msg_create_post"
-	OpWeightMsgEditPost         = "op_weight_msg_edit_post"
-	OpWeightMsgAddReaction      = "op_weight_msg_add_reaction"
-	OpWeightMsgRemoveReaction   = "op_weight_msg_remove_reaction"
-	OpWeightMsgAnswerPoll       = "op_weight_msg_answer_poll"
-	OpWeightMsgRegisterReaction = "op_weight_msg_register_reaction"
+	OpWeightMsgCreatePost        = "op_weight_msg_create_post"
+	OpWeightMsgEditPost          = "op_weight_msg_edit_post"
+	OpWeightMsgAddReaction       = "op_weight_msg_add_reaction"
+	OpWeightMsgRemoveReaction    = "op_weight_msg_remove_reaction"
+	OpWeightMsgAnswerPoll        = "op_weight_msg_answer_poll"
+	OpWeightMsgRegisterReaction  = "op_weight_msg_register_reaction"
+	OpWeightMsgTipPost           = "op_weight_msg_tip_post"
+	OpWeightMsgSetSubscriberOnly = "op_weight_msg_set_subscriber_only"
 
 	DefaultGasValue = 800000
 )
@@ -70,6 +72,20 @@ func WeightedOperations(appParams sim.AppParams, cdc *codec.Codec, k keeper.Keep
 		},
 	)
 
+	var weightMsgTipPost int
+	appParams.GetOrGenerate(cdc, OpWeightMsgTipPost, &weightMsgTipPost, nil,
+		func(_ *rand.Rand) {
+			weightMsgTipPost = params.DefaultWeightMsgTipPost
+		},
+	)
+
+	var weightMsgSetSubscriberOnly int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSetSubscriberOnly, &weightMsgSetSubscriberOnly, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetSubscriberOnly = params.DefaultWeightMsgSetSubscriberOnly
+		},
+	)
+
 	return sim.WeightedOperations{
 		sim.NewWeightedOperation(
 			weightMsgCreatePost,
@@ -95,5 +111,13 @@ func WeightedOperations(appParams sim.AppParams, cdc *codec.Codec, k keeper.Keep
 			weightMsgAnswerPoll,
 			SimulateMsgAnswerToPoll(k, ak),
 		),
+		sim.NewWeightedOperation(
+			weightMsgTipPost,
+			SimulateMsgTipPost(k, ak),
+		),
+		sim.NewWeightedOperation(
+			weightMsgSetSubscriberOnly,
+			SimulateMsgSetSubscriberOnly(k, ak),
+		),
 	}
 }
@@ -102,6 +102,10 @@ func randomPostCreateFields(
 	posts := k.GetPosts(ctx)
 	if posts != nil {
 		if parent, _ := RandomPost(r, posts); parent.AllowsComments {
+			// Skip the operation as the parent creator has blocked the post creator
+			if k.RelationshipsKeeper.IsUserBlocked(ctx, parent.Creator, postData.Creator.Address) {
+				return nil, true
+			}
 			postData.ParentID = parent.PostID
 		}
 	}
@@ -100,6 +100,11 @@ func randomAddPostReactionFields(
 		return nil, true
 	}
 
+	// Skip if the post creator has blocked the reacting user
+	if k.RelationshipsKeeper.IsUserBlocked(ctx, post.Creator, reactionData.User.Address) {
+		return nil, true
+	}
+
 	// Skip if the reaction already exists
 	reactions := k.GetPostReactions(ctx, post.PostID)
 	if reactions.ContainsReactionFrom(reactionData.User.Address, reactionData.Value) {
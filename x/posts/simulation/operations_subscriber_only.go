@@ -0,0 +1,106 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	sim "github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/desmos-labs/desmos/x/posts/keeper"
+	"github.com/desmos-labs/desmos/x/posts/types"
+)
+
+// SimulateMsgSetSubscriberOnly tests and runs a single msg set subscriber only where the post creator account
+// already exists
+func SimulateMsgSetSubscriberOnly(k keeper.Keeper, ak auth.AccountKeeper) sim.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string,
+	) (sim.OperationMsg, []sim.FutureOperation, error) {
+
+		acc, postID, minTier, skip := randomSetSubscriberOnlyFields(r, ctx, accs, k, ak)
+		if skip {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgSetSubscriberOnly(postID, minTier, acc.Address)
+		err := sendMsgSetSubscriberOnly(r, app, ak, msg, ctx, chainID, []crypto.PrivKey{acc.PrivKey})
+		if err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// sendMsgSetSubscriberOnly sends a transaction with a MsgSetSubscriberOnly from a provided random account.
+func sendMsgSetSubscriberOnly(
+	r *rand.Rand, app *baseapp.BaseApp, ak auth.AccountKeeper,
+	msg types.MsgSetSubscriberOnly, ctx sdk.Context, chainID string, privkeys []crypto.PrivKey,
+) error {
+
+	account := ak.GetAccount(ctx, msg.Creator)
+	coins := account.SpendableCoins(ctx.BlockTime())
+
+	fees, err := sim.RandomFees(r, ctx, coins)
+	if err != nil {
+		return err
+	}
+
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		DefaultGasValue,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		privkeys...,
+	)
+
+	_, _, err = app.Deliver(tx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// randomSetSubscriberOnlyFields returns the data used to create a MsgSetSubscriberOnly message
+func randomSetSubscriberOnlyFields(
+	r *rand.Rand, ctx sdk.Context, accs []sim.Account, k keeper.Keeper, ak auth.AccountKeeper,
+) (sim.Account, types.PostID, uint32, bool) {
+
+	posts := k.GetPosts(ctx)
+	if len(posts) == 0 {
+		return sim.Account{}, "", 0, true
+	}
+
+	post, _ := RandomPost(r, posts)
+
+	// Skip the operation without error as the post creator is not one of the simulation accounts
+	var simAccount sim.Account
+	found := false
+	for _, account := range accs {
+		if account.Address.Equals(post.Creator) {
+			simAccount, found = account, true
+			break
+		}
+	}
+	if !found || ak.GetAccount(ctx, simAccount.Address) == nil {
+		return sim.Account{}, "", 0, true
+	}
+
+	// Either reserve the post to one of the creator tiers or make it public again
+	tiers := k.SubscriptionsKeeper.GetCreatorTiers(ctx, post.Creator)
+	if len(tiers) == 0 || r.Intn(4) == 0 {
+		return simAccount, post.PostID, 0, false
+	}
+
+	return simAccount, post.PostID, tiers[r.Intn(len(tiers))].Level, false
+}
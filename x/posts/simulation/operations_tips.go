@@ -0,0 +1,108 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	sim "github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/desmos-labs/desmos/x/posts/keeper"
+	"github.com/desmos-labs/desmos/x/posts/types"
+)
+
+// SimulateMsgTipPost tests and runs a single msg tip post where the tipping user account already exists
+func SimulateMsgTipPost(k keeper.Keeper, ak auth.AccountKeeper) sim.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string,
+	) (sim.OperationMsg, []sim.FutureOperation, error) {
+
+		acc, amount, postID, skip := randomTipPostFields(r, ctx, accs, k, ak)
+		if skip {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgTipPost(postID, amount, acc.Address)
+		err := sendMsgTipPost(r, app, ak, msg, ctx, chainID, []crypto.PrivKey{acc.PrivKey})
+		if err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// sendMsgTipPost sends a transaction with a MsgTipPost from a provided random account.
+func sendMsgTipPost(
+	r *rand.Rand, app *baseapp.BaseApp, ak auth.AccountKeeper,
+	msg types.MsgTipPost, ctx sdk.Context, chainID string, privkeys []crypto.PrivKey,
+) error {
+
+	account := ak.GetAccount(ctx, msg.Tipper)
+	coins := account.SpendableCoins(ctx.BlockTime()).Sub(msg.Amount)
+
+	fees, err := sim.RandomFees(r, ctx, coins)
+	if err != nil {
+		return err
+	}
+
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		DefaultGasValue,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		privkeys...,
+	)
+
+	_, _, err = app.Deliver(tx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// randomTipPostFields returns the data used to create a MsgTipPost message
+func randomTipPostFields(
+	r *rand.Rand, ctx sdk.Context, accs []sim.Account, k keeper.Keeper, ak auth.AccountKeeper,
+) (sim.Account, sdk.Coins, types.PostID, bool) {
+
+	posts := k.GetPosts(ctx)
+	if len(posts) == 0 {
+		return sim.Account{}, nil, "", true
+	}
+
+	post, _ := RandomPost(r, posts)
+	simAccount, _ := sim.RandomAcc(r, accs)
+	acc := ak.GetAccount(ctx, simAccount.Address)
+
+	// Skip the operation without error as the account is not valid
+	if acc == nil {
+		return sim.Account{}, nil, "", true
+	}
+
+	// Skip the operation without error as the post cannot be tipped by this account
+	if post.Creator.Equals(acc.GetAddress()) || k.RelationshipsKeeper.IsUserBlocked(ctx, post.Creator, acc.GetAddress()) {
+		return sim.Account{}, nil, "", true
+	}
+
+	// Tip up to half of the spendable coins, so that the fees can still be paid
+	amount := sim.RandSubsetCoins(r, acc.SpendableCoins(ctx.BlockTime()))
+	for index := range amount {
+		amount[index].Amount = amount[index].Amount.QuoRaw(2)
+	}
+	amount = sdk.NewCoins(amount...)
+	if amount.IsZero() {
+		return sim.Account{}, nil, "", true
+	}
+
+	return simAccount, amount, post.PostID, false
+}
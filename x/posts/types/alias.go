@@ -8,6 +8,7 @@ import (
 	"github.com/desmos-labs/desmos/x/posts/types/models/common"
 	"github.com/desmos-labs/desmos/x/posts/types/models/polls"
 	"github.com/desmos-labs/desmos/x/posts/types/models/reactions"
+	"github.com/desmos-labs/desmos/x/posts/types/models/tips"
 	"github.com/desmos-labs/desmos/x/posts/types/msgs"
 )
 
@@ -21,12 +22,16 @@ const (
 	ActionAddPostReaction    = common.ActionAddPostReaction
 	ActionRemovePostReaction = common.ActionRemovePostReaction
 	ActionRegisterReaction   = common.ActionRegisterReaction
+	ActionTipPost            = common.ActionTipPost
+	ActionSetSubscriberOnly  = common.ActionSetSubscriberOnly
 	QuerierRoute             = common.QuerierRoute
 	QueryPost                = common.QueryPost
 	QueryPosts               = common.QueryPosts
 	QueryPollAnswers         = common.QueryPollAnswers
 	QueryRegisteredReactions = common.QueryRegisteredReactions
 	QueryParams              = common.QueryParams
+	QueryTimeline            = common.QueryTimeline
+	QueryPostTips            = common.QueryPostTips
 	PostSortByCreationDate   = common.PostSortByCreationDate
 	PostSortByID             = common.PostSortByID
 	PostSortOrderAscending   = common.PostSortOrderAscending
@@ -44,6 +49,13 @@ var (
 	PostReactionsStoreKey      = models.PostReactionsStoreKey
 	ReactionsStoreKey          = models.ReactionsStoreKey
 	PollAnswersStoreKey        = models.PollAnswersStoreKey
+	CreatorPostsPrefix         = models.CreatorPostsPrefix
+	CreatorPostStoreKey        = models.CreatorPostStoreKey
+	HiddenPostStoreKey         = models.HiddenPostStoreKey
+	PostTipsStoreKey           = models.PostTipsStoreKey
+	PostMinTierStoreKey        = models.PostMinTierStoreKey
+	NewPostTipsQueryResponse   = models.NewPostTipsQueryResponse
+	NewTimelineQueryResponse   = models.NewTimelineQueryResponse
 	RegisterModelsCodec        = models.RegisterModelsCodec
 	NewAttachment              = common.NewAttachment
 	NewAttachments             = common.NewAttachments
@@ -63,6 +75,8 @@ var (
 	NewReaction                = reactions.NewReaction
 	IsEmoji                    = reactions.IsEmoji
 	NewReactions               = reactions.NewReactions
+	NewTip                     = tips.NewTip
+	NewPostTips                = tips.NewPostTips
 	NewMsgCreatePost           = msgs.NewMsgCreatePost
 	NewMsgEditPost             = msgs.NewMsgEditPost
 	NewMsgRegisterReaction     = msgs.NewMsgRegisterReaction
@@ -70,6 +84,8 @@ var (
 	NewMsgAddPostReaction      = msgs.NewMsgAddPostReaction
 	NewMsgRemovePostReaction   = msgs.NewMsgRemovePostReaction
 	NewMsgAnswerPoll           = msgs.NewMsgAnswerPoll
+	NewMsgTipPost              = msgs.NewMsgTipPost
+	NewMsgSetSubscriberOnly    = msgs.NewMsgSetSubscriberOnly
 
 	// variable aliases
 	ModelsCdc                = models.ModelsCdc
@@ -81,6 +97,10 @@ var (
 	PostReactionsStorePrefix = common.PostReactionsStorePrefix
 	ReactionsStorePrefix     = common.ReactionsStorePrefix
 	PollAnswersStorePrefix   = common.PollAnswersStorePrefix
+	CreatorPostsStorePrefix  = common.CreatorPostsStorePrefix
+	HiddenPostsStorePrefix   = common.HiddenPostsStorePrefix
+	PostTipsStorePrefix      = common.PostTipsStorePrefix
+	PostMinTierStorePrefix   = common.PostMinTierStorePrefix
 	MsgsCodec                = msgs.MsgsCodec
 )
 
@@ -95,18 +115,25 @@ type (
 	PostReactions            = reactions.PostReactions
 	Reaction                 = reactions.Reaction
 	Reactions                = reactions.Reactions
+	Tip                      = tips.Tip
+	Tips                     = tips.Tips
+	PostTips                 = tips.PostTips
 	MsgCreatePost            = msgs.MsgCreatePost
 	MsgEditPost              = msgs.MsgEditPost
 	MsgRegisterReaction      = msgs.MsgRegisterReaction
 	MsgAddPostReaction       = msgs.MsgAddPostReaction
 	MsgRemovePostReaction    = msgs.MsgRemovePostReaction
 	MsgAnswerPoll            = msgs.MsgAnswerPoll
+	MsgTipPost               = msgs.MsgTipPost
+	MsgSetSubscriberOnly     = msgs.MsgSetSubscriberOnly
 	PostID                   = models.PostID
 	PostIDs                  = models.PostIDs
 	Post                     = models.Post
 	Posts                    = models.Posts
 	PostQueryResponse        = models.PostQueryResponse
 	PollAnswersQueryResponse = models.PollAnswersQueryResponse
+	PostTipsQueryResponse    = models.PostTipsQueryResponse
+	TimelineQueryResponse    = models.TimelineQueryResponse
 	Attachment               = common.Attachment
 	Attachments              = common.Attachments
 	OptionalData             = common.OptionalData
@@ -9,6 +9,8 @@ const (
 	EventTypeAnsweredPoll        = "post_poll_answered"
 	EventTypeClosePoll           = "post_poll_closed"
 	EventTypeRegisterReaction    = "reaction_registered"
+	EventTypePostTipped          = "post_tipped"
+	EventTypeSubscriberOnlySet   = "post_subscriber_only_set"
 
 	// Post attributes
 	AttributeKeyPostID           = "post_id"
@@ -28,4 +30,12 @@ const (
 	// Reaction attributes
 	AttributeKeyReactionCreator  = "reaction_creator"
 	AttributeKeyReactionSubSpace = "reaction_subspace"
+
+	// Tip attributes
+	AttributeKeyTipper    = "tipper"
+	AttributeKeyTipAmount = "tip_amount"
+	AttributeKeyTipFee    = "tip_fee"
+
+	// Subscriber-only attributes
+	AttributeKeyMinTier = "min_tier"
 )
@@ -1,5 +1,9 @@
 package types
 
+import (
+	"fmt"
+)
+
 // GenesisState contains the data of the genesis state for the posts module
 type GenesisState struct {
 	Posts               Posts                    `json:"posts"`
@@ -7,6 +11,9 @@ type GenesisState struct {
 	PostReactions       map[string]PostReactions `json:"post_reactions"`
 	RegisteredReactions Reactions                `json:"registered_reactions"`
 	Params              Params                   `json:"params"`
+	HiddenPosts         PostIDs                  `json:"hidden_posts,omitempty"`
+	PostsTips           map[string]PostTips      `json:"posts_tips,omitempty"`
+	PostsMinTiers       map[string]uint32        `json:"posts_min_tiers,omitempty"`
 }
 
 // NewGenesisState creates a new genesis state
@@ -60,5 +67,31 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	for _, postID := range data.HiddenPosts {
+		if !postID.Valid() {
+			return fmt.Errorf("invalid hidden post id: %s", postID)
+		}
+	}
+
+	for postID, postTips := range data.PostsTips {
+		if !PostID(postID).Valid() {
+			return fmt.Errorf("invalid tipped post id: %s", postID)
+		}
+
+		if err := postTips.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for postID, minTier := range data.PostsMinTiers {
+		if !PostID(postID).Valid() {
+			return fmt.Errorf("invalid subscriber-only post id: %s", postID)
+		}
+
+		if minTier == 0 {
+			return fmt.Errorf("invalid min tier of subscriber-only post %s: %d", postID, minTier)
+		}
+	}
+
 	return nil
 }
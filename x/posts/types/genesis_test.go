@@ -82,6 +82,77 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			shouldError: true,
 		},
+		{
+			name: "Genesis with invalid hidden post returns errors",
+			genesis: types.GenesisState{
+				Params:      types.DefaultParams(),
+				HiddenPosts: types.PostIDs{"1234"},
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with invalid tipped post id returns errors",
+			genesis: types.GenesisState{
+				Params: types.DefaultParams(),
+				PostsTips: map[string]types.PostTips{
+					"1234": types.NewPostTips(types.NewTip(user, sdk.NewCoins(sdk.NewInt64Coin("udaric", 10)))),
+				},
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with invalid post tips returns errors",
+			genesis: types.GenesisState{
+				Params: types.DefaultParams(),
+				PostsTips: map[string]types.PostTips{
+					"19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af": {
+						Total: sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)),
+						Tips:  types.Tips{types.NewTip(user, sdk.NewCoins(sdk.NewInt64Coin("udaric", 10)))},
+					},
+				},
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with valid post tips does not error",
+			genesis: types.GenesisState{
+				Params: types.DefaultParams(),
+				PostsTips: map[string]types.PostTips{
+					"19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af": types.NewPostTips(
+						types.NewTip(user, sdk.NewCoins(sdk.NewInt64Coin("udaric", 10))),
+					),
+				},
+			},
+			shouldError: false,
+		},
+		{
+			name: "Genesis with invalid subscriber-only post id returns errors",
+			genesis: types.GenesisState{
+				Params:        types.DefaultParams(),
+				PostsMinTiers: map[string]uint32{"1234": 1},
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with zero min tier returns errors",
+			genesis: types.GenesisState{
+				Params: types.DefaultParams(),
+				PostsMinTiers: map[string]uint32{
+					"19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af": 0,
+				},
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with valid min tiers does not error",
+			genesis: types.GenesisState{
+				Params: types.DefaultParams(),
+				PostsMinTiers: map[string]uint32{
+					"19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af": 1,
+				},
+			},
+			shouldError: false,
+		},
 	}
 
 	for _, test := range tests {
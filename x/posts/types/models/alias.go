@@ -7,6 +7,7 @@ import (
 	"github.com/desmos-labs/desmos/x/posts/types/models/common"
 	"github.com/desmos-labs/desmos/x/posts/types/models/polls"
 	"github.com/desmos-labs/desmos/x/posts/types/models/reactions"
+	"github.com/desmos-labs/desmos/x/posts/types/models/tips"
 )
 
 const (
@@ -19,12 +20,16 @@ const (
 	ActionAddPostReaction    = common.ActionAddPostReaction
 	ActionRemovePostReaction = common.ActionRemovePostReaction
 	ActionRegisterReaction   = common.ActionRegisterReaction
+	ActionTipPost            = common.ActionTipPost
+	ActionSetSubscriberOnly  = common.ActionSetSubscriberOnly
 	QuerierRoute             = common.QuerierRoute
 	QueryPost                = common.QueryPost
 	QueryPosts               = common.QueryPosts
 	QueryPollAnswers         = common.QueryPollAnswers
 	QueryRegisteredReactions = common.QueryRegisteredReactions
 	QueryParams              = common.QueryParams
+	QueryTimeline            = common.QueryTimeline
+	QueryPostTips            = common.QueryPostTips
 	PostSortByCreationDate   = common.PostSortByCreationDate
 	PostSortByID             = common.PostSortByID
 	PostSortOrderAscending   = common.PostSortOrderAscending
@@ -51,6 +56,8 @@ var (
 	NewReaction                = reactions.NewReaction
 	IsEmoji                    = reactions.IsEmoji
 	NewReactions               = reactions.NewReactions
+	NewTip                     = tips.NewTip
+	NewPostTips                = tips.NewPostTips
 
 	// variable aliases
 	ModuleAddress            = common.ModuleAddress
@@ -61,6 +68,10 @@ var (
 	PostReactionsStorePrefix = common.PostReactionsStorePrefix
 	ReactionsStorePrefix     = common.ReactionsStorePrefix
 	PollAnswersStorePrefix   = common.PollAnswersStorePrefix
+	CreatorPostsStorePrefix  = common.CreatorPostsStorePrefix
+	HiddenPostsStorePrefix   = common.HiddenPostsStorePrefix
+	PostTipsStorePrefix      = common.PostTipsStorePrefix
+	PostMinTierStorePrefix   = common.PostMinTierStorePrefix
 )
 
 type (
@@ -78,4 +89,7 @@ type (
 	PostReactions = reactions.PostReactions
 	Reaction      = reactions.Reaction
 	Reactions     = reactions.Reactions
+	Tip           = tips.Tip
+	Tips          = tips.Tips
+	PostTips      = tips.PostTips
 )
@@ -17,6 +17,8 @@ const (
 	ActionAddPostReaction    = "add_post_reaction"
 	ActionRemovePostReaction = "remove_post_reaction"
 	ActionRegisterReaction   = "register_reaction"
+	ActionTipPost            = "tip_post"
+	ActionSetSubscriberOnly  = "set_subscriber_only"
 
 	// Queries
 	QuerierRoute             = ModuleName
@@ -25,6 +27,8 @@ const (
 	QueryPollAnswers         = "poll-answers"
 	QueryRegisteredReactions = "registered-reactions"
 	QueryParams              = "params"
+	QueryTimeline            = "timeline"
+	QueryPostTips            = "post-tips"
 
 	// Sorting
 	PostSortByCreationDate  = "created"
@@ -47,6 +51,10 @@ var (
 	PostReactionsStorePrefix = []byte("p_reactions")
 	ReactionsStorePrefix     = []byte("reactions")
 	PollAnswersStorePrefix   = []byte("poll_answers")
+	CreatorPostsStorePrefix  = []byte("creator_posts")
+	HiddenPostsStorePrefix   = []byte("hidden_posts")
+	PostTipsStorePrefix      = []byte("p_tips")
+	PostMinTierStorePrefix   = []byte("p_min_tier")
 )
 
 // IsValidPostID tells whether the given value represents a valid post id or not
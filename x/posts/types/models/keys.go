@@ -2,6 +2,9 @@ package models
 
 import (
 	"regexp"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -43,3 +46,32 @@ func ReactionsStoreKey(shortCode, subspace string) []byte {
 func PollAnswersStoreKey(id PostID) []byte {
 	return append(PollAnswersStorePrefix, []byte(id)...)
 }
+
+// CreatorPostsPrefix returns the prefix used to index all the posts created by the given creator
+func CreatorPostsPrefix(creator sdk.AccAddress) []byte {
+	return append(CreatorPostsStorePrefix, []byte(creator)...)
+}
+
+// CreatorPostStoreKey turns a creator address, a creation time and an id into the key used to index the post
+// inside the creator posts, so that iterating over them returns the posts sorted by creation time
+//nolint: interfacer
+func CreatorPostStoreKey(creator sdk.AccAddress, created time.Time, id PostID) []byte {
+	return append(append(CreatorPostsPrefix(creator), sdk.FormatTimeBytes(created)...), []byte(id)...)
+}
+
+// HiddenPostStoreKey turns an id into the key used to mark the post as hidden
+func HiddenPostStoreKey(id PostID) []byte {
+	return append(HiddenPostsStorePrefix, []byte(id)...)
+}
+
+// PostTipsStoreKey turns an id into the key used to store the tips received by a post
+//nolint: interfacer
+func PostTipsStoreKey(id PostID) []byte {
+	return append(PostTipsStorePrefix, []byte(id)...)
+}
+
+// PostMinTierStoreKey turns an id into the key used to store the minimum subscription tier required to see a post
+//nolint: interfacer
+func PostMinTierStoreKey(id PostID) []byte {
+	return append(PostMinTierStorePrefix, []byte(id)...)
+}
@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // PostQueryResponse represents the data of a post
@@ -13,6 +15,11 @@ type PostQueryResponse struct {
 	PollAnswers []UserAnswer   `json:"poll_answers,omitempty" yaml:"poll_answers,omitempty"`
 	Reactions   []PostReaction `json:"reactions" yaml:"reactions,omitempty"`
 	Children    PostIDs        `json:"children" yaml:"children"`
+	Hidden      bool           `json:"hidden,omitempty" yaml:"hidden,omitempty"`
+	Tips        sdk.Coins      `json:"tips,omitempty" yaml:"tips,omitempty"`
+
+	SubscriptionTier uint32 `json:"subscription_tier,omitempty" yaml:"subscription_tier,omitempty"`
+	Locked           bool   `json:"locked,omitempty" yaml:"locked,omitempty"`
 }
 
 // String implements fmt.Stringer
@@ -36,6 +43,18 @@ func NewPostResponse(
 	}
 }
 
+// Lock returns a copy of the response marking it as locked, having all the post contents
+// reserved to the creator subscribers removed
+func (response PostQueryResponse) Lock() PostQueryResponse {
+	response.Message = ""
+	response.OptionalData = nil
+	response.Attachments = nil
+	response.PollData = nil
+	response.PollAnswers = nil
+	response.Locked = true
+	return response
+}
+
 // MarshalJSON implements json.Marshaler as Amino does
 // not respect default json composition
 func (response PostQueryResponse) MarshalJSON() ([]byte, error) {
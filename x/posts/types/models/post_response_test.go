@@ -179,3 +179,52 @@ func TestPostQueryResponse_String(t *testing.T) {
 	stringResponse := postResponse.String()
 	require.Equal(t, strings.TrimSpace(expected), stringResponse)
 }
+
+func TestPostQueryResponse_Lock(t *testing.T) {
+	postOwner, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	require.NoError(t, err)
+
+	liker, err := sdk.AccAddressFromBech32("cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4")
+	require.NoError(t, err)
+
+	post := models.NewPost(
+		"",
+		"Post",
+		true,
+		"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e",
+		map[string]string{"key": "value"},
+		time.Date(2020, 2, 2, 15, 0, 0, 0, time.UTC),
+		postOwner,
+	).WithAttachments(models.NewAttachments(
+		models.NewAttachment("https://uri.com", "text/plain", nil),
+	)).WithPollData(models.NewPollData(
+		"poll?",
+		time.Date(2050, 1, 1, 15, 15, 00, 000, time.UTC),
+		models.PollAnswers{models.NewPollAnswer(models.AnswerID(1), "Yes")},
+		false,
+		true,
+	))
+
+	postResponse := models.NewPostResponse(
+		post,
+		models.NewUserAnswers(models.NewUserAnswer([]models.AnswerID{models.AnswerID(1)}, liker)),
+		[]models.PostReaction{models.NewPostReaction(":like:", "https://example.com/like", liker)},
+		models.PostIDs{},
+	)
+	postResponse.SubscriptionTier = 2
+
+	locked := postResponse.Lock()
+	require.True(t, locked.Locked)
+	require.Equal(t, uint32(2), locked.SubscriptionTier)
+	require.Empty(t, locked.Message)
+	require.Nil(t, locked.OptionalData)
+	require.Nil(t, locked.Attachments)
+	require.Nil(t, locked.PollData)
+	require.Nil(t, locked.PollAnswers)
+	require.Equal(t, post.PostID, locked.PostID)
+	require.Equal(t, postResponse.Reactions, locked.Reactions)
+
+	// The original response is left untouched
+	require.False(t, postResponse.Locked)
+	require.Equal(t, post.Message, postResponse.Message)
+}
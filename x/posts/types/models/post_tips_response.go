@@ -0,0 +1,29 @@
+package models
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PostTipsQueryResponse represents the tips sent to a post
+// that are returned to user upon a query
+type PostTipsQueryResponse struct {
+	PostID PostID    `json:"post_id" yaml:"post_id"`
+	Total  sdk.Coins `json:"total" yaml:"total"`
+	Tips   Tips      `json:"tips" yaml:"tips"`
+}
+
+// NewPostTipsQueryResponse returns a new PostTipsQueryResponse containing the given post tips
+func NewPostTipsQueryResponse(id PostID, postTips PostTips) PostTipsQueryResponse {
+	return PostTipsQueryResponse{
+		PostID: id,
+		Total:  postTips.Total,
+		Tips:   postTips.Tips,
+	}
+}
+
+// String implements fmt.Stringer
+func (response PostTipsQueryResponse) String() string {
+	return fmt.Sprintf("Post ID [%s] - Total: %s\n%s", response.PostID, response.Total, response.Tips)
+}
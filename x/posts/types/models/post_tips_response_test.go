@@ -0,0 +1,22 @@
+package models_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/posts/types/models"
+	"github.com/desmos-labs/desmos/x/posts/types/models/tips"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostTipsQueryResponse_String(t *testing.T) {
+	tipper, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	response := models.NewPostTipsQueryResponse(
+		"dd065b70feb810a8c6f535cf670fe6e3534085221fa964ed2660ebca93f910d1",
+		tips.NewPostTips(tips.NewTip(tipper, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)))),
+	)
+
+	require.Equal(t, "Post ID [dd065b70feb810a8c6f535cf670fe6e3534085221fa964ed2660ebca93f910d1] - Total: 100udaric\nTipper - Amount\ncosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns - 100udaric", response.String())
+}
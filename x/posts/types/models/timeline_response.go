@@ -0,0 +1,33 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TimelineQueryResponse represents a page of the posts created by the users followed by someone,
+// sorted from the newest to the oldest one.
+// NextCursor contains the id of the post to be used as the cursor to get the following page,
+// and it is empty when no more posts are present
+type TimelineQueryResponse struct {
+	Posts      []PostQueryResponse `json:"posts" yaml:"posts"`
+	NextCursor PostID              `json:"next_cursor,omitempty" yaml:"next_cursor,omitempty"`
+}
+
+// NewTimelineQueryResponse is a constructor function for TimelineQueryResponse
+func NewTimelineQueryResponse(posts []PostQueryResponse, nextCursor PostID) TimelineQueryResponse {
+	return TimelineQueryResponse{
+		Posts:      posts,
+		NextCursor: nextCursor,
+	}
+}
+
+// String implements fmt.Stringer
+func (response TimelineQueryResponse) String() string {
+	out := "Timeline:\n"
+	for _, post := range response.Posts {
+		out += fmt.Sprintf("%s\n", post.String())
+	}
+	out += fmt.Sprintf("Next cursor: %s", response.NextCursor)
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,134 @@
+package tips
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ---------------
+// --- Tip
+// ---------------
+
+// Tip contains the total amount of coins that a user has tipped to a post
+type Tip struct {
+	Tipper sdk.AccAddress `json:"tipper" yaml:"tipper"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewTip returns a new Tip object
+func NewTip(tipper sdk.AccAddress, amount sdk.Coins) Tip {
+	return Tip{
+		Tipper: tipper,
+		Amount: amount,
+	}
+}
+
+// String implements fmt.Stringer
+func (tip Tip) String() string {
+	return fmt.Sprintf("Tipper: %s - Amount: %s", tip.Tipper, tip.Amount)
+}
+
+// Validate implements validator
+func (tip Tip) Validate() error {
+	if tip.Tipper.Empty() {
+		return fmt.Errorf("invalid tipper address: %s", tip.Tipper)
+	}
+
+	if !tip.Amount.IsValid() || tip.Amount.Empty() {
+		return fmt.Errorf("invalid tip amount: %s", tip.Amount)
+	}
+
+	return nil
+}
+
+// ---------------
+// --- Tips
+// ---------------
+
+// Tips represents a slice of Tip objects
+type Tips []Tip
+
+// String implements fmt.Stringer
+func (tips Tips) String() string {
+	out := "Tipper - Amount\n"
+	for _, tip := range tips {
+		out += fmt.Sprintf("%s - %s\n", tip.Tipper, tip.Amount)
+	}
+	return strings.TrimSpace(out)
+}
+
+// ---------------
+// --- PostTips
+// ---------------
+
+// PostTips contains all the tips that have been sent to a post, along with their total amount
+type PostTips struct {
+	Total sdk.Coins `json:"total" yaml:"total"`
+	Tips  Tips      `json:"tips" yaml:"tips"`
+}
+
+// NewPostTips returns a new PostTips object containing the given tips
+func NewPostTips(tips ...Tip) PostTips {
+	postTips := PostTips{Total: sdk.NewCoins(), Tips: Tips{}}
+	for _, tip := range tips {
+		postTips = postTips.AddTip(tip.Tipper, tip.Amount)
+	}
+	return postTips
+}
+
+// String implements fmt.Stringer
+func (postTips PostTips) String() string {
+	return fmt.Sprintf("Total: %s\n%s", postTips.Total, postTips.Tips)
+}
+
+// AddTip adds the given amount to the tips sent by the tipper, creating a new entry if
+// this is the first time that the tipper sends a tip.
+// It returns a new PostTips object containing the updated total.
+func (postTips PostTips) AddTip(tipper sdk.AccAddress, amount sdk.Coins) PostTips {
+	tips := make(Tips, len(postTips.Tips))
+	copy(tips, postTips.Tips)
+
+	found := false
+	for index, tip := range tips {
+		if tip.Tipper.Equals(tipper) {
+			tips[index] = NewTip(tipper, tip.Amount.Add(amount...))
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		tips = append(tips, NewTip(tipper, amount))
+	}
+
+	return PostTips{
+		Total: postTips.Total.Add(amount...),
+		Tips:  tips,
+	}
+}
+
+// Validate implements validator
+func (postTips PostTips) Validate() error {
+	total := sdk.NewCoins()
+	tippers := make(map[string]bool, len(postTips.Tips))
+	for _, tip := range postTips.Tips {
+		if err := tip.Validate(); err != nil {
+			return err
+		}
+
+		if tippers[tip.Tipper.String()] {
+			return fmt.Errorf("duplicated tips of tipper %s", tip.Tipper)
+		}
+		tippers[tip.Tipper.String()] = true
+
+		total = total.Add(tip.Amount...)
+	}
+
+	if !postTips.Total.IsValid() || !total.IsAllGTE(postTips.Total) || !postTips.Total.IsAllGTE(total) {
+		return fmt.Errorf("invalid tips total: %s", postTips.Total)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package tips_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/posts/types/models/tips"
+	"github.com/stretchr/testify/require"
+)
+
+// ---------------
+// --- Tip
+// ---------------
+
+func TestTip_String(t *testing.T) {
+	tipper, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	tip := tips.NewTip(tipper, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)))
+	require.Equal(t, "Tipper: cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns - Amount: 100udaric", tip.String())
+}
+
+func TestTip_Validate(t *testing.T) {
+	tipper, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		tip    tips.Tip
+		expErr error
+	}{
+		{
+			name:   "Empty tipper returns error",
+			tip:    tips.NewTip(nil, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))),
+			expErr: fmt.Errorf("invalid tipper address: "),
+		},
+		{
+			name:   "Empty amount returns error",
+			tip:    tips.NewTip(tipper, sdk.NewCoins()),
+			expErr: fmt.Errorf("invalid tip amount: "),
+		},
+		{
+			name:   "Invalid amount returns error",
+			tip:    tips.NewTip(tipper, sdk.Coins{sdk.Coin{Denom: "udaric", Amount: sdk.NewInt(-1)}}),
+			expErr: fmt.Errorf("invalid tip amount: -1udaric"),
+		},
+		{
+			name:   "Valid tip returns no error",
+			tip:    tips.NewTip(tipper, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.tip.Validate())
+		})
+	}
+}
+
+// ---------------
+// --- PostTips
+// ---------------
+
+func TestPostTips_AddTip(t *testing.T) {
+	tipper, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	otherTipper, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	require.NoError(t, err)
+
+	postTips := tips.NewPostTips()
+	postTips = postTips.AddTip(tipper, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)))
+	postTips = postTips.AddTip(otherTipper, sdk.NewCoins(sdk.NewInt64Coin("udesmos", 10)))
+	postTips = postTips.AddTip(tipper, sdk.NewCoins(sdk.NewInt64Coin("udaric", 50), sdk.NewInt64Coin("udesmos", 5)))
+
+	expected := tips.PostTips{
+		Total: sdk.NewCoins(sdk.NewInt64Coin("udaric", 150), sdk.NewInt64Coin("udesmos", 15)),
+		Tips: tips.Tips{
+			tips.NewTip(tipper, sdk.NewCoins(sdk.NewInt64Coin("udaric", 150), sdk.NewInt64Coin("udesmos", 5))),
+			tips.NewTip(otherTipper, sdk.NewCoins(sdk.NewInt64Coin("udesmos", 10))),
+		},
+	}
+	require.Equal(t, expected, postTips)
+	require.NoError(t, postTips.Validate())
+}
+
+func TestPostTips_String(t *testing.T) {
+	tipper, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	postTips := tips.NewPostTips(tips.NewTip(tipper, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))))
+	require.Equal(t, "Total: 100udaric\nTipper - Amount\ncosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns - 100udaric", postTips.String())
+}
+
+func TestPostTips_Validate(t *testing.T) {
+	tipper, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	tip := tips.NewTip(tipper, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)))
+
+	tests := []struct {
+		name     string
+		postTips tips.PostTips
+		expErr   error
+	}{
+		{
+			name:     "Invalid tip returns error",
+			postTips: tips.PostTips{Total: tip.Amount, Tips: tips.Tips{tips.NewTip(nil, tip.Amount)}},
+			expErr:   fmt.Errorf("invalid tipper address: "),
+		},
+		{
+			name:     "Duplicated tipper returns error",
+			postTips: tips.PostTips{Total: tip.Amount.Add(tip.Amount...), Tips: tips.Tips{tip, tip}},
+			expErr:   fmt.Errorf("duplicated tips of tipper cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"),
+		},
+		{
+			name:     "Wrong total returns error",
+			postTips: tips.PostTips{Total: sdk.NewCoins(sdk.NewInt64Coin("udaric", 10)), Tips: tips.Tips{tip}},
+			expErr:   fmt.Errorf("invalid tips total: 10udaric"),
+		},
+		{
+			name:     "Empty tips return no error",
+			postTips: tips.NewPostTips(),
+			expErr:   nil,
+		},
+		{
+			name:     "Valid tips return no error",
+			postTips: tips.NewPostTips(tip),
+			expErr:   nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.postTips.Validate())
+		})
+	}
+}
@@ -19,4 +19,6 @@ func RegisterMessagesCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgRemovePostReaction{}, "desmos/MsgRemovePostReaction", nil)
 	cdc.RegisterConcrete(MsgAnswerPoll{}, "desmos/MsgAnswerPoll", nil)
 	cdc.RegisterConcrete(MsgRegisterReaction{}, "desmos/MsgRegisterReaction", nil)
+	cdc.RegisterConcrete(MsgTipPost{}, "desmos/MsgTipPost", nil)
+	cdc.RegisterConcrete(MsgSetSubscriberOnly{}, "desmos/MsgSetSubscriberOnly", nil)
 }
@@ -27,6 +27,7 @@ type MsgCreatePost struct {
 	Creator        sdk.AccAddress     `json:"creator" yaml:"creator"`
 	Attachments    models.Attachments `json:"attachments,omitempty" yaml:"attachments,omitempty"`
 	PollData       *models.PollData   `json:"poll_data,omitempty" yaml:"poll_data,omitempty"`
+	ActingAs       sdk.AccAddress     `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
 }
 
 // NewMsgCreatePost is a constructor function for MsgCreatePost
@@ -44,6 +45,13 @@ func NewMsgCreatePost(message string, parentID models.PostID, allowsComments boo
 	}
 }
 
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgCreatePost) WithActingAs(owner sdk.AccAddress) MsgCreatePost {
+	msg.ActingAs = owner
+	return msg
+}
+
 // Route should return the name of the module
 func (msg MsgCreatePost) Route() string { return models.RouterKey }
 
@@ -77,6 +85,10 @@ func (msg MsgCreatePost) ValidateBasic() error {
 		}
 	}
 
+	if !msg.ActingAs.Empty() && msg.ActingAs.Equals(msg.Creator) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the creator")
+	}
+
 	return nil
 }
 
@@ -108,6 +120,7 @@ type MsgEditPost struct {
 	Attachments models.Attachments `json:"attachments,omitempty" yaml:"attachments,omitempty"`
 	PollData    *models.PollData   `json:"poll_data,omitempty" yaml:"poll_data,omitempty"`
 	Editor      sdk.AccAddress     `json:"editor" yaml:"editor"`
+	ActingAs    sdk.AccAddress     `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
 }
 
 // NewMsgEditPost is the constructor function for MsgEditPost
@@ -122,6 +135,13 @@ func NewMsgEditPost(id models.PostID, message string,
 	}
 }
 
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgEditPost) WithActingAs(owner sdk.AccAddress) MsgEditPost {
+	msg.ActingAs = owner
+	return msg
+}
+
 // Route should return the name of the module
 func (msg MsgEditPost) Route() string { return models.RouterKey }
 
@@ -155,6 +175,10 @@ func (msg MsgEditPost) ValidateBasic() error {
 		}
 	}
 
+	if !msg.ActingAs.Empty() && msg.ActingAs.Equals(msg.Editor) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the editor")
+	}
+
 	return nil
 }
 
@@ -20,6 +20,7 @@ type MsgAnswerPoll struct {
 	PostID      models.PostID     `json:"post_id" yaml:"post_id"`
 	UserAnswers []models.AnswerID `json:"answers" yaml:"answers"`
 	Answerer    sdk.AccAddress    `json:"answerer" yaml:"answerer"`
+	ActingAs    sdk.AccAddress    `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
 }
 
 // NewMsgAnswerPoll is the constructor function for MsgAnswerPoll
@@ -31,6 +32,13 @@ func NewMsgAnswerPoll(id models.PostID, providedAnswers []models.AnswerID, answe
 	}
 }
 
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgAnswerPoll) WithActingAs(owner sdk.AccAddress) MsgAnswerPoll {
+	msg.ActingAs = owner
+	return msg
+}
+
 // Route should return the name of the module
 func (msg MsgAnswerPoll) Route() string { return models.RouterKey }
 
@@ -51,6 +59,10 @@ func (msg MsgAnswerPoll) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "provided answers must contains at least one answer")
 	}
 
+	if !msg.ActingAs.Empty() && msg.ActingAs.Equals(msg.Answerer) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the answerer")
+	}
+
 	return nil
 }
 
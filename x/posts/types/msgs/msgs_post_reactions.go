@@ -21,6 +21,7 @@ type MsgAddPostReaction struct {
 	PostID   models.PostID  `json:"post_id" yaml:"post_id"`   // Id of the post to react to
 	Reaction string         `json:"reaction" yaml:"reaction"` // Reaction of the reaction
 	User     sdk.AccAddress `json:"user" yaml:"user"`         // Address of the user reacting to the post
+	ActingAs sdk.AccAddress `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
 }
 
 // NewMsgAddPostReaction is a constructor function for MsgAddPostReaction
@@ -32,6 +33,13 @@ func NewMsgAddPostReaction(postID models.PostID, value string, user sdk.AccAddre
 	}
 }
 
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgAddPostReaction) WithActingAs(owner sdk.AccAddress) MsgAddPostReaction {
+	msg.ActingAs = owner
+	return msg
+}
+
 // Route should return the name of the module
 func (msg MsgAddPostReaction) Route() string { return models.RouterKey }
 
@@ -53,6 +61,10 @@ func (msg MsgAddPostReaction) ValidateBasic() error {
 		return sdkerrors.Wrap(postserrors.ErrInvalidReactionCode, msg.Reaction)
 	}
 
+	if !msg.ActingAs.Empty() && msg.ActingAs.Equals(msg.User) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the user")
+	}
+
 	return nil
 }
 
@@ -76,6 +88,7 @@ type MsgRemovePostReaction struct {
 	PostID   models.PostID  `json:"post_id" yaml:"post_id"`   // Id of the post to unlike
 	Reaction string         `json:"reaction" yaml:"reaction"` // Reaction of the reaction to be removed
 	User     sdk.AccAddress `json:"user" yaml:"user"`         // Address of the user that has previously liked the post
+	ActingAs sdk.AccAddress `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
 }
 
 // MsgUnlikePostPost is the constructor of MsgRemovePostReaction
@@ -87,6 +100,13 @@ func NewMsgRemovePostReaction(postID models.PostID, user sdk.AccAddress, value s
 	}
 }
 
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgRemovePostReaction) WithActingAs(owner sdk.AccAddress) MsgRemovePostReaction {
+	msg.ActingAs = owner
+	return msg
+}
+
 // Route should return the name of the module
 func (msg MsgRemovePostReaction) Route() string { return models.RouterKey }
 
@@ -108,6 +128,10 @@ func (msg MsgRemovePostReaction) ValidateBasic() error {
 		return sdkerrors.Wrap(postserrors.ErrInvalidReactionCode, msg.Reaction)
 	}
 
+	if !msg.ActingAs.Empty() && msg.ActingAs.Equals(msg.User) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the user")
+	}
+
 	return nil
 }
 
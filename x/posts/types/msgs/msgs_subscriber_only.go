@@ -0,0 +1,60 @@
+package msgs
+
+import (
+	"fmt"
+
+	postserrors "github.com/desmos-labs/desmos/x/posts/types/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/desmos-labs/desmos/x/posts/types/models"
+)
+
+// ----------------------
+// --- MsgSetSubscriberOnly
+// ----------------------
+
+// MsgSetSubscriberOnly defines the SetSubscriberOnly message.
+// A MinTier equal to zero makes the post visible to everyone again.
+type MsgSetSubscriberOnly struct {
+	PostID  models.PostID  `json:"post_id" yaml:"post_id"`
+	MinTier uint32         `json:"min_tier" yaml:"min_tier"`
+	Creator sdk.AccAddress `json:"creator" yaml:"creator"`
+}
+
+// NewMsgSetSubscriberOnly is the constructor function for MsgSetSubscriberOnly
+func NewMsgSetSubscriberOnly(id models.PostID, minTier uint32, creator sdk.AccAddress) MsgSetSubscriberOnly {
+	return MsgSetSubscriberOnly{
+		PostID:  id,
+		MinTier: minTier,
+		Creator: creator,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetSubscriberOnly) Route() string { return models.RouterKey }
+
+// Type should return the action
+func (msg MsgSetSubscriberOnly) Type() string { return models.ActionSetSubscriberOnly }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetSubscriberOnly) ValidateBasic() error {
+	if !msg.PostID.Valid() {
+		return sdkerrors.Wrap(postserrors.ErrInvalidPostID, msg.PostID.String())
+	}
+
+	if msg.Creator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", msg.Creator))
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetSubscriberOnly) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgsCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetSubscriberOnly) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Creator} }
@@ -0,0 +1,80 @@
+package msgs_test
+
+import (
+	"testing"
+
+	postserrors "github.com/desmos-labs/desmos/x/posts/types/errors"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/posts/types/msgs"
+)
+
+// ----------------------
+// --- MsgSetSubscriberOnly
+// ----------------------
+
+var msgSetSubscriberOnly = msgs.NewMsgSetSubscriberOnly(id, 1, testOwner)
+
+func TestMsgSetSubscriberOnly_Route(t *testing.T) {
+	actual := msgSetSubscriberOnly.Route()
+	require.Equal(t, "posts", actual)
+}
+
+func TestMsgSetSubscriberOnly_Type(t *testing.T) {
+	actual := msgSetSubscriberOnly.Type()
+	require.Equal(t, "set_subscriber_only", actual)
+}
+
+func TestMsgSetSubscriberOnly_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   msgs.MsgSetSubscriberOnly
+		error error
+	}{
+		{
+			name:  "Invalid post id",
+			msg:   msgs.NewMsgSetSubscriberOnly("", 1, testOwner),
+			error: sdkerrors.Wrap(postserrors.ErrInvalidPostID, ""),
+		},
+		{
+			name:  "Invalid creator address",
+			msg:   msgs.NewMsgSetSubscriberOnly(id, 1, nil),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address: "),
+		},
+		{
+			name: "Zero tier returns no error",
+			msg:  msgs.NewMsgSetSubscriberOnly(id, 0, testOwner),
+		},
+		{
+			name: "Valid message returns no error",
+			msg:  msgSetSubscriberOnly,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			returnedError := test.msg.ValidateBasic()
+			if test.error == nil {
+				require.Nil(t, returnedError)
+			} else {
+				require.NotNil(t, returnedError)
+				require.Equal(t, test.error.Error(), returnedError.Error())
+			}
+		})
+	}
+}
+
+func TestMsgSetSubscriberOnly_GetSignBytes(t *testing.T) {
+	actual := msgSetSubscriberOnly.GetSignBytes()
+	expected := `{"type":"desmos/MsgSetSubscriberOnly","value":{"creator":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","min_tier":1,"post_id":"dd065b70feb810a8c6f535cf670fe6e3534085221fa964ed2660ebca93f910d1"}}`
+	require.Equal(t, expected, string(actual))
+}
+
+func TestMsgSetSubscriberOnly_GetSigners(t *testing.T) {
+	actual := msgSetSubscriberOnly.GetSigners()
+	require.Equal(t, 1, len(actual))
+	require.Equal(t, msgSetSubscriberOnly.Creator, actual[0])
+}
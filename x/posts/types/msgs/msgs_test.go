@@ -82,6 +82,20 @@ func TestMsgCreatePost_ValidateBasic(t *testing.T) {
 			),
 			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address: "),
 		},
+		{
+			name: "Acting as the creator itself returns error",
+			msg: msgs.NewMsgCreatePost(
+				"Message",
+				"",
+				false,
+				"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e",
+				map[string]string{},
+				creator,
+				nil,
+				nil,
+			).WithActingAs(creator),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the creator"),
+		},
 		{
 			name: "Empty message returns error if attachments, poll data and message are empty",
 			msg: msgs.NewMsgCreatePost(
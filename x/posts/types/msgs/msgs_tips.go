@@ -0,0 +1,63 @@
+package msgs
+
+import (
+	"fmt"
+
+	postserrors "github.com/desmos-labs/desmos/x/posts/types/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/desmos-labs/desmos/x/posts/types/models"
+)
+
+// ----------------------
+// --- MsgTipPost
+// ----------------------
+
+// MsgTipPost defines the TipPost message
+type MsgTipPost struct {
+	PostID models.PostID  `json:"post_id" yaml:"post_id"`
+	Amount sdk.Coins      `json:"amount" yaml:"amount"`
+	Tipper sdk.AccAddress `json:"tipper" yaml:"tipper"`
+}
+
+// NewMsgTipPost is the constructor function for MsgTipPost
+func NewMsgTipPost(id models.PostID, amount sdk.Coins, tipper sdk.AccAddress) MsgTipPost {
+	return MsgTipPost{
+		PostID: id,
+		Amount: amount,
+		Tipper: tipper,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTipPost) Route() string { return models.RouterKey }
+
+// Type should return the action
+func (msg MsgTipPost) Type() string { return models.ActionTipPost }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTipPost) ValidateBasic() error {
+	if !msg.PostID.Valid() {
+		return sdkerrors.Wrap(postserrors.ErrInvalidPostID, msg.PostID.String())
+	}
+
+	if msg.Tipper.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid tipper address: %s", msg.Tipper))
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, fmt.Sprintf("invalid tip amount: %s", msg.Amount))
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTipPost) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgsCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTipPost) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{msg.Tipper} }
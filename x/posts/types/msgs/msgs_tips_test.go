@@ -0,0 +1,87 @@
+package msgs_test
+
+import (
+	"testing"
+
+	postserrors "github.com/desmos-labs/desmos/x/posts/types/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/posts/types/msgs"
+)
+
+// ----------------------
+// --- MsgTipPost
+// ----------------------
+
+var msgTipPost = msgs.NewMsgTipPost(id, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)), testOwner)
+
+func TestMsgTipPost_Route(t *testing.T) {
+	actual := msgTipPost.Route()
+	require.Equal(t, "posts", actual)
+}
+
+func TestMsgTipPost_Type(t *testing.T) {
+	actual := msgTipPost.Type()
+	require.Equal(t, "tip_post", actual)
+}
+
+func TestMsgTipPost_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   msgs.MsgTipPost
+		error error
+	}{
+		{
+			name:  "Invalid post id",
+			msg:   msgs.NewMsgTipPost("", msgTipPost.Amount, msgTipPost.Tipper),
+			error: sdkerrors.Wrap(postserrors.ErrInvalidPostID, ""),
+		},
+		{
+			name:  "Invalid tipper address",
+			msg:   msgs.NewMsgTipPost(id, msgTipPost.Amount, nil),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid tipper address: "),
+		},
+		{
+			name:  "Empty amount",
+			msg:   msgs.NewMsgTipPost(id, sdk.NewCoins(), msgTipPost.Tipper),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid tip amount: "),
+		},
+		{
+			name:  "Invalid amount",
+			msg:   msgs.NewMsgTipPost(id, sdk.Coins{sdk.Coin{Denom: "udaric", Amount: sdk.NewInt(-1)}}, msgTipPost.Tipper),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid tip amount: -1udaric"),
+		},
+		{
+			name: "Valid message returns no error",
+			msg:  msgTipPost,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			returnedError := test.msg.ValidateBasic()
+			if test.error == nil {
+				require.Nil(t, returnedError)
+			} else {
+				require.NotNil(t, returnedError)
+				require.Equal(t, test.error.Error(), returnedError.Error())
+			}
+		})
+	}
+}
+
+func TestMsgTipPost_GetSignBytes(t *testing.T) {
+	actual := msgTipPost.GetSignBytes()
+	expected := `{"type":"desmos/MsgTipPost","value":{"amount":[{"amount":"100","denom":"udaric"}],"post_id":"dd065b70feb810a8c6f535cf670fe6e3534085221fa964ed2660ebca93f910d1","tipper":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"}}`
+	require.Equal(t, expected, string(actual))
+}
+
+func TestMsgTipPost_GetSigners(t *testing.T) {
+	actual := msgTipPost.GetSigners()
+	require.Equal(t, 1, len(actual))
+	require.Equal(t, msgTipPost.Tipper, actual[0])
+}
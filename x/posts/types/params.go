@@ -25,6 +25,7 @@ var (
 	MaxPostMessageLengthKey            = []byte("MaxPostMessageLength")
 	MaxOptionalDataFieldsNumberKey     = []byte("MaxOptionalDataFieldsNumber")
 	MaxOptionalDataFieldValueLengthKey = []byte("MaxOptionalDataFieldValueLength")
+	SubspaceTipFeesKey                 = []byte("SubspaceTipFees")
 )
 
 // ParamKeyTable Key declaration for parameters
@@ -33,17 +34,21 @@ func ParamKeyTable() paramsModule.KeyTable {
 }
 
 type Params struct {
-	MaxPostMessageLength            sdk.Int `json:"max_post_message_length" yaml:"max_post_message_length"`
-	MaxOptionalDataFieldsNumber     sdk.Int `json:"max_optional_data_fields_number" yaml:"max_optional_data_fields_number"`
-	MaxOptionalDataFieldValueLength sdk.Int `json:"max_optional_data_field_value_length" yaml:"max_optional_data_field_value_length"`
+	MaxPostMessageLength            sdk.Int         `json:"max_post_message_length" yaml:"max_post_message_length"`
+	MaxOptionalDataFieldsNumber     sdk.Int         `json:"max_optional_data_fields_number" yaml:"max_optional_data_fields_number"`
+	MaxOptionalDataFieldValueLength sdk.Int         `json:"max_optional_data_field_value_length" yaml:"max_optional_data_field_value_length"`
+	SubspaceTipFees                 SubspaceTipFees `json:"subspace_tip_fees" yaml:"subspace_tip_fees"`
 }
 
 // NewParams creates a new Params obj
-func NewParams(maxPostMLen, maxOpDataFieldNum, maxOpDataFieldValLen sdk.Int) Params {
+func NewParams(
+	maxPostMLen, maxOpDataFieldNum, maxOpDataFieldValLen sdk.Int, subspaceTipFees SubspaceTipFees,
+) Params {
 	return Params{
 		MaxPostMessageLength:            maxPostMLen,
 		MaxOptionalDataFieldsNumber:     maxOpDataFieldNum,
 		MaxOptionalDataFieldValueLength: maxOpDataFieldValLen,
+		SubspaceTipFees:                 subspaceTipFees,
 	}
 }
 
@@ -53,16 +58,18 @@ func DefaultParams() Params {
 		MaxPostMessageLength:            DefaultMaxPostMessageLength,
 		MaxOptionalDataFieldsNumber:     DefaultMaxOptionalDataFieldsNumber,
 		MaxOptionalDataFieldValueLength: DefaultMaxOptionalDataFieldValueLength,
+		SubspaceTipFees:                 nil,
 	}
 }
 
 // String implements Stringer
 func (params Params) String() string {
 	out := "Posts parameters:\n"
-	out += fmt.Sprintf("MaxPostMessageLength: %s\nMaxOptionalDataFieldsNumber: %s\nMaxOptionalDataFieldValueLength: %s\n",
+	out += fmt.Sprintf("MaxPostMessageLength: %s\nMaxOptionalDataFieldsNumber: %s\nMaxOptionalDataFieldValueLength: %s\nSubspaceTipFees:\n%s\n",
 		params.MaxPostMessageLength,
 		params.MaxOptionalDataFieldsNumber,
 		params.MaxOptionalDataFieldValueLength,
+		params.SubspaceTipFees,
 	)
 
 	return strings.TrimSpace(out)
@@ -75,6 +82,7 @@ func (params *Params) ParamSetPairs() paramsModule.ParamSetPairs {
 		paramsModule.NewParamSetPair(MaxPostMessageLengthKey, &params.MaxPostMessageLength, ValidateMaxPostMessageLengthParam),
 		paramsModule.NewParamSetPair(MaxOptionalDataFieldsNumberKey, &params.MaxOptionalDataFieldsNumber, ValidateMaxOptionalDataFieldNumberParam),
 		paramsModule.NewParamSetPair(MaxOptionalDataFieldValueLengthKey, &params.MaxOptionalDataFieldValueLength, ValidateMaxOptionalDataFieldValueLengthParam),
+		paramsModule.NewParamSetPair(SubspaceTipFeesKey, &params.SubspaceTipFees, ValidateSubspaceTipFeesParam),
 	}
 }
 
@@ -92,7 +100,7 @@ func (params Params) Validate() error {
 		return err
 	}
 
-	return nil
+	return ValidateSubspaceTipFeesParam(params.SubspaceTipFees)
 }
 
 func ValidateMaxPostMessageLengthParam(i interface{}) error {
@@ -136,3 +144,102 @@ func ValidateMaxOptionalDataFieldValueLengthParam(i interface{}) error {
 
 	return nil
 }
+
+func ValidateSubspaceTipFeesParam(i interface{}) error {
+	fees, isCorrectParam := i.(SubspaceTipFees)
+
+	if !isCorrectParam {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	if err := fees.Validate(); err != nil {
+		return fmt.Errorf("invalid subspace tip fees param: %s", err)
+	}
+
+	return nil
+}
+
+// SubspaceTipFee represents the share of the tips sent to the posts of a subspace
+// that is paid to the subspace recipient
+type SubspaceTipFee struct {
+	Subspace  string         `json:"subspace" yaml:"subspace"`
+	Recipient sdk.AccAddress `json:"recipient" yaml:"recipient"`
+	Rate      sdk.Dec        `json:"rate" yaml:"rate"`
+}
+
+// NewSubspaceTipFee is a constructor function for SubspaceTipFee
+func NewSubspaceTipFee(subspace string, recipient sdk.AccAddress, rate sdk.Dec) SubspaceTipFee {
+	return SubspaceTipFee{
+		Subspace:  subspace,
+		Recipient: recipient,
+		Rate:      rate,
+	}
+}
+
+// String implements Stringer
+func (fee SubspaceTipFee) String() string {
+	return fmt.Sprintf("%s - %s - %s", fee.Subspace, fee.Recipient, fee.Rate)
+}
+
+// Validate checks the validity of the SubspaceTipFee
+func (fee SubspaceTipFee) Validate() error {
+	if !IsValidSubspace(fee.Subspace) {
+		return fmt.Errorf("invalid tip fee subspace: %s", fee.Subspace)
+	}
+
+	if fee.Recipient.Empty() {
+		return fmt.Errorf("invalid tip fee recipient for subspace %s: %s", fee.Subspace, fee.Recipient)
+	}
+
+	if fee.Rate.IsNil() || !fee.Rate.IsPositive() || fee.Rate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("invalid tip fee rate for subspace %s: %s", fee.Subspace, fee.Rate)
+	}
+
+	return nil
+}
+
+// Fee returns the part of the given amount that should be paid to the subspace recipient,
+// truncating each coin to its integer amount
+func (fee SubspaceTipFee) Fee(amount sdk.Coins) sdk.Coins {
+	fees, _ := sdk.NewDecCoinsFromCoins(amount...).MulDecTruncate(fee.Rate).TruncateDecimal()
+	return fees
+}
+
+// SubspaceTipFees represents a slice of SubspaceTipFee objects
+type SubspaceTipFees []SubspaceTipFee
+
+// String implements Stringer
+func (fees SubspaceTipFees) String() string {
+	out := "Subspace - Recipient - Rate\n"
+	for _, fee := range fees {
+		out += fee.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// Find returns the tip fee of the given subspace, and whether it has been found or not
+func (fees SubspaceTipFees) Find(subspace string) (SubspaceTipFee, bool) {
+	for _, fee := range fees {
+		if fee.Subspace == subspace {
+			return fee, true
+		}
+	}
+	return SubspaceTipFee{}, false
+}
+
+// Validate checks the validity of all the tip fees, making sure each subspace appears only once
+func (fees SubspaceTipFees) Validate() error {
+	subspaces := make(map[string]bool, len(fees))
+	for _, fee := range fees {
+		if err := fee.Validate(); err != nil {
+			return err
+		}
+
+		if subspaces[fee.Subspace] {
+			return fmt.Errorf("duplicated tip fee for subspace %s", fee.Subspace)
+		}
+		subspaces[fee.Subspace] = true
+	}
+
+	return nil
+}
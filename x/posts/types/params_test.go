@@ -10,13 +10,13 @@ import (
 )
 
 func TestDefaultParams(t *testing.T) {
-	params := types.NewParams(sdk.NewInt(500), sdk.NewInt(10), sdk.NewInt(200))
+	params := types.NewParams(sdk.NewInt(500), sdk.NewInt(10), sdk.NewInt(200), nil)
 	require.Equal(t, params, types.DefaultParams())
 }
 
 func TestParams_String(t *testing.T) {
 	params := types.DefaultParams()
-	require.Equal(t, "Posts parameters:\nMaxPostMessageLength: 500\nMaxOptionalDataFieldsNumber: 10\nMaxOptionalDataFieldValueLength: 200", params.String())
+	require.Equal(t, "Posts parameters:\nMaxPostMessageLength: 500\nMaxOptionalDataFieldsNumber: 10\nMaxOptionalDataFieldValueLength: 200\nSubspaceTipFees:\nSubspace - Recipient - Rate", params.String())
 }
 
 func TestValidateParams(t *testing.T) {
@@ -28,19 +28,26 @@ func TestValidateParams(t *testing.T) {
 	}{
 		{
 			name:   "invalid max post message length param returns error",
-			params: types.NewParams(sdk.NewInt(-1), sdk.NewInt(12), sdk.NewInt(200)),
+			params: types.NewParams(sdk.NewInt(-1), sdk.NewInt(12), sdk.NewInt(200), nil),
 			expErr: fmt.Errorf("invalid max post message length param: -1"),
 		},
 		{
 			name:   "invalid max optional data number param returns error",
-			params: types.NewParams(sdk.NewInt(500), sdk.NewInt(8), sdk.NewInt(200)),
+			params: types.NewParams(sdk.NewInt(500), sdk.NewInt(8), sdk.NewInt(200), nil),
 			expErr: fmt.Errorf("invalid max optional data fields number param: 8"),
 		},
 		{
 			name:   "invalid max optional data field value length returns error",
-			params: types.NewParams(sdk.NewInt(500), sdk.NewInt(10), sdk.NewInt(10)),
+			params: types.NewParams(sdk.NewInt(500), sdk.NewInt(10), sdk.NewInt(10), nil),
 			expErr: fmt.Errorf("invalid max optional data fields value length param: %s", sdk.NewInt(10)),
 		},
+		{
+			name: "invalid subspace tip fees returns error",
+			params: types.NewParams(sdk.NewInt(500), sdk.NewInt(10), sdk.NewInt(200), types.SubspaceTipFees{
+				types.NewSubspaceTipFee("subspace", nil, sdk.NewDecWithPrec(1, 1)),
+			}),
+			expErr: fmt.Errorf("invalid subspace tip fees param: invalid tip fee subspace: subspace"),
+		},
 		{
 			name:   "valid params returns no error",
 			params: types.DefaultParams(),
@@ -151,3 +158,72 @@ func TestValidateMaxOptionalDataFieldValueLengthParam(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateSubspaceTipFeesParam(t *testing.T) {
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	recipient, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		fees   interface{}
+		expErr error
+	}{
+		{
+			name:   "invalid param type returns error",
+			fees:   "param",
+			expErr: fmt.Errorf("invalid parameters type: param"),
+		},
+		{
+			name:   "empty recipient returns error",
+			fees:   types.SubspaceTipFees{types.NewSubspaceTipFee(subspace, nil, sdk.NewDecWithPrec(1, 1))},
+			expErr: fmt.Errorf("invalid subspace tip fees param: invalid tip fee recipient for subspace %s: ", subspace),
+		},
+		{
+			name: "invalid rate returns error",
+			fees: types.SubspaceTipFees{types.NewSubspaceTipFee(subspace, recipient, sdk.OneDec())},
+			expErr: fmt.Errorf("invalid subspace tip fees param: invalid tip fee rate for subspace %s: %s",
+				subspace, sdk.OneDec()),
+		},
+		{
+			name: "duplicated subspace returns error",
+			fees: types.SubspaceTipFees{
+				types.NewSubspaceTipFee(subspace, recipient, sdk.NewDecWithPrec(1, 1)),
+				types.NewSubspaceTipFee(subspace, recipient, sdk.NewDecWithPrec(2, 1)),
+			},
+			expErr: fmt.Errorf("invalid subspace tip fees param: duplicated tip fee for subspace %s", subspace),
+		},
+		{
+			name:   "nil param returns no errors",
+			fees:   types.SubspaceTipFees(nil),
+			expErr: nil,
+		},
+		{
+			name:   "valid param returns no errors",
+			fees:   types.SubspaceTipFees{types.NewSubspaceTipFee(subspace, recipient, sdk.NewDecWithPrec(1, 1))},
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := types.ValidateSubspaceTipFeesParam(test.fees)
+			require.Equal(t, test.expErr, err)
+		})
+	}
+}
+
+func TestSubspaceTipFee_Fee(t *testing.T) {
+	recipient, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	fee := types.NewSubspaceTipFee(
+		"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e",
+		recipient,
+		sdk.NewDecWithPrec(15, 2),
+	)
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("udaric", 1000), sdk.NewInt64Coin("udesmos", 5))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udaric", 150)), fee.Fee(amount))
+}
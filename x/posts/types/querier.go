@@ -20,6 +20,10 @@ type QueryPostsParams struct {
 	Subspace       string
 	Creator        sdk.AccAddress
 	Hashtags       []string
+
+	IncludeHidden bool // Tells whether the posts that have been hidden should be returned too
+
+	Viewer sdk.AccAddress // User reading the posts, used to unlock the subscriber-only ones
 }
 
 func DefaultQueryPostsParams(page, limit int) QueryPostsParams {
@@ -38,3 +42,21 @@ func DefaultQueryPostsParams(page, limit int) QueryPostsParams {
 		Hashtags:       nil,
 	}
 }
+
+// QueryTimelineParams Params for query 'custom/posts/timeline'.
+// An empty subspace considers the relationships and posts inside all the subspaces,
+// while an empty cursor returns the newest posts
+type QueryTimelineParams struct {
+	Subspace string
+	Cursor   PostID
+	Limit    int
+}
+
+// NewQueryTimelineParams is a constructor function for QueryTimelineParams
+func NewQueryTimelineParams(subspace string, cursor PostID, limit int) QueryTimelineParams {
+	return QueryTimelineParams{
+		Subspace: subspace,
+		Cursor:   cursor,
+		Limit:    limit,
+	}
+}
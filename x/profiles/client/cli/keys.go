@@ -5,4 +5,12 @@ const (
 	flagBio        = "bio"
 	flagProfilePic = "profile-pic"
 	flagCoverPic   = "cover-pic"
+
+	flagProfilePicHash = "profile-pic-hash"
+	flagCoverPicHash   = "cover-pic-hash"
+
+	flagCustomField = "custom-field"
+
+	flagPage     = "page"
+	flagNumLimit = "limit"
 )
@@ -2,13 +2,16 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/desmos-labs/desmos/x/profiles/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // GetQueryCmd adds the query commands
@@ -23,6 +26,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	profileQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryProfile(cdc),
 		GetCmdQueryProfiles(cdc),
+		GetCmdSearchProfiles(cdc),
+		GetCmdQueryGrants(cdc),
 		GetCmdQueryProfileParams(cdc),
 	)...)
 	return profileQueryCmd
@@ -74,6 +79,74 @@ func GetCmdQueryProfiles(cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdSearchProfiles queries the profiles having a dtag or a moniker word starting with the given prefix
+func GetCmdSearchProfiles(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search [prefix]",
+		Short: "Search the profiles having a dtag or a moniker word that starts with the given prefix.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Search for paginated profiles having a dtag or a moniker word that starts with the given prefix.
+The search is case insensitive.
+
+Example:
+$ %s query profiles search leo
+$ %s query profiles search leo --page=2 --limit=100
+`,
+				version.ClientName, version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQuerySearchParams(args[0], viper.GetInt(flagPage), viper.GetInt(flagNumLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySearch)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Printf("Could not search profiles with prefix %s \n", args[0])
+				return nil
+			}
+
+			var out types.Profiles
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().Int(flagPage, 1, "pagination page of profiles to query for")
+	cmd.Flags().Int(flagNumLimit, 100, "pagination limit of profiles to query for")
+
+	return cmd
+}
+
+// GetCmdQueryGrants queries all the delegations granted by the given address
+func GetCmdQueryGrants(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants [address]",
+		Short: "Retrieve all the delegations granted by the given address.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryGrants, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf("Could not find any delegation granted by %s \n", args[0])
+				return nil
+			}
+
+			var out types.Grants
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
 // GetCmdQueryProfileParams queries all the profiles' module params
 func GetCmdQueryProfileParams(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -3,6 +3,8 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -30,6 +32,8 @@ func GetTxCmd(_ string, cdc *codec.Codec) *cobra.Command {
 	profileTxCmd.AddCommand(flags.PostCommands(
 		GetCmdSaveProfile(cdc),
 		GetCmdDeleteProfile(cdc),
+		GetCmdGrantDelegation(cdc),
+		GetCmdRevokeDelegation(cdc),
 	)...)
 
 	return profileTxCmd
@@ -43,6 +47,19 @@ func getFlagValueOrNilOnDefault(flag string) *string {
 	return &flagValue
 }
 
+// getCustomFields parses the given key=value strings into a set of custom fields
+func getCustomFields(values []string) (types.CustomFields, error) {
+	fields := make([]types.CustomField, len(values))
+	for index, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid custom field %s, it should be in the key=value format", value)
+		}
+		fields[index] = types.NewCustomField(parts[0], parts[1])
+	}
+	return types.NewCustomFields(fields...), nil
+}
+
 // GetCmdSaveProfile is the CLI command for saving an profile
 func GetCmdSaveProfile(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -51,6 +68,8 @@ func GetCmdSaveProfile(cdc *codec.Codec) *cobra.Command {
 		Short: "Save your profile associating to it the given DTag.",
 		Long: fmt.Sprintf(`
 Save a new profile or edit the existing one specifying a DTag, a moniker, biography, profile picture and cover picture.
+The SHA-256 hashes of the pictures contents can optionally be given as well.
+Custom fields can be specified using the %s flag multiple times, once for each key=value pair.
 Every data given through the flags is optional.
 If you are editing an existing profile you should fill all the existent fields otherwise the existing values
 will be removed.
@@ -58,9 +77,12 @@ will be removed.
 %s tx profiles save LeoDiCap \
 	%s "Leonardo Di Caprio" \
 	%s "Hollywood actor. Proud environmentalist" \
-	%s "https://profilePic.jpg"
-	%s "https://profileCover.jpg"
-`, version.ClientName, flagMoniker, flagBio, flagProfilePic, flagCoverPic),
+	%s "https://profilePic.jpg" \
+	%s "ipfs://QmXoypizjW3WknFiJnKLwHCnL72vedxjQkDDP1mXWo6uco" \
+	%s "location=Los Angeles" \
+	%s "website=https://leonardodicaprio.com"
+`, flagCustomField, version.ClientName, flagMoniker, flagBio, flagProfilePic, flagCoverPic,
+			flagCustomField, flagCustomField),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -70,8 +92,22 @@ will be removed.
 			picture := getFlagValueOrNilOnDefault(flagProfilePic)
 			cover := getFlagValueOrNilOnDefault(flagCoverPic)
 			bio := getFlagValueOrNilOnDefault(flagBio)
+			pictureHash := getFlagValueOrNilOnDefault(flagProfilePicHash)
+			coverHash := getFlagValueOrNilOnDefault(flagCoverPicHash)
+
+			customFieldsValues, err := cmd.Flags().GetStringArray(flagCustomField)
+			if err != nil {
+				return err
+			}
+
+			customFields, err := getCustomFields(customFieldsValues)
+			if err != nil {
+				return err
+			}
 
-			msg := types.NewMsgSaveProfile(args[0], moniker, bio, picture, cover, cliCtx.FromAddress)
+			msg := types.NewMsgSaveProfile(args[0], moniker, bio, picture, cover, cliCtx.FromAddress).
+				WithPicturesHashes(pictureHash, coverHash).
+				WithCustomFields(customFields)
 
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
@@ -81,6 +117,9 @@ will be removed.
 	cmd.Flags().String(flagBio, "", "Biography to be used")
 	cmd.Flags().String(flagProfilePic, "", "Profile picture")
 	cmd.Flags().String(flagCoverPic, "", "Cover picture")
+	cmd.Flags().String(flagProfilePicHash, "", "Hex encoded SHA-256 hash of the profile picture content")
+	cmd.Flags().String(flagCoverPicHash, "", "Hex encoded SHA-256 hash of the cover picture content")
+	cmd.Flags().StringArray(flagCustomField, []string{}, "Custom field to be associated with the profile, in the key=value format")
 
 	return cmd
 }
@@ -104,3 +143,73 @@ func GetCmdDeleteProfile(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdGrantDelegation is the CLI command for authorizing another account to perform messages on your behalf
+func GetCmdGrantDelegation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-delegation [grantee] [msg-types] [expiration]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Authorize another account to perform the given types of messages on your behalf until the expiration date.",
+		Long: fmt.Sprintf(`
+Authorize the grantee to perform the given comma separated types of messages on your behalf until the expiration date.
+The grantee will need to specify your address as the acting-as field of the messages it sends.
+The expiration date must be given in RFC3339 format.
+
+%s tx profiles grant-delegation desmos1qugw5ux0ea0v3cdxj7n9jnrz69f9wyc4668ek5 \
+	create_post,add_post_reaction,answer_poll \
+	2021-01-01T00:00:00Z
+`, version.ClientName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			expiration, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgGrantDelegation(cliCtx.FromAddress, grantee, strings.Split(args[1], ","), expiration)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdRevokeDelegation is the CLI command for revoking a delegation previously granted to another account
+func GetCmdRevokeDelegation(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-delegation [grantee]",
+		Short: "Revoke the delegation previously granted to the given account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeDelegation(cliCtx.FromAddress, grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -1,6 +1,8 @@
 package rest
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/desmos-labs/desmos/x/profiles/types"
@@ -15,14 +17,28 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 
 // SaveProfileReq defines the properties of a profile save request's body
 type SaveProfileReq struct {
-	BaseReq  rest.BaseReq    `json:"base_req"`
-	DTag     string          `json:"dtag"`
-	Moniker  *string         `json:"moniker,omitempty"`
-	Bio      *string         `json:"bio,omitempty"`
-	Pictures *types.Pictures `json:"pictures,omitempty"`
+	BaseReq      rest.BaseReq       `json:"base_req"`
+	DTag         string             `json:"dtag"`
+	Moniker      *string            `json:"moniker,omitempty"`
+	Bio          *string            `json:"bio,omitempty"`
+	Pictures     *types.Pictures    `json:"pictures,omitempty"`
+	CustomFields types.CustomFields `json:"custom_fields,omitempty"`
 }
 
 // Delete defines the properties of a profile deletion request's body
 type DeleteProfileReq struct {
 	BaseReq rest.BaseReq `json:"base_req"`
 }
+
+// GrantDelegationReq defines the properties of a delegation grant request's body
+type GrantDelegationReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	Grantee    string       `json:"grantee"`
+	MsgTypes   []string     `json:"msg_types"`
+	Expiration time.Time    `json:"expiration"`
+}
+
+// RevokeDelegationReq defines the properties of a delegation revocation request's body
+type RevokeDelegationReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+}
@@ -8,10 +8,17 @@ import (
 )
 
 // ExportGenesis returns the GenesisState associated with the given context
+// Profiles having dtags that are equal once normalized are reported, as they need to be resolved
+// before the exported state can be imported again
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	for _, collision := range k.GetDtagCollisions(ctx) {
+		ctx.Logger().Error("found colliding profiles dtags", "collision", collision.String())
+	}
+
 	return types.GenesisState{
 		Profiles: k.GetProfiles(ctx),
 		Params:   k.GetParams(ctx),
+		Grants:   k.GetGrants(ctx),
 	}
 }
 
@@ -28,5 +35,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []ab
 		}
 	}
 
+	for _, grant := range data.Grants {
+		k.SaveGrant(ctx, grant)
+	}
+
 	return nil
 }
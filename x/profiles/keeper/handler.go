@@ -3,10 +3,13 @@ package keeper
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/desmos-labs/desmos/x/commons"
 	"github.com/desmos-labs/desmos/x/profiles/types"
 )
 
@@ -20,6 +23,10 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgSaveProfile(ctx, keeper, msg)
 		case types.MsgDeleteProfile:
 			return handleMsgDeleteProfile(ctx, keeper, msg)
+		case types.MsgGrantDelegation:
+			return handleMsgGrantDelegation(ctx, keeper, msg)
+		case types.MsgRevokeDelegation:
+			return handleMsgRevokeDelegation(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized Profiles message type: %v", msg.Type())
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -33,15 +40,19 @@ func ValidateProfile(ctx sdk.Context, keeper Keeper, profile types.Profile) erro
 
 	minMonikerLen := params.MonikerParams.MinMonikerLen.Int64()
 	maxMonikerLen := params.MonikerParams.MaxMonikerLen.Int64()
+	maxMonikerBytes := params.MonikerParams.MaxMonikerBytes.Int64()
 
 	if profile.Moniker != nil {
-		nameLen := int64(len(*profile.Moniker))
+		nameLen := int64(utf8.RuneCountInString(*profile.Moniker))
 		if nameLen < minMonikerLen {
 			return fmt.Errorf("profile moniker cannot be less than %d characters", minMonikerLen)
 		}
 		if nameLen > maxMonikerLen {
 			return fmt.Errorf("profile moniker cannot exceed %d characters", maxMonikerLen)
 		}
+		if int64(len(*profile.Moniker)) > maxMonikerBytes {
+			return fmt.Errorf("profile moniker cannot exceed %d bytes", maxMonikerBytes)
+		}
 	}
 
 	dTagRegEx := regexp.MustCompile(params.DtagParams.RegEx)
@@ -62,14 +73,70 @@ func ValidateProfile(ctx sdk.Context, keeper Keeper, profile types.Profile) erro
 	}
 
 	maxBioLen := params.MaxBioLen.Int64()
-	if profile.Bio != nil && int64(len(*profile.Bio)) > maxBioLen {
-		return fmt.Errorf("profile biography cannot exceed %d characters", maxBioLen)
+	maxBioBytes := params.MaxBioBytes.Int64()
+	if profile.Bio != nil {
+		if int64(utf8.RuneCountInString(*profile.Bio)) > maxBioLen {
+			return fmt.Errorf("profile biography cannot exceed %d characters", maxBioLen)
+		}
+		if int64(len(*profile.Bio)) > maxBioBytes {
+			return fmt.Errorf("profile biography cannot exceed %d bytes", maxBioBytes)
+		}
 	}
 
 	if err := profile.Validate(); err != nil {
 		return err
 	}
 
+	if err := validateProfileCustomFields(params, profile.CustomFields); err != nil {
+		return err
+	}
+
+	if profile.Pictures != nil {
+		if profile.Pictures.Profile != nil && !commons.IsURIValidWithSchemes(*profile.Pictures.Profile, params.AllowedURISchemes) {
+			return fmt.Errorf("profile picture uri scheme not allowed, it should be one of: %s",
+				strings.Join(params.AllowedURISchemes, ", "))
+		}
+
+		if profile.Pictures.Cover != nil && !commons.IsURIValidWithSchemes(*profile.Pictures.Cover, params.AllowedURISchemes) {
+			return fmt.Errorf("profile cover uri scheme not allowed, it should be one of: %s",
+				strings.Join(params.AllowedURISchemes, ", "))
+		}
+	}
+
+	return nil
+}
+
+// validateProfileCustomFields checks if the given custom fields are valid according to the given params
+func validateProfileCustomFields(params types.Params, fields types.CustomFields) error {
+	maxFields := params.CustomFieldsParams.MaxFields.Int64()
+	if int64(len(fields)) > maxFields {
+		return fmt.Errorf("profile cannot have more than %d custom fields", maxFields)
+	}
+
+	keyRegEx := regexp.MustCompile(params.CustomFieldsParams.KeyRegEx)
+	maxKeyLen := params.CustomFieldsParams.MaxKeyLength.Int64()
+	maxValueLen := params.CustomFieldsParams.MaxValueLength.Int64()
+
+	for _, field := range fields {
+		if !keyRegEx.MatchString(field.Key) {
+			return fmt.Errorf("invalid custom field key %s, it should match the following regEx %s", field.Key, keyRegEx)
+		}
+
+		if int64(len(field.Key)) > maxKeyLen {
+			return fmt.Errorf("custom field key %s cannot exceed %d characters", field.Key, maxKeyLen)
+		}
+
+		if int64(utf8.RuneCountInString(field.Value)) > maxValueLen {
+			return fmt.Errorf("custom field %s value cannot exceed %d characters", field.Key, maxValueLen)
+		}
+
+		if schema, found := params.CustomFieldsSchemas.GetSchema(field.Key); found {
+			if err := schema.ValidateValue(field.Value); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -92,7 +159,9 @@ func handleMsgSaveProfile(ctx sdk.Context, keeper Keeper, msg types.MsgSaveProfi
 	profile = profile.
 		WithMoniker(msg.Moniker).
 		WithBio(msg.Bio).
-		WithPictures(msg.ProfilePic, msg.CoverPic)
+		WithPictures(msg.ProfilePic, msg.CoverPic).
+		WithPicturesHashes(msg.ProfilePicHash, msg.CoverPicHash).
+		WithCustomFields(msg.CustomFields)
 	err := ValidateProfile(ctx, keeper, profile)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
@@ -144,3 +213,54 @@ func handleMsgDeleteProfile(ctx sdk.Context, keeper Keeper, msg types.MsgDeleteP
 
 	return &result, nil
 }
+
+// handleMsgGrantDelegation handles the authorization of an account to perform messages on behalf of a profile
+func handleMsgGrantDelegation(ctx sdk.Context, keeper Keeper, msg types.MsgGrantDelegation) (*sdk.Result, error) {
+	if _, found := keeper.GetProfile(ctx, msg.Granter); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("no profile associated with this address: %s", msg.Granter))
+	}
+
+	if !msg.Expiration.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "delegation expiration must be in the future")
+	}
+
+	grant := types.NewGrant(msg.Granter, msg.Grantee, msg.MsgTypes, msg.Expiration)
+	keeper.SaveGrant(ctx, grant)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDelegationGranted,
+		sdk.NewAttribute(types.AttributeDelegationGranter, grant.Granter.String()),
+		sdk.NewAttribute(types.AttributeDelegationGrantee, grant.Grantee.String()),
+		sdk.NewAttribute(types.AttributeDelegationMsgTypes, strings.Join(grant.MsgTypes, ",")),
+		sdk.NewAttribute(types.AttributeDelegationExpiration, grant.Expiration.Format(time.RFC3339)),
+	))
+
+	result := sdk.Result{
+		Data:   keeper.Cdc.MustMarshalBinaryLengthPrefixed("Delegation granted properly"),
+		Events: ctx.EventManager().Events(),
+	}
+	return &result, nil
+}
+
+// handleMsgRevokeDelegation handles the revocation of a previously granted delegation
+func handleMsgRevokeDelegation(ctx sdk.Context, keeper Keeper, msg types.MsgRevokeDelegation) (*sdk.Result, error) {
+	if _, found := keeper.GetGrant(ctx, msg.Granter, msg.Grantee); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("no delegation granted by %s to %s", msg.Granter, msg.Grantee))
+	}
+
+	keeper.DeleteGrant(ctx, msg.Granter, msg.Grantee)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDelegationRevoked,
+		sdk.NewAttribute(types.AttributeDelegationGranter, msg.Granter.String()),
+		sdk.NewAttribute(types.AttributeDelegationGrantee, msg.Grantee.String()),
+	))
+
+	result := sdk.Result{
+		Data:   keeper.Cdc.MustMarshalBinaryLengthPrefixed("Delegation revoked properly"),
+		Events: ctx.EventManager().Events(),
+	}
+	return &result, nil
+}
@@ -15,6 +15,7 @@ func (suite *KeeperTestSuite) Test_validateProfile() {
 	tests := []struct {
 		name    string
 		profile types.Profile
+		params  types.Params
 		expErr  error
 	}{
 		{
@@ -94,6 +95,158 @@ func (suite *KeeperTestSuite) Test_validateProfile() {
 				),
 			expErr: fmt.Errorf("invalid profile picture uri provided"),
 		},
+		{
+			name: "Max moniker bytes exceeded",
+			profile: types.NewProfile("custom_dtag", suite.testData.profile.Creator, suite.testData.profile.CreationDate).
+				WithMoniker(newStrPtr(strings.Repeat("𝔸", 1000))).
+				WithBio(newStrPtr("my-bio")),
+			params: types.NewParams(
+				types.NewMonikerParams(types.DefaultMinMonikerLength, types.DefaultMaxMonikerLength, sdk.NewInt(3999)),
+				types.DefaultDtagParams(),
+				types.DefaultMaxBioLength,
+				types.DefaultMaxBioBytes,
+				types.DefaultAllowedURISchemes,
+				types.DefaultCustomFieldsParams(),
+				nil,
+			),
+			expErr: fmt.Errorf("profile moniker cannot exceed 3999 bytes"),
+		},
+		{
+			name: "Multi-byte moniker within the characters limit returns no error",
+			profile: types.NewProfile("custom_dtag", suite.testData.profile.Creator, suite.testData.profile.CreationDate).
+				WithMoniker(newStrPtr(strings.Repeat("𝔸", 1000))).
+				WithBio(newStrPtr("my-bio")),
+			expErr: nil,
+		},
+		{
+			name: "Max bio bytes exceeded",
+			profile: types.NewProfile("custom_dtag", suite.testData.profile.Creator, suite.testData.profile.CreationDate).
+				WithMoniker(newStrPtr("moniker")).
+				WithBio(newStrPtr(strings.Repeat("é", 600))),
+			params: types.NewParams(
+				types.DefaultMonikerParams(),
+				types.DefaultDtagParams(),
+				types.DefaultMaxBioLength,
+				sdk.NewInt(1000),
+				types.DefaultAllowedURISchemes,
+				types.DefaultCustomFieldsParams(),
+				nil,
+			),
+			expErr: fmt.Errorf("profile biography cannot exceed 1000 bytes"),
+		},
+		{
+			name: "Not allowed picture uri scheme returns error",
+			profile: types.NewProfile("dtag", suite.testData.profile.Creator, suite.testData.profile.CreationDate).
+				WithPictures(
+					newStrPtr("ftp://test.com/profile-picture"),
+					newStrPtr("https://test.com/cover-pic"),
+				),
+			expErr: fmt.Errorf("profile picture uri scheme not allowed, it should be one of: http, https, ipfs"),
+		},
+		{
+			name: "Not allowed cover uri scheme returns error",
+			profile: types.NewProfile("dtag", suite.testData.profile.Creator, suite.testData.profile.CreationDate).
+				WithPictures(
+					newStrPtr("https://test.com/profile-picture"),
+					newStrPtr("ipfs://QmXoypizjW3WknFiJnKLwHCnL72vedxjQkDDP1mXWo6uco"),
+				),
+			params: types.NewParams(
+				types.DefaultMonikerParams(),
+				types.DefaultDtagParams(),
+				types.DefaultMaxBioLength,
+				types.DefaultMaxBioBytes,
+				[]string{"https"},
+				types.DefaultCustomFieldsParams(),
+				nil,
+			),
+			expErr: fmt.Errorf("profile cover uri scheme not allowed, it should be one of: https"),
+		},
+		{
+			name: "Valid profile with ipfs pictures and hashes returns no error",
+			profile: types.NewProfile("dtag", suite.testData.profile.Creator, suite.testData.profile.CreationDate).
+				WithPictures(
+					newStrPtr("ipfs://QmXoypizjW3WknFiJnKLwHCnL72vedxjQkDDP1mXWo6uco"),
+					newStrPtr("https://test.com/cover-pic"),
+				).
+				WithPicturesHashes(
+					newStrPtr("ae2d7cb4e5b3a27b0f4a1e0a0b1a7b9d53a6c1e8b6a7f52e3b6e3d5c1c0e7f9a"),
+					nil,
+				),
+			expErr: nil,
+		},
+		{
+			name: "Too many custom fields returns error",
+			profile: types.NewProfile("dtag", suite.testData.profile.Creator, suite.testData.profile.CreationDate).
+				WithCustomFields(types.NewCustomFields(
+					types.NewCustomField("location", "Lugano"),
+					types.NewCustomField("website", "https://desmos.network"),
+				)),
+			params: types.NewParams(
+				types.DefaultMonikerParams(),
+				types.DefaultDtagParams(),
+				types.DefaultMaxBioLength,
+				types.DefaultMaxBioBytes,
+				types.DefaultAllowedURISchemes,
+				types.NewCustomFieldsParams(sdk.NewInt(1), sdk.NewInt(30), sdk.NewInt(500), types.DefaultCustomFieldKeyRegEx),
+				nil,
+			),
+			expErr: fmt.Errorf("profile cannot have more than 1 custom fields"),
+		},
+		{
+			name: "Custom field key not matching regEx returns error",
+			profile: types.NewProfile("dtag", suite.testData.profile.Creator, suite.testData.profile.CreationDate).
+				WithCustomFields(types.NewCustomFields(types.NewCustomField("Location", "Lugano"))),
+			expErr: fmt.Errorf("invalid custom field key Location, it should match the following regEx ^[a-z0-9_]+$"),
+		},
+		{
+			name: "Custom field key too long returns error",
+			profile: types.NewProfile("dtag", suite.testData.profile.Creator, suite.testData.profile.CreationDate).
+				WithCustomFields(types.NewCustomFields(types.NewCustomField(strings.Repeat("a", 31), "value"))),
+			expErr: fmt.Errorf("custom field key %s cannot exceed 30 characters", strings.Repeat("a", 31)),
+		},
+		{
+			name: "Custom field value too long returns error",
+			profile: types.NewProfile("dtag", suite.testData.profile.Creator, suite.testData.profile.CreationDate).
+				WithCustomFields(types.NewCustomFields(types.NewCustomField("location", strings.Repeat("é", 501)))),
+			expErr: fmt.Errorf("custom field location value cannot exceed 500 characters"),
+		},
+		{
+			name: "Custom field not satisfying its schema returns error",
+			profile: types.NewProfile("dtag", suite.testData.profile.Creator, suite.testData.profile.CreationDate).
+				WithCustomFields(types.NewCustomFields(types.NewCustomField("pgp_fingerprint", "fingerprint"))),
+			params: types.NewParams(
+				types.DefaultMonikerParams(),
+				types.DefaultDtagParams(),
+				types.DefaultMaxBioLength,
+				types.DefaultMaxBioBytes,
+				types.DefaultAllowedURISchemes,
+				types.DefaultCustomFieldsParams(),
+				types.CustomFieldsSchemas{
+					types.NewCustomFieldSchema("pgp_fingerprint", `{"type":"string","pattern":"^[0-9a-f]{40}$"}`),
+				},
+			),
+			expErr: fmt.Errorf("custom field pgp_fingerprint value should match the following regEx ^[0-9a-f]{40}$"),
+		},
+		{
+			name: "Valid profile with custom fields returns no error",
+			profile: types.NewProfile("dtag", suite.testData.profile.Creator, suite.testData.profile.CreationDate).
+				WithCustomFields(types.NewCustomFields(
+					types.NewCustomField("pgp_fingerprint", "0d69e11f12bddb0f87c3e4b2e8d3c59bcd4ec4fa"),
+					types.NewCustomField("location", "Lugano"),
+				)),
+			params: types.NewParams(
+				types.DefaultMonikerParams(),
+				types.DefaultDtagParams(),
+				types.DefaultMaxBioLength,
+				types.DefaultMaxBioBytes,
+				types.DefaultAllowedURISchemes,
+				types.DefaultCustomFieldsParams(),
+				types.CustomFieldsSchemas{
+					types.NewCustomFieldSchema("pgp_fingerprint", `{"type":"string","pattern":"^[0-9a-f]{40}$"}`),
+				},
+			),
+			expErr: nil,
+		},
 		{
 			name: "Valid profile returns no error",
 			profile: types.NewProfile("dtag", suite.testData.profile.Creator, suite.testData.profile.CreationDate).
@@ -111,7 +264,11 @@ func (suite *KeeperTestSuite) Test_validateProfile() {
 		test := test
 		suite.Run(test.name, func() {
 			suite.SetupTest() // reset
-			suite.keeper.SetParams(suite.ctx, types.DefaultParams())
+			if test.params.AllowedURISchemes != nil {
+				suite.keeper.SetParams(suite.ctx, test.params)
+			} else {
+				suite.keeper.SetParams(suite.ctx, types.DefaultParams())
+			}
 			actual := keeper.ValidateProfile(suite.ctx, suite.keeper, test.profile)
 			suite.Equal(test.expErr, actual)
 		})
@@ -328,3 +485,151 @@ func (suite *KeeperTestSuite) Test_handleMsgDeleteProfile() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) Test_handleMsgGrantDelegation() {
+	blockTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiration := blockTime.Add(24 * time.Hour)
+
+	tests := []struct {
+		name            string
+		existentProfile *types.Profile
+		msg             types.MsgGrantDelegation
+		expErr          error
+	}{
+		{
+			name:            "Granter without profile returns error",
+			existentProfile: nil,
+			msg: types.NewMsgGrantDelegation(
+				suite.testData.user, suite.testData.otherUser, []string{"create_post"}, expiration,
+			),
+			expErr: sdkerrors.Wrap(
+				sdkerrors.ErrInvalidRequest,
+				"no profile associated with this address: cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47",
+			),
+		},
+		{
+			name:            "Past expiration returns error",
+			existentProfile: &suite.testData.profile,
+			msg: types.NewMsgGrantDelegation(
+				suite.testData.user, suite.testData.otherUser, []string{"create_post"}, blockTime,
+			),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "delegation expiration must be in the future"),
+		},
+		{
+			name:            "Delegation granted successfully",
+			existentProfile: &suite.testData.profile,
+			msg: types.NewMsgGrantDelegation(
+				suite.testData.user, suite.testData.otherUser, []string{"create_post", "edit_post"}, expiration,
+			),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.ctx = suite.ctx.WithBlockTime(blockTime)
+
+			if test.existentProfile != nil {
+				err := suite.keeper.SaveProfile(suite.ctx, *test.existentProfile)
+				suite.Require().NoError(err)
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expErr != nil {
+				suite.Nil(res)
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Empty(suite.keeper.GetGrants(suite.ctx))
+				return
+			}
+
+			suite.NoError(err)
+			suite.Equal(suite.keeper.Cdc.MustMarshalBinaryLengthPrefixed("Delegation granted properly"), res.Data)
+
+			grantedEv := sdk.NewEvent(
+				types.EventTypeDelegationGranted,
+				sdk.NewAttribute(types.AttributeDelegationGranter, test.msg.Granter.String()),
+				sdk.NewAttribute(types.AttributeDelegationGrantee, test.msg.Grantee.String()),
+				sdk.NewAttribute(types.AttributeDelegationMsgTypes, "create_post,edit_post"),
+				sdk.NewAttribute(types.AttributeDelegationExpiration, "2020-01-02T00:00:00Z"),
+			)
+			suite.Len(res.Events, 1)
+			suite.Contains(res.Events, grantedEv)
+
+			grant, found := suite.keeper.GetGrant(suite.ctx, test.msg.Granter, test.msg.Grantee)
+			suite.True(found)
+			suite.Equal(types.NewGrant(test.msg.Granter, test.msg.Grantee, test.msg.MsgTypes, test.msg.Expiration), grant)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgRevokeDelegation() {
+	expiration := time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		storedGrant *types.Grant
+		msg         types.MsgRevokeDelegation
+		expErr      error
+	}{
+		{
+			name:        "Missing delegation returns error",
+			storedGrant: nil,
+			msg:         types.NewMsgRevokeDelegation(suite.testData.user, suite.testData.otherUser),
+			expErr: sdkerrors.Wrap(
+				sdkerrors.ErrInvalidRequest,
+				"no delegation granted by cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47 to cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+			),
+		},
+		{
+			name: "Delegation revoked successfully",
+			storedGrant: &types.Grant{
+				Granter:    suite.testData.user,
+				Grantee:    suite.testData.otherUser,
+				MsgTypes:   []string{"create_post"},
+				Expiration: expiration,
+			},
+			msg:    types.NewMsgRevokeDelegation(suite.testData.user, suite.testData.otherUser),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+
+			if test.storedGrant != nil {
+				suite.keeper.SaveGrant(suite.ctx, *test.storedGrant)
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expErr != nil {
+				suite.Nil(res)
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				return
+			}
+
+			suite.NoError(err)
+			suite.Equal(suite.keeper.Cdc.MustMarshalBinaryLengthPrefixed("Delegation revoked properly"), res.Data)
+
+			revokedEv := sdk.NewEvent(
+				types.EventTypeDelegationRevoked,
+				sdk.NewAttribute(types.AttributeDelegationGranter, test.msg.Granter.String()),
+				sdk.NewAttribute(types.AttributeDelegationGrantee, test.msg.Grantee.String()),
+			)
+			suite.Len(res.Events, 1)
+			suite.Contains(res.Events, revokedEv)
+
+			_, found := suite.keeper.GetGrant(suite.ctx, test.msg.Granter, test.msg.Grantee)
+			suite.False(found)
+		})
+	}
+}
@@ -50,7 +50,7 @@ func (k Keeper) GetDtagRelatedAddress(ctx sdk.Context, dtag string) (addr sdk.Ac
 	return addr
 }
 
-// GetDtagFromAddress returns the dtag associated with the given address or an empty string if no dtag exists
+// GetDtagFromAddress returns the normalized dtag associated with the given address or an empty string if no dtag exists
 func (k Keeper) GetDtagFromAddress(ctx sdk.Context, addr sdk.AccAddress) (dtag string) {
 	store := ctx.KVStore(k.StoreKey)
 	it := sdk.KVStorePrefixIterator(store, types.DtagStorePrefix)
@@ -73,6 +73,11 @@ func (k Keeper) DeleteDtagAddressAssociation(ctx sdk.Context, dtag string) {
 	store.Delete(types.DtagStoreKey(dtag))
 }
 
+// GetDtagCollisions returns all the sets of stored profiles having dtags that are equal once normalized
+func (k Keeper) GetDtagCollisions(ctx sdk.Context) []types.DtagCollision {
+	return types.FindDtagCollisions(k.GetProfiles(ctx))
+}
+
 // replaceDtag delete the oldDtag related to the creator address and associate the new one to it
 func (k Keeper) replaceDtag(ctx sdk.Context, oldDtag, newDtag string, creator sdk.AccAddress) {
 	k.DeleteDtagAddressAssociation(ctx, oldDtag)
@@ -91,6 +96,11 @@ func (k Keeper) SaveProfile(ctx sdk.Context, profile types.Profile) error {
 	oldDtag := k.GetDtagFromAddress(ctx, profile.Creator)
 	k.replaceDtag(ctx, oldDtag, profile.DTag, profile.Creator)
 
+	if oldProfile, found := k.GetProfile(ctx, profile.Creator); found {
+		k.removeSearchIndexes(ctx, oldProfile)
+	}
+	k.storeSearchIndexes(ctx, profile)
+
 	store := ctx.KVStore(k.StoreKey)
 	key := types.ProfileStoreKey(profile.Creator)
 
@@ -99,13 +109,51 @@ func (k Keeper) SaveProfile(ctx sdk.Context, profile types.Profile) error {
 	return nil
 }
 
+// storeSearchIndexes indexes the given profile by its dtag and its moniker tokens
+func (k Keeper) storeSearchIndexes(ctx sdk.Context, profile types.Profile) {
+	store := ctx.KVStore(k.StoreKey)
+	bz := k.Cdc.MustMarshalBinaryBare(&profile.Creator)
+
+	store.Set(types.DtagSearchStoreKey(profile.DTag, profile.Creator), bz)
+	if profile.Moniker != nil {
+		for _, token := range types.MonikerTokens(*profile.Moniker) {
+			store.Set(types.MonikerSearchStoreKey(token, profile.Creator), bz)
+		}
+	}
+}
+
+// removeSearchIndexes removes all the search indexes associated with the given profile
+func (k Keeper) removeSearchIndexes(ctx sdk.Context, profile types.Profile) {
+	store := ctx.KVStore(k.StoreKey)
+
+	store.Delete(types.DtagSearchStoreKey(profile.DTag, profile.Creator))
+	if profile.Moniker != nil {
+		for _, token := range types.MonikerTokens(*profile.Moniker) {
+			store.Delete(types.MonikerSearchStoreKey(token, profile.Creator))
+		}
+	}
+}
+
 // DeleteProfile allows to delete a profile associated with the given address inside the current context.
 // It assumes that the address-related profile exists.
 // nolint: interfacer
 func (k Keeper) DeleteProfile(ctx sdk.Context, address sdk.AccAddress, dtag string) {
+	if profile, found := k.GetProfile(ctx, address); found {
+		k.removeSearchIndexes(ctx, profile)
+	}
+
 	store := ctx.KVStore(k.StoreKey)
 	store.Delete(types.ProfileStoreKey(address))
-	k.DeleteDtagAddressAssociation(ctx, dtag)
+
+	// Delete all the delegations granted by the profile owner
+	for _, grant := range k.GetGranterGrants(ctx, address) {
+		k.DeleteGrant(ctx, grant.Granter, grant.Grantee)
+	}
+
+	// Make sure to not delete the association of a different profile having a colliding dtag
+	if addr := k.GetDtagRelatedAddress(ctx, dtag); addr.Equals(address) {
+		k.DeleteDtagAddressAssociation(ctx, dtag)
+	}
 }
 
 // GetProfiles returns all the created profiles inside the current context.
@@ -136,3 +184,67 @@ func (k Keeper) GetProfile(ctx sdk.Context, address sdk.AccAddress) (profile typ
 
 	return types.Profile{}, false
 }
+
+// iterateSearchIndex iterates over the addresses stored inside the search index entries having the given prefix,
+// calling fn on each one of them. It returns true if the iteration has been stopped by fn
+func (k Keeper) iterateSearchIndex(ctx sdk.Context, prefix []byte, fn func(address sdk.AccAddress) (stop bool)) bool {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var address sdk.AccAddress
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &address)
+		if fn(address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SearchProfiles returns the profiles having a dtag or a moniker word that starts with the given prefix,
+// regardless of its case. Profiles matching the dtag are returned first sorted by their dtag,
+// followed by the ones matching only a moniker word sorted by such word.
+// The search indexes are paginated while being iterated, so that they are read only up to the requested page,
+// which contains at most 100 profiles
+func (k Keeper) SearchProfiles(ctx sdk.Context, params types.QuerySearchParams) types.Profiles {
+	// Default page, default and maximum limit
+	page, limit := params.Page, params.Limit
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 || limit > 100 {
+		limit = 100
+	}
+	offset := (page - 1) * limit
+
+	profiles := types.Profiles{}
+	found := map[string]bool{}
+	matched := 0
+	collect := func(address sdk.AccAddress) (stop bool) {
+		// Profiles matching both the dtag and one or more moniker words are returned once
+		if found[address.String()] {
+			return false
+		}
+		found[address.String()] = true
+
+		profile, exists := k.GetProfile(ctx, address)
+		if !exists {
+			return false
+		}
+
+		if matched >= offset {
+			profiles = append(profiles, profile)
+		}
+		matched++
+
+		return matched >= offset+limit
+	}
+
+	if !k.iterateSearchIndex(ctx, types.DtagSearchPrefixKey(params.Prefix), collect) {
+		k.iterateSearchIndex(ctx, types.MonikerSearchPrefixKey(params.Prefix), collect)
+	}
+
+	return profiles
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/desmos-labs/desmos/x/profiles/types"
+)
+
+// SaveGrant allows to save the given grant inside the current context, replacing any existing
+// grant between the same granter and grantee.
+// It assumes that the given grant has already been validated.
+func (k Keeper) SaveGrant(ctx sdk.Context, grant types.Grant) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.GrantStoreKey(grant.Granter, grant.Grantee), k.Cdc.MustMarshalBinaryBare(&grant))
+}
+
+// GetGrant returns the grant given by the granter to the grantee, if any
+func (k Keeper) GetGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant types.Grant, found bool) {
+	store := ctx.KVStore(k.StoreKey)
+	bz := store.Get(types.GrantStoreKey(granter, grantee))
+	if bz == nil {
+		return types.Grant{}, false
+	}
+
+	k.Cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// DeleteGrant deletes the grant given by the granter to the grantee
+func (k Keeper) DeleteGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Delete(types.GrantStoreKey(granter, grantee))
+}
+
+// GetGranterGrants returns all the grants given by the specified granter
+func (k Keeper) GetGranterGrants(ctx sdk.Context, granter sdk.AccAddress) types.Grants {
+	return k.getGrants(ctx, types.GranterGrantsPrefix(granter))
+}
+
+// GetGrants returns all the stored grants
+func (k Keeper) GetGrants(ctx sdk.Context) types.Grants {
+	return k.getGrants(ctx, types.GrantStorePrefix)
+}
+
+// getGrants returns all the grants stored using a key having the given prefix
+func (k Keeper) getGrants(ctx sdk.Context, prefix []byte) types.Grants {
+	grants := types.Grants{}
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var grant types.Grant
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &grant)
+		grants = append(grants, grant)
+	}
+
+	return grants
+}
+
+// GetActingAccount returns the account on behalf of which the given signer is performing a message
+// having the given type. If actingAs is empty, the signer itself is returned.
+// Otherwise, an error is returned if the owner of actingAs has not authorized the signer to perform
+// such message type, or if the authorization is expired.
+func (k Keeper) GetActingAccount(ctx sdk.Context, signer, actingAs sdk.AccAddress, msgType string) (sdk.AccAddress, error) {
+	if actingAs.Empty() {
+		return signer, nil
+	}
+
+	grant, found := k.GetGrant(ctx, actingAs, signer)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("%s has not been authorized to act on behalf of %s", signer, actingAs))
+	}
+
+	if grant.IsExpired(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("the authorization given by %s to %s is expired", actingAs, signer))
+	}
+
+	if !grant.Allows(msgType) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("%s has not been authorized to perform %s messages on behalf of %s", signer, msgType, actingAs))
+	}
+
+	return actingAs, nil
+}
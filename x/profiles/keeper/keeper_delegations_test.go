@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/desmos-labs/desmos/x/profiles/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SaveGrant() {
+	expiration := time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)
+	grant := types.NewGrant(suite.testData.user, suite.testData.otherUser, []string{"create_post"}, expiration)
+	suite.keeper.SaveGrant(suite.ctx, grant)
+
+	stored, found := suite.keeper.GetGrant(suite.ctx, suite.testData.user, suite.testData.otherUser)
+	suite.True(found)
+	suite.Equal(grant, stored)
+
+	// Saving a new grant between the same accounts replaces the existing one
+	updated := types.NewGrant(suite.testData.user, suite.testData.otherUser, []string{"edit_post"}, expiration)
+	suite.keeper.SaveGrant(suite.ctx, updated)
+	suite.Equal(types.Grants{updated}, suite.keeper.GetGrants(suite.ctx))
+
+	_, found = suite.keeper.GetGrant(suite.ctx, suite.testData.otherUser, suite.testData.user)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteGrant() {
+	expiration := time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)
+	grant := types.NewGrant(suite.testData.user, suite.testData.otherUser, []string{"create_post"}, expiration)
+	suite.keeper.SaveGrant(suite.ctx, grant)
+
+	suite.keeper.DeleteGrant(suite.ctx, suite.testData.user, suite.testData.otherUser)
+
+	_, found := suite.keeper.GetGrant(suite.ctx, suite.testData.user, suite.testData.otherUser)
+	suite.False(found)
+	suite.Empty(suite.keeper.GetGrants(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetGranterGrants() {
+	thirdUser, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	suite.Require().NoError(err)
+
+	expiration := time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)
+	grants := types.Grants{
+		types.NewGrant(suite.testData.user, suite.testData.otherUser, []string{"create_post"}, expiration),
+		types.NewGrant(suite.testData.user, thirdUser, []string{"edit_post"}, expiration),
+		types.NewGrant(suite.testData.otherUser, suite.testData.user, []string{"create_post"}, expiration),
+	}
+	for _, grant := range grants {
+		suite.keeper.SaveGrant(suite.ctx, grant)
+	}
+
+	granterGrants := suite.keeper.GetGranterGrants(suite.ctx, suite.testData.user)
+	suite.Len(granterGrants, 2)
+	suite.Contains(granterGrants, grants[0])
+	suite.Contains(granterGrants, grants[1])
+
+	suite.Equal(types.Grants{grants[2]}, suite.keeper.GetGranterGrants(suite.ctx, suite.testData.otherUser))
+	suite.Equal(types.Grants{}, suite.keeper.GetGranterGrants(suite.ctx, thirdUser))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetActingAccount() {
+	blockTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	owner := suite.testData.user
+	signer := suite.testData.otherUser
+
+	tests := []struct {
+		name        string
+		storedGrant *types.Grant
+		actingAs    sdk.AccAddress
+		msgType     string
+		expAccount  sdk.AccAddress
+		expErr      error
+	}{
+		{
+			name:       "Empty acting as returns the signer",
+			actingAs:   nil,
+			msgType:    "create_post",
+			expAccount: signer,
+		},
+		{
+			name:     "Missing grant returns error",
+			actingAs: owner,
+			msgType:  "create_post",
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns has not been authorized to act on behalf of cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+		},
+		{
+			name: "Expired grant returns error",
+			storedGrant: &types.Grant{
+				Granter: owner, Grantee: signer, MsgTypes: []string{"create_post"}, Expiration: blockTime,
+			},
+			actingAs: owner,
+			msgType:  "create_post",
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				"the authorization given by cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47 to cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns is expired"),
+		},
+		{
+			name: "Not allowed message type returns error",
+			storedGrant: &types.Grant{
+				Granter: owner, Grantee: signer, MsgTypes: []string{"create_post"}, Expiration: blockTime.Add(time.Hour),
+			},
+			actingAs: owner,
+			msgType:  "edit_post",
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns has not been authorized to perform edit_post messages on behalf of cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47"),
+		},
+		{
+			name: "Valid grant returns the acting account",
+			storedGrant: &types.Grant{
+				Granter: owner, Grantee: signer, MsgTypes: []string{"create_post"}, Expiration: blockTime.Add(time.Hour),
+			},
+			actingAs:   owner,
+			msgType:    "create_post",
+			expAccount: owner,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.ctx = suite.ctx.WithBlockTime(blockTime)
+
+			if test.storedGrant != nil {
+				suite.keeper.SaveGrant(suite.ctx, *test.storedGrant)
+			}
+
+			account, err := suite.keeper.GetActingAccount(suite.ctx, signer, test.actingAs, test.msgType)
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(account)
+			} else {
+				suite.NoError(err)
+				suite.Equal(test.expAccount, account)
+			}
+		})
+	}
+}
@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/desmos-labs/desmos/x/profiles/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
 )
 
 func (suite *KeeperTestSuite) TestKeeper_AssociateDtagWithAddress() {
@@ -102,6 +104,24 @@ func (suite *KeeperTestSuite) TestKeeper_SaveProfile() {
 			existentAccounts: types.Profiles{suite.testData.profile},
 			expError:         fmt.Errorf("a profile with dtag: dtag has already been created"),
 		},
+		{
+			name: "Existent account with different case dtag returns error",
+			account: types.Profile{
+				DTag:    "DTag",
+				Creator: diffCreator,
+			},
+			existentAccounts: types.Profiles{suite.testData.profile},
+			expError:         fmt.Errorf("a profile with dtag: DTag has already been created"),
+		},
+		{
+			name: "Existent account with confusable dtag returns error",
+			account: types.Profile{
+				DTag:    "dtаg", // Cyrillic a
+				Creator: diffCreator,
+			},
+			existentAccounts: types.Profiles{suite.testData.profile},
+			expError:         fmt.Errorf("a profile with dtag: dtаg has already been created"),
+		},
 	}
 
 	for _, test := range tests {
@@ -122,6 +142,44 @@ func (suite *KeeperTestSuite) TestKeeper_SaveProfile() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeper_SaveProfile_PreservesDtagCase() {
+	profile := suite.testData.profile
+	profile.DTag = "LeoDiCap"
+	suite.NoError(suite.keeper.SaveProfile(suite.ctx, profile))
+
+	stored, found := suite.keeper.GetProfile(suite.ctx, profile.Creator)
+	suite.True(found)
+	suite.Equal("LeoDiCap", stored.DTag)
+	suite.Equal(profile.Creator, suite.keeper.GetDtagRelatedAddress(suite.ctx, "leodicap"))
+
+	// Changing only the case of the dtag is allowed
+	profile.DTag = "leodicap"
+	suite.NoError(suite.keeper.SaveProfile(suite.ctx, profile))
+	suite.Equal(profile.Creator, suite.keeper.GetDtagRelatedAddress(suite.ctx, "LEODiCAP"))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetDtagCollisions() {
+	date := suite.testData.profile.CreationDate
+	first := types.NewProfile("Alice", suite.testData.otherUser, date)
+	second := types.NewProfile("alice", suite.testData.user, date.Add(time.Hour))
+
+	// Store the colliding profiles directly, since they cannot be saved using SaveProfile
+	store := suite.ctx.KVStore(suite.keeper.StoreKey)
+	for _, profile := range []types.Profile{second, first} {
+		profile := profile
+		store.Set(types.ProfileStoreKey(profile.Creator), suite.keeper.Cdc.MustMarshalBinaryBare(&profile))
+	}
+
+	expCollisions := []types.DtagCollision{
+		{
+			NormalizedDtag: "alice",
+			Dtags:          []string{"Alice", "alice"},
+			Creators:       []sdk.AccAddress{first.Creator, second.Creator},
+		},
+	}
+	suite.Equal(expCollisions, suite.keeper.GetDtagCollisions(suite.ctx))
+}
+
 func (suite *KeeperTestSuite) TestKeeper_DeleteProfile() {
 	err := suite.keeper.SaveProfile(suite.ctx, suite.testData.profile)
 	suite.Nil(err)
@@ -137,6 +195,133 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteProfile() {
 	suite.False(found)
 }
 
+func (suite *KeeperTestSuite) TestKeeper_DeleteProfile_DeletesGrants() {
+	err := suite.keeper.SaveProfile(suite.ctx, suite.testData.profile)
+	suite.Nil(err)
+
+	expiration := time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)
+	suite.keeper.SaveGrant(suite.ctx, types.NewGrant(suite.testData.user, suite.testData.otherUser, []string{"create_post"}, expiration))
+	otherGrant := types.NewGrant(suite.testData.otherUser, suite.testData.user, []string{"create_post"}, expiration)
+	suite.keeper.SaveGrant(suite.ctx, otherGrant)
+
+	suite.keeper.DeleteProfile(suite.ctx, suite.testData.profile.Creator, suite.testData.profile.DTag)
+
+	suite.Equal(types.Grants{otherGrant}, suite.keeper.GetGrants(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SearchProfiles() {
+	leo := types.NewProfile("LeoDiCap", suite.testData.user, suite.testData.profile.CreationDate).
+		WithMoniker(newStrPtr("Leonardo Di Caprio"))
+	kate := types.NewProfile("kate_w", suite.testData.otherUser, suite.testData.profile.CreationDate).
+		WithMoniker(newStrPtr("Kate Winslet, leading actress"))
+
+	tests := []struct {
+		name      string
+		params    types.QuerySearchParams
+		expResult types.Profiles
+	}{
+		{
+			name:      "Dtag prefix is matched regardless of its case",
+			params:    types.NewQuerySearchParams("leod", 1, 10),
+			expResult: types.Profiles{leo},
+		},
+		{
+			name:      "Moniker words prefix is matched regardless of its case",
+			params:    types.NewQuerySearchParams("WINS", 1, 10),
+			expResult: types.Profiles{kate},
+		},
+		{
+			name:      "Words in the middle of the moniker are matched",
+			params:    types.NewQuerySearchParams("lea", 1, 10),
+			expResult: types.Profiles{kate},
+		},
+		{
+			name:      "Profiles matching both dtag and moniker are returned once",
+			params:    types.NewQuerySearchParams("le", 1, 10),
+			expResult: types.Profiles{leo, kate},
+		},
+		{
+			name:      "Pagination is applied",
+			params:    types.NewQuerySearchParams("le", 2, 1),
+			expResult: types.Profiles{kate},
+		},
+		{
+			name:      "Page out of range returns empty profiles",
+			params:    types.NewQuerySearchParams("le", 3, 1),
+			expResult: types.Profiles{},
+		},
+		{
+			name:      "Non matching prefix returns empty profiles",
+			params:    types.NewQuerySearchParams("xyz", 1, 10),
+			expResult: types.Profiles{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.NoError(suite.keeper.SaveProfile(suite.ctx, leo))
+			suite.NoError(suite.keeper.SaveProfile(suite.ctx, kate))
+
+			suite.Equal(test.expResult, suite.keeper.SearchProfiles(suite.ctx, test.params))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SearchProfiles_IndexesUpdated() {
+	profile := types.NewProfile("LeoDiCap", suite.testData.user, suite.testData.profile.CreationDate).
+		WithMoniker(newStrPtr("Leonardo Di Caprio"))
+	suite.NoError(suite.keeper.SaveProfile(suite.ctx, profile))
+
+	// Edit the profile changing both the dtag and the moniker
+	edited := types.NewProfile("Titanic", suite.testData.user, suite.testData.profile.CreationDate).
+		WithMoniker(newStrPtr("Jack Dawson"))
+	suite.NoError(suite.keeper.SaveProfile(suite.ctx, edited))
+
+	suite.Equal(types.Profiles{}, suite.keeper.SearchProfiles(suite.ctx, types.NewQuerySearchParams("leo", 1, 10)))
+	suite.Equal(types.Profiles{}, suite.keeper.SearchProfiles(suite.ctx, types.NewQuerySearchParams("caprio", 1, 10)))
+	suite.Equal(types.Profiles{edited}, suite.keeper.SearchProfiles(suite.ctx, types.NewQuerySearchParams("tit", 1, 10)))
+	suite.Equal(types.Profiles{edited}, suite.keeper.SearchProfiles(suite.ctx, types.NewQuerySearchParams("daw", 1, 10)))
+
+	// Delete the profile
+	suite.keeper.DeleteProfile(suite.ctx, edited.Creator, edited.DTag)
+	suite.Equal(types.Profiles{}, suite.keeper.SearchProfiles(suite.ctx, types.NewQuerySearchParams("tit", 1, 10)))
+	suite.Equal(types.Profiles{}, suite.keeper.SearchProfiles(suite.ctx, types.NewQuerySearchParams("jack", 1, 10)))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SearchProfiles_DtagMatchesFirst() {
+	thirdUser, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	suite.Require().NoError(err)
+
+	leo := types.NewProfile("LeoDiCap", suite.testData.user, suite.testData.profile.CreationDate).
+		WithMoniker(newStrPtr("Leonardo Di Caprio"))
+	kate := types.NewProfile("kate_w", suite.testData.otherUser, suite.testData.profile.CreationDate).
+		WithMoniker(newStrPtr("Kate Winslet, leading actress"))
+	zed := types.NewProfile("zed", thirdUser, suite.testData.profile.CreationDate).
+		WithMoniker(newStrPtr("Leaf"))
+
+	suite.NoError(suite.keeper.SaveProfile(suite.ctx, zed))
+	suite.NoError(suite.keeper.SaveProfile(suite.ctx, leo))
+	suite.NoError(suite.keeper.SaveProfile(suite.ctx, kate))
+
+	suite.Equal(types.Profiles{leo, kate, zed}, suite.keeper.SearchProfiles(suite.ctx, types.NewQuerySearchParams("le", 1, 10)))
+	suite.Equal(types.Profiles{leo, kate}, suite.keeper.SearchProfiles(suite.ctx, types.NewQuerySearchParams("le", 1, 2)))
+	suite.Equal(types.Profiles{zed}, suite.keeper.SearchProfiles(suite.ctx, types.NewQuerySearchParams("le", 2, 2)))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SearchProfiles_LimitClamped() {
+	profiles := make(types.Profiles, 101)
+	for index := range profiles {
+		address := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		profiles[index] = types.NewProfile(fmt.Sprintf("user%03d", index), address, suite.testData.profile.CreationDate)
+		suite.NoError(suite.keeper.SaveProfile(suite.ctx, profiles[index]))
+	}
+
+	suite.Equal(profiles[:100], suite.keeper.SearchProfiles(suite.ctx, types.NewQuerySearchParams("user", 1, 1000)))
+	suite.Equal(profiles[100:], suite.keeper.SearchProfiles(suite.ctx, types.NewQuerySearchParams("user", 2, 1000)))
+}
+
 func (suite *KeeperTestSuite) TestKeeper_GetProfile() {
 	var testPostOwner, _ = sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
 
@@ -8,10 +8,10 @@ import (
 func (suite *KeeperTestSuite) TestKeeper_SetParams() {
 	min := sdk.NewInt(3)
 	max := sdk.NewInt(1000)
-	nsParams := types.NewMonikerParams(min, max)
+	nsParams := types.NewMonikerParams(min, max, types.DefaultMaxMonikerBytes)
 	monikerParams := types.NewDtagParams("^[A-Za-z0-9_]+$", min, max)
 
-	params := types.NewParams(nsParams, monikerParams, max)
+	params := types.NewParams(nsParams, monikerParams, max, types.DefaultMaxBioBytes, types.DefaultAllowedURISchemes, types.DefaultCustomFieldsParams(), nil)
 
 	suite.keeper.SetParams(suite.ctx, params)
 
@@ -23,9 +23,9 @@ func (suite *KeeperTestSuite) TestKeeper_SetParams() {
 func (suite *KeeperTestSuite) TestKeeper_GetParams() {
 	min := sdk.NewInt(3)
 	max := sdk.NewInt(1000)
-	nsParams := types.NewMonikerParams(min, max)
+	nsParams := types.NewMonikerParams(min, max, types.DefaultMaxMonikerBytes)
 	monikerParams := types.NewDtagParams("^[A-Za-z0-9_]+$", min, max)
-	params := types.NewParams(nsParams, monikerParams, max)
+	params := types.NewParams(nsParams, monikerParams, max, types.DefaultMaxBioBytes, types.DefaultAllowedURISchemes, types.DefaultCustomFieldsParams(), nil)
 
 	tests := []struct {
 		name      string
@@ -21,6 +21,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryProfiles(ctx, req, keeper)
 		case types.QueryParams:
 			return queryProfileParams(ctx, req, keeper)
+		case types.QuerySearch:
+			return querySearchProfiles(ctx, req, keeper)
+		case types.QueryGrants:
+			return queryGrants(ctx, path[1:], req, keeper)
 		default:
 			return nil, fmt.Errorf("unknown profiles query endpoint")
 		}
@@ -69,6 +73,44 @@ func queryProfiles(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte,
 	return bz, nil
 }
 
+// querySearchProfiles handles the request of searching the profiles by their dtag or moniker prefix
+func querySearchProfiles(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QuerySearchParams
+	if err := keeper.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if len(strings.TrimSpace(params.Prefix)) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "search prefix cannot be empty or blank")
+	}
+
+	profiles := keeper.SearchProfiles(ctx, params)
+
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &profiles)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryGrants handles the request of listing all the delegations granted by an address
+func queryGrants(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	granter, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("Invalid bech32 address: %s", path[0]))
+	}
+
+	grants := keeper.GetGranterGrants(ctx, granter)
+
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &grants)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
 // queryProfileParams handles the request of listing all the profiles params
 func queryProfileParams(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	profileParams := keeper.GetParams(ctx)
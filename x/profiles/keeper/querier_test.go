@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -52,6 +54,12 @@ func (suite *KeeperTestSuite) Test_queryProfile() {
 			storedAccount: suite.testData.profile,
 			expErr:        nil,
 		},
+		{
+			name:          "Profile returned correctly (dtag with different case given)",
+			path:          []string{types.QueryProfile, "DTAG"},
+			storedAccount: suite.testData.profile,
+			expErr:        nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -129,11 +137,104 @@ func (suite *KeeperTestSuite) Test_queryProfiles() {
 
 }
 
+func (suite *KeeperTestSuite) Test_querySearchProfiles() {
+	tests := []struct {
+		name      string
+		params    types.QuerySearchParams
+		expResult types.Profiles
+		expError  error
+	}{
+		{
+			name:     "Empty prefix returns error",
+			params:   types.NewQuerySearchParams(" ", 1, 10),
+			expError: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "search prefix cannot be empty or blank"),
+		},
+		{
+			name:      "Matching profiles returned correctly",
+			params:    types.NewQuerySearchParams("DT", 1, 10),
+			expResult: types.Profiles{suite.testData.profile},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.NoError(suite.keeper.SaveProfile(suite.ctx, suite.testData.profile))
+
+			querier := keeper.NewQuerier(suite.keeper)
+			request := abci.RequestQuery{Data: suite.keeper.Cdc.MustMarshalJSON(test.params)}
+			result, err := querier(suite.ctx, []string{types.QuerySearch}, request)
+
+			if test.expError != nil {
+				suite.Equal(test.expError.Error(), err.Error())
+				suite.Nil(result)
+			} else {
+				suite.NoError(err)
+				expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expResult)
+				suite.NoError(err)
+				suite.Equal(string(expectedIndented), string(result))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_queryGrants() {
+	expiration := time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)
+	grant := types.NewGrant(suite.testData.user, suite.testData.otherUser, []string{"create_post"}, expiration)
+
+	tests := []struct {
+		name      string
+		path      []string
+		expResult types.Grants
+		expErr    error
+	}{
+		{
+			name:   "Invalid address returns error",
+			path:   []string{types.QueryGrants, "invalid"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid bech32 address: invalid"),
+		},
+		{
+			name:      "Grants of the granter are returned correctly",
+			path:      []string{types.QueryGrants, suite.testData.user.String()},
+			expResult: types.Grants{grant},
+		},
+		{
+			name:      "Empty grants are returned correctly",
+			path:      []string{types.QueryGrants, suite.testData.otherUser.String()},
+			expResult: types.Grants{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.keeper.SaveGrant(suite.ctx, grant)
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, abci.RequestQuery{})
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
+				return
+			}
+
+			suite.NoError(err)
+			expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expResult)
+			suite.NoError(err)
+			suite.Equal(string(expectedIndented), string(result))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) Test_queryParams() {
 	validMin := sdk.NewInt(3)
 	validMax := sdk.NewInt(30)
 
-	nsParams := types.NewMonikerParams(validMin, validMax)
+	nsParams := types.NewMonikerParams(validMin, validMax, types.DefaultMaxMonikerBytes)
 	monikerParams := types.NewDtagParams("^[A-Za-z0-9_]+$", validMin, validMax)
 
 	tests := []struct {
@@ -150,7 +251,7 @@ func (suite *KeeperTestSuite) Test_queryParams() {
 			nsParamsStored:      nsParams,
 			monikerParamsStored: monikerParams,
 			bioParamStored:      validMax,
-			expResult:           types.NewParams(nsParams, monikerParams, validMax),
+			expResult:           types.NewParams(nsParams, monikerParams, validMax, types.DefaultMaxBioBytes, types.DefaultAllowedURISchemes, types.DefaultCustomFieldsParams(), nil),
 		},
 	}
 
@@ -158,7 +259,7 @@ func (suite *KeeperTestSuite) Test_queryParams() {
 		test := test
 		suite.Run(test.name, func() {
 			suite.SetupTest() // reset
-			suite.keeper.SetParams(suite.ctx, types.NewParams(test.nsParamsStored, test.monikerParamsStored, test.bioParamStored))
+			suite.keeper.SetParams(suite.ctx, types.NewParams(test.nsParamsStored, test.monikerParamsStored, test.bioParamStored, types.DefaultMaxBioBytes, types.DefaultAllowedURISchemes, types.DefaultCustomFieldsParams(), nil))
 			querier := keeper.NewQuerier(suite.keeper)
 			result, err := querier(suite.ctx, test.path, abci.RequestQuery{})
 
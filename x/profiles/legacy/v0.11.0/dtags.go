@@ -0,0 +1,93 @@
+package v0110
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v080profiles "github.com/desmos-labs/desmos/x/profiles/legacy/v0.8.0"
+	"github.com/desmos-labs/desmos/x/profiles/types/models"
+)
+
+// DtagRename contains the data of a profile whose dtag has been renamed because it collided
+// with the dtag of a profile created before it
+type DtagRename struct {
+	Creator sdk.AccAddress `json:"creator"`
+	OldDtag string         `json:"old_dtag"`
+	NewDtag string         `json:"new_dtag"`
+}
+
+// String implements fmt.Stringer
+func (rename DtagRename) String() string {
+	return fmt.Sprintf("Dtag of %s renamed from %s to %s", rename.Creator, rename.OldDtag, rename.NewDtag)
+}
+
+// ResolveDtagCollisions makes sure that no two profiles have dtags that are equal once normalized.
+// Inside each set of colliding profiles, the one that has been created first keeps its dtag,
+// while the others are renamed appending the first "_<n>" suffix that makes their dtag unique.
+// Suffixed dtags are truncated so that they are not longer than the given max length.
+// It returns the resolved profiles along with all the performed renames
+func ResolveDtagCollisions(
+	oldProfiles []v080profiles.Profile, maxDtagLen int,
+) ([]v080profiles.Profile, []DtagRename) {
+	profiles := make([]v080profiles.Profile, len(oldProfiles))
+	copy(profiles, oldProfiles)
+
+	taken := map[string]bool{}
+	groups := map[string][]int{}
+	for index, profile := range profiles {
+		normalized := models.NormalizeDtag(profile.DTag)
+		taken[normalized] = true
+		groups[normalized] = append(groups[normalized], index)
+	}
+
+	var normalizedDtags []string
+	for normalized, group := range groups {
+		if len(group) > 1 {
+			normalizedDtags = append(normalizedDtags, normalized)
+		}
+	}
+	sort.Strings(normalizedDtags)
+
+	var renames []DtagRename
+	for _, normalized := range normalizedDtags {
+		group := groups[normalized]
+		sort.SliceStable(group, func(i, j int) bool {
+			first, second := profiles[group[i]], profiles[group[j]]
+			return first.CreationDate.Before(second.CreationDate) ||
+				(first.CreationDate.Equal(second.CreationDate) && first.Creator.String() < second.Creator.String())
+		})
+
+		for _, index := range group[1:] {
+			newDtag := uniqueDtag(profiles[index].DTag, maxDtagLen, taken)
+			taken[models.NormalizeDtag(newDtag)] = true
+
+			renames = append(renames, DtagRename{
+				Creator: profiles[index].Creator,
+				OldDtag: profiles[index].DTag,
+				NewDtag: newDtag,
+			})
+			profiles[index].DTag = newDtag
+		}
+	}
+
+	return profiles, renames
+}
+
+// uniqueDtag returns the given dtag with the first "_<n>" suffix that makes it not collide with any of the taken ones
+func uniqueDtag(dtag string, maxDtagLen int, taken map[string]bool) string {
+	for n := 1; ; n++ {
+		suffix := fmt.Sprintf("_%d", n)
+
+		base := []rune(dtag)
+		if maxLen := maxDtagLen - len(suffix); maxLen > 0 && len(base) > maxLen {
+			base = base[:maxLen]
+		}
+
+		candidate := string(base) + suffix
+		if !taken[models.NormalizeDtag(candidate)] {
+			return candidate
+		}
+	}
+}
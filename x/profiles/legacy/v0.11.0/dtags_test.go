@@ -0,0 +1,109 @@
+package v0110_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v0110profiles "github.com/desmos-labs/desmos/x/profiles/legacy/v0.11.0"
+	v080profiles "github.com/desmos-labs/desmos/x/profiles/legacy/v0.8.0"
+)
+
+func TestResolveDtagCollisions(t *testing.T) {
+	first, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	second, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	require.NoError(t, err)
+
+	third, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	require.NoError(t, err)
+
+	fourth, err := sdk.AccAddressFromBech32("cosmos15lt0mflt6j9a9auj7yl3p20xec4xvljge0zhae")
+	require.NoError(t, err)
+
+	date := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+
+	tests := []struct {
+		name        string
+		profiles    []v080profiles.Profile
+		maxDtagLen  int
+		expProfiles []v080profiles.Profile
+		expRenames  []v0110profiles.DtagRename
+	}{
+		{
+			name: "Profiles without collisions are left untouched",
+			profiles: []v080profiles.Profile{
+				{DTag: "bill", Creator: first, CreationDate: date},
+				{DTag: "biil", Creator: second, CreationDate: date},
+			},
+			maxDtagLen: 30,
+			expProfiles: []v080profiles.Profile{
+				{DTag: "bill", Creator: first, CreationDate: date},
+				{DTag: "biil", Creator: second, CreationDate: date},
+			},
+			expRenames: nil,
+		},
+		{
+			name: "The oldest profile keeps the dtag and the others are renamed",
+			profiles: []v080profiles.Profile{
+				{DTag: "alice", Creator: first, CreationDate: date.Add(time.Hour)},
+				{DTag: "Alice", Creator: second, CreationDate: date},
+				{DTag: "alice_1", Creator: third, CreationDate: date},
+				{DTag: "aLice", Creator: fourth, CreationDate: date.Add(2 * time.Hour)},
+			},
+			maxDtagLen: 30,
+			expProfiles: []v080profiles.Profile{
+				{DTag: "alice_2", Creator: first, CreationDate: date.Add(time.Hour)},
+				{DTag: "Alice", Creator: second, CreationDate: date},
+				{DTag: "alice_1", Creator: third, CreationDate: date},
+				{DTag: "aLice_3", Creator: fourth, CreationDate: date.Add(2 * time.Hour)},
+			},
+			expRenames: []v0110profiles.DtagRename{
+				{Creator: first, OldDtag: "alice", NewDtag: "alice_2"},
+				{Creator: fourth, OldDtag: "aLice", NewDtag: "aLice_3"},
+			},
+		},
+		{
+			name: "Profiles created at the same time are sorted by creator",
+			profiles: []v080profiles.Profile{
+				{DTag: "Leo", Creator: second, CreationDate: date},
+				{DTag: "leo", Creator: first, CreationDate: date},
+			},
+			maxDtagLen: 30,
+			expProfiles: []v080profiles.Profile{
+				{DTag: "Leo_1", Creator: second, CreationDate: date},
+				{DTag: "leo", Creator: first, CreationDate: date},
+			},
+			expRenames: []v0110profiles.DtagRename{
+				{Creator: second, OldDtag: "Leo", NewDtag: "Leo_1"},
+			},
+		},
+		{
+			name: "Renamed dtags are truncated to the max length",
+			profiles: []v080profiles.Profile{
+				{DTag: "leonardo", Creator: first, CreationDate: date},
+				{DTag: "Leonardo", Creator: second, CreationDate: date.Add(time.Hour)},
+			},
+			maxDtagLen: 8,
+			expProfiles: []v080profiles.Profile{
+				{DTag: "leonardo", Creator: first, CreationDate: date},
+				{DTag: "Leonar_1", Creator: second, CreationDate: date.Add(time.Hour)},
+			},
+			expRenames: []v0110profiles.DtagRename{
+				{Creator: second, OldDtag: "Leonardo", NewDtag: "Leonar_1"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			profiles, renames := v0110profiles.ResolveDtagCollisions(test.profiles, test.maxDtagLen)
+			require.Equal(t, test.expProfiles, profiles)
+			require.Equal(t, test.expRenames, renames)
+		})
+	}
+}
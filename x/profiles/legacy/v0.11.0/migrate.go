@@ -0,0 +1,47 @@
+package v0110
+
+import (
+	v080profiles "github.com/desmos-labs/desmos/x/profiles/legacy/v0.8.0"
+)
+
+// Migrate accepts exported genesis state from v0.10.0 and migrates it to v0.11.0
+// genesis state. Since the v0.10.0 profiles state has the same layout of the v0.8.0 one,
+// this migration sets the default values of the params added in v0.11.0 and renames the dtags
+// that collide once normalized, so that they are unique regardless of their case and confusable characters.
+// All the performed renames are returned so that they can be reported
+func Migrate(oldGenState v080profiles.GenesisState) (GenesisState, []DtagRename) {
+	profiles, renames := ResolveDtagCollisions(
+		oldGenState.Profiles,
+		int(oldGenState.Params.DtagParams.MaxDtagLen.Int64()),
+	)
+
+	return GenesisState{
+		Profiles: profiles,
+		Params:   ConvertParams(oldGenState.Params),
+		Grants:   nil,
+	}, renames
+}
+
+// ConvertParams converts the given v0.8.0 params into v0.11.0 params,
+// setting the default values of the moniker and biography bytes limits, of the allowed URI schemes
+// and of the custom fields params
+func ConvertParams(oldParams v080profiles.Params) Params {
+	return Params{
+		MonikerParams: MonikerParams{
+			MinMonikerLen:   oldParams.MonikerParams.MinMonikerLen,
+			MaxMonikerLen:   oldParams.MonikerParams.MaxMonikerLen,
+			MaxMonikerBytes: DefaultMaxMonikerBytes,
+		},
+		DtagParams:        oldParams.DtagParams,
+		MaxBioLen:         oldParams.MaxBioLen,
+		MaxBioBytes:       DefaultMaxBioBytes,
+		AllowedURISchemes: DefaultAllowedURISchemes,
+		CustomFieldsParams: CustomFieldsParams{
+			MaxFields:      DefaultMaxCustomFields,
+			MaxKeyLength:   DefaultMaxCustomFieldKeyLen,
+			MaxValueLength: DefaultMaxCustomFieldValueLen,
+			KeyRegEx:       DefaultCustomFieldKeyRegEx,
+		},
+		CustomFieldsSchemas: nil,
+	}
+}
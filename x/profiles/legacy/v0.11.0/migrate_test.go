@@ -0,0 +1,54 @@
+package v0110_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v0110profiles "github.com/desmos-labs/desmos/x/profiles/legacy/v0.11.0"
+	v080profiles "github.com/desmos-labs/desmos/x/profiles/legacy/v0.8.0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	creator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	profiles := []v080profiles.Profile{
+		{DTag: "dtag", Creator: creator, CreationDate: time.Date(2020, 1, 1, 15, 0, 0, 0, time.UTC)},
+	}
+
+	v080GenState := v080profiles.GenesisState{
+		Profiles: profiles,
+		Params: v080profiles.Params{
+			MonikerParams: v080profiles.MonikerParams{MinMonikerLen: sdk.NewInt(3), MaxMonikerLen: sdk.NewInt(100)},
+			DtagParams:    v080profiles.DtagParams{RegEx: `^[A-Za-z0-9_]+$`, MinDtagLen: sdk.NewInt(3), MaxDtagLen: sdk.NewInt(30)},
+			MaxBioLen:     sdk.NewInt(500),
+		},
+	}
+
+	expected := v0110profiles.GenesisState{
+		Profiles: profiles,
+		Params: v0110profiles.Params{
+			MonikerParams: v0110profiles.MonikerParams{
+				MinMonikerLen:   sdk.NewInt(3),
+				MaxMonikerLen:   sdk.NewInt(100),
+				MaxMonikerBytes: v0110profiles.DefaultMaxMonikerBytes,
+			},
+			DtagParams:        v080profiles.DtagParams{RegEx: `^[A-Za-z0-9_]+$`, MinDtagLen: sdk.NewInt(3), MaxDtagLen: sdk.NewInt(30)},
+			MaxBioLen:         sdk.NewInt(500),
+			MaxBioBytes:       v0110profiles.DefaultMaxBioBytes,
+			AllowedURISchemes: v0110profiles.DefaultAllowedURISchemes,
+			CustomFieldsParams: v0110profiles.CustomFieldsParams{
+				MaxFields:      v0110profiles.DefaultMaxCustomFields,
+				MaxKeyLength:   v0110profiles.DefaultMaxCustomFieldKeyLen,
+				MaxValueLength: v0110profiles.DefaultMaxCustomFieldValueLen,
+				KeyRegEx:       v0110profiles.DefaultCustomFieldKeyRegEx,
+			},
+		},
+	}
+
+	migrated, renames := v0110profiles.Migrate(v080GenState)
+	require.Equal(t, expected, migrated)
+	require.Empty(t, renames)
+}
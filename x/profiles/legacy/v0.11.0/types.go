@@ -0,0 +1,74 @@
+package v0110
+
+// DONTCOVER
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v080profiles "github.com/desmos-labs/desmos/x/profiles/legacy/v0.8.0"
+)
+
+const (
+	ModuleName = "profiles"
+)
+
+var (
+	DefaultMaxMonikerBytes   = sdk.NewInt(4000)
+	DefaultMaxBioBytes       = sdk.NewInt(4000)
+	DefaultAllowedURISchemes = []string{"http", "https", "ipfs"}
+
+	DefaultMaxCustomFields        = sdk.NewInt(10)
+	DefaultMaxCustomFieldKeyLen   = sdk.NewInt(30)
+	DefaultMaxCustomFieldValueLen = sdk.NewInt(500)
+	DefaultCustomFieldKeyRegEx    = `^[a-z0-9_]+$`
+)
+
+// GenesisState contains the data of a v0.11.0 genesis state for the profiles module
+type GenesisState struct {
+	Profiles []v080profiles.Profile `json:"profiles" yaml:"profiles"`
+	Params   Params                 `json:"params" yaml:"params"`
+	Grants   []Grant                `json:"grants" yaml:"grants"`
+}
+
+// Params contains the parameters of the profiles module
+type Params struct {
+	MonikerParams     MonikerParams           `json:"moniker_params" yaml:"moniker_params"`
+	DtagParams        v080profiles.DtagParams `json:"dtag_params" yaml:"dtag_params"`
+	MaxBioLen         sdk.Int                 `json:"max_bio_length" yaml:"max_bio_length"`
+	MaxBioBytes       sdk.Int                 `json:"max_bio_bytes" yaml:"max_bio_bytes"`
+	AllowedURISchemes []string                `json:"allowed_uri_schemes" yaml:"allowed_uri_schemes"`
+
+	CustomFieldsParams  CustomFieldsParams  `json:"custom_fields_params" yaml:"custom_fields_params"`
+	CustomFieldsSchemas []CustomFieldSchema `json:"custom_fields_schemas" yaml:"custom_fields_schemas"`
+}
+
+// MonikerParams defines the params around profiles' monikers
+type MonikerParams struct {
+	MinMonikerLen   sdk.Int `json:"min_length" yaml:"min_length"`
+	MaxMonikerLen   sdk.Int `json:"max_length" yaml:"max_length"`
+	MaxMonikerBytes sdk.Int `json:"max_bytes" yaml:"max_bytes"`
+}
+
+// CustomFieldsParams defines the params around profiles' custom fields
+type CustomFieldsParams struct {
+	MaxFields      sdk.Int `json:"max_fields" yaml:"max_fields"`
+	MaxKeyLength   sdk.Int `json:"max_key_length" yaml:"max_key_length"`
+	MaxValueLength sdk.Int `json:"max_value_length" yaml:"max_value_length"`
+	KeyRegEx       string  `json:"key_reg_ex" yaml:"key_reg_ex"`
+}
+
+// CustomFieldSchema associates a JSON schema to the custom field having the given key
+type CustomFieldSchema struct {
+	Key    string `json:"key" yaml:"key"`
+	Schema string `json:"schema" yaml:"schema"`
+}
+
+// Grant allows a grantee to perform the given messages types on behalf of a granter
+type Grant struct {
+	Granter    sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgTypes   []string       `json:"msg_types" yaml:"msg_types"`
+	Expiration time.Time      `json:"expiration" yaml:"expiration"`
+}
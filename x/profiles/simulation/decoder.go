@@ -18,11 +18,18 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		cdc.MustUnmarshalBinaryBare(kvA.Value, &profileA)
 		cdc.MustUnmarshalBinaryBare(kvB.Value, &profileB)
 		return fmt.Sprintf("ProfileA: %s\nProfileB: %s\n", profileA, profileB)
-	case bytes.HasPrefix(kvA.Key, types.DtagStorePrefix):
+	case bytes.HasPrefix(kvA.Key, types.DtagStorePrefix),
+		bytes.HasPrefix(kvA.Key, types.DtagSearchStorePrefix),
+		bytes.HasPrefix(kvA.Key, types.MonikerSearchStorePrefix):
 		var addressA, addressB sdk.AccAddress
 		cdc.MustUnmarshalBinaryBare(kvA.Value, &addressA)
 		cdc.MustUnmarshalBinaryBare(kvB.Value, &addressB)
 		return fmt.Sprintf("AddressA: %s\nAddressB: %s\n", addressA, addressB)
+	case bytes.HasPrefix(kvA.Key, types.GrantStorePrefix):
+		var grantA, grantB types.Grant
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &grantA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &grantB)
+		return fmt.Sprintf("GrantA: %s\nGrantB: %s\n", grantA, grantB)
 	default:
 		panic(fmt.Sprintf("invalid profiles key %X", kvA.Key))
 	}
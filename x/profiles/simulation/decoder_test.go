@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -24,6 +25,13 @@ var (
 		Bio:     &bio,
 		Creator: accountCreatorAddr,
 	}
+
+	grant = types.NewGrant(
+		accountCreatorAddr,
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		[]string{"create_post"},
+		time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC),
+	)
 )
 
 func makeTestCodec() (cdc *codec.Codec) {
@@ -40,6 +48,8 @@ func TestDecodeStore(t *testing.T) {
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: types.ProfileStoreKey(profile.Creator), Value: cdc.MustMarshalBinaryBare(&profile)},
 		kv.Pair{Key: types.DtagStoreKey(profile.DTag), Value: cdc.MustMarshalBinaryBare(&profile.Creator)},
+		kv.Pair{Key: types.DtagSearchStoreKey(profile.DTag, profile.Creator), Value: cdc.MustMarshalBinaryBare(&profile.Creator)},
+		kv.Pair{Key: types.GrantStoreKey(grant.Granter, grant.Grantee), Value: cdc.MustMarshalBinaryBare(&grant)},
 	}
 
 	tests := []struct {
@@ -48,6 +58,8 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Profile", fmt.Sprintf("ProfileA: %s\nProfileB: %s\n", profile, profile)},
 		{"Address", fmt.Sprintf("AddressA: %s\nAddressB: %s\n", profile.Creator, profile.Creator)},
+		{"Search index", fmt.Sprintf("AddressA: %s\nAddressB: %s\n", profile.Creator, profile.Creator)},
+		{"Grant", fmt.Sprintf("GrantA: %s\nGrantB: %s\n", grant, grant)},
 		{"other", ""},
 	}
 
@@ -18,14 +18,25 @@ func RandomizedGenState(simsState *module.SimulationState) {
 
 	profileGenesis := types.NewGenesisState(
 		randomProfiles(simsState),
-		types.NewParams(RandomMonikerParams(simsState.Rand), RandomDTagParams(simsState.Rand), RandomBioParams(simsState.Rand)),
+		types.NewParams(
+			RandomMonikerParams(simsState.Rand),
+			RandomDTagParams(simsState.Rand),
+			RandomBioParams(simsState.Rand),
+			RandomBioBytesParams(simsState.Rand),
+			types.DefaultAllowedURISchemes,
+			RandomCustomFieldsParams(simsState.Rand),
+			nil,
+		),
 		userRelationshipsMap,
+		nil,
 	)
 
-	fmt.Printf("Selected randomly generated profile parameters:\n%s\n%s\n%s\n",
+	fmt.Printf("Selected randomly generated profile parameters:\n%s\n%s\n%s\n%s\n%s\n",
 		codec.MustMarshalJSONIndent(simsState.Cdc, profileGenesis.Params.MonikerParams),
 		codec.MustMarshalJSONIndent(simsState.Cdc, profileGenesis.Params.DtagParams),
 		codec.MustMarshalJSONIndent(simsState.Cdc, profileGenesis.Params.MaxBioLen),
+		codec.MustMarshalJSONIndent(simsState.Cdc, profileGenesis.Params.MaxBioBytes),
+		codec.MustMarshalJSONIndent(simsState.Cdc, profileGenesis.Params.CustomFieldsParams),
 	)
 
 	simsState.GenState[types.ModuleName] = simsState.Cdc.MustMarshalJSON(profileGenesis)
@@ -35,7 +35,8 @@ func SimulateMsgSaveProfile(k keeper.Keeper, ak auth.AccountKeeper) sim.Operatio
 			coverPic = *data.Pictures.Cover
 		}
 
-		msg := types.NewMsgSaveProfile(data.DTag, data.Moniker, data.Bio, &profilePic, &coverPic, acc.Address)
+		msg := types.NewMsgSaveProfile(data.DTag, data.Moniker, data.Bio, &profilePic, &coverPic, acc.Address).
+			WithCustomFields(data.CustomFields)
 		if err := sendMsgSaveProfile(r, app, ak, msg, ctx, chainID, []crypto.PrivKey{acc.PrivKey}); err != nil {
 			return sim.NoOpMsg(types.ModuleName), nil, err
 		}
@@ -101,7 +102,13 @@ func randomProfileSaveFields(
 		profile = profile.
 			WithMoniker(RandomMoniker(r)).
 			WithBio(RandomBio(r)).
-			WithPictures(RandomProfilePic(r), RandomProfileCover(r))
+			WithPictures(RandomProfilePic(r), RandomProfileCover(r)).
+			WithCustomFields(RandomCustomFields(r))
+	}
+
+	// Skip if the dtag is already taken by someone else, even with a different case or confusable characters
+	if addr := k.GetDtagRelatedAddress(ctx, profile.DTag); addr != nil && !addr.Equals(account.Address) {
+		return sim.Account{}, types.Profile{}, true
 	}
 
 	return account, profile, false
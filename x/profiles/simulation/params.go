@@ -33,5 +33,18 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf(`{"max_bio_len":"%s"}`, params)
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.MaxBioBytesParamsKey),
+			func(r *rand.Rand) string {
+				params := RandomBioBytesParams(r)
+				return fmt.Sprintf(`"%s"`, params)
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.CustomFieldsParamsKey),
+			func(r *rand.Rand) string {
+				params := RandomCustomFieldsParams(r)
+				return fmt.Sprintf(`{"max_fields":"%s","max_key_length":"%s","max_value_length":"%s","key_reg_ex":"%s"}`,
+					params.MaxFields, params.MaxKeyLength, params.MaxValueLength, params.KeyRegEx)
+			},
+		),
 	}
 }
@@ -41,6 +41,13 @@ var (
 		"https://shorturl.at/cgpyF",
 		"https://shorturl.at/cgpyG",
 	}
+
+	randomCustomFields = []types.CustomField{
+		types.NewCustomField("location", "Lugano, Switzerland"),
+		types.NewCustomField("website", "https://desmos.network"),
+		types.NewCustomField("pronouns", "they/them"),
+		types.NewCustomField("pgp_fingerprint", "0d69e11f12bddb0f87c3e4b2e8d3c59bcd4ec4fa"),
+	}
 )
 
 // NewRandomProfile return a random ProfileData from random data and the given account
@@ -50,7 +57,8 @@ func NewRandomProfile(r *rand.Rand, account sdk.AccAddress) types.Profile {
 		WithMoniker(RandomMoniker(r)).
 		WithPictures(
 			RandomProfilePic(r),
-			RandomProfileCover(r))
+			RandomProfileCover(r)).
+		WithCustomFields(RandomCustomFields(r))
 }
 
 // RandomProfile picks and returns a random profile from an array
@@ -89,6 +97,17 @@ func RandomProfileCover(r *rand.Rand) *string {
 	return &randomProfileCovers[idx]
 }
 
+// RandomCustomFields return a random subset of the randomCustomFields given
+func RandomCustomFields(r *rand.Rand) types.CustomFields {
+	var fields types.CustomFields
+	for _, field := range randomCustomFields {
+		if r.Intn(2) == 0 {
+			fields = append(fields, field)
+		}
+	}
+	return types.NewCustomFields(fields...)
+}
+
 // GetProfile gets the profile having the given address from the accs list
 func GetSimAccount(address sdk.Address, accs []sim.Account) *sim.Account {
 	for _, acc := range accs {
@@ -103,7 +122,8 @@ func GetSimAccount(address sdk.Address, accs []sim.Account) *sim.Account {
 func RandomMonikerParams(r *rand.Rand) types.MonikerParams {
 	randomMin := sdk.NewInt(int64(sim.RandIntBetween(r, 2, 3)))
 	randomMax := sdk.NewInt(int64(sim.RandIntBetween(r, 30, 1000)))
-	return types.NewMonikerParams(randomMin, randomMax)
+	randomMaxBytes := randomMax.MulRaw(int64(sim.RandIntBetween(r, 1, 4)))
+	return types.NewMonikerParams(randomMin, randomMax, randomMaxBytes)
 }
 
 // RandomDTagParams return a random set of moniker params
@@ -117,3 +137,16 @@ func RandomDTagParams(r *rand.Rand) types.DtagParams {
 func RandomBioParams(r *rand.Rand) sdk.Int {
 	return sdk.NewInt(int64(sim.RandIntBetween(r, 500, 1000)))
 }
+
+// RandomBioBytesParams return a random biography bytes param
+func RandomBioBytesParams(r *rand.Rand) sdk.Int {
+	return sdk.NewInt(int64(sim.RandIntBetween(r, 1000, 4000)))
+}
+
+// RandomCustomFieldsParams return a random set of custom fields params
+func RandomCustomFieldsParams(r *rand.Rand) types.CustomFieldsParams {
+	randomMaxFields := sdk.NewInt(int64(sim.RandIntBetween(r, 4, 20)))
+	randomMaxKeyLen := sdk.NewInt(int64(sim.RandIntBetween(r, 15, 50)))
+	randomMaxValueLen := sdk.NewInt(int64(sim.RandIntBetween(r, 50, 500)))
+	return types.NewCustomFieldsParams(randomMaxFields, randomMaxKeyLen, randomMaxValueLen, types.DefaultCustomFieldKeyRegEx)
+}
@@ -9,40 +9,71 @@ import (
 )
 
 const (
-	ModuleName          = models.ModuleName
-	RouterKey           = models.RouterKey
-	StoreKey            = models.StoreKey
-	ActionSaveProfile   = models.ActionSaveProfile
-	ActionDeleteProfile = models.ActionDeleteProfile
-	QuerierRoute        = models.QuerierRoute
-	QueryProfile        = models.QueryProfile
-	QueryProfiles       = models.QueryProfiles
-	QueryParams         = models.QueryParams
+	ModuleName             = models.ModuleName
+	RouterKey              = models.RouterKey
+	StoreKey               = models.StoreKey
+	ActionSaveProfile      = models.ActionSaveProfile
+	ActionDeleteProfile    = models.ActionDeleteProfile
+	QuerierRoute           = models.QuerierRoute
+	QueryProfile           = models.QueryProfile
+	QueryProfiles          = models.QueryProfiles
+	QueryParams            = models.QueryParams
+	QuerySearch            = models.QuerySearch
+	QueryGrants            = models.QueryGrants
+	ActionGrantDelegation  = models.ActionGrantDelegation
+	ActionRevokeDelegation = models.ActionRevokeDelegation
 )
 
 var (
 	// functions aliases
-	ProfileStoreKey       = models.ProfileStoreKey
-	DtagStoreKey          = models.DtagStoreKey
-	NewProfile            = models.NewProfile
-	NewProfiles           = models.NewProfiles
-	NewPictures           = models.NewPictures
-	RegisterModelsCodec   = models.RegisterModelsCodec
-	NewMsgSaveProfile     = msgs.NewMsgSaveProfile
-	NewMsgDeleteProfile   = msgs.NewMsgDeleteProfile
-	RegisterMessagesCodec = msgs.RegisterMessagesCodec
+	ProfileStoreKey        = models.ProfileStoreKey
+	DtagStoreKey           = models.DtagStoreKey
+	DtagSearchPrefixKey    = models.DtagSearchPrefixKey
+	DtagSearchStoreKey     = models.DtagSearchStoreKey
+	MonikerSearchPrefixKey = models.MonikerSearchPrefixKey
+	MonikerSearchStoreKey  = models.MonikerSearchStoreKey
+	MonikerTokens          = models.MonikerTokens
+	NormalizeDtag          = models.NormalizeDtag
+	GranterGrantsPrefix    = models.GranterGrantsPrefix
+	GrantStoreKey          = models.GrantStoreKey
+	NewGrant               = models.NewGrant
+	NewMsgGrantDelegation  = msgs.NewMsgGrantDelegation
+	NewMsgRevokeDelegation = msgs.NewMsgRevokeDelegation
+	FindDtagCollisions     = models.FindDtagCollisions
+	NewQuerySearchParams   = models.NewQuerySearchParams
+	NewProfile             = models.NewProfile
+	NewProfiles            = models.NewProfiles
+	NewPictures            = models.NewPictures
+	NewCustomField         = models.NewCustomField
+	NewCustomFields        = models.NewCustomFields
+	RegisterModelsCodec    = models.RegisterModelsCodec
+	NewMsgSaveProfile      = msgs.NewMsgSaveProfile
+	NewMsgDeleteProfile    = msgs.NewMsgDeleteProfile
+	RegisterMessagesCodec  = msgs.RegisterMessagesCodec
 
 	// variable aliases
-	ProfileStorePrefix = models.ProfileStorePrefix
-	DtagStorePrefix    = models.DtagStorePrefix
-	ModelsCdc          = models.ModelsCdc
-	MsgsCodec          = msgs.MsgsCodec
+	ProfileStorePrefix       = models.ProfileStorePrefix
+	DtagStorePrefix          = models.DtagStorePrefix
+	DtagSearchStorePrefix    = models.DtagSearchStorePrefix
+	MonikerSearchStorePrefix = models.MonikerSearchStorePrefix
+	GrantStorePrefix         = models.GrantStorePrefix
+	NonDelegableMsgTypes     = models.NonDelegableMsgTypes
+	ModelsCdc                = models.ModelsCdc
+	MsgsCodec                = msgs.MsgsCodec
 )
 
 type (
-	Profile          = models.Profile
-	Profiles         = models.Profiles
-	Pictures         = models.Pictures
-	MsgSaveProfile   = msgs.MsgSaveProfile
-	MsgDeleteProfile = msgs.MsgDeleteProfile
+	Profile             = models.Profile
+	Profiles            = models.Profiles
+	Pictures            = models.Pictures
+	CustomField         = models.CustomField
+	CustomFields        = models.CustomFields
+	QuerySearchParams   = models.QuerySearchParams
+	DtagCollision       = models.DtagCollision
+	Grant               = models.Grant
+	Grants              = models.Grants
+	MsgGrantDelegation  = msgs.MsgGrantDelegation
+	MsgRevokeDelegation = msgs.MsgRevokeDelegation
+	MsgSaveProfile      = msgs.MsgSaveProfile
+	MsgDeleteProfile    = msgs.MsgDeleteProfile
 )
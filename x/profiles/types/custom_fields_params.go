@@ -0,0 +1,239 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Default custom fields params
+var (
+	DefaultMaxCustomFields        = sdk.NewInt(10)
+	DefaultMaxCustomFieldKeyLen   = sdk.NewInt(30)
+	DefaultMaxCustomFieldValueLen = sdk.NewInt(500)
+	DefaultCustomFieldKeyRegEx    = `^[a-z0-9_]+$`
+)
+
+// CustomFieldsParams defines the params around profiles' custom fields
+type CustomFieldsParams struct {
+	MaxFields      sdk.Int `json:"max_fields" yaml:"max_fields"`
+	MaxKeyLength   sdk.Int `json:"max_key_length" yaml:"max_key_length"`
+	MaxValueLength sdk.Int `json:"max_value_length" yaml:"max_value_length"`
+	KeyRegEx       string  `json:"key_reg_ex" yaml:"key_reg_ex"`
+}
+
+// NewCustomFieldsParams creates a new CustomFieldsParams obj
+func NewCustomFieldsParams(maxFields, maxKeyLen, maxValueLen sdk.Int, keyRegEx string) CustomFieldsParams {
+	return CustomFieldsParams{
+		MaxFields:      maxFields,
+		MaxKeyLength:   maxKeyLen,
+		MaxValueLength: maxValueLen,
+		KeyRegEx:       keyRegEx,
+	}
+}
+
+// DefaultCustomFieldsParams return default custom fields params
+func DefaultCustomFieldsParams() CustomFieldsParams {
+	return NewCustomFieldsParams(
+		DefaultMaxCustomFields,
+		DefaultMaxCustomFieldKeyLen,
+		DefaultMaxCustomFieldValueLen,
+		DefaultCustomFieldKeyRegEx,
+	)
+}
+
+// String implements stringer interface
+func (params CustomFieldsParams) String() string {
+	out := "Custom fields params:\n"
+	out += fmt.Sprintf("Max fields: %s\nMax key length: %s\nMax value length: %s\nKey RegEx: %s",
+		params.MaxFields,
+		params.MaxKeyLength,
+		params.MaxValueLength,
+		params.KeyRegEx,
+	)
+
+	return strings.TrimSpace(out)
+}
+
+func ValidateCustomFieldsParams(i interface{}) error {
+	params, isCustomFieldsParams := i.(CustomFieldsParams)
+	if !isCustomFieldsParams {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	if params.MaxFields.IsNegative() {
+		return fmt.Errorf("invalid max custom fields param: %s", params.MaxFields)
+	}
+
+	if !params.MaxKeyLength.IsPositive() {
+		return fmt.Errorf("invalid max custom field key length param: %s", params.MaxKeyLength)
+	}
+
+	if !params.MaxValueLength.IsPositive() {
+		return fmt.Errorf("invalid max custom field value length param: %s", params.MaxValueLength)
+	}
+
+	if len(strings.TrimSpace(params.KeyRegEx)) == 0 {
+		return fmt.Errorf("empty custom field key regEx param")
+	}
+
+	if _, err := regexp.Compile(params.KeyRegEx); err != nil {
+		return fmt.Errorf("invalid custom field key regEx param: %s", params.KeyRegEx)
+	}
+
+	return nil
+}
+
+// ___________________________________________________________________________________________________________________
+
+// CustomFieldSchema associates a JSON schema to the custom field having the given key.
+// When saving a profile, the value of such custom field must satisfy the schema.
+// Only a subset of the JSON schema specification that is meaningful for string values is supported:
+// "type" (that must be "string"), "pattern", "minLength", "maxLength" and "enum".
+// The "$schema", "$id", "title" and "description" annotations are accepted and ignored.
+type CustomFieldSchema struct {
+	Key    string `json:"key" yaml:"key"`
+	Schema string `json:"schema" yaml:"schema"`
+}
+
+// NewCustomFieldSchema is a constructor function for CustomFieldSchema
+func NewCustomFieldSchema(key, schema string) CustomFieldSchema {
+	return CustomFieldSchema{
+		Key:    key,
+		Schema: schema,
+	}
+}
+
+// customFieldJSONSchema contains the supported keywords of a custom field JSON schema
+type customFieldJSONSchema struct {
+	Schema      string   `json:"$schema,omitempty"`
+	ID          string   `json:"$id,omitempty"`
+	Title       string   `json:"title,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Type        *string  `json:"type,omitempty"`
+	Pattern     *string  `json:"pattern,omitempty"`
+	MinLength   *int64   `json:"minLength,omitempty"`
+	MaxLength   *int64   `json:"maxLength,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+}
+
+// parse parses the schema returning an error if it is not valid or uses unsupported keywords
+func (schema CustomFieldSchema) parse() (customFieldJSONSchema, error) {
+	var parsed customFieldJSONSchema
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(schema.Schema)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&parsed); err != nil {
+		return parsed, fmt.Errorf("invalid custom field %s schema: %s", schema.Key, err)
+	}
+
+	if parsed.Type != nil && *parsed.Type != "string" {
+		return parsed, fmt.Errorf("invalid custom field %s schema: only the string type is supported", schema.Key)
+	}
+
+	if parsed.Pattern != nil {
+		if _, err := regexp.Compile(*parsed.Pattern); err != nil {
+			return parsed, fmt.Errorf("invalid custom field %s schema pattern: %s", schema.Key, *parsed.Pattern)
+		}
+	}
+
+	if parsed.MinLength != nil && *parsed.MinLength < 0 {
+		return parsed, fmt.Errorf("invalid custom field %s schema: minLength cannot be negative", schema.Key)
+	}
+
+	if parsed.MaxLength != nil && *parsed.MaxLength < 0 {
+		return parsed, fmt.Errorf("invalid custom field %s schema: maxLength cannot be negative", schema.Key)
+	}
+
+	return parsed, nil
+}
+
+// Validate check the validity of the CustomFieldSchema
+func (schema CustomFieldSchema) Validate() error {
+	if len(strings.TrimSpace(schema.Key)) == 0 {
+		return fmt.Errorf("custom field schema key cannot be empty or blank")
+	}
+
+	_, err := schema.parse()
+	return err
+}
+
+// ValidateValue checks whether the given value satisfies the schema
+func (schema CustomFieldSchema) ValidateValue(value string) error {
+	parsed, err := schema.parse()
+	if err != nil {
+		return err
+	}
+
+	length := int64(utf8.RuneCountInString(value))
+	if parsed.MinLength != nil && length < *parsed.MinLength {
+		return fmt.Errorf("custom field %s value cannot be less than %d characters", schema.Key, *parsed.MinLength)
+	}
+
+	if parsed.MaxLength != nil && length > *parsed.MaxLength {
+		return fmt.Errorf("custom field %s value cannot exceed %d characters", schema.Key, *parsed.MaxLength)
+	}
+
+	if parsed.Pattern != nil && !regexp.MustCompile(*parsed.Pattern).MatchString(value) {
+		return fmt.Errorf("custom field %s value should match the following regEx %s", schema.Key, *parsed.Pattern)
+	}
+
+	if len(parsed.Enum) > 0 {
+		for _, allowed := range parsed.Enum {
+			if value == allowed {
+				return nil
+			}
+		}
+		return fmt.Errorf("custom field %s value should be one of: %s", schema.Key, strings.Join(parsed.Enum, ", "))
+	}
+
+	return nil
+}
+
+// CustomFieldsSchemas represents a slice of CustomFieldSchema objects
+type CustomFieldsSchemas []CustomFieldSchema
+
+// GetSchema returns the schema associated with the custom field having the given key, if any
+func (schemas CustomFieldsSchemas) GetSchema(key string) (CustomFieldSchema, bool) {
+	for _, schema := range schemas {
+		if schema.Key == key {
+			return schema, true
+		}
+	}
+	return CustomFieldSchema{}, false
+}
+
+// String implements stringer interface
+func (schemas CustomFieldsSchemas) String() string {
+	out := "Custom fields schemas:"
+	for _, schema := range schemas {
+		out += fmt.Sprintf("\n%s: %s", schema.Key, schema.Schema)
+	}
+	return strings.TrimSpace(out)
+}
+
+func ValidateCustomFieldsSchemasParams(i interface{}) error {
+	schemas, isSchemasParams := i.(CustomFieldsSchemas)
+	if !isSchemasParams {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	keys := map[string]bool{}
+	for _, schema := range schemas {
+		if err := schema.Validate(); err != nil {
+			return err
+		}
+
+		if keys[schema.Key] {
+			return fmt.Errorf("duplicated custom field schema for key: %s", schema.Key)
+		}
+		keys[schema.Key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/profiles/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCustomFieldsParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params interface{}
+		expErr error
+	}{
+		{
+			name:   "Invalid max fields param returns error",
+			params: types.NewCustomFieldsParams(sdk.NewInt(-1), sdk.NewInt(30), sdk.NewInt(500), "^[a-z]+$"),
+			expErr: fmt.Errorf("invalid max custom fields param: -1"),
+		},
+		{
+			name:   "Invalid max key length param returns error",
+			params: types.NewCustomFieldsParams(sdk.NewInt(10), sdk.NewInt(0), sdk.NewInt(500), "^[a-z]+$"),
+			expErr: fmt.Errorf("invalid max custom field key length param: 0"),
+		},
+		{
+			name:   "Invalid max value length param returns error",
+			params: types.NewCustomFieldsParams(sdk.NewInt(10), sdk.NewInt(30), sdk.NewInt(0), "^[a-z]+$"),
+			expErr: fmt.Errorf("invalid max custom field value length param: 0"),
+		},
+		{
+			name:   "Empty key regEx returns error",
+			params: types.NewCustomFieldsParams(sdk.NewInt(10), sdk.NewInt(30), sdk.NewInt(500), " "),
+			expErr: fmt.Errorf("empty custom field key regEx param"),
+		},
+		{
+			name:   "Invalid key regEx returns error",
+			params: types.NewCustomFieldsParams(sdk.NewInt(10), sdk.NewInt(30), sdk.NewInt(500), "^[a-z+$"),
+			expErr: fmt.Errorf("invalid custom field key regEx param: ^[a-z+$"),
+		},
+		{
+			name:   "Valid params returns no error",
+			params: types.DefaultCustomFieldsParams(),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, types.ValidateCustomFieldsParams(test.params))
+		})
+	}
+}
+
+func TestValidateCustomFieldsSchemasParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params interface{}
+		expErr error
+	}{
+		{
+			name:   "Empty key returns error",
+			params: types.CustomFieldsSchemas{types.NewCustomFieldSchema("", `{"type":"string"}`)},
+			expErr: fmt.Errorf("custom field schema key cannot be empty or blank"),
+		},
+		{
+			name:   "Non string type returns error",
+			params: types.CustomFieldsSchemas{types.NewCustomFieldSchema("age", `{"type":"integer"}`)},
+			expErr: fmt.Errorf("invalid custom field age schema: only the string type is supported"),
+		},
+		{
+			name:   "Unsupported keyword returns error",
+			params: types.CustomFieldsSchemas{types.NewCustomFieldSchema("website", `{"format":"uri"}`)},
+			expErr: fmt.Errorf("invalid custom field website schema: json: unknown field \"format\""),
+		},
+		{
+			name:   "Invalid pattern returns error",
+			params: types.CustomFieldsSchemas{types.NewCustomFieldSchema("website", `{"pattern":"^(http"}`)},
+			expErr: fmt.Errorf("invalid custom field website schema pattern: ^(http"),
+		},
+		{
+			name: "Duplicated keys return error",
+			params: types.CustomFieldsSchemas{
+				types.NewCustomFieldSchema("website", `{"type":"string"}`),
+				types.NewCustomFieldSchema("website", `{"maxLength":100}`),
+			},
+			expErr: fmt.Errorf("duplicated custom field schema for key: website"),
+		},
+		{
+			name: "Valid params returns no error",
+			params: types.CustomFieldsSchemas{
+				types.NewCustomFieldSchema("pgp_fingerprint", `{"title":"PGP key fingerprint","type":"string","pattern":"^[0-9a-f]{40}$"}`),
+				types.NewCustomFieldSchema("pronouns", `{"enum":["he/him","she/her","they/them"]}`),
+			},
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, types.ValidateCustomFieldsSchemasParams(test.params))
+		})
+	}
+}
+
+func TestCustomFieldSchema_ValidateValue(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema types.CustomFieldSchema
+		value  string
+		expErr error
+	}{
+		{
+			name:   "Value shorter than min length returns error",
+			schema: types.NewCustomFieldSchema("location", `{"minLength":3}`),
+			value:  "ab",
+			expErr: fmt.Errorf("custom field location value cannot be less than 3 characters"),
+		},
+		{
+			name:   "Value longer than max length returns error",
+			schema: types.NewCustomFieldSchema("location", `{"maxLength":3}`),
+			value:  "abcd",
+			expErr: fmt.Errorf("custom field location value cannot exceed 3 characters"),
+		},
+		{
+			name:   "Value not matching pattern returns error",
+			schema: types.NewCustomFieldSchema("pgp_fingerprint", `{"pattern":"^[0-9a-f]{40}$"}`),
+			value:  "not-a-fingerprint",
+			expErr: fmt.Errorf("custom field pgp_fingerprint value should match the following regEx ^[0-9a-f]{40}$"),
+		},
+		{
+			name:   "Value not inside enum returns error",
+			schema: types.NewCustomFieldSchema("pronouns", `{"enum":["he/him","she/her"]}`),
+			value:  "they/them",
+			expErr: fmt.Errorf("custom field pronouns value should be one of: he/him, she/her"),
+		},
+		{
+			name:   "Valid value returns no error",
+			schema: types.NewCustomFieldSchema("pgp_fingerprint", `{"type":"string","pattern":"^[0-9a-f]{40}$"}`),
+			value:  "0d69e11f12bddb0f87c3e4b2e8d3c59bcd4ec4fa",
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.schema.ValidateValue(test.value))
+		})
+	}
+}
@@ -4,8 +4,17 @@ const (
 	EventTypeProfileSaved   = "profile_saved"
 	EventTypeProfileDeleted = "profile_deleted"
 
+	EventTypeDelegationGranted = "delegation_granted"
+	EventTypeDelegationRevoked = "delegation_revoked"
+
 	// Profile attributes
 	AttributeProfileDtag         = "profile_dtag"
 	AttributeProfileCreator      = "profile_creator"
 	AttributeProfileCreationTime = "profile_creation_time"
+
+	// Delegation attributes
+	AttributeDelegationGranter    = "granter"
+	AttributeDelegationGrantee    = "grantee"
+	AttributeDelegationMsgTypes   = "msg_types"
+	AttributeDelegationExpiration = "expiration"
 )
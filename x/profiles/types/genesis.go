@@ -11,14 +11,18 @@ type GenesisState struct {
 	Profiles           []Profile                   `json:"profiles" yaml:"profiles"`
 	Params             Params                      `json:"params" yaml:"params"`
 	UsersRelationships map[string][]sdk.AccAddress `json:"users_relationships"`
+	Grants             []Grant                     `json:"grants" yaml:"grants"`
 }
 
 // NewGenesisState creates a new genesis state
-func NewGenesisState(profiles []Profile, params Params, usersRelationships map[string][]sdk.AccAddress) GenesisState {
+func NewGenesisState(
+	profiles []Profile, params Params, usersRelationships map[string][]sdk.AccAddress, grants []Grant,
+) GenesisState {
 	return GenesisState{
 		Profiles:           profiles,
 		Params:             params,
 		UsersRelationships: usersRelationships,
+		Grants:             grants,
 	}
 }
 
@@ -28,6 +32,7 @@ func DefaultGenesisState() GenesisState {
 		Profiles:           Profiles{},
 		Params:             DefaultParams(),
 		UsersRelationships: map[string][]sdk.AccAddress{},
+		Grants:             Grants{},
 	}
 }
 
@@ -39,10 +44,20 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	if collisions := FindDtagCollisions(data.Profiles); len(collisions) > 0 {
+		return fmt.Errorf("invalid profiles dtags: %s", collisions[0])
+	}
+
 	if err := data.Params.Validate(); err != nil {
 		return err
 	}
 
+	for _, grant := range data.Grants {
+		if err := grant.Validate(); err != nil {
+			return err
+		}
+	}
+
 	for _, relationships := range data.UsersRelationships {
 		for _, address := range relationships {
 			if !address.Empty() {
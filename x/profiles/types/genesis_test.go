@@ -15,17 +15,20 @@ func TestNewGenesis(t *testing.T) {
 	nameSurnameParams := types.MonikerParams{}
 	monikerParams := types.DtagParams{}
 	bioParams := sdk.Int{}
-	params := types.NewParams(nameSurnameParams, monikerParams, bioParams)
+	params := types.NewParams(nameSurnameParams, monikerParams, bioParams, types.DefaultMaxBioBytes, types.DefaultAllowedURISchemes, types.DefaultCustomFieldsParams(), nil)
 
 	usersRelationships := map[string][]sdk.AccAddress{}
 
+	grants := types.Grants{}
+
 	expGenState := types.GenesisState{
 		Profiles:           profiles,
 		Params:             params,
 		UsersRelationships: usersRelationships,
+		Grants:             grants,
 	}
 
-	actualGenState := types.NewGenesisState(profiles, params, usersRelationships)
+	actualGenState := types.NewGenesisState(profiles, params, usersRelationships, grants)
 	require.Equal(t, expGenState, actualGenState)
 }
 
@@ -61,6 +64,28 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			shouldError: true,
 		},
+		{
+			name: "Genesis with colliding dtags returns error",
+			genesis: types.GenesisState{
+				Profiles: types.NewProfiles(
+					types.NewProfile("custom_dtag", user, date),
+					types.NewProfile("Custom_DTag", otherUser, date),
+				),
+				Params: types.DefaultParams(),
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with invalid grant returns error",
+			genesis: types.GenesisState{
+				Profiles: types.NewProfiles(types.NewProfile("custom_dtag", user, date)),
+				Params:   types.DefaultParams(),
+				Grants: types.Grants{
+					types.NewGrant(user, otherUser, []string{types.ActionDeleteProfile}, date),
+				},
+			},
+			shouldError: true,
+		},
 		{
 			name: "Genesis with invalid relationship return error",
 			genesis: types.GenesisState{
@@ -91,7 +116,7 @@ func TestValidateGenesis(t *testing.T) {
 							common.NewStrPtr("https://test.com/cover-pic"),
 						),
 				),
-				Params: types.NewParams(types.NewMonikerParams(sdk.NewInt(-1), sdk.NewInt(10)), types.DefaultDtagParams(), types.DefaultMaxBioLength),
+				Params: types.NewParams(types.NewMonikerParams(sdk.NewInt(-1), sdk.NewInt(10), types.DefaultMaxMonikerBytes), types.DefaultDtagParams(), types.DefaultMaxBioLength, types.DefaultMaxBioBytes, types.DefaultAllowedURISchemes, types.DefaultCustomFieldsParams(), nil),
 			},
 			shouldError: true,
 		},
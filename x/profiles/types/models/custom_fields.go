@@ -0,0 +1,109 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CustomField represents a single key/value information that a user can associate to its own profile
+// (e.g. location, website, pronouns or a PGP/SSH key fingerprint)
+type CustomField struct {
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+// NewCustomField is a constructor function for CustomField
+func NewCustomField(key, value string) CustomField {
+	return CustomField{
+		Key:   key,
+		Value: value,
+	}
+}
+
+// String implements fmt.Stringer
+func (field CustomField) String() string {
+	return fmt.Sprintf("%s: %s", field.Key, field.Value)
+}
+
+// Validate check the validity of the CustomField
+func (field CustomField) Validate() error {
+	if len(strings.TrimSpace(field.Key)) == 0 {
+		return fmt.Errorf("custom field key cannot be empty or blank")
+	}
+
+	if len(strings.TrimSpace(field.Value)) == 0 {
+		return fmt.Errorf("custom field %s value cannot be empty or blank", field.Key)
+	}
+
+	return nil
+}
+
+// CustomFields represents a set of custom fields, sorted by their keys.
+// Amino does not support maps serialization, so a slice is used instead
+type CustomFields []CustomField
+
+// NewCustomFields returns a new CustomFields object containing the given fields sorted by their keys
+func NewCustomFields(fields ...CustomField) CustomFields {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	customFields := make(CustomFields, len(fields))
+	copy(customFields, fields)
+	sort.SliceStable(customFields, func(i, j int) bool {
+		return customFields[i].Key < customFields[j].Key
+	})
+	return customFields
+}
+
+// GetValue returns the value associated with the given key, and false if no field with such key exists
+func (fields CustomFields) GetValue(key string) (string, bool) {
+	for _, field := range fields {
+		if field.Key == key {
+			return field.Value, true
+		}
+	}
+	return "", false
+}
+
+// String implements fmt.Stringer
+func (fields CustomFields) String() string {
+	out := make([]string, len(fields))
+	for index, field := range fields {
+		out[index] = field.String()
+	}
+	return fmt.Sprintf("[%s]", strings.Join(out, ", "))
+}
+
+// Equals returns true iff fields and other contain the same fields in the same order
+func (fields CustomFields) Equals(other CustomFields) bool {
+	if len(fields) != len(other) {
+		return false
+	}
+
+	for index, field := range fields {
+		if field != other[index] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Validate check the validity of the CustomFields
+func (fields CustomFields) Validate() error {
+	keys := map[string]bool{}
+	for _, field := range fields {
+		if err := field.Validate(); err != nil {
+			return err
+		}
+
+		if keys[field.Key] {
+			return fmt.Errorf("duplicated custom field key: %s", field.Key)
+		}
+		keys[field.Key] = true
+	}
+
+	return nil
+}
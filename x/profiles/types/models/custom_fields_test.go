@@ -0,0 +1,92 @@
+package models_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/desmos-labs/desmos/x/profiles/types/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCustomFields(t *testing.T) {
+	fields := models.NewCustomFields(
+		models.NewCustomField("website", "https://desmos.network"),
+		models.NewCustomField("location", "Lugano"),
+	)
+
+	expected := models.CustomFields{
+		models.NewCustomField("location", "Lugano"),
+		models.NewCustomField("website", "https://desmos.network"),
+	}
+	require.Equal(t, expected, fields)
+	require.Nil(t, models.NewCustomFields())
+}
+
+func TestCustomFields_GetValue(t *testing.T) {
+	fields := models.NewCustomFields(models.NewCustomField("location", "Lugano"))
+
+	value, found := fields.GetValue("location")
+	require.True(t, found)
+	require.Equal(t, "Lugano", value)
+
+	_, found = fields.GetValue("website")
+	require.False(t, found)
+}
+
+func TestCustomFields_String(t *testing.T) {
+	fields := models.NewCustomFields(
+		models.NewCustomField("website", "https://desmos.network"),
+		models.NewCustomField("location", "Lugano"),
+	)
+	require.Equal(t, "[location: Lugano, website: https://desmos.network]", fields.String())
+}
+
+func TestCustomFields_Equals(t *testing.T) {
+	fields := models.NewCustomFields(models.NewCustomField("location", "Lugano"))
+
+	require.True(t, fields.Equals(models.NewCustomFields(models.NewCustomField("location", "Lugano"))))
+	require.False(t, fields.Equals(models.NewCustomFields(models.NewCustomField("location", "Rome"))))
+	require.False(t, fields.Equals(nil))
+}
+
+func TestCustomFields_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields models.CustomFields
+		expErr error
+	}{
+		{
+			name:   "Empty key returns error",
+			fields: models.CustomFields{models.NewCustomField(" ", "value")},
+			expErr: fmt.Errorf("custom field key cannot be empty or blank"),
+		},
+		{
+			name:   "Empty value returns error",
+			fields: models.CustomFields{models.NewCustomField("location", "")},
+			expErr: fmt.Errorf("custom field location value cannot be empty or blank"),
+		},
+		{
+			name: "Duplicated key returns error",
+			fields: models.CustomFields{
+				models.NewCustomField("location", "Lugano"),
+				models.NewCustomField("location", "Rome"),
+			},
+			expErr: fmt.Errorf("duplicated custom field key: location"),
+		},
+		{
+			name: "Valid fields return no error",
+			fields: models.NewCustomFields(
+				models.NewCustomField("location", "Lugano"),
+				models.NewCustomField("pronouns", "they/them"),
+			),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.fields.Validate())
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// dtagCaseConfusables maps the characters that can be visually confused with a lowercase latin "l" before their
+// case is folded. Since a capital "I" is lowercased to "i", it needs to be mapped before folding the case
+// so that "I", "l" and "1" are all considered the same character, while "i" and "l" are not
+var dtagCaseConfusables = map[rune]rune{
+	// Latin characters
+	'I': 'l', '1': 'l',
+
+	// Cyrillic and greek characters
+	'І': 'l', 'Ӏ': 'l', 'Ι': 'l',
+}
+
+// dtagConfusables maps the lowercase characters that can be visually confused with a latin letter
+// to their latin skeleton. It contains a subset of the Unicode confusables (https://www.unicode.org/reports/tr39/)
+// covering the greek and cyrillic scripts
+var dtagConfusables = map[rune]rune{
+	// Latin characters
+	'ı': 'i',
+
+	// Cyrillic characters
+	'а': 'a', 'в': 'b', 'е': 'e', 'к': 'k', 'м': 'm', 'н': 'h', 'о': 'o', 'р': 'p',
+	'с': 'c', 'т': 't', 'у': 'y', 'х': 'x', 'ѕ': 's', 'і': 'i', 'ј': 'j', 'ԁ': 'd',
+	'һ': 'h', 'ӏ': 'l', 'ԛ': 'q', 'ԝ': 'w',
+
+	// Greek characters
+	'α': 'a', 'β': 'b', 'ε': 'e', 'ζ': 'z', 'η': 'h', 'ι': 'i', 'κ': 'k', 'μ': 'm',
+	'ν': 'v', 'ο': 'o', 'ρ': 'p', 'τ': 't', 'υ': 'u', 'χ': 'x',
+}
+
+// dtagCaseSkeleton returns the latin skeleton of the given character that must be computed before folding its case
+func dtagCaseSkeleton(r rune) rune {
+	// Fullwidth ASCII variants are mapped to their ASCII equivalent
+	if r >= '！' && r <= '～' {
+		r -= '！' - '!'
+	}
+
+	if skeleton, ok := dtagCaseConfusables[r]; ok {
+		return skeleton
+	}
+	return r
+}
+
+// dtagSkeleton returns the latin skeleton of the given lowercase character
+func dtagSkeleton(r rune) rune {
+	if skeleton, ok := dtagConfusables[r]; ok {
+		return skeleton
+	}
+	return r
+}
+
+// NormalizeDtag returns the normalized version of the given dtag, that is used to check its uniqueness.
+// Two dtags having the same normalized version are considered the same, as they differ only by
+// their case or by characters that can be visually confused with each other (e.g. latin and cyrillic homoglyphs)
+func NormalizeDtag(dtag string) string {
+	return strings.Map(dtagSkeleton, strings.ToLower(strings.Map(dtagCaseSkeleton, dtag)))
+}
+
+// DtagCollision contains the data of a set of profiles having dtags that are equal once normalized
+type DtagCollision struct {
+	NormalizedDtag string           `json:"normalized_dtag" yaml:"normalized_dtag"`
+	Dtags          []string         `json:"dtags" yaml:"dtags"`
+	Creators       []sdk.AccAddress `json:"creators" yaml:"creators"`
+}
+
+// String implements fmt.Stringer
+func (collision DtagCollision) String() string {
+	entries := make([]string, len(collision.Dtags))
+	for index, dtag := range collision.Dtags {
+		entries[index] = fmt.Sprintf("%s (%s)", dtag, collision.Creators[index])
+	}
+	return fmt.Sprintf("Dtag %s collides with: %s", collision.NormalizedDtag, strings.Join(entries, ", "))
+}
+
+// FindDtagCollisions returns all the sets of profiles having dtags that are equal once normalized.
+// Inside each collision, profiles are sorted by their creation date so that the first one
+// is the profile that has registered the dtag first
+func FindDtagCollisions(profiles []Profile) []DtagCollision {
+	groups := map[string][]Profile{}
+	for _, profile := range profiles {
+		normalized := NormalizeDtag(profile.DTag)
+		groups[normalized] = append(groups[normalized], profile)
+	}
+
+	var collisions []DtagCollision
+	for normalized, group := range groups {
+		if len(group) < 2 {
+			continue
+		}
+
+		sort.SliceStable(group, func(i, j int) bool {
+			return group[i].CreationDate.Before(group[j].CreationDate) ||
+				(group[i].CreationDate.Equal(group[j].CreationDate) && group[i].Creator.String() < group[j].Creator.String())
+		})
+
+		collision := DtagCollision{NormalizedDtag: normalized}
+		for _, profile := range group {
+			collision.Dtags = append(collision.Dtags, profile.DTag)
+			collision.Creators = append(collision.Creators, profile.Creator)
+		}
+		collisions = append(collisions, collision)
+	}
+
+	sort.Slice(collisions, func(i, j int) bool {
+		return collisions[i].NormalizedDtag < collisions[j].NormalizedDtag
+	})
+
+	return collisions
+}
@@ -0,0 +1,102 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/profiles/types/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeDtag(t *testing.T) {
+	tests := []struct {
+		name          string
+		dtag          string
+		expNormalized string
+	}{
+		{
+			name:          "Upper case letters are folded",
+			dtag:          "LeoDiCap",
+			expNormalized: "leodicap",
+		},
+		{
+			name:          "Cyrillic homoglyphs are mapped to latin letters",
+			dtag:          "аlicе",
+			expNormalized: "alice",
+		},
+		{
+			name:          "Greek homoglyphs are mapped to latin letters",
+			dtag:          "ΑΒΕ_ο",
+			expNormalized: "abe_o",
+		},
+		{
+			name:          "Capital i and one are mapped to lowercase l",
+			dtag:          "AIice_1l",
+			expNormalized: "alice_ll",
+		},
+		{
+			name:          "Lowercase i and l are not confused",
+			dtag:          "bill",
+			expNormalized: "bill",
+		},
+		{
+			name:          "Cyrillic and greek capital i are mapped to lowercase l",
+			dtag:          "ІΙӀ",
+			expNormalized: "lll",
+		},
+		{
+			name:          "Fullwidth characters are mapped to ascii",
+			dtag:          "ＡＬＩＣＥ",
+			expNormalized: "allce",
+		},
+		{
+			name:          "Fullwidth lowercase characters are mapped to ascii",
+			dtag:          "Ａｌｉｃｅ",
+			expNormalized: "alice",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			normalized := models.NormalizeDtag(test.dtag)
+			require.Equal(t, test.expNormalized, normalized)
+			require.Equal(t, normalized, models.NormalizeDtag(normalized))
+		})
+	}
+}
+
+func TestFindDtagCollisions(t *testing.T) {
+	first, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	second, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	require.NoError(t, err)
+
+	third, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	require.NoError(t, err)
+
+	date := time.Date(2020, 1, 1, 12, 00, 00, 000, time.UTC)
+	profiles := []models.Profile{
+		models.NewProfile("alice", second, date.Add(time.Hour)),
+		models.NewProfile("bob", third, date),
+		models.NewProfile("Alice", first, date),
+	}
+
+	expected := []models.DtagCollision{
+		{
+			NormalizedDtag: "alice",
+			Dtags:          []string{"Alice", "alice"},
+			Creators:       []sdk.AccAddress{first, second},
+		},
+	}
+	require.Equal(t, expected, models.FindDtagCollisions(profiles))
+	require.Equal(
+		t,
+		"Dtag alice collides with: Alice (cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns), alice (cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47)",
+		expected[0].String(),
+	)
+
+	require.Nil(t, models.FindDtagCollisions(profiles[1:]))
+}
@@ -0,0 +1,108 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NonDelegableMsgTypes contains the types of the messages that can never be delegated to another account,
+// as they would allow the grantee to take control of the granter profile
+var NonDelegableMsgTypes = []string{
+	ActionSaveProfile,
+	ActionDeleteProfile,
+	ActionGrantDelegation,
+	ActionRevokeDelegation,
+}
+
+// Grant represents the authorization given by a profile owner (the granter) to another account (the grantee)
+// to perform the specified types of messages on its behalf, until the expiration date
+type Grant struct {
+	Granter    sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgTypes   []string       `json:"msg_types" yaml:"msg_types"`
+	Expiration time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+// NewGrant is a constructor function for Grant
+func NewGrant(granter, grantee sdk.AccAddress, msgTypes []string, expiration time.Time) Grant {
+	return Grant{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypes:   msgTypes,
+		Expiration: expiration,
+	}
+}
+
+// String implements fmt.Stringer
+func (grant Grant) String() string {
+	return fmt.Sprintf("Grant:\n[Granter] %s [Grantee] %s [Msg Types] %s [Expiration] %s",
+		grant.Granter,
+		grant.Grantee,
+		strings.Join(grant.MsgTypes, ", "),
+		grant.Expiration.Format(time.RFC3339),
+	)
+}
+
+// IsExpired tells whether the grant is expired at the given time
+func (grant Grant) IsExpired(time time.Time) bool {
+	return !grant.Expiration.After(time)
+}
+
+// Allows tells whether the grant allows the grantee to perform messages having the given type
+func (grant Grant) Allows(msgType string) bool {
+	for _, allowed := range grant.MsgTypes {
+		if allowed == msgType {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate check the validity of the Grant
+func (grant Grant) Validate() error {
+	if grant.Granter.Empty() {
+		return fmt.Errorf("invalid granter address: %s", grant.Granter)
+	}
+
+	if grant.Grantee.Empty() {
+		return fmt.Errorf("invalid grantee address: %s", grant.Grantee)
+	}
+
+	if grant.Granter.Equals(grant.Grantee) {
+		return fmt.Errorf("granter and grantee must be different")
+	}
+
+	if len(grant.MsgTypes) == 0 {
+		return fmt.Errorf("grant must contain at least one message type")
+	}
+
+	found := map[string]bool{}
+	for _, msgType := range grant.MsgTypes {
+		if len(strings.TrimSpace(msgType)) == 0 {
+			return fmt.Errorf("grant message type cannot be empty or blank")
+		}
+
+		for _, nonDelegable := range NonDelegableMsgTypes {
+			if msgType == nonDelegable {
+				return fmt.Errorf("message type %s cannot be delegated", msgType)
+			}
+		}
+
+		if found[msgType] {
+			return fmt.Errorf("duplicated grant message type: %s", msgType)
+		}
+		found[msgType] = true
+	}
+
+	if grant.Expiration.IsZero() {
+		return fmt.Errorf("invalid grant expiration")
+	}
+
+	return nil
+}
+
+// Grants represents a slice of Grant objects
+type Grants []Grant
@@ -0,0 +1,97 @@
+package models_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/profiles/types/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrant_Validate(t *testing.T) {
+	granter, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	grantee, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	require.NoError(t, err)
+
+	expiration := time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		grant  models.Grant
+		expErr error
+	}{
+		{
+			name:   "Empty granter returns error",
+			grant:  models.NewGrant(nil, grantee, []string{"create_post"}, expiration),
+			expErr: fmt.Errorf("invalid granter address: "),
+		},
+		{
+			name:   "Empty grantee returns error",
+			grant:  models.NewGrant(granter, nil, []string{"create_post"}, expiration),
+			expErr: fmt.Errorf("invalid grantee address: "),
+		},
+		{
+			name:   "Equal granter and grantee returns error",
+			grant:  models.NewGrant(granter, granter, []string{"create_post"}, expiration),
+			expErr: fmt.Errorf("granter and grantee must be different"),
+		},
+		{
+			name:   "Empty message types returns error",
+			grant:  models.NewGrant(granter, grantee, nil, expiration),
+			expErr: fmt.Errorf("grant must contain at least one message type"),
+		},
+		{
+			name:   "Blank message type returns error",
+			grant:  models.NewGrant(granter, grantee, []string{" "}, expiration),
+			expErr: fmt.Errorf("grant message type cannot be empty or blank"),
+		},
+		{
+			name:   "Non delegable message type returns error",
+			grant:  models.NewGrant(granter, grantee, []string{"create_post", "delete_profile"}, expiration),
+			expErr: fmt.Errorf("message type delete_profile cannot be delegated"),
+		},
+		{
+			name:   "Duplicated message type returns error",
+			grant:  models.NewGrant(granter, grantee, []string{"create_post", "create_post"}, expiration),
+			expErr: fmt.Errorf("duplicated grant message type: create_post"),
+		},
+		{
+			name:   "Zero expiration returns error",
+			grant:  models.NewGrant(granter, grantee, []string{"create_post"}, time.Time{}),
+			expErr: fmt.Errorf("invalid grant expiration"),
+		},
+		{
+			name:   "Valid grant returns no error",
+			grant:  models.NewGrant(granter, grantee, []string{"create_post", "report_post"}, expiration),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.grant.Validate())
+		})
+	}
+}
+
+func TestGrant_IsExpired(t *testing.T) {
+	expiration := time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)
+	grant := models.NewGrant(nil, nil, []string{"create_post"}, expiration)
+
+	require.False(t, grant.IsExpired(expiration.Add(-time.Second)))
+	require.True(t, grant.IsExpired(expiration))
+	require.True(t, grant.IsExpired(expiration.Add(time.Second)))
+}
+
+func TestGrant_Allows(t *testing.T) {
+	grant := models.NewGrant(nil, nil, []string{"create_post", "add_post_reaction"}, time.Time{})
+
+	require.True(t, grant.Allows("create_post"))
+	require.True(t, grant.Allows("add_post_reaction"))
+	require.False(t, grant.Allows("edit_post"))
+}
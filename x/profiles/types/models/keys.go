@@ -1,6 +1,9 @@
 package models
 
 import (
+	"strings"
+	"unicode"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,19 +12,28 @@ const (
 	RouterKey  = ModuleName
 	StoreKey   = ModuleName
 
-	ActionSaveProfile   = "save_profile"
-	ActionDeleteProfile = "delete_profile"
+	ActionSaveProfile      = "save_profile"
+	ActionDeleteProfile    = "delete_profile"
+	ActionGrantDelegation  = "grant_delegation"
+	ActionRevokeDelegation = "revoke_delegation"
 
 	//Queries
 	QuerierRoute  = ModuleName
 	QueryProfile  = "profile"
 	QueryProfiles = "all"
 	QueryParams   = "params"
+	QuerySearch   = "search"
+	QueryGrants   = "grants"
 )
 
 var (
-	ProfileStorePrefix = []byte("profile")
-	DtagStorePrefix    = []byte("dtag")
+	ProfileStorePrefix       = []byte("profile")
+	DtagStorePrefix          = []byte("dtag")
+	DtagSearchStorePrefix    = []byte("search_dtag")
+	MonikerSearchStorePrefix = []byte("search_moniker")
+	GrantStorePrefix         = []byte("grant")
+
+	searchKeySeparator = []byte{0x00}
 )
 
 // ProfileStoreKey turns an address to a key used to store a profile into the profiles store
@@ -29,7 +41,61 @@ func ProfileStoreKey(address sdk.AccAddress) []byte {
 	return append(ProfileStorePrefix, address...)
 }
 
-// DtagStoreKey turns a dtag to a key used to store a dtag -> address couple
+// DtagStoreKey turns a dtag to a key used to store a dtag -> address couple.
+// The dtag is normalized so that dtags differing only by their case or by confusable characters share the same key
 func DtagStoreKey(dtag string) []byte {
-	return append(DtagStorePrefix, []byte(dtag)...)
+	return append(DtagStorePrefix, []byte(NormalizeDtag(dtag))...)
+}
+
+// DtagSearchPrefixKey returns the prefix of all the keys used to search the profiles having a dtag
+// that starts with the given prefix, regardless of its case
+func DtagSearchPrefixKey(prefix string) []byte {
+	return append(DtagSearchStorePrefix, []byte(strings.ToLower(prefix))...)
+}
+
+// DtagSearchStoreKey turns a dtag and an address into a key used to index the profile by its dtag.
+// The dtag is lowercased so that searches are case insensitive
+func DtagSearchStoreKey(dtag string, address sdk.AccAddress) []byte {
+	key := append(DtagSearchPrefixKey(dtag), searchKeySeparator...)
+	return append(key, address...)
+}
+
+// MonikerSearchPrefixKey returns the prefix of all the keys used to search the profiles having
+// a moniker token that starts with the given prefix, regardless of its case
+func MonikerSearchPrefixKey(prefix string) []byte {
+	return append(MonikerSearchStorePrefix, []byte(strings.ToLower(prefix))...)
+}
+
+// MonikerSearchStoreKey turns a moniker token and an address into a key used to index the profile by its moniker
+func MonikerSearchStoreKey(token string, address sdk.AccAddress) []byte {
+	key := append(MonikerSearchPrefixKey(token), searchKeySeparator...)
+	return append(key, address...)
+}
+
+// MonikerTokens splits the given moniker into its lowercase, unique words.
+// Every character that is neither a letter nor a number is considered a words separator
+func MonikerTokens(moniker string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(moniker), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	var tokens []string
+	found := map[string]bool{}
+	for _, field := range fields {
+		if !found[field] {
+			found[field] = true
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
+
+// GranterGrantsPrefix returns the prefix of all the keys used to store the grants given by the granter
+func GranterGrantsPrefix(granter sdk.AccAddress) []byte {
+	return append(GrantStorePrefix, granter...)
+}
+
+// GrantStoreKey turns a granter and a grantee into the key used to store the grant between them
+func GrantStoreKey(granter, grantee sdk.AccAddress) []byte {
+	return append(GranterGrantsPrefix(granter), grantee...)
 }
@@ -0,0 +1,54 @@
+package models_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/profiles/types/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonikerTokens(t *testing.T) {
+	tests := []struct {
+		name      string
+		moniker   string
+		expTokens []string
+	}{
+		{
+			name:      "Empty moniker returns no tokens",
+			moniker:   "",
+			expTokens: nil,
+		},
+		{
+			name:      "Words are lowercased and split on separators",
+			moniker:   "Leonardo Di-Caprio, actor",
+			expTokens: []string{"leonardo", "di", "caprio", "actor"},
+		},
+		{
+			name:      "Duplicated words are returned once",
+			moniker:   "John John",
+			expTokens: []string{"john"},
+		},
+		{
+			name:      "Non latin letters are kept",
+			moniker:   "Jürgen 綾波",
+			expTokens: []string{"jürgen", "綾波"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expTokens, models.MonikerTokens(test.moniker))
+		})
+	}
+}
+
+func TestDtagSearchStoreKey(t *testing.T) {
+	address, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	key := models.DtagSearchStoreKey("LeoDiCap", address)
+	require.Equal(t, append(append([]byte("search_dtagleodicap"), 0x00), address...), key)
+	require.Equal(t, []byte("search_dtagleo"), models.DtagSearchPrefixKey("LEO"))
+}
@@ -1,15 +1,19 @@
 package models
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strings"
 
 	"github.com/desmos-labs/desmos/x/commons"
 )
 
 // Pictures contains the data of a user profile's related pictures
 type Pictures struct {
-	Profile *string `json:"profile,omitempty" yaml:"profile,omitempty"`
-	Cover   *string `json:"cover,omitempty" yaml:"cover,omitempty"`
+	Profile     *string `json:"profile,omitempty" yaml:"profile,omitempty"`
+	Cover       *string `json:"cover,omitempty" yaml:"cover,omitempty"`
+	ProfileHash *string `json:"profile_hash,omitempty" yaml:"profile_hash,omitempty"`
+	CoverHash   *string `json:"cover_hash,omitempty" yaml:"cover_hash,omitempty"`
 }
 
 // NewPictures is a constructor function for Pictures
@@ -23,6 +27,13 @@ func NewPictures(profile, cover *string) *Pictures {
 	}
 }
 
+// WithHashes returns a copy of pic having the given profile and cover content hashes
+func (pic Pictures) WithHashes(profileHash, coverHash *string) *Pictures {
+	pic.ProfileHash = profileHash
+	pic.CoverHash = coverHash
+	return &pic
+}
+
 // Equals allows to check whether the contents of pic are the same of otherPics
 func (pic Pictures) Equals(otherPic *Pictures) bool {
 	if otherPic == nil {
@@ -30,22 +41,52 @@ func (pic Pictures) Equals(otherPic *Pictures) bool {
 	}
 
 	return commons.StringPtrsEqual(pic.Profile, otherPic.Profile) &&
-		commons.StringPtrsEqual(pic.Cover, otherPic.Cover)
+		commons.StringPtrsEqual(pic.Cover, otherPic.Cover) &&
+		commons.StringPtrsEqual(pic.ProfileHash, otherPic.ProfileHash) &&
+		commons.StringPtrsEqual(pic.CoverHash, otherPic.CoverHash)
+}
+
+// isValidContentHash tells whether the given hash is a valid hex encoded SHA-256 hash
+func isValidContentHash(hash string) bool {
+	bz, err := hex.DecodeString(hash)
+	return err == nil && len(bz) == 32 && hash == strings.ToLower(hash)
 }
 
-// Validate check the validity of the Pictures
+// Validate check the validity of the Pictures.
+// The URIs schemes are not checked here, as the allowed ones are defined inside the module params
 func (pic Pictures) Validate() error {
 
 	if pic.Profile != nil {
-		if valid := commons.IsURIValid(*pic.Profile); !valid {
+		if valid := commons.IsURIValidWithSchemes(*pic.Profile, nil); !valid {
 			return fmt.Errorf("invalid profile picture uri provided")
 		}
 	}
 
 	if pic.Cover != nil {
-		if valid := commons.IsURIValid(*pic.Cover); !valid {
+		if valid := commons.IsURIValidWithSchemes(*pic.Cover, nil); !valid {
 			return fmt.Errorf("invalid profile cover uri provided")
 		}
 	}
+
+	if pic.ProfileHash != nil {
+		if pic.Profile == nil {
+			return fmt.Errorf("profile picture hash provided without a profile picture")
+		}
+
+		if !isValidContentHash(*pic.ProfileHash) {
+			return fmt.Errorf("invalid profile picture hash provided, it must be a lowercase hex encoded SHA-256 hash")
+		}
+	}
+
+	if pic.CoverHash != nil {
+		if pic.Cover == nil {
+			return fmt.Errorf("profile cover hash provided without a profile cover")
+		}
+
+		if !isValidContentHash(*pic.CoverHash) {
+			return fmt.Errorf("invalid profile cover hash provided, it must be a lowercase hex encoded SHA-256 hash")
+		}
+	}
+
 	return nil
 }
@@ -73,6 +73,9 @@ func TestPictures_Validate(t *testing.T) {
 	profilePic := "https://shorturl.at/adnX3"
 	profileCov := "https://shorturl.at/cgpyF"
 	invalidURI := "invalid"
+	ipfsPic := "ipfs://QmXoypizjW3WknFiJnKLwHCnL72vedxjQkDDP1mXWo6uco"
+	validHash := "ae2d7cb4e5b3a27b0f4a1e0a0b1a7b9d53a6c1e8b6a7f52e3b6e3d5c1c0e7f9a"
+	invalidHash := "AE2D7CB4"
 	tests := []struct {
 		name     string
 		pictures *models.Pictures
@@ -93,6 +96,26 @@ func TestPictures_Validate(t *testing.T) {
 			pictures: models.NewPictures(&profilePic, &invalidURI),
 			expErr:   fmt.Errorf("invalid profile cover uri provided"),
 		},
+		{
+			name:     "Valid Pictures with ipfs uri and hashes",
+			pictures: models.NewPictures(&ipfsPic, &profileCov).WithHashes(&validHash, &validHash),
+			expErr:   nil,
+		},
+		{
+			name:     "Invalid Pictures profile hash",
+			pictures: models.NewPictures(&profilePic, &profileCov).WithHashes(&invalidHash, nil),
+			expErr:   fmt.Errorf("invalid profile picture hash provided, it must be a lowercase hex encoded SHA-256 hash"),
+		},
+		{
+			name:     "Invalid Pictures cover hash",
+			pictures: models.NewPictures(&profilePic, &profileCov).WithHashes(nil, &invalidHash),
+			expErr:   fmt.Errorf("invalid profile cover hash provided, it must be a lowercase hex encoded SHA-256 hash"),
+		},
+		{
+			name:     "Pictures cover hash without cover",
+			pictures: models.NewPictures(&profilePic, nil).WithHashes(nil, &validHash),
+			expErr:   fmt.Errorf("profile cover hash provided without a profile cover"),
+		},
 	}
 
 	for _, test := range tests {
@@ -15,6 +15,7 @@ type Profile struct {
 	Moniker      *string        `json:"moniker,omitempty" yaml:"moniker,omitempty"`
 	Bio          *string        `json:"bio,omitempty" yaml:"bio,omitempty"`
 	Pictures     *Pictures      `json:"pictures,omitempty" yaml:"pictures,omitempty"`
+	CustomFields CustomFields   `json:"custom_fields,omitempty" yaml:"custom_fields,omitempty"`
 	Creator      sdk.AccAddress `json:"creator" yaml:"creator"`
 	CreationDate time.Time      `json:"creation_date" yaml:"creation_date"`
 }
@@ -41,6 +42,25 @@ func (profile Profile) WithPictures(profilePic, coverPic *string) Profile {
 	return profile
 }
 
+// WithPicturesHashes updates profile's pictures content hashes with the given ones
+func (profile Profile) WithPicturesHashes(profileHash, coverHash *string) Profile {
+	if profile.Pictures == nil {
+		if profileHash == nil && coverHash == nil {
+			return profile
+		}
+		profile.Pictures = &Pictures{}
+	}
+
+	profile.Pictures = profile.Pictures.WithHashes(profileHash, coverHash)
+	return profile
+}
+
+// WithCustomFields updates profile's custom fields with the given ones
+func (profile Profile) WithCustomFields(fields CustomFields) Profile {
+	profile.CustomFields = NewCustomFields(fields...)
+	return profile
+}
+
 // String implements fmt.Stringer
 func (profile Profile) String() string {
 	out := "Profile:\n"
@@ -56,6 +76,10 @@ func (profile Profile) String() string {
 		out += fmt.Sprintf("[Biography] %s ", *profile.Bio)
 	}
 
+	if len(profile.CustomFields) != 0 {
+		out += fmt.Sprintf("[Custom Fields] %s ", profile.CustomFields)
+	}
+
 	if profile.Pictures != nil {
 		out += "Pictures:\n"
 		switch {
@@ -87,6 +111,7 @@ func (profile Profile) Equals(other Profile) bool {
 		commons.StringPtrsEqual(profile.Moniker, other.Moniker) &&
 		commons.StringPtrsEqual(profile.Bio, other.Bio) &&
 		arePicturesEquals &&
+		profile.CustomFields.Equals(other.CustomFields) &&
 		profile.CreationDate.Equal(other.CreationDate) &&
 		profile.Creator.Equals(other.Creator)
 }
@@ -106,6 +131,10 @@ func (profile Profile) Validate() error {
 		}
 	}
 
+	if err := profile.CustomFields.Validate(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
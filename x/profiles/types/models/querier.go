@@ -0,0 +1,17 @@
+package models
+
+// QuerySearchParams Params for query 'custom/profiles/search'
+type QuerySearchParams struct {
+	Prefix string
+	Page   int
+	Limit  int
+}
+
+// NewQuerySearchParams is a constructor function for QuerySearchParams
+func NewQuerySearchParams(prefix string, page, limit int) QuerySearchParams {
+	return QuerySearchParams{
+		Prefix: prefix,
+		Page:   page,
+		Limit:  limit,
+	}
+}
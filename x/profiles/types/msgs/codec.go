@@ -15,4 +15,6 @@ func init() {
 func RegisterMessagesCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSaveProfile{}, "desmos/MsgSaveProfile", nil)
 	cdc.RegisterConcrete(MsgDeleteProfile{}, "desmos/MsgDeleteProfile", nil)
+	cdc.RegisterConcrete(MsgGrantDelegation{}, "desmos/MsgGrantDelegation", nil)
+	cdc.RegisterConcrete(MsgRevokeDelegation{}, "desmos/MsgRevokeDelegation", nil)
 }
@@ -15,12 +15,15 @@ import (
 
 // MsgSaveProfile defines a SaveProfile message
 type MsgSaveProfile struct {
-	Dtag       string         `json:"dtag" yaml:"dtag"`
-	Moniker    *string        `json:"moniker,omitempty" yaml:"moniker,omitempty"`
-	Bio        *string        `json:"bio,omitempty" yaml:"bio,omitempty"`
-	ProfilePic *string        `json:"profile_picture,omitempty" yaml:"profile_pic,omitempty"`
-	CoverPic   *string        `json:"cover_picture,omitempty" yaml:"cover_pic,omitempty"`
-	Creator    sdk.AccAddress `json:"creator" yaml:"creator"`
+	Dtag           string              `json:"dtag" yaml:"dtag"`
+	Moniker        *string             `json:"moniker,omitempty" yaml:"moniker,omitempty"`
+	Bio            *string             `json:"bio,omitempty" yaml:"bio,omitempty"`
+	ProfilePic     *string             `json:"profile_picture,omitempty" yaml:"profile_pic,omitempty"`
+	ProfilePicHash *string             `json:"profile_picture_hash,omitempty" yaml:"profile_pic_hash,omitempty"`
+	CoverPic       *string             `json:"cover_picture,omitempty" yaml:"cover_pic,omitempty"`
+	CoverPicHash   *string             `json:"cover_picture_hash,omitempty" yaml:"cover_pic_hash,omitempty"`
+	CustomFields   models.CustomFields `json:"custom_fields,omitempty" yaml:"custom_fields,omitempty"`
+	Creator        sdk.AccAddress      `json:"creator" yaml:"creator"`
 }
 
 // NewMsgSaveProfile is a constructor function for MsgSaveProfile
@@ -35,6 +38,19 @@ func NewMsgSaveProfile(dtag string, moniker, bio, profilePic, coverPic *string,
 	}
 }
 
+// WithPicturesHashes returns a copy of msg having the given profile and cover pictures content hashes
+func (msg MsgSaveProfile) WithPicturesHashes(profilePicHash, coverPicHash *string) MsgSaveProfile {
+	msg.ProfilePicHash = profilePicHash
+	msg.CoverPicHash = coverPicHash
+	return msg
+}
+
+// WithCustomFields returns a copy of msg having the given custom fields
+func (msg MsgSaveProfile) WithCustomFields(fields models.CustomFields) MsgSaveProfile {
+	msg.CustomFields = fields
+	return msg
+}
+
 // Route should return the name of the module
 func (msg MsgSaveProfile) Route() string { return models.RouterKey }
 
@@ -51,6 +67,18 @@ func (msg MsgSaveProfile) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "profile dtag cannot be empty or blank")
 	}
 
+	if msg.ProfilePicHash != nil && msg.ProfilePic == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "profile picture hash provided without a profile picture")
+	}
+
+	if msg.CoverPicHash != nil && msg.CoverPic == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "profile cover hash provided without a profile cover")
+	}
+
+	if err := msg.CustomFields.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
 	return nil
 }
 
@@ -0,0 +1,115 @@
+package msgs
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/desmos-labs/desmos/x/profiles/types/models"
+)
+
+// ----------------------
+// --- MsgGrantDelegation
+// ----------------------
+
+// MsgGrantDelegation defines the message used by a profile owner to allow
+// another account to perform the given types of messages on its behalf
+type MsgGrantDelegation struct {
+	Granter    sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	MsgTypes   []string       `json:"msg_types" yaml:"msg_types"`
+	Expiration time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+// NewMsgGrantDelegation is a constructor function for MsgGrantDelegation
+func NewMsgGrantDelegation(granter, grantee sdk.AccAddress, msgTypes []string, expiration time.Time) MsgGrantDelegation {
+	return MsgGrantDelegation{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgTypes:   msgTypes,
+		Expiration: expiration,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgGrantDelegation) Route() string { return models.RouterKey }
+
+// Type should return the action
+func (msg MsgGrantDelegation) Type() string { return models.ActionGrantDelegation }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgGrantDelegation) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid granter address: %s", msg.Granter))
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid grantee address: %s", msg.Grantee))
+	}
+
+	grant := models.NewGrant(msg.Granter, msg.Grantee, msg.MsgTypes, msg.Expiration)
+	if err := grant.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgGrantDelegation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgsCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgGrantDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// ----------------------
+// --- MsgRevokeDelegation
+// ----------------------
+
+// MsgRevokeDelegation defines the message used by a profile owner to revoke
+// a delegation previously granted to another account
+type MsgRevokeDelegation struct {
+	Granter sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewMsgRevokeDelegation is a constructor function for MsgRevokeDelegation
+func NewMsgRevokeDelegation(granter, grantee sdk.AccAddress) MsgRevokeDelegation {
+	return MsgRevokeDelegation{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRevokeDelegation) Route() string { return models.RouterKey }
+
+// Type should return the action
+func (msg MsgRevokeDelegation) Type() string { return models.ActionRevokeDelegation }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRevokeDelegation) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid granter address: %s", msg.Granter))
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid grantee address: %s", msg.Grantee))
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRevokeDelegation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgsCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRevokeDelegation) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
@@ -0,0 +1,150 @@
+package msgs_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/desmos-labs/desmos/x/profiles/types/msgs"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------
+// --- MsgGrantDelegation
+// ----------------------
+
+var grantee, _ = sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+var delegationExpiration = time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC)
+
+var msgGrantDelegation = msgs.NewMsgGrantDelegation(
+	user,
+	grantee,
+	[]string{"create_post"},
+	delegationExpiration,
+)
+
+var msgRevokeDelegation = msgs.NewMsgRevokeDelegation(user, grantee)
+
+func TestMsgGrantDelegation_Route(t *testing.T) {
+	require.Equal(t, "profiles", msgGrantDelegation.Route())
+}
+
+func TestMsgGrantDelegation_Type(t *testing.T) {
+	require.Equal(t, "grant_delegation", msgGrantDelegation.Type())
+}
+
+func TestMsgGrantDelegation_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   msgs.MsgGrantDelegation
+		error error
+	}{
+		{
+			name:  "Empty granter returns error",
+			msg:   msgs.NewMsgGrantDelegation(nil, grantee, []string{"create_post"}, delegationExpiration),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid granter address: "),
+		},
+		{
+			name:  "Empty grantee returns error",
+			msg:   msgs.NewMsgGrantDelegation(user, nil, []string{"create_post"}, delegationExpiration),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid grantee address: "),
+		},
+		{
+			name:  "Non delegable message type returns error",
+			msg:   msgs.NewMsgGrantDelegation(user, grantee, []string{"save_profile"}, delegationExpiration),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "message type save_profile cannot be delegated"),
+		},
+		{
+			name:  "Valid message returns no error",
+			msg:   msgGrantDelegation,
+			error: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			returnedError := test.msg.ValidateBasic()
+			if test.error == nil {
+				require.Nil(t, returnedError)
+			} else {
+				require.NotNil(t, returnedError)
+				require.Equal(t, test.error.Error(), returnedError.Error())
+			}
+		})
+	}
+}
+
+func TestMsgGrantDelegation_GetSignBytes(t *testing.T) {
+	actual := msgGrantDelegation.GetSignBytes()
+	expected := `{"type":"desmos/MsgGrantDelegation","value":{"expiration":"2050-01-01T00:00:00Z","grantee":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","granter":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","msg_types":["create_post"]}}`
+	require.Equal(t, expected, string(actual))
+}
+
+func TestMsgGrantDelegation_GetSigners(t *testing.T) {
+	actual := msgGrantDelegation.GetSigners()
+	require.Equal(t, 1, len(actual))
+	require.Equal(t, msgGrantDelegation.Granter, actual[0])
+}
+
+// ----------------------
+// --- MsgRevokeDelegation
+// ----------------------
+
+func TestMsgRevokeDelegation_Route(t *testing.T) {
+	require.Equal(t, "profiles", msgRevokeDelegation.Route())
+}
+
+func TestMsgRevokeDelegation_Type(t *testing.T) {
+	require.Equal(t, "revoke_delegation", msgRevokeDelegation.Type())
+}
+
+func TestMsgRevokeDelegation_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   msgs.MsgRevokeDelegation
+		error error
+	}{
+		{
+			name:  "Empty granter returns error",
+			msg:   msgs.NewMsgRevokeDelegation(nil, grantee),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid granter address: "),
+		},
+		{
+			name:  "Empty grantee returns error",
+			msg:   msgs.NewMsgRevokeDelegation(user, nil),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid grantee address: "),
+		},
+		{
+			name:  "Valid message returns no error",
+			msg:   msgRevokeDelegation,
+			error: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			returnedError := test.msg.ValidateBasic()
+			if test.error == nil {
+				require.Nil(t, returnedError)
+			} else {
+				require.NotNil(t, returnedError)
+				require.Equal(t, test.error.Error(), returnedError.Error())
+			}
+		})
+	}
+}
+
+func TestMsgRevokeDelegation_GetSignBytes(t *testing.T) {
+	actual := msgRevokeDelegation.GetSignBytes()
+	expected := `{"type":"desmos/MsgRevokeDelegation","value":{"grantee":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","granter":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"}}`
+	require.Equal(t, expected, string(actual))
+}
+
+func TestMsgRevokeDelegation_GetSigners(t *testing.T) {
+	actual := msgRevokeDelegation.GetSigners()
+	require.Equal(t, 1, len(actual))
+	require.Equal(t, msgRevokeDelegation.Granter, actual[0])
+}
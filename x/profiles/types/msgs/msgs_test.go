@@ -73,6 +73,27 @@ func TestMsgSaveProfile_ValidateBasic(t *testing.T) {
 			msg:   msgs.NewMsgSaveProfile("", nil, nil, nil, nil, testProfile.Creator),
 			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "profile dtag cannot be empty or blank"),
 		},
+		{
+			name: "Profile picture hash without profile picture returns error",
+			msg: msgs.NewMsgSaveProfile("dtag", nil, nil, nil, nil, testProfile.Creator).
+				WithPicturesHashes(common.NewStrPtr("ae2d7cb4e5b3a27b0f4a1e0a0b1a7b9d53a6c1e8b6a7f52e3b6e3d5c1c0e7f9a"), nil),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "profile picture hash provided without a profile picture"),
+		},
+		{
+			name: "Cover picture hash without cover picture returns error",
+			msg: msgs.NewMsgSaveProfile("dtag", nil, nil, nil, nil, testProfile.Creator).
+				WithPicturesHashes(nil, common.NewStrPtr("ae2d7cb4e5b3a27b0f4a1e0a0b1a7b9d53a6c1e8b6a7f52e3b6e3d5c1c0e7f9a")),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "profile cover hash provided without a profile cover"),
+		},
+		{
+			name: "Duplicated custom field keys return error",
+			msg: msgs.NewMsgSaveProfile("dtag", nil, nil, nil, nil, testProfile.Creator).
+				WithCustomFields(models.CustomFields{
+					models.NewCustomField("location", "Lugano"),
+					models.NewCustomField("location", "Rome"),
+				}),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "duplicated custom field key: location"),
+		},
 		{
 			name: "No error message",
 			msg: msgs.NewMsgSaveProfile(
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,19 +16,27 @@ const (
 
 // Default profile paramsModule
 var (
-	DefaultMinMonikerLength = sdk.NewInt(2)
-	DefaultMaxMonikerLength = sdk.NewInt(1000) //longest name on earth count 954 chars
-	DefaultRegEx            = `^[A-Za-z0-9_]+$`
-	DefaultMinDTagLength    = sdk.NewInt(3)
-	DefaultMaxDTagLength    = sdk.NewInt(30)
-	DefaultMaxBioLength     = sdk.NewInt(1000)
+	DefaultMinMonikerLength  = sdk.NewInt(2)
+	DefaultMaxMonikerLength  = sdk.NewInt(1000) //longest name on earth count 954 chars
+	DefaultMaxMonikerBytes   = sdk.NewInt(4000) // 4 bytes for each one of the max allowed UTF-8 characters
+	DefaultRegEx             = `^[A-Za-z0-9_]+$`
+	DefaultMinDTagLength     = sdk.NewInt(3)
+	DefaultMaxDTagLength     = sdk.NewInt(30)
+	DefaultMaxBioLength      = sdk.NewInt(1000)
+	DefaultMaxBioBytes       = sdk.NewInt(4000)
+	DefaultAllowedURISchemes = []string{"http", "https", "ipfs"}
+	uriSchemeRegEx           = regexp.MustCompile(`^[a-z][a-z0-9+.\-]*$`)
 )
 
 // Parameters store keys
 var (
-	MonikerLenParamsKey = []byte("MonikerParams")
-	DtagLenParamsKey    = []byte("DtagParams")
-	MaxBioLenParamsKey  = []byte("MaxBioLen")
+	MonikerLenParamsKey        = []byte("MonikerParams")
+	DtagLenParamsKey           = []byte("DtagParams")
+	MaxBioLenParamsKey         = []byte("MaxBioLen")
+	MaxBioBytesParamsKey       = []byte("MaxBioBytes")
+	AllowedURISchemesParamsKey = []byte("AllowedURISchemes")
+	CustomFieldsParamsKey      = []byte("CustomFieldsParams")
+	CustomFieldsSchemasKey     = []byte("CustomFieldsSchemas")
 )
 
 // ParamKeyTable Key declaration for parameters
@@ -36,35 +45,57 @@ func ParamKeyTable() paramsModule.KeyTable {
 }
 
 type Params struct {
-	MonikerParams MonikerParams `json:"moniker_params" yaml:"moniker_params"`
-	DtagParams    DtagParams    `json:"dtag_params" yaml:"dtag_params"`
-	MaxBioLen     sdk.Int       `json:"max_bio_length" yaml:"max_bio_length"`
+	MonikerParams     MonikerParams `json:"moniker_params" yaml:"moniker_params"`
+	DtagParams        DtagParams    `json:"dtag_params" yaml:"dtag_params"`
+	MaxBioLen         sdk.Int       `json:"max_bio_length" yaml:"max_bio_length"`
+	MaxBioBytes       sdk.Int       `json:"max_bio_bytes" yaml:"max_bio_bytes"`
+	AllowedURISchemes []string      `json:"allowed_uri_schemes" yaml:"allowed_uri_schemes"`
+
+	CustomFieldsParams  CustomFieldsParams  `json:"custom_fields_params" yaml:"custom_fields_params"`
+	CustomFieldsSchemas CustomFieldsSchemas `json:"custom_fields_schemas" yaml:"custom_fields_schemas"`
 }
 
 // NewParams creates a new ProfileParams obj
-func NewParams(monikerLen MonikerParams, dtagLen DtagParams, maxBioLen sdk.Int) Params {
+func NewParams(
+	monikerLen MonikerParams, dtagLen DtagParams, maxBioLen, maxBioBytes sdk.Int, allowedURISchemes []string,
+	customFieldsParams CustomFieldsParams, customFieldsSchemas CustomFieldsSchemas,
+) Params {
 	return Params{
-		MonikerParams: monikerLen,
-		DtagParams:    dtagLen,
-		MaxBioLen:     maxBioLen,
+		MonikerParams:       monikerLen,
+		DtagParams:          dtagLen,
+		MaxBioLen:           maxBioLen,
+		MaxBioBytes:         maxBioBytes,
+		AllowedURISchemes:   allowedURISchemes,
+		CustomFieldsParams:  customFieldsParams,
+		CustomFieldsSchemas: customFieldsSchemas,
 	}
 }
 
 // DefaultParams return default paramsModule
 func DefaultParams() Params {
 	return Params{
-		MonikerParams: DefaultMonikerParams(),
-		DtagParams:    DefaultDtagParams(),
-		MaxBioLen:     DefaultMaxBioLength,
+		MonikerParams:     DefaultMonikerParams(),
+		DtagParams:        DefaultDtagParams(),
+		MaxBioLen:         DefaultMaxBioLength,
+		MaxBioBytes:       DefaultMaxBioBytes,
+		AllowedURISchemes: DefaultAllowedURISchemes,
+
+		CustomFieldsParams:  DefaultCustomFieldsParams(),
+		CustomFieldsSchemas: nil,
 	}
 }
 
 func (params Params) String() string {
 	out := "Profiles parameters:\n"
-	out += fmt.Sprintf("%s\n%s\nBiography params lengths:\nMax accepted length: %s\n",
+	out += fmt.Sprintf("%s\n%s\nBiography params lengths:\nMax accepted length: %s\nMax accepted bytes: %s\n"+
+		"Allowed URI schemes: %s\n%s\n%s\n",
 		params.MonikerParams.String(),
 		params.DtagParams.String(),
 		params.MaxBioLen,
+		params.MaxBioBytes,
+		strings.Join(params.AllowedURISchemes, ", "),
+		params.CustomFieldsParams.String(),
+		params.CustomFieldsSchemas.String(),
 	)
 
 	return strings.TrimSpace(out)
@@ -77,6 +108,10 @@ func (params *Params) ParamSetPairs() paramsModule.ParamSetPairs {
 		paramsModule.NewParamSetPair(MonikerLenParamsKey, &params.MonikerParams, ValidateMonikerParams),
 		paramsModule.NewParamSetPair(DtagLenParamsKey, &params.DtagParams, ValidateDtagParams),
 		paramsModule.NewParamSetPair(MaxBioLenParamsKey, &params.MaxBioLen, ValidateBioParams),
+		paramsModule.NewParamSetPair(MaxBioBytesParamsKey, &params.MaxBioBytes, ValidateBioBytesParams),
+		paramsModule.NewParamSetPair(AllowedURISchemesParamsKey, &params.AllowedURISchemes, ValidateURISchemesParams),
+		paramsModule.NewParamSetPair(CustomFieldsParamsKey, &params.CustomFieldsParams, ValidateCustomFieldsParams),
+		paramsModule.NewParamSetPair(CustomFieldsSchemasKey, &params.CustomFieldsSchemas, ValidateCustomFieldsSchemasParams),
 	}
 }
 
@@ -90,35 +125,56 @@ func (params Params) Validate() error {
 		return err
 	}
 
-	return ValidateBioParams(params.MaxBioLen)
+	if err := ValidateBioParams(params.MaxBioLen); err != nil {
+		return err
+	}
+
+	if err := ValidateBioBytesParams(params.MaxBioBytes); err != nil {
+		return err
+	}
+
+	if err := ValidateURISchemesParams(params.AllowedURISchemes); err != nil {
+		return err
+	}
+
+	if err := ValidateCustomFieldsParams(params.CustomFieldsParams); err != nil {
+		return err
+	}
+
+	return ValidateCustomFieldsSchemasParams(params.CustomFieldsSchemas)
 }
 
 // MonikerParams defines the paramsModule around moniker len
+// Lengths are expressed in characters (runes), while the max bytes are expressed
+// in the number of bytes of the UTF-8 encoded moniker
 type MonikerParams struct {
-	MinMonikerLen sdk.Int `json:"min_length" yaml:"min_length"`
-	MaxMonikerLen sdk.Int `json:"max_length" yaml:"max_length"`
+	MinMonikerLen   sdk.Int `json:"min_length" yaml:"min_length"`
+	MaxMonikerLen   sdk.Int `json:"max_length" yaml:"max_length"`
+	MaxMonikerBytes sdk.Int `json:"max_bytes" yaml:"max_bytes"`
 }
 
 // NewMonikerParams creates a new MonikerParams obj
-func NewMonikerParams(minLen, maxLen sdk.Int) MonikerParams {
+func NewMonikerParams(minLen, maxLen, maxBytes sdk.Int) MonikerParams {
 	return MonikerParams{
-		MinMonikerLen: minLen,
-		MaxMonikerLen: maxLen,
+		MinMonikerLen:   minLen,
+		MaxMonikerLen:   maxLen,
+		MaxMonikerBytes: maxBytes,
 	}
 }
 
 // DefaultMonikerParams return default moniker params
 func DefaultMonikerParams() MonikerParams {
 	return NewMonikerParams(DefaultMinMonikerLength,
-		DefaultMaxMonikerLength)
+		DefaultMaxMonikerLength, DefaultMaxMonikerBytes)
 }
 
 // String implements stringer interface
 func (params MonikerParams) String() string {
 	out := "Moniker params lengths:\n"
-	out += fmt.Sprintf("Min accepted length: %s\nMax accepted length: %s",
+	out += fmt.Sprintf("Min accepted length: %s\nMax accepted length: %s\nMax accepted bytes: %s",
 		params.MinMonikerLen,
 		params.MaxMonikerLen,
+		params.MaxMonikerBytes,
 	)
 
 	return strings.TrimSpace(out)
@@ -140,6 +196,10 @@ func ValidateMonikerParams(i interface{}) error {
 		return fmt.Errorf("invalid max moniker length param: %s", params.MaxMonikerLen)
 	}
 
+	if params.MaxMonikerBytes.LT(params.MinMonikerLen) {
+		return fmt.Errorf("invalid max moniker bytes param: %s", params.MaxMonikerBytes)
+	}
+
 	return nil
 }
 
@@ -213,3 +273,35 @@ func ValidateBioParams(i interface{}) error {
 
 	return nil
 }
+
+func ValidateBioBytesParams(i interface{}) error {
+	bioBytes, isBioBytesParams := i.(sdk.Int)
+	if !isBioBytesParams {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	if bioBytes.IsNegative() {
+		return fmt.Errorf("invalid max bio bytes param: %s", bioBytes)
+	}
+
+	return nil
+}
+
+func ValidateURISchemesParams(i interface{}) error {
+	schemes, isSchemesParams := i.([]string)
+	if !isSchemesParams {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	if len(schemes) == 0 {
+		return fmt.Errorf("allowed uri schemes param cannot be empty")
+	}
+
+	for _, scheme := range schemes {
+		if !uriSchemeRegEx.MatchString(scheme) {
+			return fmt.Errorf("invalid allowed uri scheme param: %s", scheme)
+		}
+	}
+
+	return nil
+}
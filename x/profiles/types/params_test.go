@@ -10,18 +10,18 @@ import (
 )
 
 func TestDefaultParams(t *testing.T) {
-	nameSurnameParams := types.NewMonikerParams(sdk.NewInt(2), sdk.NewInt(1000))
+	nameSurnameParams := types.NewMonikerParams(sdk.NewInt(2), sdk.NewInt(1000), types.DefaultMaxMonikerBytes)
 	monikerParams := types.NewDtagParams("^[A-Za-z0-9_]+$", sdk.NewInt(3), sdk.NewInt(30))
 	bioParams := sdk.NewInt(1000)
 
-	params := types.NewParams(nameSurnameParams, monikerParams, bioParams)
+	params := types.NewParams(nameSurnameParams, monikerParams, bioParams, types.DefaultMaxBioBytes, types.DefaultAllowedURISchemes, types.DefaultCustomFieldsParams(), nil)
 
 	require.Equal(t, params, types.DefaultParams())
 }
 
 func TestParams_String(t *testing.T) {
 	params := types.DefaultParams()
-	require.Equal(t, "Profiles parameters:\nMoniker params lengths:\nMin accepted length: 2\nMax accepted length: 1000\nDtag params:\nRegEx: ^[A-Za-z0-9_]+$\nMin accepted length: 3\nMax accepted length: 30\nBiography params lengths:\nMax accepted length: 1000", params.String())
+	require.Equal(t, "Profiles parameters:\nMoniker params lengths:\nMin accepted length: 2\nMax accepted length: 1000\nMax accepted bytes: 4000\nDtag params:\nRegEx: ^[A-Za-z0-9_]+$\nMin accepted length: 3\nMax accepted length: 30\nBiography params lengths:\nMax accepted length: 1000\nMax accepted bytes: 4000\nAllowed URI schemes: http, https, ipfs\nCustom fields params:\nMax fields: 10\nMax key length: 30\nMax value length: 500\nKey RegEx: ^[a-z0-9_]+$\nCustom fields schemas:", params.String())
 }
 
 func TestValidateParams(t *testing.T) {
@@ -37,22 +37,22 @@ func TestValidateParams(t *testing.T) {
 	}{
 		{
 			name:   "Invalid min moniker param returns error",
-			params: types.NewParams(types.NewMonikerParams(invalidNameMin, validNameMax), types.DefaultDtagParams(), types.DefaultMaxBioLength),
+			params: types.NewParams(types.NewMonikerParams(invalidNameMin, validNameMax, types.DefaultMaxMonikerBytes), types.DefaultDtagParams(), types.DefaultMaxBioLength, types.DefaultMaxBioBytes, types.DefaultAllowedURISchemes, types.DefaultCustomFieldsParams(), nil),
 			expErr: fmt.Errorf("invalid minimum moniker length param: 1"),
 		},
 		{
 			name:   "Invalid max dTag param return error",
-			params: types.NewParams(types.DefaultMonikerParams(), types.NewDtagParams("regEx", validDtagMin, invalidDtagMax), types.DefaultMaxBioLength),
+			params: types.NewParams(types.DefaultMonikerParams(), types.NewDtagParams("regEx", validDtagMin, invalidDtagMax), types.DefaultMaxBioLength, types.DefaultMaxBioBytes, types.DefaultAllowedURISchemes, types.DefaultCustomFieldsParams(), nil),
 			expErr: fmt.Errorf("invalid max dTag length param: -30"),
 		},
 		{
 			name:   "Invalid max param returns error",
-			params: types.NewParams(types.DefaultMonikerParams(), types.DefaultDtagParams(), sdk.NewInt(-1000)),
+			params: types.NewParams(types.DefaultMonikerParams(), types.DefaultDtagParams(), sdk.NewInt(-1000), types.DefaultMaxBioBytes, types.DefaultAllowedURISchemes, types.DefaultCustomFieldsParams(), nil),
 			expErr: fmt.Errorf("invalid max bio length param: -1000"),
 		},
 		{
 			name:   "Valid params return no error",
-			params: types.NewParams(types.DefaultMonikerParams(), types.DefaultDtagParams(), types.DefaultMaxBioLength),
+			params: types.NewParams(types.DefaultMonikerParams(), types.DefaultDtagParams(), types.DefaultMaxBioLength, types.DefaultMaxBioBytes, types.DefaultAllowedURISchemes, types.DefaultCustomFieldsParams(), nil),
 			expErr: nil,
 		},
 	}
@@ -66,7 +66,7 @@ func TestValidateParams(t *testing.T) {
 }
 
 func TestDefaultMonikerParams(t *testing.T) {
-	monikerParams := types.NewMonikerParams(sdk.NewInt(2), sdk.NewInt(1000))
+	monikerParams := types.NewMonikerParams(sdk.NewInt(2), sdk.NewInt(1000), types.DefaultMaxMonikerBytes)
 	defaultMonikerParams := types.DefaultMonikerParams()
 	require.Equal(t, defaultMonikerParams, monikerParams)
 }
@@ -78,9 +78,9 @@ func TestDefaultDTagParams(t *testing.T) {
 }
 
 func TestMonikerParams_String(t *testing.T) {
-	monikerParams := types.NewMonikerParams(sdk.NewInt(2), sdk.NewInt(1000))
+	monikerParams := types.NewMonikerParams(sdk.NewInt(2), sdk.NewInt(1000), types.DefaultMaxMonikerBytes)
 	actual := monikerParams.String()
-	require.Equal(t, "Moniker params lengths:\nMin accepted length: 2\nMax accepted length: 1000", actual)
+	require.Equal(t, "Moniker params lengths:\nMin accepted length: 2\nMax accepted length: 1000\nMax accepted bytes: 4000", actual)
 }
 
 func TestDTagParams_String(t *testing.T) {
@@ -102,17 +102,22 @@ func TestValidateMonikerParams(t *testing.T) {
 	}{
 		{
 			name:   "Invalid min param returns error",
-			params: types.NewMonikerParams(invalidMonikerMin, validMonikerMax),
+			params: types.NewMonikerParams(invalidMonikerMin, validMonikerMax, types.DefaultMaxMonikerBytes),
 			expErr: fmt.Errorf("invalid minimum moniker length param: 1"),
 		},
 		{
 			name:   "Invalid max param returns error",
-			params: types.NewMonikerParams(validMonikerMin, invalidMonikerMax),
+			params: types.NewMonikerParams(validMonikerMin, invalidMonikerMax, types.DefaultMaxMonikerBytes),
 			expErr: fmt.Errorf("invalid max moniker length param: -10"),
 		},
+		{
+			name:   "Invalid max bytes param returns error",
+			params: types.NewMonikerParams(validMonikerMin, validMonikerMax, sdk.NewInt(1)),
+			expErr: fmt.Errorf("invalid max moniker bytes param: 1"),
+		},
 		{
 			name:   "Valid params returns no error",
-			params: types.NewMonikerParams(validMonikerMin, validMonikerMax),
+			params: types.NewMonikerParams(validMonikerMin, validMonikerMax, types.DefaultMaxMonikerBytes),
 			expErr: nil,
 		},
 	}
@@ -193,3 +198,60 @@ func TestValidateBioParams(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBioBytesParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params interface{}
+		expErr error
+	}{
+		{
+			name:   "Invalid max bytes param returns error",
+			params: sdk.NewInt(-4000),
+			expErr: fmt.Errorf("invalid max bio bytes param: -4000"),
+		},
+		{
+			name:   "Valid params returns no error",
+			params: sdk.NewInt(4000),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, types.ValidateBioBytesParams(test.params))
+		})
+	}
+}
+
+func TestValidateURISchemesParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params interface{}
+		expErr error
+	}{
+		{
+			name:   "Empty schemes returns error",
+			params: []string{},
+			expErr: fmt.Errorf("allowed uri schemes param cannot be empty"),
+		},
+		{
+			name:   "Invalid scheme returns error",
+			params: []string{"https", "ipfs://"},
+			expErr: fmt.Errorf("invalid allowed uri scheme param: ipfs://"),
+		},
+		{
+			name:   "Valid params returns no error",
+			params: []string{"https", "ipfs"},
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, types.ValidateURISchemesParams(test.params))
+		})
+	}
+}
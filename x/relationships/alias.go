@@ -12,34 +12,76 @@ import (
 )
 
 const (
-	ModuleName               = models.ModuleName
-	RouterKey                = models.RouterKey
-	StoreKey                 = models.StoreKey
-	ActionCreateRelationship = models.ActionCreateRelationship
-	ActionDeleteRelationship = models.ActionDeleteRelationship
-	QuerierRoute             = models.QuerierRoute
-	QueryUserRelationships   = models.QueryUserRelationships
-	QueryRelationships       = models.QueryRelationships
+	ModuleName                       = models.ModuleName
+	RouterKey                        = models.RouterKey
+	StoreKey                         = models.StoreKey
+	ActionCreateRelationship         = models.ActionCreateRelationship
+	ActionDeleteRelationship         = models.ActionDeleteRelationship
+	ActionRequestRelationship        = models.ActionRequestRelationship
+	ActionAcceptRelationshipRequest  = models.ActionAcceptRelationshipRequest
+	ActionDeclineRelationshipRequest = models.ActionDeclineRelationshipRequest
+	ActionCancelRelationshipRequest  = models.ActionCancelRelationshipRequest
+	ActionBlockUser                  = models.ActionBlockUser
+	ActionUnblockUser                = models.ActionUnblockUser
+	QuerierRoute                     = models.QuerierRoute
+	QueryUserRelationships           = models.QueryUserRelationships
+	QueryRelationships               = models.QueryRelationships
+	QueryRelationshipRequests        = models.QueryRelationshipRequests
+	QueryUserBlocks                  = models.QueryUserBlocks
+	QueryFollowers                   = models.QueryFollowers
+	QueryRelationshipsCounts         = models.QueryRelationshipsCounts
+	QueryMutuals                     = models.QueryMutuals
+	QuerySuggestions                 = models.QuerySuggestions
+	QueryUsersPath                   = models.QueryUsersPath
+
+	RelationshipTypeFollow      = models.RelationshipTypeFollow
+	RelationshipTypeFriend      = models.RelationshipTypeFriend
+	RelationshipTypeCloseFriend = models.RelationshipTypeCloseFriend
 )
 
 var (
 	// functions aliases
-	NewHandler                   = keeper.NewHandler
-	NewKeeper                    = keeper.NewKeeper
-	NewQuerier                   = keeper.NewQuerier
-	NewRelationshipResponse      = models.NewRelationshipResponse
-	RelationshipsStoreKey        = models.RelationshipsStoreKey
-	RegisterModelsCodec          = models.RegisterModelsCodec
-	NewMsgCreateRelationship     = msgs.NewMsgCreateRelationship
-	NewMsgDeleteRelationship     = msgs.NewMsgDeleteRelationship
-	RegisterMessagesCodec        = msgs.RegisterMessagesCodec
-	GetQueryCmd                  = cli.GetQueryCmd
-	GetCmdQueryRelationships     = cli.GetCmdQueryRelationships
-	GetCmdQueryUserRelationships = cli.GetCmdQueryUserRelationships
-	GetTxCmd                     = cli.GetTxCmd
-	GetCmdCreateRelationship     = cli.GetCmdCreateRelationship
-	GetCmdDeleteUserRelationship = cli.GetCmdDeleteRelationship
-	RegisterRoutes               = rest.RegisterRoutes
+	NewHandler                       = keeper.NewHandler
+	NewKeeper                        = keeper.NewKeeper
+	NewQuerier                       = keeper.NewQuerier
+	RegisterInvariants               = keeper.RegisterInvariants
+	AllInvariants                    = keeper.AllInvariants
+	NewRelationshipResponse          = models.NewRelationshipResponse
+	RelationshipsStoreKey            = models.RelationshipsStoreKey
+	NewRelationship                  = models.NewRelationship
+	RegisterModelsCodec              = models.RegisterModelsCodec
+	NewMsgCreateRelationship         = msgs.NewMsgCreateRelationship
+	NewMsgDeleteRelationship         = msgs.NewMsgDeleteRelationship
+	NewMsgRequestRelationship        = msgs.NewMsgRequestRelationship
+	NewMsgAcceptRelationshipRequest  = msgs.NewMsgAcceptRelationshipRequest
+	NewMsgDeclineRelationshipRequest = msgs.NewMsgDeclineRelationshipRequest
+	NewMsgCancelRelationshipRequest  = msgs.NewMsgCancelRelationshipRequest
+	NewMsgBlockUser                  = msgs.NewMsgBlockUser
+	NewMsgUnblockUser                = msgs.NewMsgUnblockUser
+	NewUserBlock                     = models.NewUserBlock
+	NewFollower                      = models.NewFollower
+	NewRelationshipRequest           = models.NewRelationshipRequest
+	RegisterMessagesCodec            = msgs.RegisterMessagesCodec
+	GetQueryCmd                      = cli.GetQueryCmd
+	GetCmdQueryRelationships         = cli.GetCmdQueryRelationships
+	GetCmdQueryUserRelationships     = cli.GetCmdQueryUserRelationships
+	GetTxCmd                         = cli.GetTxCmd
+	GetCmdCreateRelationship         = cli.GetCmdCreateRelationship
+	GetCmdDeleteUserRelationship     = cli.GetCmdDeleteRelationship
+	GetCmdRequestRelationship        = cli.GetCmdRequestRelationship
+	GetCmdAcceptRelationshipRequest  = cli.GetCmdAcceptRelationshipRequest
+	GetCmdDeclineRelationshipRequest = cli.GetCmdDeclineRelationshipRequest
+	GetCmdCancelRelationshipRequest  = cli.GetCmdCancelRelationshipRequest
+	GetCmdQueryRelationshipRequests  = cli.GetCmdQueryRelationshipRequests
+	GetCmdBlockUser                  = cli.GetCmdBlockUser
+	GetCmdUnblockUser                = cli.GetCmdUnblockUser
+	GetCmdQueryUserBlocks            = cli.GetCmdQueryUserBlocks
+	GetCmdQueryFollowers             = cli.GetCmdQueryFollowers
+	GetCmdQueryRelationshipsCounts   = cli.GetCmdQueryRelationshipsCounts
+	GetCmdQueryMutuals               = cli.GetCmdQueryMutuals
+	GetCmdQuerySuggestions           = cli.GetCmdQuerySuggestions
+	GetCmdQueryUsersPath             = cli.GetCmdQueryUsersPath
+	RegisterRoutes                   = rest.RegisterRoutes
 
 	// variable aliases
 	RelationshipsStorePrefix = models.RelationshipsStorePrefix
@@ -48,8 +90,29 @@ var (
 )
 
 type (
-	CommonRelationshipReq = rest.CommonRelationshipReq
-	Keeper                = keeper.Keeper
-	MsgCreateRelationship = msgs.MsgCreateRelationship
-	MsgDeleteRelationship = msgs.MsgDeleteRelationship
+	CommonRelationshipReq         = rest.CommonRelationshipReq
+	Keeper                        = keeper.Keeper
+	MsgCreateRelationship         = msgs.MsgCreateRelationship
+	Relationship                  = models.Relationship
+	Relationships                 = models.Relationships
+	RelationshipType              = models.RelationshipType
+	MsgDeleteRelationship         = msgs.MsgDeleteRelationship
+	MsgRequestRelationship        = msgs.MsgRequestRelationship
+	MsgAcceptRelationshipRequest  = msgs.MsgAcceptRelationshipRequest
+	MsgDeclineRelationshipRequest = msgs.MsgDeclineRelationshipRequest
+	MsgCancelRelationshipRequest  = msgs.MsgCancelRelationshipRequest
+	MsgBlockUser                  = msgs.MsgBlockUser
+	MsgUnblockUser                = msgs.MsgUnblockUser
+	UserBlock                     = models.UserBlock
+	UserBlocks                    = models.UserBlocks
+	Follower                      = models.Follower
+	Followers                     = models.Followers
+	RelationshipsCounts           = models.RelationshipsCounts
+	Suggestion                    = models.Suggestion
+	Suggestions                   = models.Suggestions
+	UsersPath                     = models.UsersPath
+	RelationshipRequest           = models.RelationshipRequest
+	RelationshipRequests          = models.RelationshipRequests
+	RelationshipRequestReq        = rest.RelationshipRequestReq
+	UserBlockReq                  = rest.UserBlockReq
 )
@@ -8,8 +8,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/desmos-labs/desmos/x/relationships/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // GetQueryCmd adds the query commands
@@ -24,6 +26,13 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.AddCommand(flags.GetCommands(
 		GetCmdQueryUserRelationships(cdc),
 		GetCmdQueryRelationships(cdc),
+		GetCmdQueryRelationshipRequests(cdc),
+		GetCmdQueryUserBlocks(cdc),
+		GetCmdQueryFollowers(cdc),
+		GetCmdQueryRelationshipsCounts(cdc),
+		GetCmdQueryMutuals(cdc),
+		GetCmdQuerySuggestions(cdc),
+		GetCmdQueryUsersPath(cdc),
 	)...)
 	return cmd
 }
@@ -43,7 +52,7 @@ func GetCmdQueryRelationships(cdc *codec.Codec) *cobra.Command {
 				return nil
 			}
 
-			var out map[string][]sdk.AccAddress
+			var out map[string]types.Relationships
 			cdc.MustUnmarshalJSON(res, &out)
 			return cliCtx.PrintOutput(out)
 		},
@@ -52,15 +61,25 @@ func GetCmdQueryRelationships(cdc *codec.Codec) *cobra.Command {
 
 // GetCmdQueryUserRelationships queries all the profiles' users' relationships
 func GetCmdQueryUserRelationships(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "user [address]",
-		Short: "Retrieve all the user's relationships",
+		Short: "Retrieve all the user's relationships, optionally filtered by subspace and type",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
+			params := types.NewQueryUserRelationshipsParams(
+				viper.GetString(flagSubspace),
+				types.RelationshipType(viper.GetString(flagRelationshipType)),
+			)
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
 			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryUserRelationships, args[0])
-			res, _, err := cliCtx.QueryWithData(route, nil)
+			res, _, err := cliCtx.QueryWithData(route, bz)
 			if err != nil {
 				fmt.Printf("Could not find any relationship associated with the given address %s", args[0])
 				return nil
@@ -71,4 +90,247 @@ func GetCmdQueryUserRelationships(cdc *codec.Codec) *cobra.Command {
 			return cliCtx.PrintOutput(out)
 		},
 	}
+
+	cmd.Flags().String(flagSubspace, "", "(optional) filter the relationships by subspace")
+	cmd.Flags().String(flagRelationshipType, "", "(optional) filter the relationships by type")
+
+	return cmd
+}
+
+// GetCmdQueryRelationshipRequests queries all the pending relationship requests of a user
+func GetCmdQueryRelationshipRequests(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "requests [address]",
+		Short: "Retrieve all the pending relationship requests that the user has received and sent",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryRelationshipRequests, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf("Could not find any relationship request associated with the given address %s", args[0])
+				return nil
+			}
+
+			var out types.RelationshipRequestsResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryUserBlocks queries all the users blocked by the given user
+func GetCmdQueryUserBlocks(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "blocks [address]",
+		Short: "Retrieve all the users that the given user has blocked, along with the reasons",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryUserBlocks, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf("Could not find any block associated with the given address %s", args[0])
+				return nil
+			}
+
+			var out types.UserBlocks
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryFollowers queries the users that have created a relationship towards the given user
+func GetCmdQueryFollowers(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "followers [address]",
+		Short: "Retrieve the users having a relationship with the given user, optionally filtered by subspace and type",
+		Long: fmt.Sprintf(`
+Retrieve the users that have created a relationship towards the given user.
+The followers can be filtered by subspace and type, and are returned using pagination.
+
+E.g.
+%s query relationships followers desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud --subspace=4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e --page=2 --limit=50
+`, version.ClientName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryFollowersParams(
+				viper.GetString(flagSubspace),
+				types.RelationshipType(viper.GetString(flagRelationshipType)),
+				viper.GetInt(flagPage),
+				viper.GetInt(flagNumLimit),
+			)
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryFollowers, args[0])
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Printf("Could not find any follower associated with the given address %s", args[0])
+				return nil
+			}
+
+			var out types.Followers
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(flagSubspace, "", "(optional) filter the followers by subspace")
+	cmd.Flags().String(flagRelationshipType, "", "(optional) filter the followers by relationship type")
+	cmd.Flags().Int(flagPage, 1, "pagination page of followers to query for")
+	cmd.Flags().Int(flagNumLimit, 100, "pagination limit of followers to query for")
+
+	return cmd
+}
+
+// GetCmdQueryRelationshipsCounts queries the followers and following counts of the given user
+func GetCmdQueryRelationshipsCounts(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "counts [address]",
+		Short: "Retrieve the number of followers of the given user and the number of users he is following",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryRelationshipsCountsParams(viper.GetString(flagSubspace))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryRelationshipsCounts, args[0])
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Printf("Could not get the relationships counts of the given address %s", args[0])
+				return nil
+			}
+
+			var out types.RelationshipsCounts
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(flagSubspace, "", "(optional) count only the relationships inside the given subspace")
+
+	return cmd
+}
+
+// GetCmdQueryMutuals queries the users with which both the given users have a relationship
+func GetCmdQueryMutuals(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mutuals [address] [other-address]",
+		Short: "Retrieve the users with which both the given users have a relationship",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryMutualsParams(viper.GetString(flagSubspace))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, types.QueryMutuals, args[0], args[1])
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Printf("Could not get the mutuals of the given addresses: %s", err)
+				return nil
+			}
+
+			var out []sdk.AccAddress
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(flagSubspace, "", "(optional) consider only the relationships inside the given subspace")
+
+	return cmd
+}
+
+// GetCmdQuerySuggestions queries the users that the given user might want to create a relationship with
+func GetCmdQuerySuggestions(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "suggestions [address]",
+		Short: "Retrieve the users related to the ones the given user is related to, ranked by overlap",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQuerySuggestionsParams(viper.GetString(flagSubspace), viper.GetInt(flagNumLimit))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QuerySuggestions, args[0])
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Printf("Could not get the suggestions of the given address: %s", err)
+				return nil
+			}
+
+			var out types.Suggestions
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(flagSubspace, "", "(optional) consider only the relationships inside the given subspace")
+	cmd.Flags().Int(flagNumLimit, types.DefaultSuggestionsLimit,
+		fmt.Sprintf("number of suggestions to return, at most %d", types.MaxSuggestionsLimit))
+
+	return cmd
+}
+
+// GetCmdQueryUsersPath queries whether a user is connected to another one through a chain of relationships
+func GetCmdQueryUsersPath(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "path [from-address] [to-address]",
+		Short: "Tell whether a user is connected to another one through a chain of relationships",
+		Long: fmt.Sprintf(`
+Tell whether the first user is connected to the second one through a chain of at most --%s relationships.
+The search visits a bounded number of users, and the result tells whether such bound has been reached.
+
+E.g.
+%s query relationships path desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud desmos1jsdcqvglv9zcxz5h9p0rzscwk9zm7qq2yvt03d --%s=4
+`, flagMaxHops, version.ClientName, flagMaxHops),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewQueryUsersPathParams(viper.GetString(flagSubspace), viper.GetUint64(flagMaxHops))
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s/%s", types.QuerierRoute, types.QueryUsersPath, args[0], args[1])
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Printf("Could not get the path between the given addresses: %s", err)
+				return nil
+			}
+
+			var out types.UsersPath
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+
+	cmd.Flags().String(flagSubspace, "", "(optional) consider only the relationships inside the given subspace")
+	cmd.Flags().Uint64(flagMaxHops, types.DefaultPathMaxHops,
+		fmt.Sprintf("maximum number of relationships in the path, at most %d", types.MaxPathMaxHops))
+
+	return cmd
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,9 +13,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagActingAs         = "acting-as"
+	flagRelationshipType = "type"
+	flagSubspace         = "subspace"
+	flagPage             = "page"
+	flagNumLimit         = "limit"
+	flagMaxHops          = "max-hops"
 )
 
 // GetTxCmd set the tx commands
@@ -30,17 +42,43 @@ func GetTxCmd(_ string, cdc *codec.Codec) *cobra.Command {
 	cmd.AddCommand(flags.PostCommands(
 		GetCmdCreateRelationship(cdc),
 		GetCmdDeleteRelationship(cdc),
+		GetCmdRequestRelationship(cdc),
+		GetCmdAcceptRelationshipRequest(cdc),
+		GetCmdDeclineRelationshipRequest(cdc),
+		GetCmdCancelRelationshipRequest(cdc),
+		GetCmdBlockUser(cdc),
+		GetCmdUnblockUser(cdc),
 	)...)
 
 	return cmd
 }
 
+// getActingAs parses the address of the profile on whose behalf the message should be performed.
+// If no address is specified, it returns `nil` instead.
+func getActingAs() (sdk.AccAddress, error) {
+	actingAs := viper.GetString(flagActingAs)
+	if len(strings.TrimSpace(actingAs)) == 0 {
+		return nil, nil
+	}
+
+	return sdk.AccAddressFromBech32(actingAs)
+}
+
 // GetCmdCreateRelationship is the CLI command for creating a relationship
 func GetCmdCreateRelationship(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create [receiver]",
-		Short: "Create a relationship with the given receiver address",
-		Args:  cobra.ExactArgs(1),
+		Use:   "create [receiver] [subspace]",
+		Short: "Create a relationship with the given receiver address inside the given subspace",
+		Long: fmt.Sprintf(`
+Create a one-way relationship with the given receiver inside the given subspace.
+By default, the created relationship is a %s. You can specify a different type using the --%s flag.
+Mutual relationships such as %s and %s must be requested to the receiver using the request command instead.
+
+E.g.
+%s tx relationships create desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud 4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e
+`, types.RelationshipTypeFollow, flagRelationshipType, types.RelationshipTypeFriend, types.RelationshipTypeCloseFriend,
+			version.ClientName),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -51,21 +89,31 @@ func GetCmdCreateRelationship(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgCreateRelationship(cliCtx.FromAddress, receiver)
+			relType := types.RelationshipType(viper.GetString(flagRelationshipType))
+			msg := types.NewMsgCreateRelationship(cliCtx.FromAddress, receiver, args[1], relType)
+
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
 
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
+	cmd.Flags().String(flagRelationshipType, string(types.RelationshipTypeFollow), "Type of the relationship")
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
+
 	return cmd
 }
 
 // GetCmdDeleteRelationship is the CLI command for deleting a relationship
 func GetCmdDeleteRelationship(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "delete [receiver]",
-		Short: "Delete the relationship with the given user",
-		Args:  cobra.ExactArgs(1),
+		Use:   "delete [receiver] [subspace]",
+		Short: "Delete the relationship with the given user inside the given subspace",
+		Args:  cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -76,10 +124,242 @@ func GetCmdDeleteRelationship(cdc *codec.Codec) *cobra.Command {
 				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid receiver address: %s", receiver))
 			}
 
-			msg := types.NewMsgDeleteRelationship(cliCtx.FromAddress, receiver)
+			msg := types.NewMsgDeleteRelationship(cliCtx.FromAddress, receiver, args[1])
+
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
 
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
+
+	return cmd
+}
+
+// GetCmdRequestRelationship is the CLI command for requesting a mutual relationship
+func GetCmdRequestRelationship(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request [receiver] [subspace]",
+		Short: "Request a mutual relationship to the given receiver address inside the given subspace",
+		Long: fmt.Sprintf(`
+Request a mutual relationship to the given receiver inside the given subspace.
+The relationship will be created only once the receiver accepts the request.
+By default, the requested relationship is a %s. You can specify a different type using the --%s flag.
+The supported types are: %s and %s.
+
+E.g.
+%s tx relationships request desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud 4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e --type close_friend
+`, types.RelationshipTypeFriend, flagRelationshipType, types.RelationshipTypeFriend, types.RelationshipTypeCloseFriend,
+			version.ClientName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			receiver, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			relType := types.RelationshipType(viper.GetString(flagRelationshipType))
+			msg := types.NewMsgRequestRelationship(cliCtx.FromAddress, receiver, args[1], relType)
+
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagRelationshipType, string(types.RelationshipTypeFriend), "Type of the relationship")
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
+
+	return cmd
+}
+
+// GetCmdAcceptRelationshipRequest is the CLI command for accepting a relationship request
+func GetCmdAcceptRelationshipRequest(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept [sender] [subspace]",
+		Short: "Accept the relationship request received from the given sender inside the given subspace",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			sender, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptRelationshipRequest(cliCtx.FromAddress, sender, args[1])
+
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
+
+	return cmd
+}
+
+// GetCmdDeclineRelationshipRequest is the CLI command for declining a relationship request
+func GetCmdDeclineRelationshipRequest(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decline [sender] [subspace]",
+		Short: "Decline the relationship request received from the given sender inside the given subspace",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			sender, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeclineRelationshipRequest(cliCtx.FromAddress, sender, args[1])
+
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
+
+	return cmd
+}
+
+// GetCmdCancelRelationshipRequest is the CLI command for canceling a relationship request
+func GetCmdCancelRelationshipRequest(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [receiver] [subspace]",
+		Short: "Cancel the relationship request sent to the given receiver inside the given subspace",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			receiver, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelRelationshipRequest(cliCtx.FromAddress, receiver, args[1])
+
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
+
+	return cmd
+}
+
+// GetCmdBlockUser is the CLI command for blocking a user
+func GetCmdBlockUser(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block [address] [[reason]]",
+		Short: "Block the user with the given address, optionally specifying the reason why",
+		Long: fmt.Sprintf(`
+Block the user with the given address, optionally specifying the reason why.
+Blocked users cannot comment on your posts, react to them or create relationships with you.
+Blocking a user also removes all the relationships and pending relationship requests existing between you.
+
+E.g.
+%s tx relationships block desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud "Spam"
+`, version.ClientName),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			blocked, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var reason string
+			if len(args) == 2 {
+				reason = args[1]
+			}
+
+			msg := types.NewMsgBlockUser(cliCtx.FromAddress, blocked, reason)
+
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
+
+	return cmd
+}
+
+// GetCmdUnblockUser is the CLI command for unblocking a user
+func GetCmdUnblockUser(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unblock [address]",
+		Short: "Unblock the user with the given address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			blocked, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnblockUser(cliCtx.FromAddress, blocked)
+
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
+
 	return cmd
 }
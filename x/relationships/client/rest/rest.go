@@ -2,6 +2,7 @@ package rest
 
 import (
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
 )
@@ -14,6 +15,29 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 
 // CommonRelationshipReq defines the properties of a create relationship operation request's body
 type CommonRelationshipReq struct {
-	BaseReq  rest.BaseReq `json:"base_req"`
-	Receiver string       `json:"receiver"`
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Receiver string         `json:"receiver"`
+	Subspace string         `json:"subspace"`
+	Type     string         `json:"type,omitempty"`
+	ActingAs sdk.AccAddress `json:"acting_as,omitempty"`
+}
+
+// RelationshipRequestReq defines the properties of a relationship request operation request's body.
+// Counterparty represents the receiver of the request when requesting or canceling it,
+// and its sender when accepting or declining it
+type RelationshipRequestReq struct {
+	BaseReq      rest.BaseReq   `json:"base_req"`
+	Counterparty string         `json:"counterparty"`
+	Subspace     string         `json:"subspace"`
+	Type         string         `json:"type,omitempty"`
+	ActingAs     sdk.AccAddress `json:"acting_as,omitempty"`
+}
+
+// UserBlockReq defines the properties of a block or unblock user operation request's body.
+// The reason is used only when blocking the user
+type UserBlockReq struct {
+	BaseReq  rest.BaseReq   `json:"base_req"`
+	Blocked  string         `json:"blocked"`
+	Reason   string         `json:"reason,omitempty"`
+	ActingAs sdk.AccAddress `json:"acting_as,omitempty"`
 }
@@ -14,6 +14,12 @@ import (
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/relationships", createRelationshipHandler(cliCtx)).Methods("POST")
 	r.HandleFunc("/relationships", deleteRelationshipHandler(cliCtx)).Methods("DELETE")
+	r.HandleFunc("/relationships/requests", relationshipRequestHandler(cliCtx, requestRelationship)).Methods("POST")
+	r.HandleFunc("/relationships/requests", relationshipRequestHandler(cliCtx, cancelRelationshipRequest)).Methods("DELETE")
+	r.HandleFunc("/relationships/requests/accept", relationshipRequestHandler(cliCtx, acceptRelationshipRequest)).Methods("POST")
+	r.HandleFunc("/relationships/requests/decline", relationshipRequestHandler(cliCtx, declineRelationshipRequest)).Methods("POST")
+	r.HandleFunc("/relationships/blocks", userBlockHandler(cliCtx, blockUser)).Methods("POST")
+	r.HandleFunc("/relationships/blocks", userBlockHandler(cliCtx, unblockUser)).Methods("DELETE")
 }
 
 func createRelationshipHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -42,7 +48,13 @@ func createRelationshipHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgCreateRelationship(sender, receiver)
+		relType := types.RelationshipTypeFollow
+		if req.Type != "" {
+			relType = types.RelationshipType(req.Type)
+		}
+
+		msg := types.NewMsgCreateRelationship(sender, receiver, req.Subspace, relType)
+		msg = msg.WithActingAs(req.ActingAs)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -75,10 +87,120 @@ func deleteRelationshipHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		receiver, err := sdk.AccAddressFromBech32(req.Receiver)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, "invalid receiver given")
+			return
+		}
+
+		msg := types.NewMsgDeleteRelationship(user, receiver, req.Subspace)
+		msg = msg.WithActingAs(req.ActingAs)
+
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+// relationshipRequestMsgBuilder builds the message associated to a relationship request operation
+// performed by the given signer with the given counterparty
+type relationshipRequestMsgBuilder func(signer, counterparty sdk.AccAddress, req RelationshipRequestReq) sdk.Msg
+
+func requestRelationship(signer, counterparty sdk.AccAddress, req RelationshipRequestReq) sdk.Msg {
+	relType := types.RelationshipTypeFriend
+	if req.Type != "" {
+		relType = types.RelationshipType(req.Type)
+	}
+	return types.NewMsgRequestRelationship(signer, counterparty, req.Subspace, relType).WithActingAs(req.ActingAs)
+}
+
+func acceptRelationshipRequest(signer, counterparty sdk.AccAddress, req RelationshipRequestReq) sdk.Msg {
+	return types.NewMsgAcceptRelationshipRequest(signer, counterparty, req.Subspace).WithActingAs(req.ActingAs)
+}
+
+func declineRelationshipRequest(signer, counterparty sdk.AccAddress, req RelationshipRequestReq) sdk.Msg {
+	return types.NewMsgDeclineRelationshipRequest(signer, counterparty, req.Subspace).WithActingAs(req.ActingAs)
+}
+
+func cancelRelationshipRequest(signer, counterparty sdk.AccAddress, req RelationshipRequestReq) sdk.Msg {
+	return types.NewMsgCancelRelationshipRequest(signer, counterparty, req.Subspace).WithActingAs(req.ActingAs)
+}
+
+func relationshipRequestHandler(cliCtx context.CLIContext, buildMsg relationshipRequestMsgBuilder) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RelationshipRequestReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		signer, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
 		}
 
-		msg := types.NewMsgDeleteRelationship(receiver, user)
+		counterparty, err := sdk.AccAddressFromBech32(req.Counterparty)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := buildMsg(signer, counterparty, req)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+// userBlockMsgBuilder builds the message associated to a user block operation
+// performed by the given blocker towards the blocked user
+type userBlockMsgBuilder func(blocker, blocked sdk.AccAddress, req UserBlockReq) sdk.Msg
+
+func blockUser(blocker, blocked sdk.AccAddress, req UserBlockReq) sdk.Msg {
+	return types.NewMsgBlockUser(blocker, blocked, req.Reason).WithActingAs(req.ActingAs)
+}
+
+func unblockUser(blocker, blocked sdk.AccAddress, req UserBlockReq) sdk.Msg {
+	return types.NewMsgUnblockUser(blocker, blocked).WithActingAs(req.ActingAs)
+}
+
+func userBlockHandler(cliCtx context.CLIContext, buildMsg userBlockMsgBuilder) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UserBlockReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		blocker, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		blocked, err := sdk.AccAddressFromBech32(req.Blocked)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
+		msg := buildMsg(blocker, blocked, req)
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
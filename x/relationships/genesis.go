@@ -10,7 +10,9 @@ import (
 // ExportGenesis returns the GenesisState associated with the given context
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		UsersRelationships: k.GetUsersRelationships(ctx),
+		UsersRelationships:   k.GetUsersRelationships(ctx),
+		RelationshipRequests: k.GetRelationshipRequests(ctx),
+		UsersBlocks:          k.GetUsersBlocks(ctx),
 	}
 }
 
@@ -21,13 +23,26 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) []ab
 		if err != nil {
 			panic(err)
 		}
-		for _, receiver := range relationships {
-			err := k.StoreRelationship(ctx, addr, receiver)
+		for _, relationship := range relationships {
+			err := k.StoreRelationship(ctx, addr, relationship)
 			if err != nil {
 				panic(err)
 			}
 		}
 	}
 
+	for _, request := range data.RelationshipRequests {
+		if err := k.SaveRelationshipRequest(ctx, request); err != nil {
+			panic(err)
+		}
+	}
+
+	// Blocks are saved last, so that they do not prevent the existing relationships from being stored
+	for _, block := range data.UsersBlocks {
+		if err := k.SaveUserBlock(ctx, block); err != nil {
+			panic(err)
+		}
+	}
+
 	return nil
 }
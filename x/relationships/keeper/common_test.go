@@ -7,6 +7,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	profilesK "github.com/desmos-labs/desmos/x/profiles/keeper"
+	profilesT "github.com/desmos-labs/desmos/x/profiles/types"
 	"github.com/desmos-labs/desmos/x/relationships/keeper"
 	"github.com/desmos-labs/desmos/x/relationships/types"
 	"github.com/stretchr/testify/suite"
@@ -19,38 +21,51 @@ import (
 type KeeperTestSuite struct {
 	suite.Suite
 
-	cdc          *codec.Codec
-	ctx          sdk.Context
-	keeper       keeper.Keeper
-	paramsKeeper params.Keeper
-	testData     TestData
+	cdc            *codec.Codec
+	ctx            sdk.Context
+	keeper         keeper.Keeper
+	profilesKeeper profilesK.Keeper
+	paramsKeeper   params.Keeper
+	testData       TestData
 }
 
 type TestData struct {
-	user      sdk.AccAddress
-	otherUser sdk.AccAddress
+	user          sdk.AccAddress
+	otherUser     sdk.AccAddress
+	subspace      string
+	otherSubspace string
 }
 
 func (suite *KeeperTestSuite) SetupTest() {
 	// define store keys
 	relationshipsKey := sdk.NewKVStoreKey("relationships")
+	profilesKey := sdk.NewKVStoreKey(profilesT.StoreKey)
+	paramsKey := sdk.NewKVStoreKey("params")
+	paramsTKey := sdk.NewTransientStoreKey("transient_params")
 
 	// create an in-memory db
 	memDB := db.NewMemDB()
 	ms := store.NewCommitMultiStore(memDB)
 	ms.MountStoreWithDB(relationshipsKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(profilesKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, memDB)
 	if err := ms.LoadLatestVersion(); err != nil {
 		panic(err)
 	}
 
 	suite.ctx = sdk.NewContext(ms, abci.Header{ChainID: "test-chain-id"}, false, log.NewNopLogger())
 	suite.cdc = testCodec()
-	suite.keeper = keeper.NewKeeper(suite.cdc, relationshipsKey)
+	suite.paramsKeeper = params.NewKeeper(suite.cdc, paramsKey, paramsTKey)
+	suite.profilesKeeper = profilesK.NewKeeper(suite.cdc, profilesKey, suite.paramsKeeper.Subspace(profilesT.DefaultParamspace))
+	suite.keeper = keeper.NewKeeper(suite.profilesKeeper, suite.cdc, relationshipsKey)
 
 	// setup Data
 	// nolint - errcheck
 	suite.testData.user, _ = sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
 	suite.testData.otherUser, _ = sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	suite.testData.subspace = "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	suite.testData.otherSubspace = "2bdf5932925584b9a86470bea60adce69041608a447f84a3317723aa5678ec88"
 }
 
 func TestKeeperTestSuite(t *testing.T) {
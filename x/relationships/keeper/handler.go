@@ -18,6 +18,18 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgCreateRelationship(ctx, keeper, msg)
 		case types.MsgDeleteRelationship:
 			return handleMsgDeleteRelationship(ctx, keeper, msg)
+		case types.MsgRequestRelationship:
+			return handleMsgRequestRelationship(ctx, keeper, msg)
+		case types.MsgAcceptRelationshipRequest:
+			return handleMsgAcceptRelationshipRequest(ctx, keeper, msg)
+		case types.MsgDeclineRelationshipRequest:
+			return handleMsgDeclineRelationshipRequest(ctx, keeper, msg)
+		case types.MsgCancelRelationshipRequest:
+			return handleMsgCancelRelationshipRequest(ctx, keeper, msg)
+		case types.MsgBlockUser:
+			return handleMsgBlockUser(ctx, keeper, msg)
+		case types.MsgUnblockUser:
+			return handleMsgUnblockUser(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized Relationships message type: %v", msg.Type())
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -27,8 +39,16 @@ func NewHandler(keeper Keeper) sdk.Handler {
 
 // handleMsgCreateRelationship handles the creation of a relationship
 func handleMsgCreateRelationship(ctx sdk.Context, keeper Keeper, msg types.MsgCreateRelationship) (*sdk.Result, error) {
+	// Resolve the account on whose behalf the message is performed
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, msg.Sender, msg.ActingAs, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	msg.Sender = actor
+
 	// Save the relationship
-	err := keeper.StoreRelationship(ctx, msg.Sender, msg.Receiver)
+	relationship := types.NewRelationship(msg.Receiver, msg.Subspace, msg.RelationshipType)
+	err = keeper.StoreRelationship(ctx, msg.Sender, relationship)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -37,6 +57,8 @@ func handleMsgCreateRelationship(ctx sdk.Context, keeper Keeper, msg types.MsgCr
 		types.EventTypeRelationshipCreated,
 		sdk.NewAttribute(types.AttributeRelationshipSender, msg.Sender.String()),
 		sdk.NewAttribute(types.AttributeRelationshipReceiver, msg.Receiver.String()),
+		sdk.NewAttribute(types.AttributeRelationshipSubspace, msg.Subspace),
+		sdk.NewAttribute(types.AttributeRelationshipType, string(msg.RelationshipType)),
 	))
 
 	result := sdk.Result{
@@ -49,12 +71,26 @@ func handleMsgCreateRelationship(ctx sdk.Context, keeper Keeper, msg types.MsgCr
 
 // handleMsgDeleteRelationship handles the relationship's deletion
 func handleMsgDeleteRelationship(ctx sdk.Context, keeper Keeper, msg types.MsgDeleteRelationship) (*sdk.Result, error) {
-	keeper.DeleteRelationship(ctx, msg.Sender, msg.Counterparty)
+	// Resolve the account on whose behalf the message is performed
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, msg.Sender, msg.ActingAs, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	msg.Sender = actor
+
+	// Mutual relationships are stored for both the users, so they need to be removed from both sides
+	relationship, found := keeper.GetRelationship(ctx, msg.Sender, msg.Subspace, msg.Counterparty)
+	if found && relationship.Type.IsMutual() {
+		keeper.DeleteRelationship(ctx, msg.Counterparty, msg.Sender, msg.Subspace)
+	}
+
+	keeper.DeleteRelationship(ctx, msg.Sender, msg.Counterparty, msg.Subspace)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeRelationshipsDeleted,
 		sdk.NewAttribute(types.AttributeRelationshipSender, msg.Sender.String()),
 		sdk.NewAttribute(types.AttributeRelationshipReceiver, msg.Counterparty.String()),
+		sdk.NewAttribute(types.AttributeRelationshipSubspace, msg.Subspace),
 	))
 
 	result := sdk.Result{
@@ -64,3 +100,244 @@ func handleMsgDeleteRelationship(ctx sdk.Context, keeper Keeper, msg types.MsgDe
 
 	return &result, nil
 }
+
+// handleMsgRequestRelationship handles the creation of a relationship request
+func handleMsgRequestRelationship(ctx sdk.Context, keeper Keeper, msg types.MsgRequestRelationship) (*sdk.Result, error) {
+	// Resolve the account on whose behalf the message is performed
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, msg.Sender, msg.ActingAs, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	msg.Sender = actor
+
+	// Make sure the users are not already mutually related
+	if relationship, found := keeper.GetRelationship(ctx, msg.Sender, msg.Subspace, msg.Receiver); found &&
+		relationship.Type.IsMutual() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("relationship already exists with %s inside subspace %s", msg.Receiver, msg.Subspace))
+	}
+
+	// Make sure the receiver has not already requested a relationship to the sender
+	if _, found := keeper.GetRelationshipRequest(ctx, msg.Receiver, msg.Subspace, msg.Sender); found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("%s has already sent a relationship request to %s inside subspace %s",
+				msg.Receiver, msg.Sender, msg.Subspace))
+	}
+
+	request := types.NewRelationshipRequest(msg.Sender, msg.Receiver, msg.Subspace, msg.RelationshipType)
+	if err := keeper.SaveRelationshipRequest(ctx, request); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRelationshipRequested,
+		sdk.NewAttribute(types.AttributeRelationshipSender, msg.Sender.String()),
+		sdk.NewAttribute(types.AttributeRelationshipReceiver, msg.Receiver.String()),
+		sdk.NewAttribute(types.AttributeRelationshipSubspace, msg.Subspace),
+		sdk.NewAttribute(types.AttributeRelationshipType, string(msg.RelationshipType)),
+	))
+
+	result := sdk.Result{
+		Data:   keeper.Cdc.MustMarshalBinaryLengthPrefixed(msg.Receiver),
+		Events: ctx.EventManager().Events(),
+	}
+
+	return &result, nil
+}
+
+// handleMsgAcceptRelationshipRequest handles the acceptance of a relationship request,
+// creating the mutual relationship between the two users
+func handleMsgAcceptRelationshipRequest(
+	ctx sdk.Context, keeper Keeper, msg types.MsgAcceptRelationshipRequest,
+) (*sdk.Result, error) {
+	// Resolve the account on whose behalf the message is performed
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, msg.Receiver, msg.ActingAs, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	msg.Receiver = actor
+
+	request, found := keeper.GetRelationshipRequest(ctx, msg.Sender, msg.Subspace, msg.Receiver)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("relationship request from %s not found inside subspace %s", msg.Sender, msg.Subspace))
+	}
+
+	// Replace any previous one-way relationship between the users with the mutual one
+	keeper.DeleteRelationship(ctx, request.Sender, request.Receiver, request.Subspace)
+	keeper.DeleteRelationship(ctx, request.Receiver, request.Sender, request.Subspace)
+
+	err = keeper.StoreRelationship(ctx, request.Sender,
+		types.NewRelationship(request.Receiver, request.Subspace, request.Type))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	err = keeper.StoreRelationship(ctx, request.Receiver,
+		types.NewRelationship(request.Sender, request.Subspace, request.Type))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	keeper.DeleteRelationshipRequest(ctx, request.Sender, request.Subspace, request.Receiver)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRelationshipRequestAccepted,
+		sdk.NewAttribute(types.AttributeRelationshipSender, request.Sender.String()),
+		sdk.NewAttribute(types.AttributeRelationshipReceiver, request.Receiver.String()),
+		sdk.NewAttribute(types.AttributeRelationshipSubspace, request.Subspace),
+		sdk.NewAttribute(types.AttributeRelationshipType, string(request.Type)),
+	))
+
+	result := sdk.Result{
+		Data:   keeper.Cdc.MustMarshalBinaryLengthPrefixed(msg.Sender),
+		Events: ctx.EventManager().Events(),
+	}
+
+	return &result, nil
+}
+
+// handleMsgDeclineRelationshipRequest handles the refusal of a relationship request
+func handleMsgDeclineRelationshipRequest(
+	ctx sdk.Context, keeper Keeper, msg types.MsgDeclineRelationshipRequest,
+) (*sdk.Result, error) {
+	// Resolve the account on whose behalf the message is performed
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, msg.Receiver, msg.ActingAs, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	msg.Receiver = actor
+
+	if _, found := keeper.GetRelationshipRequest(ctx, msg.Sender, msg.Subspace, msg.Receiver); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("relationship request from %s not found inside subspace %s", msg.Sender, msg.Subspace))
+	}
+
+	keeper.DeleteRelationshipRequest(ctx, msg.Sender, msg.Subspace, msg.Receiver)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRelationshipRequestDeclined,
+		sdk.NewAttribute(types.AttributeRelationshipSender, msg.Sender.String()),
+		sdk.NewAttribute(types.AttributeRelationshipReceiver, msg.Receiver.String()),
+		sdk.NewAttribute(types.AttributeRelationshipSubspace, msg.Subspace),
+	))
+
+	result := sdk.Result{
+		Data:   keeper.Cdc.MustMarshalBinaryLengthPrefixed(msg.Sender),
+		Events: ctx.EventManager().Events(),
+	}
+
+	return &result, nil
+}
+
+// handleMsgCancelRelationshipRequest handles the cancellation of a relationship request made by its sender
+func handleMsgCancelRelationshipRequest(
+	ctx sdk.Context, keeper Keeper, msg types.MsgCancelRelationshipRequest,
+) (*sdk.Result, error) {
+	// Resolve the account on whose behalf the message is performed
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, msg.Sender, msg.ActingAs, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	msg.Sender = actor
+
+	if _, found := keeper.GetRelationshipRequest(ctx, msg.Sender, msg.Subspace, msg.Receiver); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("relationship request to %s not found inside subspace %s", msg.Receiver, msg.Subspace))
+	}
+
+	keeper.DeleteRelationshipRequest(ctx, msg.Sender, msg.Subspace, msg.Receiver)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRelationshipRequestCanceled,
+		sdk.NewAttribute(types.AttributeRelationshipSender, msg.Sender.String()),
+		sdk.NewAttribute(types.AttributeRelationshipReceiver, msg.Receiver.String()),
+		sdk.NewAttribute(types.AttributeRelationshipSubspace, msg.Subspace),
+	))
+
+	result := sdk.Result{
+		Data:   keeper.Cdc.MustMarshalBinaryLengthPrefixed(msg.Receiver),
+		Events: ctx.EventManager().Events(),
+	}
+
+	return &result, nil
+}
+
+// handleMsgBlockUser handles the creation of a block towards a user
+func handleMsgBlockUser(ctx sdk.Context, keeper Keeper, msg types.MsgBlockUser) (*sdk.Result, error) {
+	// Resolve the account on whose behalf the message is performed
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, msg.Blocker, msg.ActingAs, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	msg.Blocker = actor
+
+	err = keeper.SaveUserBlock(ctx, types.NewUserBlock(msg.Blocker, msg.Blocked, msg.Reason))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// Remove all the relationships and pending requests existing between the two users
+	deleteUsersLinks(ctx, keeper, msg.Blocker, msg.Blocked)
+	deleteUsersLinks(ctx, keeper, msg.Blocked, msg.Blocker)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUserBlocked,
+		sdk.NewAttribute(types.AttributeUserBlockBlocker, msg.Blocker.String()),
+		sdk.NewAttribute(types.AttributeUserBlockBlocked, msg.Blocked.String()),
+		sdk.NewAttribute(types.AttributeUserBlockReason, msg.Reason),
+	))
+
+	// Let the other modules react to the block
+	keeper.AfterUserBlocked(ctx, msg.Blocker, msg.Blocked)
+
+	result := sdk.Result{
+		Data:   keeper.Cdc.MustMarshalBinaryLengthPrefixed(msg.Blocked),
+		Events: ctx.EventManager().Events(),
+	}
+
+	return &result, nil
+}
+
+// deleteUsersLinks deletes all the relationships and the pending relationship requests
+// that the given user has created towards the counterparty
+func deleteUsersLinks(ctx sdk.Context, keeper Keeper, user, counterparty sdk.AccAddress) {
+	for _, relationship := range keeper.GetUserRelationships(ctx, user) {
+		if relationship.Recipient.Equals(counterparty) {
+			keeper.DeleteRelationship(ctx, user, counterparty, relationship.Subspace)
+		}
+	}
+
+	for _, request := range keeper.GetOutgoingRelationshipRequests(ctx, user) {
+		if request.Receiver.Equals(counterparty) {
+			keeper.DeleteRelationshipRequest(ctx, user, request.Subspace, counterparty)
+		}
+	}
+}
+
+// handleMsgUnblockUser handles the removal of a block towards a user
+func handleMsgUnblockUser(ctx sdk.Context, keeper Keeper, msg types.MsgUnblockUser) (*sdk.Result, error) {
+	// Resolve the account on whose behalf the message is performed
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, msg.Blocker, msg.ActingAs, msg.Type())
+	if err != nil {
+		return nil, err
+	}
+	msg.Blocker = actor
+
+	if err := keeper.DeleteUserBlock(ctx, msg.Blocker, msg.Blocked); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUserUnblocked,
+		sdk.NewAttribute(types.AttributeUserBlockBlocker, msg.Blocker.String()),
+		sdk.NewAttribute(types.AttributeUserBlockBlocked, msg.Blocked.String()),
+	))
+
+	result := sdk.Result{
+		Data:   keeper.Cdc.MustMarshalBinaryLengthPrefixed(msg.Blocked),
+		Events: ctx.EventManager().Events(),
+	}
+
+	return &result, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/desmos-labs/desmos/x/relationships/keeper"
@@ -17,36 +18,55 @@ func (suite *KeeperTestSuite) Test_handleMsgCreateRelationship() {
 	tests := []struct {
 		name                string
 		msg                 types.MsgCreateRelationship
-		storedRelationships []sdk.AccAddress
+		storedRelationships types.Relationships
 		expErr              error
 		expEvent            sdk.Event
 	}{
 		{
-			name:                "Relationship already created returns error",
-			msg:                 types.NewMsgCreateRelationship(sender, receiver),
-			storedRelationships: []sdk.AccAddress{receiver},
-			expErr:              sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("relationship already exists with %s", receiver)),
+			name: "Relationship already created returns error",
+			msg:  types.NewMsgCreateRelationship(sender, receiver, suite.testData.subspace, types.RelationshipTypeFollow),
+			storedRelationships: types.Relationships{
+				types.NewRelationship(receiver, suite.testData.subspace, types.RelationshipTypeFollow),
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("relationship already exists with %s inside subspace %s", receiver, suite.testData.subspace)),
+		},
+		{
+			name: "Relationship inside another subspace has been saved correctly",
+			msg:  types.NewMsgCreateRelationship(sender, receiver, suite.testData.otherSubspace, types.RelationshipTypeFollow),
+			storedRelationships: types.Relationships{
+				types.NewRelationship(receiver, suite.testData.subspace, types.RelationshipTypeFollow),
+			},
+			expErr: nil,
+			expEvent: sdk.NewEvent(
+				types.EventTypeRelationshipCreated,
+				sdk.NewAttribute(types.AttributeRelationshipSender, sender.String()),
+				sdk.NewAttribute(types.AttributeRelationshipReceiver, receiver.String()),
+				sdk.NewAttribute(types.AttributeRelationshipSubspace, suite.testData.otherSubspace),
+				sdk.NewAttribute(types.AttributeRelationshipType, string(types.RelationshipTypeFollow)),
+			),
 		},
 		{
 			name:                "Relationship has been saved correctly",
-			msg:                 types.NewMsgCreateRelationship(sender, receiver),
+			msg:                 types.NewMsgCreateRelationship(sender, receiver, suite.testData.subspace, types.RelationshipTypeFollow),
 			storedRelationships: nil,
 			expErr:              nil,
 			expEvent: sdk.NewEvent(
 				types.EventTypeRelationshipCreated,
 				sdk.NewAttribute(types.AttributeRelationshipSender, sender.String()),
 				sdk.NewAttribute(types.AttributeRelationshipReceiver, receiver.String()),
+				sdk.NewAttribute(types.AttributeRelationshipSubspace, suite.testData.subspace),
+				sdk.NewAttribute(types.AttributeRelationshipType, string(types.RelationshipTypeFollow)),
 			),
 		},
 	}
 
 	for _, test := range tests {
-		suite.SetupTest()
+		test := test
 		suite.Run(test.name, func() {
-			if test.storedRelationships != nil {
-				store := suite.ctx.KVStore(suite.keeper.StoreKey)
-				store.Set(types.RelationshipsStoreKey(test.msg.Sender),
-					suite.keeper.Cdc.MustMarshalBinaryBare(&test.storedRelationships))
+			suite.SetupTest()
+			for _, relationship := range test.storedRelationships {
+				suite.NoError(suite.keeper.StoreRelationship(suite.ctx, test.msg.Sender, relationship))
 			}
 
 			handler := keeper.NewHandler(suite.keeper)
@@ -63,9 +83,11 @@ func (suite *KeeperTestSuite) Test_handleMsgCreateRelationship() {
 				// Check the events
 				suite.Len(res.Events, 1)
 				suite.Contains(res.Events, test.expEvent)
-				suite.Len(suite.keeper.GetUserRelationships(suite.ctx, sender), 1)
-			}
 
+				relationship, found := suite.keeper.GetRelationship(suite.ctx, sender, test.msg.Subspace, receiver)
+				suite.True(found)
+				suite.Equal(types.NewRelationship(receiver, test.msg.Subspace, test.msg.RelationshipType), relationship)
+			}
 		})
 	}
 }
@@ -76,18 +98,26 @@ func (suite *KeeperTestSuite) Test_handleMsgDeleteRelationship() {
 	addr2, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
 	suite.NoError(err)
 
-	store := suite.ctx.KVStore(suite.keeper.StoreKey)
-	store.Set(types.RelationshipsStoreKey(suite.testData.user),
-		suite.keeper.Cdc.MustMarshalBinaryBare(&[]sdk.AccAddress{addr1, addr2}))
+	relationships := types.Relationships{
+		types.NewRelationship(addr1, suite.testData.subspace, types.RelationshipTypeFollow),
+		types.NewRelationship(addr1, suite.testData.otherSubspace, types.RelationshipTypeFriend),
+		types.NewRelationship(addr2, suite.testData.subspace, types.RelationshipTypeCloseFriend),
+	}
+	for _, relationship := range relationships {
+		suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user, relationship))
+	}
 
-	testMsg := types.NewMsgDeleteRelationship(suite.testData.user, addr1)
+	testMsg := types.NewMsgDeleteRelationship(suite.testData.user, addr1, suite.testData.subspace)
 
 	handler := keeper.NewHandler(suite.keeper)
 	res, err := handler(suite.ctx, testMsg)
 
 	suite.NoError(err)
 
-	suite.Equal([]sdk.AccAddress{addr2}, suite.keeper.GetUserRelationships(suite.ctx, suite.testData.user))
+	stored := suite.keeper.GetUserRelationships(suite.ctx, suite.testData.user)
+	suite.Len(stored, 2)
+	suite.Contains(stored, relationships[1])
+	suite.Contains(stored, relationships[2])
 
 	// Check the events
 	suite.Len(res.Events, 1)
@@ -95,5 +125,289 @@ func (suite *KeeperTestSuite) Test_handleMsgDeleteRelationship() {
 		types.EventTypeRelationshipsDeleted,
 		sdk.NewAttribute(types.AttributeRelationshipSender, suite.testData.user.String()),
 		sdk.NewAttribute(types.AttributeRelationshipReceiver, addr1.String()),
+		sdk.NewAttribute(types.AttributeRelationshipSubspace, suite.testData.subspace),
+	))
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgDeleteRelationship_Mutual() {
+	suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user,
+		types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFriend)))
+	suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.otherUser,
+		types.NewRelationship(suite.testData.user, suite.testData.subspace, types.RelationshipTypeFriend)))
+
+	handler := keeper.NewHandler(suite.keeper)
+	_, err := handler(suite.ctx, types.NewMsgDeleteRelationship(suite.testData.user, suite.testData.otherUser, suite.testData.subspace))
+	suite.NoError(err)
+
+	suite.Empty(suite.keeper.GetUserRelationships(suite.ctx, suite.testData.user))
+	suite.Empty(suite.keeper.GetUserRelationships(suite.ctx, suite.testData.otherUser))
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgRequestRelationship() {
+	sender := suite.testData.user
+	receiver := suite.testData.otherUser
+	subspace := suite.testData.subspace
+
+	tests := []struct {
+		name               string
+		storedRelationship *types.Relationship
+		storedRequest      *types.RelationshipRequest
+		msg                types.MsgRequestRelationship
+		expErr             error
+		expEvent           sdk.Event
+	}{
+		{
+			name:               "Already existing mutual relationship returns error",
+			storedRelationship: &types.Relationship{Recipient: receiver, Subspace: subspace, Type: types.RelationshipTypeFriend},
+			msg:                types.NewMsgRequestRelationship(sender, receiver, subspace, types.RelationshipTypeCloseFriend),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("relationship already exists with %s inside subspace %s", receiver, subspace)),
+		},
+		{
+			name: "Request already received from the receiver returns error",
+			storedRequest: &types.RelationshipRequest{
+				Sender: receiver, Receiver: sender, Subspace: subspace, Type: types.RelationshipTypeFriend,
+			},
+			msg: types.NewMsgRequestRelationship(sender, receiver, subspace, types.RelationshipTypeFriend),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("%s has already sent a relationship request to %s inside subspace %s", receiver, sender, subspace)),
+		},
+		{
+			name: "Request already sent returns error",
+			storedRequest: &types.RelationshipRequest{
+				Sender: sender, Receiver: receiver, Subspace: subspace, Type: types.RelationshipTypeFriend,
+			},
+			msg: types.NewMsgRequestRelationship(sender, receiver, subspace, types.RelationshipTypeCloseFriend),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("relationship request already sent to %s inside subspace %s", receiver, subspace)),
+		},
+		{
+			name:               "Request is saved correctly when following the receiver",
+			storedRelationship: &types.Relationship{Recipient: receiver, Subspace: subspace, Type: types.RelationshipTypeFollow},
+			msg:                types.NewMsgRequestRelationship(sender, receiver, subspace, types.RelationshipTypeFriend),
+			expEvent: sdk.NewEvent(
+				types.EventTypeRelationshipRequested,
+				sdk.NewAttribute(types.AttributeRelationshipSender, sender.String()),
+				sdk.NewAttribute(types.AttributeRelationshipReceiver, receiver.String()),
+				sdk.NewAttribute(types.AttributeRelationshipSubspace, subspace),
+				sdk.NewAttribute(types.AttributeRelationshipType, string(types.RelationshipTypeFriend)),
+			),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+			if test.storedRelationship != nil {
+				suite.NoError(suite.keeper.StoreRelationship(suite.ctx, sender, *test.storedRelationship))
+			}
+			if test.storedRequest != nil {
+				suite.NoError(suite.keeper.SaveRelationshipRequest(suite.ctx, *test.storedRequest))
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				return
+			}
+
+			suite.NoError(err)
+			suite.Len(res.Events, 1)
+			suite.Contains(res.Events, test.expEvent)
+
+			request, found := suite.keeper.GetRelationshipRequest(suite.ctx, sender, subspace, receiver)
+			suite.True(found)
+			suite.Equal(types.NewRelationshipRequest(sender, receiver, subspace, test.msg.RelationshipType), request)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgAcceptRelationshipRequest() {
+	sender := suite.testData.user
+	receiver := suite.testData.otherUser
+	subspace := suite.testData.subspace
+	request := types.NewRelationshipRequest(sender, receiver, subspace, types.RelationshipTypeFriend)
+
+	handler := keeper.NewHandler(suite.keeper)
+
+	// Accepting a non existing request returns an error
+	_, err := handler(suite.ctx, types.NewMsgAcceptRelationshipRequest(receiver, sender, subspace))
+	suite.Error(err)
+	suite.Equal(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+		fmt.Sprintf("relationship request from %s not found inside subspace %s", sender, subspace)).Error(), err.Error())
+
+	// The receiver was already following the sender
+	suite.NoError(suite.keeper.StoreRelationship(suite.ctx, receiver,
+		types.NewRelationship(sender, subspace, types.RelationshipTypeFollow)))
+	suite.NoError(suite.keeper.SaveRelationshipRequest(suite.ctx, request))
+
+	res, err := handler(suite.ctx, types.NewMsgAcceptRelationshipRequest(receiver, sender, subspace))
+	suite.NoError(err)
+
+	suite.Len(res.Events, 1)
+	suite.Contains(res.Events, sdk.NewEvent(
+		types.EventTypeRelationshipRequestAccepted,
+		sdk.NewAttribute(types.AttributeRelationshipSender, sender.String()),
+		sdk.NewAttribute(types.AttributeRelationshipReceiver, receiver.String()),
+		sdk.NewAttribute(types.AttributeRelationshipSubspace, subspace),
+		sdk.NewAttribute(types.AttributeRelationshipType, string(types.RelationshipTypeFriend)),
+	))
+
+	// The relationship must be mutual
+	suite.Equal(
+		types.Relationships{types.NewRelationship(receiver, subspace, types.RelationshipTypeFriend)},
+		suite.keeper.GetUserRelationships(suite.ctx, sender),
+	)
+	suite.Equal(
+		types.Relationships{types.NewRelationship(sender, subspace, types.RelationshipTypeFriend)},
+		suite.keeper.GetUserRelationships(suite.ctx, receiver),
+	)
+
+	// The request must have been removed
+	suite.Empty(suite.keeper.GetRelationshipRequests(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgDeclineRelationshipRequest() {
+	sender := suite.testData.user
+	receiver := suite.testData.otherUser
+	subspace := suite.testData.subspace
+
+	handler := keeper.NewHandler(suite.keeper)
+
+	// Declining a non existing request returns an error
+	_, err := handler(suite.ctx, types.NewMsgDeclineRelationshipRequest(receiver, sender, subspace))
+	suite.Error(err)
+
+	suite.NoError(suite.keeper.SaveRelationshipRequest(suite.ctx,
+		types.NewRelationshipRequest(sender, receiver, subspace, types.RelationshipTypeFriend)))
+
+	res, err := handler(suite.ctx, types.NewMsgDeclineRelationshipRequest(receiver, sender, subspace))
+	suite.NoError(err)
+
+	suite.Len(res.Events, 1)
+	suite.Contains(res.Events, sdk.NewEvent(
+		types.EventTypeRelationshipRequestDeclined,
+		sdk.NewAttribute(types.AttributeRelationshipSender, sender.String()),
+		sdk.NewAttribute(types.AttributeRelationshipReceiver, receiver.String()),
+		sdk.NewAttribute(types.AttributeRelationshipSubspace, subspace),
+	))
+
+	suite.Empty(suite.keeper.GetRelationshipRequests(suite.ctx))
+	suite.Empty(suite.keeper.GetUserRelationships(suite.ctx, sender))
+	suite.Empty(suite.keeper.GetUserRelationships(suite.ctx, receiver))
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgCancelRelationshipRequest() {
+	sender := suite.testData.user
+	receiver := suite.testData.otherUser
+	subspace := suite.testData.subspace
+
+	handler := keeper.NewHandler(suite.keeper)
+
+	// Canceling a non existing request returns an error
+	_, err := handler(suite.ctx, types.NewMsgCancelRelationshipRequest(sender, receiver, subspace))
+	suite.Error(err)
+	suite.Equal(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+		fmt.Sprintf("relationship request to %s not found inside subspace %s", receiver, subspace)).Error(), err.Error())
+
+	suite.NoError(suite.keeper.SaveRelationshipRequest(suite.ctx,
+		types.NewRelationshipRequest(sender, receiver, subspace, types.RelationshipTypeFriend)))
+
+	res, err := handler(suite.ctx, types.NewMsgCancelRelationshipRequest(sender, receiver, subspace))
+	suite.NoError(err)
+
+	suite.Len(res.Events, 1)
+	suite.Contains(res.Events, sdk.NewEvent(
+		types.EventTypeRelationshipRequestCanceled,
+		sdk.NewAttribute(types.AttributeRelationshipSender, sender.String()),
+		sdk.NewAttribute(types.AttributeRelationshipReceiver, receiver.String()),
+		sdk.NewAttribute(types.AttributeRelationshipSubspace, subspace),
 	))
+
+	suite.Empty(suite.keeper.GetRelationshipRequests(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgBlockUser() {
+	blocker := suite.testData.user
+	blocked := suite.testData.otherUser
+
+	// Relationships and requests existing between the two users
+	suite.NoError(suite.keeper.StoreRelationship(suite.ctx, blocked,
+		types.NewRelationship(blocker, suite.testData.subspace, types.RelationshipTypeFollow)))
+	suite.NoError(suite.keeper.StoreRelationship(suite.ctx, blocker,
+		types.NewRelationship(blocked, suite.testData.subspace, types.RelationshipTypeFollow)))
+	suite.NoError(suite.keeper.SaveRelationshipRequest(suite.ctx,
+		types.NewRelationshipRequest(blocked, blocker, suite.testData.otherSubspace, types.RelationshipTypeFriend)))
+
+	handler := keeper.NewHandler(suite.keeper)
+	res, err := handler(suite.ctx, types.NewMsgBlockUser(blocker, blocked, "spam"))
+	suite.NoError(err)
+
+	suite.Len(res.Events, 1)
+	suite.Contains(res.Events, sdk.NewEvent(
+		types.EventTypeUserBlocked,
+		sdk.NewAttribute(types.AttributeUserBlockBlocker, blocker.String()),
+		sdk.NewAttribute(types.AttributeUserBlockBlocked, blocked.String()),
+		sdk.NewAttribute(types.AttributeUserBlockReason, "spam"),
+	))
+
+	suite.Equal(types.UserBlocks{types.NewUserBlock(blocker, blocked, "spam")}, suite.keeper.GetUsersBlocks(suite.ctx))
+	suite.Empty(suite.keeper.GetUserRelationships(suite.ctx, blocker))
+	suite.Empty(suite.keeper.GetUserRelationships(suite.ctx, blocked))
+	suite.Empty(suite.keeper.GetRelationshipRequests(suite.ctx))
+
+	// Blocking the same user twice returns an error
+	_, err = handler(suite.ctx, types.NewMsgBlockUser(blocker, blocked, ""))
+	suite.Error(err)
+	suite.Equal(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+		fmt.Sprintf("the user with address %s has already been blocked", blocked)).Error(), err.Error())
+
+	// The blocked user cannot create relationships with the blocker anymore
+	_, err = handler(suite.ctx, types.NewMsgCreateRelationship(blocked, blocker, suite.testData.subspace,
+		types.RelationshipTypeFollow))
+	suite.Error(err)
+	suite.Equal(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+		fmt.Sprintf("the user with address %s has blocked you", blocker)).Error(), err.Error())
+
+	_, err = handler(suite.ctx, types.NewMsgRequestRelationship(blocked, blocker, suite.testData.subspace,
+		types.RelationshipTypeFriend))
+	suite.Error(err)
+	suite.Equal(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+		fmt.Sprintf("the user with address %s has blocked you", blocker)).Error(), err.Error())
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgUnblockUser() {
+	blocker := suite.testData.user
+	blocked := suite.testData.otherUser
+
+	handler := keeper.NewHandler(suite.keeper)
+
+	// Unblocking a non blocked user returns an error
+	_, err := handler(suite.ctx, types.NewMsgUnblockUser(blocker, blocked))
+	suite.Error(err)
+	suite.Equal(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+		fmt.Sprintf("the user with address %s has not been blocked", blocked)).Error(), err.Error())
+
+	suite.NoError(suite.keeper.SaveUserBlock(suite.ctx, types.NewUserBlock(blocker, blocked, "spam")))
+
+	res, err := handler(suite.ctx, types.NewMsgUnblockUser(blocker, blocked))
+	suite.NoError(err)
+
+	suite.Len(res.Events, 1)
+	suite.Contains(res.Events, sdk.NewEvent(
+		types.EventTypeUserUnblocked,
+		sdk.NewAttribute(types.AttributeUserBlockBlocker, blocker.String()),
+		sdk.NewAttribute(types.AttributeUserBlockBlocked, blocked.String()),
+	))
+
+	suite.Empty(suite.keeper.GetUsersBlocks(suite.ctx))
+
+	// The unblocked user can create relationships with the blocker again
+	_, err = handler(suite.ctx, types.NewMsgCreateRelationship(blocked, blocker, suite.testData.subspace,
+		types.RelationshipTypeFollow))
+	suite.NoError(err)
 }
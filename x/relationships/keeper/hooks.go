@@ -0,0 +1,12 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AfterUserBlocked calls the registered hooks after the blocker has blocked the blocked user
+func (k Keeper) AfterUserBlocked(ctx sdk.Context, blocker, blocked sdk.AccAddress) {
+	if k.hooks != nil {
+		k.hooks.AfterUserBlocked(ctx, blocker, blocked)
+	}
+}
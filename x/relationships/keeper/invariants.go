@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/relationships/types"
+)
+
+// RegisterInvariants registers all relationships invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "valid-relationships",
+		ValidRelationshipsInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "unique-relationships",
+		UniqueRelationshipsInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "followers-index",
+		FollowersIndexInvariant(keeper))
+}
+
+// AllInvariants runs all invariants of the module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := ValidRelationshipsInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := UniqueRelationshipsInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := FollowersIndexInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		return "Every invariant condition is fulfilled correctly", true
+	}
+}
+
+// storedRelationship contains a relationship along with the user that has created it and the key used to store it
+type storedRelationship struct {
+	key          []byte
+	user         sdk.AccAddress
+	relationship types.Relationship
+}
+
+// String implements fmt.Stringer
+func (stored storedRelationship) String() string {
+	return fmt.Sprintf("[User] %s [Recipient] %s [Subspace] %s [Type] %s",
+		stored.user, stored.relationship.Recipient, stored.relationship.Subspace, stored.relationship.Type)
+}
+
+// getStoredRelationships returns all the stored relationships along with the users that created them
+func (k Keeper) getStoredRelationships(ctx sdk.Context) []storedRelationship {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RelationshipsStorePrefix)
+	defer iterator.Close()
+
+	var stored []storedRelationship
+	for ; iterator.Valid(); iterator.Next() {
+		var relationship types.Relationship
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &relationship)
+
+		// The user is always stored right after the prefix
+		key := iterator.Key()
+		user := sdk.AccAddress(key[len(types.RelationshipsStorePrefix) : len(types.RelationshipsStorePrefix)+sdk.AddrLen])
+		stored = append(stored, storedRelationship{key: key, user: user, relationship: relationship})
+	}
+
+	return stored
+}
+
+// formatOutputRelationships prepares the given relationships to be displayed correctly
+func formatOutputRelationships(relationships []storedRelationship) (output string) {
+	for _, relationship := range relationships {
+		output += relationship.String() + "\n"
+	}
+	return output
+}
+
+// ValidRelationshipsInvariant checks that all the stored relationships are valid and that no user
+// has a relationship with himself
+func ValidRelationshipsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalidRelationships []storedRelationship
+		for _, stored := range k.getStoredRelationships(ctx) {
+			if stored.relationship.Validate() != nil || stored.relationship.Recipient.Equals(stored.user) {
+				invalidRelationships = append(invalidRelationships, stored)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "invalid relationships",
+			fmt.Sprintf("The following list contains invalid relationships:\n %s",
+				formatOutputRelationships(invalidRelationships)),
+		), invalidRelationships != nil
+	}
+}
+
+// UniqueRelationshipsInvariant checks that each relationship is stored using the key identifying its user,
+// subspace and recipient, so that no user can have more than one relationship with the same recipient
+// inside the same subspace
+func UniqueRelationshipsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var duplicatedRelationships []storedRelationship
+		for _, stored := range k.getStoredRelationships(ctx) {
+			expectedKey := types.RelationshipsStoreKey(stored.user, stored.relationship.Subspace, stored.relationship.Recipient)
+			if !bytes.Equal(stored.key, expectedKey) {
+				duplicatedRelationships = append(duplicatedRelationships, stored)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "duplicated relationships",
+			fmt.Sprintf("The following list contains relationships not stored under their own key:\n %s",
+				formatOutputRelationships(duplicatedRelationships)),
+		), duplicatedRelationships != nil
+	}
+}
+
+// FollowersIndexInvariant checks that each relationship is indexed inside the recipient followers,
+// and that each follower refers to an existing relationship
+func FollowersIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		store := ctx.KVStore(k.StoreKey)
+
+		// Check that each relationship has its own follower entry
+		var missingFollowers []storedRelationship
+		indexed := 0
+		for _, stored := range k.getStoredRelationships(ctx) {
+			key := types.FollowerStoreKey(stored.relationship.Recipient, stored.relationship.Subspace, stored.user)
+			if !store.Has(key) {
+				missingFollowers = append(missingFollowers, stored)
+				continue
+			}
+
+			var follower types.Follower
+			k.Cdc.MustUnmarshalBinaryBare(store.Get(key), &follower)
+			expected := types.NewFollower(stored.user, stored.relationship.Subspace, stored.relationship.Type)
+			if !follower.User.Equals(expected.User) || follower.Subspace != expected.Subspace || follower.Type != expected.Type {
+				missingFollowers = append(missingFollowers, stored)
+				continue
+			}
+
+			indexed++
+		}
+
+		// Check that no follower entry exists without a relationship
+		followers := 0
+		iterator := sdk.KVStorePrefixIterator(store, types.FollowersStorePrefix)
+		for ; iterator.Valid(); iterator.Next() {
+			followers++
+		}
+		iterator.Close()
+
+		return sdk.FormatInvariant(types.ModuleName, "followers index",
+			fmt.Sprintf("The following relationships are not indexed correctly:\n %s"+
+				"Indexed relationships: %d, stored followers: %d\n",
+				formatOutputRelationships(missingFollowers), indexed, followers),
+		), missingFollowers != nil || indexed != followers
+	}
+}
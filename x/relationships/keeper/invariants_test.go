@@ -0,0 +1,90 @@
+package keeper_test
+
+import (
+	"github.com/desmos-labs/desmos/x/relationships/keeper"
+	"github.com/desmos-labs/desmos/x/relationships/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	relationship := types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFollow)
+
+	tests := []struct {
+		name        string
+		setup       func()
+		expResponse string
+		expBool     bool
+	}{
+		{
+			name: "All invariants are not violated",
+			setup: func() {
+				suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user, relationship))
+			},
+			expResponse: "Every invariant condition is fulfilled correctly",
+			expBool:     true,
+		},
+		{
+			name: "ValidRelationships invariant violated",
+			setup: func() {
+				self := types.NewRelationship(suite.testData.user, suite.testData.subspace, types.RelationshipTypeFollow)
+				suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user, self))
+			},
+			expResponse: "relationships: invalid relationships invariant\n" +
+				"The following list contains invalid relationships:\n " +
+				"[User] cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47 [Recipient] cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47 " +
+				"[Subspace] 4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e [Type] follow\n\n",
+			expBool: true,
+		},
+		{
+			name: "UniqueRelationships invariant violated",
+			setup: func() {
+				store := suite.ctx.KVStore(suite.keeper.StoreKey)
+				key := types.RelationshipsStoreKey(suite.testData.user, suite.testData.otherSubspace, suite.testData.otherUser)
+				store.Set(key, suite.keeper.Cdc.MustMarshalBinaryBare(&relationship))
+			},
+			expResponse: "relationships: duplicated relationships invariant\n" +
+				"The following list contains relationships not stored under their own key:\n " +
+				"[User] cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47 [Recipient] cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns " +
+				"[Subspace] 4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e [Type] follow\n\n",
+			expBool: true,
+		},
+		{
+			name: "FollowersIndex invariant violated by missing follower",
+			setup: func() {
+				suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user, relationship))
+				store := suite.ctx.KVStore(suite.keeper.StoreKey)
+				store.Delete(types.FollowerStoreKey(suite.testData.otherUser, suite.testData.subspace, suite.testData.user))
+			},
+			expResponse: "relationships: followers index invariant\n" +
+				"The following relationships are not indexed correctly:\n " +
+				"[User] cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47 [Recipient] cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns " +
+				"[Subspace] 4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e [Type] follow\n" +
+				"Indexed relationships: 0, stored followers: 0\n\n",
+			expBool: true,
+		},
+		{
+			name: "FollowersIndex invariant violated by orphan follower",
+			setup: func() {
+				follower := types.NewFollower(suite.testData.user, suite.testData.subspace, types.RelationshipTypeFollow)
+				store := suite.ctx.KVStore(suite.keeper.StoreKey)
+				store.Set(types.FollowerStoreKey(suite.testData.otherUser, suite.testData.subspace, suite.testData.user),
+					suite.keeper.Cdc.MustMarshalBinaryBare(&follower))
+			},
+			expResponse: "relationships: followers index invariant\n" +
+				"The following relationships are not indexed correctly:\n " +
+				"Indexed relationships: 0, stored followers: 1\n\n",
+			expBool: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			test.setup()
+
+			res, stop := keeper.AllInvariants(suite.keeper)(suite.ctx)
+			suite.Equal(test.expResponse, res)
+			suite.Equal(test.expBool, stop)
+		})
+	}
+}
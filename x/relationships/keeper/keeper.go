@@ -6,86 +6,182 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	profilesK "github.com/desmos-labs/desmos/x/profiles/keeper"
 	"github.com/desmos-labs/desmos/x/relationships/types"
 )
 
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
-	StoreKey sdk.StoreKey // Unexposed key to access store from sdk.Context
-	Cdc      *codec.Codec // The wire codec for binary encoding/decoding.
+	ProfilesKeeper profilesK.Keeper // Profiles' keeper to resolve the accounts acting on behalf of others
+	StoreKey       sdk.StoreKey     // Unexposed key to access store from sdk.Context
+	Cdc            *codec.Codec     // The wire codec for binary encoding/decoding.
+
+	hooks types.RelationshipsHooks // Hooks called by the other modules to react to the relationships events
 }
 
 // NewKeeper creates new instances of the magpie Keeper
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey) Keeper {
+func NewKeeper(pk profilesK.Keeper, cdc *codec.Codec, storeKey sdk.StoreKey) Keeper {
 	return Keeper{
-		StoreKey: storeKey,
-		Cdc:      cdc,
+		ProfilesKeeper: pk,
+		StoreKey:       storeKey,
+		Cdc:            cdc,
+	}
+}
+
+// SetHooks sets the hooks called after the relationships events
+func (k *Keeper) SetHooks(rh types.RelationshipsHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set relationships hooks twice")
 	}
+
+	k.hooks = rh
+	return k
 }
 
-// StoreRelationship allows to store the given receiver returning an error if he's already present.
-func (k Keeper) StoreRelationship(ctx sdk.Context, user, receiver sdk.AccAddress) error {
+// StoreRelationship allows to store the given relationship created by the user, returning an error
+// if the user has already created a relationship with the same recipient inside the same subspace,
+// or if the recipient has blocked the user.
+func (k Keeper) StoreRelationship(ctx sdk.Context, user sdk.AccAddress, relationship types.Relationship) error {
+	if k.IsUserBlocked(ctx, relationship.Recipient, user) {
+		return fmt.Errorf("the user with address %s has blocked you", relationship.Recipient)
+	}
+
 	store := ctx.KVStore(k.StoreKey)
-	key := types.RelationshipsStoreKey(user)
-	var relationships []sdk.AccAddress
-	k.Cdc.MustUnmarshalBinaryBare(store.Get(key), &relationships)
-
-	for _, addr := range relationships {
-		if addr.Equals(receiver) {
-			return fmt.Errorf("relationship already exists with %s", receiver)
-		}
+	key := types.RelationshipsStoreKey(user, relationship.Subspace, relationship.Recipient)
+	if store.Has(key) {
+		return fmt.Errorf("relationship already exists with %s inside subspace %s",
+			relationship.Recipient, relationship.Subspace)
 	}
 
-	relationships = append(relationships, receiver)
-	store.Set(key, k.Cdc.MustMarshalBinaryBare(&relationships))
+	store.Set(key, k.Cdc.MustMarshalBinaryBare(&relationship))
 
+	// Update the reverse index
+	follower := types.NewFollower(user, relationship.Subspace, relationship.Type)
+	store.Set(types.FollowerStoreKey(relationship.Recipient, relationship.Subspace, user),
+		k.Cdc.MustMarshalBinaryBare(&follower))
 	return nil
 }
 
-// GetUserRelationships allows to list all the storedRelationships that involve the given user.
-func (k Keeper) GetUserRelationships(ctx sdk.Context, user sdk.AccAddress) []sdk.AccAddress {
+// GetRelationship returns the relationship that the given user has created with the receiver inside the subspace, if any
+func (k Keeper) GetRelationship(
+	ctx sdk.Context, user sdk.AccAddress, subspace string, receiver sdk.AccAddress,
+) (relationship types.Relationship, found bool) {
 	store := ctx.KVStore(k.StoreKey)
-	key := types.RelationshipsStoreKey(user)
+	bz := store.Get(types.RelationshipsStoreKey(user, subspace, receiver))
+	if bz == nil {
+		return types.Relationship{}, false
+	}
 
-	var relationships []sdk.AccAddress
-	k.Cdc.MustUnmarshalBinaryBare(store.Get(key), &relationships)
+	k.Cdc.MustUnmarshalBinaryBare(bz, &relationship)
+	return relationship, true
+}
+
+// GetUserRelationships allows to list all the relationships that the given user has created inside all the subspaces.
+func (k Keeper) GetUserRelationships(ctx sdk.Context, user sdk.AccAddress) types.Relationships {
+	return k.getRelationships(ctx, types.UserRelationshipsPrefix(user))
+}
+
+// GetUserSubspaceRelationships allows to list all the relationships that the given user has created inside the subspace.
+func (k Keeper) GetUserSubspaceRelationships(ctx sdk.Context, user sdk.AccAddress, subspace string) types.Relationships {
+	return k.getRelationships(ctx, types.UserSubspaceRelationshipsPrefix(user, subspace))
+}
+
+// getRelationships returns all the relationships stored using a key having the given prefix
+func (k Keeper) getRelationships(ctx sdk.Context, prefix []byte) types.Relationships {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	relationships := types.Relationships{}
+	for ; iterator.Valid(); iterator.Next() {
+		var relationship types.Relationship
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &relationship)
+		relationships = append(relationships, relationship)
+	}
 
 	return relationships
 }
 
-// GetUsersRelationships allows to returns the map of all the users and their associated storedRelationships
-func (k Keeper) GetUsersRelationships(ctx sdk.Context) map[string][]sdk.AccAddress {
+// GetUsersRelationships allows to returns the map of all the users and their associated relationships
+func (k Keeper) GetUsersRelationships(ctx sdk.Context) map[string]types.Relationships {
 	store := ctx.KVStore(k.StoreKey)
 	iterator := sdk.KVStorePrefixIterator(store, types.RelationshipsStorePrefix)
+	defer iterator.Close()
 
-	usersRelationshipsMap := map[string][]sdk.AccAddress{}
-	var relationships []sdk.AccAddress
+	usersRelationshipsMap := map[string]types.Relationships{}
 	for ; iterator.Valid(); iterator.Next() {
-		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &relationships)
+		var relationship types.Relationship
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &relationship)
+
+		// The key is made of the prefix, the user address, the subspace and the recipient address
 		userBytes := bytes.TrimPrefix(iterator.Key(), types.RelationshipsStorePrefix)
-		userAddr := sdk.AccAddress(userBytes)
-		usersRelationshipsMap[userAddr.String()] = relationships
+		userBytes = userBytes[:len(userBytes)-len(relationship.Subspace)-len(relationship.Recipient)]
+		userAddr := sdk.AccAddress(userBytes).String()
+
+		usersRelationshipsMap[userAddr] = append(usersRelationshipsMap[userAddr], relationship)
 	}
 
 	return usersRelationshipsMap
 }
 
-// DeleteRelationship allows to delete the relationship between the given user and his counterparty
-func (k Keeper) DeleteRelationship(ctx sdk.Context, user, receiver sdk.AccAddress) {
+// DeleteRelationship allows to delete the relationship between the given user and his counterparty inside the subspace
+func (k Keeper) DeleteRelationship(ctx sdk.Context, user, receiver sdk.AccAddress, subspace string) {
 	store := ctx.KVStore(k.StoreKey)
-	key := types.RelationshipsStoreKey(user)
-	var relationships []sdk.AccAddress
-	k.Cdc.MustUnmarshalBinaryBare(store.Get(key), &relationships)
-
-	for index, addr := range relationships {
-		if addr.Equals(receiver) {
-			relationships = append(relationships[:index], relationships[index+1:]...)
-			if len(relationships) == 0 {
-				store.Delete(key)
-			} else {
-				store.Set(key, k.Cdc.MustMarshalBinaryBare(&relationships))
-			}
-			break
-		}
+	store.Delete(types.RelationshipsStoreKey(user, subspace, receiver))
+	store.Delete(types.FollowerStoreKey(receiver, subspace, user))
+}
+
+// GetUserFollowers returns all the users that have created a relationship towards the given user
+// inside any subspace, using the reverse relationships index
+func (k Keeper) GetUserFollowers(ctx sdk.Context, user sdk.AccAddress) types.Followers {
+	return k.getFollowers(ctx, types.UserFollowersPrefix(user))
+}
+
+// GetUserSubspaceFollowers returns all the users that have created a relationship towards the given user
+// inside the given subspace, using the reverse relationships index
+func (k Keeper) GetUserSubspaceFollowers(ctx sdk.Context, user sdk.AccAddress, subspace string) types.Followers {
+	return k.getFollowers(ctx, types.UserSubspaceFollowersPrefix(user, subspace))
+}
+
+// getFollowers returns all the followers stored using a key having the given prefix
+func (k Keeper) getFollowers(ctx sdk.Context, prefix []byte) types.Followers {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	followers := types.Followers{}
+	for ; iterator.Valid(); iterator.Next() {
+		var follower types.Follower
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &follower)
+		followers = append(followers, follower)
+	}
+
+	return followers
+}
+
+// GetRelationshipsCounts returns the number of followers of the given user and the number of users that
+// the same user is following inside the given subspace. If the subspace is empty, all the subspaces are considered
+func (k Keeper) GetRelationshipsCounts(ctx sdk.Context, user sdk.AccAddress, subspace string) types.RelationshipsCounts {
+	followersPrefix := types.UserFollowersPrefix(user)
+	followingPrefix := types.UserRelationshipsPrefix(user)
+	if subspace != "" {
+		followersPrefix = types.UserSubspaceFollowersPrefix(user, subspace)
+		followingPrefix = types.UserSubspaceRelationshipsPrefix(user, subspace)
+	}
+
+	return types.NewRelationshipsCounts(k.countKeys(ctx, followersPrefix), k.countKeys(ctx, followingPrefix))
+}
+
+// countKeys returns the number of keys stored using the given prefix
+func (k Keeper) countKeys(ctx sdk.Context, prefix []byte) uint64 {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
 	}
+
+	return count
 }
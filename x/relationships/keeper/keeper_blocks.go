@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/relationships/types"
+)
+
+// SaveUserBlock allows to store the given block, returning an error if the blocker
+// has already blocked the same user
+func (k Keeper) SaveUserBlock(ctx sdk.Context, block types.UserBlock) error {
+	store := ctx.KVStore(k.StoreKey)
+	key := types.UserBlockStoreKey(block.Blocker, block.Blocked)
+	if store.Has(key) {
+		return fmt.Errorf("the user with address %s has already been blocked", block.Blocked)
+	}
+
+	store.Set(key, k.Cdc.MustMarshalBinaryBare(&block))
+	return nil
+}
+
+// DeleteUserBlock allows to delete the block that the blocker has created towards the blocked user,
+// returning an error if no such block exists
+func (k Keeper) DeleteUserBlock(ctx sdk.Context, blocker, blocked sdk.AccAddress) error {
+	store := ctx.KVStore(k.StoreKey)
+	key := types.UserBlockStoreKey(blocker, blocked)
+	if !store.Has(key) {
+		return fmt.Errorf("the user with address %s has not been blocked", blocked)
+	}
+
+	store.Delete(key)
+	return nil
+}
+
+// IsUserBlocked tells whether the given blocker has blocked the given blocked user
+func (k Keeper) IsUserBlocked(ctx sdk.Context, blocker, blocked sdk.AccAddress) bool {
+	store := ctx.KVStore(k.StoreKey)
+	return store.Has(types.UserBlockStoreKey(blocker, blocked))
+}
+
+// GetUserBlocks returns all the blocks that the given user has created
+func (k Keeper) GetUserBlocks(ctx sdk.Context, blocker sdk.AccAddress) types.UserBlocks {
+	return k.getUserBlocks(ctx, types.UserBlocksPrefix(blocker))
+}
+
+// GetUsersBlocks returns all the blocks created by all the users
+func (k Keeper) GetUsersBlocks(ctx sdk.Context) types.UserBlocks {
+	return k.getUserBlocks(ctx, types.UsersBlocksStorePrefix)
+}
+
+// getUserBlocks returns all the blocks stored using a key having the given prefix
+func (k Keeper) getUserBlocks(ctx sdk.Context, prefix []byte) types.UserBlocks {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	blocks := types.UserBlocks{}
+	for ; iterator.Valid(); iterator.Next() {
+		var block types.UserBlock
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &block)
+		blocks = append(blocks, block)
+	}
+
+	return blocks
+}
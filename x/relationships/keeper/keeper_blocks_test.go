@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/relationships/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SaveUserBlock() {
+	block := types.NewUserBlock(suite.testData.user, suite.testData.otherUser, "spam")
+	suite.NoError(suite.keeper.SaveUserBlock(suite.ctx, block))
+
+	suite.True(suite.keeper.IsUserBlocked(suite.ctx, suite.testData.user, suite.testData.otherUser))
+	suite.False(suite.keeper.IsUserBlocked(suite.ctx, suite.testData.otherUser, suite.testData.user))
+	suite.Equal(types.UserBlocks{block}, suite.keeper.GetUserBlocks(suite.ctx, suite.testData.user))
+	suite.Equal(types.UserBlocks{}, suite.keeper.GetUserBlocks(suite.ctx, suite.testData.otherUser))
+
+	// Blocking the same user twice returns an error
+	err := suite.keeper.SaveUserBlock(suite.ctx, types.NewUserBlock(suite.testData.user, suite.testData.otherUser, ""))
+	suite.Equal(fmt.Errorf("the user with address %s has already been blocked", suite.testData.otherUser), err)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteUserBlock() {
+	// Deleting a non existing block returns an error
+	err := suite.keeper.DeleteUserBlock(suite.ctx, suite.testData.user, suite.testData.otherUser)
+	suite.Equal(fmt.Errorf("the user with address %s has not been blocked", suite.testData.otherUser), err)
+
+	block := types.NewUserBlock(suite.testData.user, suite.testData.otherUser, "spam")
+	suite.NoError(suite.keeper.SaveUserBlock(suite.ctx, block))
+
+	suite.NoError(suite.keeper.DeleteUserBlock(suite.ctx, suite.testData.user, suite.testData.otherUser))
+	suite.False(suite.keeper.IsUserBlocked(suite.ctx, suite.testData.user, suite.testData.otherUser))
+	suite.Empty(suite.keeper.GetUsersBlocks(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetUsersBlocks() {
+	thirdUser, err := sdk.AccAddressFromBech32("cosmos16vphdl9nhm26murvfrrp8gdsknvfrxctl6y29h")
+	suite.NoError(err)
+
+	blocks := types.UserBlocks{
+		types.NewUserBlock(suite.testData.user, suite.testData.otherUser, "spam"),
+		types.NewUserBlock(suite.testData.user, thirdUser, ""),
+		types.NewUserBlock(thirdUser, suite.testData.otherUser, "harassment"),
+	}
+	for _, block := range blocks {
+		suite.NoError(suite.keeper.SaveUserBlock(suite.ctx, block))
+	}
+
+	stored := suite.keeper.GetUsersBlocks(suite.ctx)
+	suite.Len(stored, len(blocks))
+	for _, block := range blocks {
+		suite.Contains(stored, block)
+	}
+
+	userBlocks := suite.keeper.GetUserBlocks(suite.ctx, suite.testData.user)
+	suite.Len(userBlocks, 2)
+	suite.Contains(userBlocks, blocks[0])
+	suite.Contains(userBlocks, blocks[1])
+}
+
+func (suite *KeeperTestSuite) TestKeeper_StoreRelationship_BlockedSender() {
+	suite.NoError(suite.keeper.SaveUserBlock(suite.ctx,
+		types.NewUserBlock(suite.testData.otherUser, suite.testData.user, "spam")))
+
+	relationship := types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFollow)
+	err := suite.keeper.StoreRelationship(suite.ctx, suite.testData.user, relationship)
+	suite.Equal(fmt.Errorf("the user with address %s has blocked you", suite.testData.otherUser), err)
+	suite.Empty(suite.keeper.GetUserRelationships(suite.ctx, suite.testData.user))
+
+	// The blocker can still create a relationship with the blocked user
+	relationship = types.NewRelationship(suite.testData.user, suite.testData.subspace, types.RelationshipTypeFollow)
+	suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.otherUser, relationship))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_SaveRelationshipRequest_BlockedSender() {
+	suite.NoError(suite.keeper.SaveUserBlock(suite.ctx,
+		types.NewUserBlock(suite.testData.otherUser, suite.testData.user, "spam")))
+
+	request := types.NewRelationshipRequest(suite.testData.user, suite.testData.otherUser,
+		suite.testData.subspace, types.RelationshipTypeFriend)
+	err := suite.keeper.SaveRelationshipRequest(suite.ctx, request)
+	suite.Equal(fmt.Errorf("the user with address %s has blocked you", suite.testData.otherUser), err)
+	suite.Empty(suite.keeper.GetRelationshipRequests(suite.ctx))
+}
@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/relationships/types"
+)
+
+// GetFollowing returns at most limit distinct users with which the given user has a relationship inside the
+// given subspace. If the subspace is empty, all the subspaces are considered.
+// The returned boolean tells whether some users have been left out due to the limit
+func (k Keeper) GetFollowing(ctx sdk.Context, user sdk.AccAddress, subspace string, limit int) ([]sdk.AccAddress, bool) {
+	prefix := types.UserRelationshipsPrefix(user)
+	if subspace != "" {
+		prefix = types.UserSubspaceRelationshipsPrefix(user, subspace)
+	}
+
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	seen := map[string]bool{}
+	var following []sdk.AccAddress
+	for ; iterator.Valid(); iterator.Next() {
+		// The recipient is always stored at the end of the relationship key
+		key := iterator.Key()
+		recipient := sdk.AccAddress(key[len(key)-sdk.AddrLen:])
+		if seen[recipient.String()] {
+			continue
+		}
+
+		if len(following) == limit {
+			return following, true
+		}
+
+		seen[recipient.String()] = true
+		following = append(following, recipient)
+	}
+
+	return following, false
+}
+
+// GetMutuals returns the users with which both the given user and the other one have a relationship
+// inside the given subspace. If the subspace is empty, all the subspaces are considered.
+// At most types.MaxGraphFanOut relationships of each user are considered
+func (k Keeper) GetMutuals(ctx sdk.Context, user, other sdk.AccAddress, subspace string) []sdk.AccAddress {
+	userFollowing, _ := k.GetFollowing(ctx, user, subspace, types.MaxGraphFanOut)
+	otherFollowing, _ := k.GetFollowing(ctx, other, subspace, types.MaxGraphFanOut)
+
+	otherFollows := map[string]bool{}
+	for _, recipient := range otherFollowing {
+		otherFollows[recipient.String()] = true
+	}
+
+	mutuals := []sdk.AccAddress{}
+	for _, recipient := range userFollowing {
+		if otherFollows[recipient.String()] {
+			mutuals = append(mutuals, recipient)
+		}
+	}
+
+	return mutuals
+}
+
+// GetSuggestions returns at most limit users that the given user might want to create a relationship with.
+// Suggested users are the ones related to the users that the given user is already related to, and are
+// ranked by the number of such users they are related to. Users that are already related to the given
+// user, as well as users blocking or blocked by the given user, are never suggested.
+// At most types.MaxGraphFanOut relationships of each user are considered
+func (k Keeper) GetSuggestions(ctx sdk.Context, user sdk.AccAddress, subspace string, limit int) types.Suggestions {
+	following, _ := k.GetFollowing(ctx, user, subspace, types.MaxGraphFanOut)
+
+	excluded := map[string]bool{user.String(): true}
+	for _, recipient := range following {
+		excluded[recipient.String()] = true
+	}
+
+	overlaps := map[string]uint64{}
+	var candidates []sdk.AccAddress
+	for _, recipient := range following {
+		recipientFollowing, _ := k.GetFollowing(ctx, recipient, subspace, types.MaxGraphFanOut)
+		for _, candidate := range recipientFollowing {
+			if excluded[candidate.String()] {
+				continue
+			}
+
+			if _, ok := overlaps[candidate.String()]; !ok {
+				candidates = append(candidates, candidate)
+			}
+			overlaps[candidate.String()]++
+		}
+	}
+
+	suggestions := types.Suggestions{}
+	for _, candidate := range candidates {
+		if k.IsUserBlocked(ctx, user, candidate) || k.IsUserBlocked(ctx, candidate, user) {
+			continue
+		}
+		suggestions = append(suggestions, types.NewSuggestion(candidate, overlaps[candidate.String()]))
+	}
+
+	sort.Sort(suggestions)
+	if len(suggestions) > limit {
+		suggestions = suggestions[:limit]
+	}
+
+	return suggestions
+}
+
+// GetUsersPath looks for a chain of at most maxHops relationships going from the given user to the other one
+// inside the given subspace. If the subspace is empty, all the subspaces are considered.
+// The search visits at most types.MaxPathVisitedUsers users, considering at most types.MaxGraphFanOut
+// relationships of each of them
+func (k Keeper) GetUsersPath(ctx sdk.Context, from, to sdk.AccAddress, subspace string, maxHops uint64) types.UsersPath {
+	if from.Equals(to) {
+		return types.NewUsersPath(from, to, maxHops, true, 0, false)
+	}
+
+	visited := map[string]bool{from.String(): true}
+	frontier := []sdk.AccAddress{from}
+	truncated := false
+
+	for hop := uint64(1); hop <= maxHops && len(frontier) > 0; hop++ {
+		var next []sdk.AccAddress
+		for _, user := range frontier {
+			following, capped := k.GetFollowing(ctx, user, subspace, types.MaxGraphFanOut)
+			truncated = truncated || capped
+
+			for _, recipient := range following {
+				if recipient.Equals(to) {
+					return types.NewUsersPath(from, to, maxHops, true, hop, false)
+				}
+
+				if visited[recipient.String()] {
+					continue
+				}
+
+				if len(visited) >= types.MaxPathVisitedUsers {
+					truncated = true
+					continue
+				}
+
+				visited[recipient.String()] = true
+				next = append(next, recipient)
+			}
+		}
+		frontier = next
+	}
+
+	return types.NewUsersPath(from, to, maxHops, false, 0, truncated)
+}
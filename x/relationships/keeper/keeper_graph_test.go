@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/relationships/types"
+)
+
+// graphUsers contains the users used inside the social graph tests
+type graphUsers struct {
+	user, otherUser, third, fourth, fifth, sixth sdk.AccAddress
+}
+
+// setupGraph stores the following relationships:
+// user -> otherUser, user -> third,
+// otherUser -> fourth, otherUser -> fifth,
+// third -> fourth (inside the other subspace), third -> user,
+// fourth -> sixth
+func (suite *KeeperTestSuite) setupGraph() graphUsers {
+	var users graphUsers
+	var err error
+	users.user = suite.testData.user
+	users.otherUser = suite.testData.otherUser
+	users.third, err = sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	suite.Require().NoError(err)
+	users.fourth, err = sdk.AccAddressFromBech32("cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4")
+	suite.Require().NoError(err)
+	users.fifth, err = sdk.AccAddressFromBech32("cosmos1l5q6tzjpse5p50zg3spef83cd79drahx58f69q")
+	suite.Require().NoError(err)
+	users.sixth, err = sdk.AccAddressFromBech32("cosmos15lt0mflt6j9a9auj7yl3p20xec4xvljge0zhae")
+	suite.Require().NoError(err)
+
+	stored := []struct {
+		user         sdk.AccAddress
+		relationship types.Relationship
+	}{
+		{users.user, types.NewRelationship(users.otherUser, suite.testData.subspace, types.RelationshipTypeFollow)},
+		{users.user, types.NewRelationship(users.third, suite.testData.subspace, types.RelationshipTypeFollow)},
+		{users.otherUser, types.NewRelationship(users.fourth, suite.testData.subspace, types.RelationshipTypeFollow)},
+		{users.otherUser, types.NewRelationship(users.fifth, suite.testData.subspace, types.RelationshipTypeFollow)},
+		{users.third, types.NewRelationship(users.fourth, suite.testData.otherSubspace, types.RelationshipTypeFollow)},
+		{users.third, types.NewRelationship(users.user, suite.testData.subspace, types.RelationshipTypeFollow)},
+		{users.fourth, types.NewRelationship(users.sixth, suite.testData.subspace, types.RelationshipTypeFollow)},
+	}
+	for _, entry := range stored {
+		suite.Require().NoError(suite.keeper.StoreRelationship(suite.ctx, entry.user, entry.relationship))
+	}
+
+	return users
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetMutuals() {
+	users := suite.setupGraph()
+
+	suite.Equal([]sdk.AccAddress{users.fourth}, suite.keeper.GetMutuals(suite.ctx, users.otherUser, users.third, ""))
+	suite.Equal([]sdk.AccAddress{}, suite.keeper.GetMutuals(suite.ctx, users.otherUser, users.third, suite.testData.subspace))
+	suite.Equal([]sdk.AccAddress{}, suite.keeper.GetMutuals(suite.ctx, users.user, users.third, ""))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetSuggestions() {
+	users := suite.setupGraph()
+
+	suite.Equal(
+		types.Suggestions{types.NewSuggestion(users.fourth, 2), types.NewSuggestion(users.fifth, 1)},
+		suite.keeper.GetSuggestions(suite.ctx, users.user, "", 10),
+	)
+
+	// Limit is respected
+	suite.Equal(
+		types.Suggestions{types.NewSuggestion(users.fourth, 2)},
+		suite.keeper.GetSuggestions(suite.ctx, users.user, "", 1),
+	)
+
+	// Only the relationships inside the subspace are considered, and ties are sorted by address
+	suite.Equal(
+		types.Suggestions{types.NewSuggestion(users.fifth, 1), types.NewSuggestion(users.fourth, 1)},
+		suite.keeper.GetSuggestions(suite.ctx, users.user, suite.testData.subspace, 10),
+	)
+
+	// Blocked and blocking users are never suggested
+	suite.Require().NoError(suite.keeper.SaveUserBlock(suite.ctx, types.NewUserBlock(users.user, users.fifth, "")))
+	suite.Require().NoError(suite.keeper.SaveUserBlock(suite.ctx, types.NewUserBlock(users.fourth, users.user, "")))
+	suite.Equal(types.Suggestions{}, suite.keeper.GetSuggestions(suite.ctx, users.user, "", 10))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetUsersPath() {
+	users := suite.setupGraph()
+
+	tests := []struct {
+		name     string
+		from     sdk.AccAddress
+		to       sdk.AccAddress
+		subspace string
+		maxHops  uint64
+		expPath  types.UsersPath
+	}{
+		{
+			name:    "Same user is connected with distance zero",
+			from:    users.user,
+			to:      users.user,
+			maxHops: 3,
+			expPath: types.NewUsersPath(users.user, users.user, 3, true, 0, false),
+		},
+		{
+			name:    "Direct relationship has distance one",
+			from:    users.user,
+			to:      users.third,
+			maxHops: 3,
+			expPath: types.NewUsersPath(users.user, users.third, 3, true, 1, false),
+		},
+		{
+			name:    "Shortest path distance is returned",
+			from:    users.user,
+			to:      users.sixth,
+			maxHops: 3,
+			expPath: types.NewUsersPath(users.user, users.sixth, 3, true, 3, false),
+		},
+		{
+			name:    "Path longer than max hops is not found",
+			from:    users.user,
+			to:      users.sixth,
+			maxHops: 2,
+			expPath: types.NewUsersPath(users.user, users.sixth, 2, false, 0, false),
+		},
+		{
+			name:     "Relationships outside the subspace are not considered",
+			from:     users.third,
+			to:       users.fourth,
+			subspace: suite.testData.subspace,
+			maxHops:  3,
+			expPath:  types.NewUsersPath(users.third, users.fourth, 3, true, 3, false),
+		},
+		{
+			name:    "Relationships direction is respected",
+			from:    users.sixth,
+			to:      users.user,
+			maxHops: 6,
+			expPath: types.NewUsersPath(users.sixth, users.user, 6, false, 0, false),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			path := suite.keeper.GetUsersPath(suite.ctx, test.from, test.to, test.subspace, test.maxHops)
+			suite.Equal(test.expPath, path)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/relationships/types"
+)
+
+// SaveRelationshipRequest allows to store the given relationship request, returning an error if the sender
+// has already sent a request to the same receiver inside the same subspace, or if the receiver has blocked the sender.
+// The request is stored both as an outgoing request of the sender and as an incoming request of the receiver.
+func (k Keeper) SaveRelationshipRequest(ctx sdk.Context, request types.RelationshipRequest) error {
+	if k.IsUserBlocked(ctx, request.Receiver, request.Sender) {
+		return fmt.Errorf("the user with address %s has blocked you", request.Receiver)
+	}
+
+	store := ctx.KVStore(k.StoreKey)
+	outgoingKey := types.OutgoingRelationshipRequestStoreKey(request.Sender, request.Subspace, request.Receiver)
+	if store.Has(outgoingKey) {
+		return fmt.Errorf("relationship request already sent to %s inside subspace %s",
+			request.Receiver, request.Subspace)
+	}
+
+	bz := k.Cdc.MustMarshalBinaryBare(&request)
+	store.Set(outgoingKey, bz)
+	store.Set(types.IncomingRelationshipRequestStoreKey(request.Receiver, request.Subspace, request.Sender), bz)
+	return nil
+}
+
+// GetRelationshipRequest returns the relationship request that the sender has sent to the receiver
+// inside the given subspace, if any
+func (k Keeper) GetRelationshipRequest(
+	ctx sdk.Context, sender sdk.AccAddress, subspace string, receiver sdk.AccAddress,
+) (request types.RelationshipRequest, found bool) {
+	store := ctx.KVStore(k.StoreKey)
+	bz := store.Get(types.OutgoingRelationshipRequestStoreKey(sender, subspace, receiver))
+	if bz == nil {
+		return types.RelationshipRequest{}, false
+	}
+
+	k.Cdc.MustUnmarshalBinaryBare(bz, &request)
+	return request, true
+}
+
+// DeleteRelationshipRequest allows to delete the relationship request that the sender
+// has sent to the receiver inside the given subspace
+func (k Keeper) DeleteRelationshipRequest(ctx sdk.Context, sender sdk.AccAddress, subspace string, receiver sdk.AccAddress) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Delete(types.OutgoingRelationshipRequestStoreKey(sender, subspace, receiver))
+	store.Delete(types.IncomingRelationshipRequestStoreKey(receiver, subspace, sender))
+}
+
+// GetOutgoingRelationshipRequests returns all the pending relationship requests that the given user has sent
+func (k Keeper) GetOutgoingRelationshipRequests(ctx sdk.Context, user sdk.AccAddress) types.RelationshipRequests {
+	return k.getRelationshipRequests(ctx, types.OutgoingRelationshipRequestsPrefix(user))
+}
+
+// GetIncomingRelationshipRequests returns all the pending relationship requests that the given user has received
+func (k Keeper) GetIncomingRelationshipRequests(ctx sdk.Context, user sdk.AccAddress) types.RelationshipRequests {
+	return k.getRelationshipRequests(ctx, types.IncomingRelationshipRequestsPrefix(user))
+}
+
+// GetRelationshipRequests returns all the pending relationship requests
+func (k Keeper) GetRelationshipRequests(ctx sdk.Context) types.RelationshipRequests {
+	return k.getRelationshipRequests(ctx, types.OutgoingRelationshipRequestsStorePrefix)
+}
+
+// getRelationshipRequests returns all the relationship requests stored using a key having the given prefix
+func (k Keeper) getRelationshipRequests(ctx sdk.Context, prefix []byte) types.RelationshipRequests {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	requests := types.RelationshipRequests{}
+	for ; iterator.Valid(); iterator.Next() {
+		var request types.RelationshipRequest
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &request)
+		requests = append(requests, request)
+	}
+
+	return requests
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/relationships/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SaveRelationshipRequest() {
+	request := types.NewRelationshipRequest(suite.testData.user, suite.testData.otherUser,
+		suite.testData.subspace, types.RelationshipTypeFriend)
+
+	suite.NoError(suite.keeper.SaveRelationshipRequest(suite.ctx, request))
+
+	stored, found := suite.keeper.GetRelationshipRequest(suite.ctx, suite.testData.user,
+		suite.testData.subspace, suite.testData.otherUser)
+	suite.True(found)
+	suite.Equal(request, stored)
+
+	suite.Equal(types.RelationshipRequests{request}, suite.keeper.GetOutgoingRelationshipRequests(suite.ctx, suite.testData.user))
+	suite.Equal(types.RelationshipRequests{request}, suite.keeper.GetIncomingRelationshipRequests(suite.ctx, suite.testData.otherUser))
+	suite.Equal(types.RelationshipRequests{}, suite.keeper.GetOutgoingRelationshipRequests(suite.ctx, suite.testData.otherUser))
+	suite.Equal(types.RelationshipRequests{}, suite.keeper.GetIncomingRelationshipRequests(suite.ctx, suite.testData.user))
+
+	// Saving the same request twice returns an error
+	err := suite.keeper.SaveRelationshipRequest(suite.ctx, request)
+	suite.Equal(fmt.Errorf("relationship request already sent to %s inside subspace %s",
+		suite.testData.otherUser, suite.testData.subspace), err)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteRelationshipRequest() {
+	request := types.NewRelationshipRequest(suite.testData.user, suite.testData.otherUser,
+		suite.testData.subspace, types.RelationshipTypeFriend)
+	suite.NoError(suite.keeper.SaveRelationshipRequest(suite.ctx, request))
+
+	suite.keeper.DeleteRelationshipRequest(suite.ctx, suite.testData.user, suite.testData.subspace, suite.testData.otherUser)
+
+	_, found := suite.keeper.GetRelationshipRequest(suite.ctx, suite.testData.user,
+		suite.testData.subspace, suite.testData.otherUser)
+	suite.False(found)
+	suite.Empty(suite.keeper.GetIncomingRelationshipRequests(suite.ctx, suite.testData.otherUser))
+	suite.Empty(suite.keeper.GetRelationshipRequests(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetRelationshipRequests() {
+	thirdUser, err := sdk.AccAddressFromBech32("cosmos16vphdl9nhm26murvfrrp8gdsknvfrxctl6y29h")
+	suite.NoError(err)
+
+	requests := types.RelationshipRequests{
+		types.NewRelationshipRequest(suite.testData.user, suite.testData.otherUser,
+			suite.testData.subspace, types.RelationshipTypeFriend),
+		types.NewRelationshipRequest(suite.testData.user, thirdUser,
+			suite.testData.otherSubspace, types.RelationshipTypeCloseFriend),
+		types.NewRelationshipRequest(thirdUser, suite.testData.otherUser,
+			suite.testData.subspace, types.RelationshipTypeFriend),
+	}
+	for _, request := range requests {
+		suite.NoError(suite.keeper.SaveRelationshipRequest(suite.ctx, request))
+	}
+
+	stored := suite.keeper.GetRelationshipRequests(suite.ctx)
+	suite.Len(stored, len(requests))
+	for _, request := range requests {
+		suite.Contains(stored, request)
+	}
+
+	incoming := suite.keeper.GetIncomingRelationshipRequests(suite.ctx, suite.testData.otherUser)
+	suite.Len(incoming, 2)
+	suite.Contains(incoming, requests[0])
+	suite.Contains(incoming, requests[2])
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/desmos-labs/desmos/x/relationships/types"
 )
@@ -9,36 +10,56 @@ import (
 func (suite *KeeperTestSuite) TestKeeper_StoreRelationship() {
 	tests := []struct {
 		name                string
-		storedRelationships []sdk.AccAddress
+		storedRelationships types.Relationships
 		user                sdk.AccAddress
-		receiver            sdk.AccAddress
+		relationship        types.Relationship
 		expErr              error
 	}{
 		{
-			name:                "already existent relationship returns error",
-			storedRelationships: []sdk.AccAddress{suite.testData.otherUser},
-			user:                suite.testData.user,
-			receiver:            suite.testData.otherUser,
-			expErr:              fmt.Errorf("relationship already exists with %s", suite.testData.otherUser),
+			name: "already existent relationship returns error",
+			storedRelationships: types.Relationships{
+				types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFollow),
+			},
+			user:         suite.testData.user,
+			relationship: types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFriend),
+			expErr: fmt.Errorf("relationship already exists with %s inside subspace %s",
+				suite.testData.otherUser, suite.testData.subspace),
+		},
+		{
+			name: "relationship inside another subspace added correctly",
+			storedRelationships: types.Relationships{
+				types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFollow),
+			},
+			user:         suite.testData.user,
+			relationship: types.NewRelationship(suite.testData.otherUser, suite.testData.otherSubspace, types.RelationshipTypeFollow),
+			expErr:       nil,
 		},
 		{
 			name:                "relationship added correctly",
 			storedRelationships: nil,
 			user:                suite.testData.user,
-			receiver:            suite.testData.otherUser,
+			relationship:        types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFollow),
 			expErr:              nil,
 		},
 	}
 
 	for _, test := range tests {
-		suite.SetupTest()
+		test := test
 		suite.Run(test.name, func() {
-			if test.storedRelationships != nil {
-				store := suite.ctx.KVStore(suite.keeper.StoreKey)
-				store.Set(types.RelationshipsStoreKey(test.user), suite.keeper.Cdc.MustMarshalBinaryBare(&test.storedRelationships))
+			suite.SetupTest()
+			for _, relationship := range test.storedRelationships {
+				suite.NoError(suite.keeper.StoreRelationship(suite.ctx, test.user, relationship))
 			}
-			err := suite.keeper.StoreRelationship(suite.ctx, test.user, test.receiver)
+
+			err := suite.keeper.StoreRelationship(suite.ctx, test.user, test.relationship)
 			suite.Equal(test.expErr, err)
+
+			if test.expErr == nil {
+				stored, found := suite.keeper.GetRelationship(suite.ctx, test.user,
+					test.relationship.Subspace, test.relationship.Recipient)
+				suite.True(found)
+				suite.Equal(test.relationship, stored)
+			}
 		})
 	}
 }
@@ -47,29 +68,36 @@ func (suite *KeeperTestSuite) TestKeeper_GetUsersRelationships() {
 	tests := []struct {
 		name                string
 		storedRelationships []sdk.AccAddress
-		expMap              map[string][]sdk.AccAddress
+		expMap              map[string]types.Relationships
 	}{
 		{
 			name:                "Return a non-empty address -> relationships map",
 			storedRelationships: []sdk.AccAddress{suite.testData.user, suite.testData.otherUser},
-			expMap: map[string][]sdk.AccAddress{
-				suite.testData.user.String():      {suite.testData.otherUser},
-				suite.testData.otherUser.String(): {suite.testData.user},
+			expMap: map[string]types.Relationships{
+				suite.testData.user.String(): {
+					types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFollow),
+				},
+				suite.testData.otherUser.String(): {
+					types.NewRelationship(suite.testData.user, suite.testData.subspace, types.RelationshipTypeFollow),
+				},
 			},
 		},
 		{
 			name:                "Return an empty address -> relationships map",
 			storedRelationships: nil,
-			expMap:              map[string][]sdk.AccAddress{},
+			expMap:              map[string]types.Relationships{},
 		},
 	}
 
 	for _, test := range tests {
-		suite.SetupTest()
+		test := test
 		suite.Run(test.name, func() {
+			suite.SetupTest()
 			if test.storedRelationships != nil {
-				_ = suite.keeper.StoreRelationship(suite.ctx, test.storedRelationships[0], test.storedRelationships[1])
-				_ = suite.keeper.StoreRelationship(suite.ctx, test.storedRelationships[1], test.storedRelationships[0])
+				_ = suite.keeper.StoreRelationship(suite.ctx, test.storedRelationships[0],
+					types.NewRelationship(test.storedRelationships[1], suite.testData.subspace, types.RelationshipTypeFollow))
+				_ = suite.keeper.StoreRelationship(suite.ctx, test.storedRelationships[1],
+					types.NewRelationship(test.storedRelationships[0], suite.testData.subspace, types.RelationshipTypeFollow))
 			}
 
 			actualIDsMap := suite.keeper.GetUsersRelationships(suite.ctx)
@@ -87,28 +115,33 @@ func (suite *KeeperTestSuite) TestKeeper_GetUserRelationships() {
 
 	tests := []struct {
 		name                string
-		storedRelationships []sdk.AccAddress
-		expRelationships    []sdk.AccAddress
+		storedRelationships types.Relationships
+		expRelationships    types.Relationships
 	}{
 		{
-			name:                "Returns non empty relationships slice",
-			storedRelationships: []sdk.AccAddress{addr1, addr2},
-			expRelationships:    []sdk.AccAddress{addr1, addr2},
+			name: "Returns non empty relationships slice",
+			storedRelationships: types.Relationships{
+				types.NewRelationship(addr1, suite.testData.subspace, types.RelationshipTypeFollow),
+				types.NewRelationship(addr2, suite.testData.subspace, types.RelationshipTypeFriend),
+			},
+			expRelationships: types.Relationships{
+				types.NewRelationship(addr1, suite.testData.subspace, types.RelationshipTypeFollow),
+				types.NewRelationship(addr2, suite.testData.subspace, types.RelationshipTypeFriend),
+			},
 		},
 		{
 			name:                "Returns empty relationships slice",
 			storedRelationships: nil,
-			expRelationships:    nil,
+			expRelationships:    types.Relationships{},
 		},
 	}
 
 	for _, test := range tests {
-		suite.SetupTest()
+		test := test
 		suite.Run(test.name, func() {
-			if test.storedRelationships != nil {
-				store := suite.ctx.KVStore(suite.keeper.StoreKey)
-				store.Set(types.RelationshipsStoreKey(suite.testData.user),
-					suite.keeper.Cdc.MustMarshalBinaryBare(&[]sdk.AccAddress{addr1, addr2}))
+			suite.SetupTest()
+			for _, relationship := range test.storedRelationships {
+				suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user, relationship))
 			}
 
 			suite.Equal(test.expRelationships, suite.keeper.GetUserRelationships(suite.ctx, suite.testData.user))
@@ -116,61 +149,145 @@ func (suite *KeeperTestSuite) TestKeeper_GetUserRelationships() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeper_GetUserSubspaceRelationships() {
+	relationships := types.Relationships{
+		types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFollow),
+		types.NewRelationship(suite.testData.otherUser, suite.testData.otherSubspace, types.RelationshipTypeCloseFriend),
+	}
+	for _, relationship := range relationships {
+		suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user, relationship))
+	}
+
+	suite.Equal(
+		types.Relationships{relationships[0]},
+		suite.keeper.GetUserSubspaceRelationships(suite.ctx, suite.testData.user, suite.testData.subspace),
+	)
+	suite.Equal(
+		types.Relationships{relationships[1]},
+		suite.keeper.GetUserSubspaceRelationships(suite.ctx, suite.testData.user, suite.testData.otherSubspace),
+	)
+	suite.Equal(
+		types.Relationships{},
+		suite.keeper.GetUserSubspaceRelationships(suite.ctx, suite.testData.otherUser, suite.testData.subspace),
+	)
+}
+
 func (suite *KeeperTestSuite) TestKeeper_DeleteRelationship() {
 	addr1, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
 	suite.NoError(err)
 	addr2, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
 	suite.NoError(err)
-	addr3, err := sdk.AccAddressFromBech32("cosmos16vphdl9nhm26murvfrrp8gdsknvfrxctl6y29h")
-	suite.NoError(err)
-
-	store := suite.ctx.KVStore(suite.keeper.StoreKey)
-	store.Set(types.RelationshipsStoreKey(suite.testData.user),
-		suite.keeper.Cdc.MustMarshalBinaryBare(&[]sdk.AccAddress{addr1, addr2, addr3}))
-
-	suite.keeper.DeleteRelationship(suite.ctx, suite.testData.user, addr2)
-
-	expRelationships := []sdk.AccAddress{addr1, addr3}
-	suite.Equal(expRelationships, suite.keeper.GetUserRelationships(suite.ctx, suite.testData.user))
 
 	tests := []struct {
 		name                string
-		storedRelationships []sdk.AccAddress
-		expRelationships    []sdk.AccAddress
+		storedRelationships types.Relationships
+		expRelationships    types.Relationships
 		userToDelete        sdk.AccAddress
+		subspace            string
 	}{
 		{
-			name:                "Delete a relationship with len(relationships) > 1",
-			storedRelationships: []sdk.AccAddress{addr1, addr2, addr3},
-			expRelationships:    []sdk.AccAddress{addr1, addr3},
-			userToDelete:        addr2,
+			name: "Delete a relationship with len(relationships) > 1",
+			storedRelationships: types.Relationships{
+				types.NewRelationship(addr1, suite.testData.subspace, types.RelationshipTypeFollow),
+				types.NewRelationship(addr2, suite.testData.subspace, types.RelationshipTypeFollow),
+			},
+			expRelationships: types.Relationships{
+				types.NewRelationship(addr1, suite.testData.subspace, types.RelationshipTypeFollow),
+			},
+			userToDelete: addr2,
+			subspace:     suite.testData.subspace,
 		},
 		{
-			name:                "Delete a relationship with len(relationships) == 1",
-			storedRelationships: []sdk.AccAddress{addr1},
-			expRelationships:    nil,
-			userToDelete:        addr1,
+			name: "Delete a relationship with len(relationships) == 1",
+			storedRelationships: types.Relationships{
+				types.NewRelationship(addr1, suite.testData.subspace, types.RelationshipTypeFollow),
+			},
+			expRelationships: types.Relationships{},
+			userToDelete:     addr1,
+			subspace:         suite.testData.subspace,
+		},
+		{
+			name: "Delete a relationship inside another subspace does nothing",
+			storedRelationships: types.Relationships{
+				types.NewRelationship(addr1, suite.testData.subspace, types.RelationshipTypeFollow),
+			},
+			expRelationships: types.Relationships{
+				types.NewRelationship(addr1, suite.testData.subspace, types.RelationshipTypeFollow),
+			},
+			userToDelete: addr1,
+			subspace:     suite.testData.otherSubspace,
 		},
 		{
 			name:                "Delete a relationship with len(relationships) == 0",
 			storedRelationships: nil,
-			expRelationships:    nil,
+			expRelationships:    types.Relationships{},
 			userToDelete:        addr1,
+			subspace:            suite.testData.subspace,
 		},
 	}
 
 	for _, test := range tests {
-		suite.SetupTest()
+		test := test
 		suite.Run(test.name, func() {
-			store := suite.ctx.KVStore(suite.keeper.StoreKey)
-			if test.storedRelationships != nil {
-				store.Set(types.RelationshipsStoreKey(suite.testData.user),
-					suite.keeper.Cdc.MustMarshalBinaryBare(&test.storedRelationships))
+			suite.SetupTest()
+			for _, relationship := range test.storedRelationships {
+				suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user, relationship))
 			}
 
-			suite.keeper.DeleteRelationship(suite.ctx, suite.testData.user, test.userToDelete)
+			suite.keeper.DeleteRelationship(suite.ctx, suite.testData.user, test.userToDelete, test.subspace)
 			rel := suite.keeper.GetUserRelationships(suite.ctx, suite.testData.user)
 			suite.Equal(test.expRelationships, rel)
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestKeeper_StoreRelationship_UpdatesFollowers() {
+	relationship := types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFollow)
+	suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user, relationship))
+
+	expFollower := types.NewFollower(suite.testData.user, suite.testData.subspace, types.RelationshipTypeFollow)
+	suite.Equal(types.Followers{expFollower}, suite.keeper.GetUserFollowers(suite.ctx, suite.testData.otherUser))
+	suite.Equal(types.Followers{}, suite.keeper.GetUserFollowers(suite.ctx, suite.testData.user))
+
+	suite.keeper.DeleteRelationship(suite.ctx, suite.testData.user, suite.testData.otherUser, suite.testData.subspace)
+	suite.Equal(types.Followers{}, suite.keeper.GetUserFollowers(suite.ctx, suite.testData.otherUser))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetUserSubspaceFollowers() {
+	addr, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	suite.NoError(err)
+
+	suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user,
+		types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFollow)))
+	suite.NoError(suite.keeper.StoreRelationship(suite.ctx, addr,
+		types.NewRelationship(suite.testData.otherUser, suite.testData.otherSubspace, types.RelationshipTypeFriend)))
+
+	suite.Equal(
+		types.Followers{types.NewFollower(suite.testData.user, suite.testData.subspace, types.RelationshipTypeFollow)},
+		suite.keeper.GetUserSubspaceFollowers(suite.ctx, suite.testData.otherUser, suite.testData.subspace),
+	)
+	suite.Equal(
+		types.Followers{types.NewFollower(addr, suite.testData.otherSubspace, types.RelationshipTypeFriend)},
+		suite.keeper.GetUserSubspaceFollowers(suite.ctx, suite.testData.otherUser, suite.testData.otherSubspace),
+	)
+	suite.Len(suite.keeper.GetUserFollowers(suite.ctx, suite.testData.otherUser), 2)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetRelationshipsCounts() {
+	addr, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	suite.NoError(err)
+
+	suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user,
+		types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFollow)))
+	suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user,
+		types.NewRelationship(addr, suite.testData.otherSubspace, types.RelationshipTypeFollow)))
+	suite.NoError(suite.keeper.StoreRelationship(suite.ctx, addr,
+		types.NewRelationship(suite.testData.user, suite.testData.subspace, types.RelationshipTypeFollow)))
+
+	suite.Equal(types.NewRelationshipsCounts(1, 2), suite.keeper.GetRelationshipsCounts(suite.ctx, suite.testData.user, ""))
+	suite.Equal(types.NewRelationshipsCounts(1, 1),
+		suite.keeper.GetRelationshipsCounts(suite.ctx, suite.testData.user, suite.testData.subspace))
+	suite.Equal(types.NewRelationshipsCounts(0, 1),
+		suite.keeper.GetRelationshipsCounts(suite.ctx, suite.testData.user, suite.testData.otherSubspace))
+	suite.Equal(types.NewRelationshipsCounts(1, 0), suite.keeper.GetRelationshipsCounts(suite.ctx, suite.testData.otherUser, ""))
+}
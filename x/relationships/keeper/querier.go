@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -18,6 +19,26 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryUserRelationships(ctx, path[1:], req, keeper)
 		case types.QueryRelationships:
 			return queryRelationships(ctx, req, keeper)
+		case types.QueryRelationshipRequests:
+			return queryRelationshipRequests(ctx, path[1:], req, keeper)
+		case types.QueryUserBlocks:
+			return queryUserBlocks(ctx, path[1:], req, keeper)
+		case types.QueryFollowers:
+			return queryFollowers(ctx, path[1:], req, keeper)
+		case types.QueryRelationshipsCounts:
+			return queryRelationshipsCounts(ctx, path[1:], req, keeper)
+		case types.QueryMutuals:
+			return WithGraphGasLimit(ctx, func(ctx sdk.Context) ([]byte, error) {
+				return queryMutuals(ctx, path[1:], req, keeper)
+			})
+		case types.QuerySuggestions:
+			return WithGraphGasLimit(ctx, func(ctx sdk.Context) ([]byte, error) {
+				return querySuggestions(ctx, path[1:], req, keeper)
+			})
+		case types.QueryUsersPath:
+			return WithGraphGasLimit(ctx, func(ctx sdk.Context) ([]byte, error) {
+				return queryUsersPath(ctx, path[1:], req, keeper)
+			})
 		default:
 			return nil, fmt.Errorf("unknown profiles query endpoint")
 		}
@@ -35,14 +56,29 @@ func queryRelationships(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]
 	return bz, nil
 }
 
-// queryUserRelationships handles the request of listing all the users' storedRelationships
-func queryUserRelationships(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+// queryUserRelationships handles the request of listing all the users' storedRelationships,
+// optionally filtering them by subspace and type
+func queryUserRelationships(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	user, err := sdk.AccAddressFromBech32(path[0])
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("Invalid bech32 address: %s", path[0]))
 	}
 
-	relationships := types.NewRelationshipResponse(keeper.GetUserRelationships(ctx, user))
+	var params types.QueryUserRelationshipsParams
+	if len(req.Data) != 0 {
+		if err := keeper.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	var userRelationships types.Relationships
+	if params.Subspace != "" {
+		userRelationships = keeper.GetUserSubspaceRelationships(ctx, user, params.Subspace)
+	} else {
+		userRelationships = keeper.GetUserRelationships(ctx, user)
+	}
+
+	relationships := types.NewRelationshipResponse(userRelationships.Filter(params.Subspace, params.Type))
 
 	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &relationships)
 	if err != nil {
@@ -51,3 +87,237 @@ func queryUserRelationships(ctx sdk.Context, path []string, _ abci.RequestQuery,
 
 	return bz, nil
 }
+
+// queryRelationshipRequests handles the request of listing all the pending relationship requests
+// that the given user has received and sent
+func queryRelationshipRequests(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	user, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("Invalid bech32 address: %s", path[0]))
+	}
+
+	requests := types.NewRelationshipRequestsResponse(
+		keeper.GetIncomingRelationshipRequests(ctx, user),
+		keeper.GetOutgoingRelationshipRequests(ctx, user),
+	)
+
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &requests)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryUserBlocks handles the request of listing all the users that the given user has blocked
+func queryUserBlocks(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	user, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("Invalid bech32 address: %s", path[0]))
+	}
+
+	blocks := keeper.GetUserBlocks(ctx, user)
+
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &blocks)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryFollowers handles the request of listing the users that have created a relationship towards the given user,
+// optionally filtering them by subspace and type
+func queryFollowers(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	user, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("Invalid bech32 address: %s", path[0]))
+	}
+
+	var params types.QueryFollowersParams
+	if len(req.Data) != 0 {
+		if err := keeper.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	var followers types.Followers
+	if params.Subspace != "" {
+		followers = keeper.GetUserSubspaceFollowers(ctx, user, params.Subspace)
+	} else {
+		followers = keeper.GetUserFollowers(ctx, user)
+	}
+	followers = followers.Filter(params.Subspace, params.Type)
+
+	// Default page
+	page := params.Page
+	if page == 0 {
+		page = 1
+	}
+
+	start, end := client.Paginate(len(followers), page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		followers = types.Followers{}
+	} else {
+		followers = followers[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &followers)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryRelationshipsCounts handles the request of counting the followers of the given user
+// and the users that he is following, optionally inside a single subspace
+func queryRelationshipsCounts(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	user, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("Invalid bech32 address: %s", path[0]))
+	}
+
+	var params types.QueryRelationshipsCountsParams
+	if len(req.Data) != 0 {
+		if err := keeper.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	counts := keeper.GetRelationshipsCounts(ctx, user, params.Subspace)
+
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &counts)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// WithGraphGasLimit runs the given social graph query using a gas meter limited to types.GraphQueriesGasLimit,
+// returning an error instead of the query result if such limit is exceeded
+func WithGraphGasLimit(ctx sdk.Context, query func(ctx sdk.Context) ([]byte, error)) (res []byte, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			oog, ok := r.(sdk.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+
+			res, err = nil, sdkerrors.Wrap(sdkerrors.ErrOutOfGas,
+				fmt.Sprintf("the query exceeded the gas limit of %d while reading %s", types.GraphQueriesGasLimit, oog.Descriptor))
+		}
+	}()
+
+	return query(ctx.WithGasMeter(sdk.NewGasMeter(types.GraphQueriesGasLimit)))
+}
+
+// parseUsersPair parses the two addresses contained inside the given query path
+func parseUsersPair(path []string) (sdk.AccAddress, sdk.AccAddress, error) {
+	if len(path) < 2 {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "two addresses must be provided")
+	}
+
+	user, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("Invalid bech32 address: %s", path[0]))
+	}
+
+	other, err := sdk.AccAddressFromBech32(path[1])
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("Invalid bech32 address: %s", path[1]))
+	}
+
+	return user, other, nil
+}
+
+// queryMutuals handles the request of listing the users with which both the given users have a relationship
+func queryMutuals(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	user, other, err := parseUsersPair(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var params types.QueryMutualsParams
+	if len(req.Data) != 0 {
+		if err := keeper.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	mutuals := keeper.GetMutuals(ctx, user, other, params.Subspace)
+
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &mutuals)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// querySuggestions handles the request of listing the users that the given user might want to create a relationship with
+func querySuggestions(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	user, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("Invalid bech32 address: %s", path[0]))
+	}
+
+	var params types.QuerySuggestionsParams
+	if len(req.Data) != 0 {
+		if err := keeper.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = types.DefaultSuggestionsLimit
+	}
+	if limit > types.MaxSuggestionsLimit {
+		limit = types.MaxSuggestionsLimit
+	}
+
+	suggestions := keeper.GetSuggestions(ctx, user, params.Subspace, limit)
+
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &suggestions)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryUsersPath handles the request of telling whether a user is connected to another one
+// through a chain of relationships not longer than the given number of hops
+func queryUsersPath(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	from, to, err := parseUsersPair(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var params types.QueryUsersPathParams
+	if len(req.Data) != 0 {
+		if err := keeper.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	maxHops := params.MaxHops
+	if maxHops == 0 {
+		maxHops = types.DefaultPathMaxHops
+	}
+	if maxHops > types.MaxPathMaxHops {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("max hops cannot be greater than %d", types.MaxPathMaxHops))
+	}
+
+	usersPath := keeper.GetUsersPath(ctx, from, to, params.Subspace, maxHops)
+
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &usersPath)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
@@ -15,12 +15,17 @@ func (suite *KeeperTestSuite) Test_queryUserRelationships() {
 	addr2, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
 	suite.NoError(err)
 
-	expRelationships := types.NewRelationshipResponse([]sdk.AccAddress{addr1, addr2})
+	relationships := types.Relationships{
+		types.NewRelationship(addr1, suite.testData.subspace, types.RelationshipTypeFollow),
+		types.NewRelationship(addr2, suite.testData.subspace, types.RelationshipTypeFriend),
+		types.NewRelationship(addr1, suite.testData.otherSubspace, types.RelationshipTypeFriend),
+	}
 
 	tests := []struct {
 		name          string
 		path          []string
-		relationships []sdk.AccAddress
+		params        *types.QueryUserRelationshipsParams
+		relationships types.Relationships
 		expResult     *types.RelationshipsResponse
 		expErr        error
 	}{
@@ -34,9 +39,31 @@ func (suite *KeeperTestSuite) Test_queryUserRelationships() {
 		{
 			name:          "User Relationships returned correctly",
 			path:          []string{types.QueryUserRelationships, suite.testData.user.String()},
-			relationships: []sdk.AccAddress{addr1, addr2},
-			expResult:     &expRelationships,
-			expErr:        nil,
+			relationships: relationships,
+			expResult: &types.RelationshipsResponse{
+				Relationships: types.Relationships{relationships[2], relationships[0], relationships[1]},
+			},
+			expErr: nil,
+		},
+		{
+			name:          "User Relationships filtered by subspace returned correctly",
+			path:          []string{types.QueryUserRelationships, suite.testData.user.String()},
+			params:        &types.QueryUserRelationshipsParams{Subspace: suite.testData.otherSubspace},
+			relationships: relationships,
+			expResult: &types.RelationshipsResponse{
+				Relationships: types.Relationships{relationships[2]},
+			},
+			expErr: nil,
+		},
+		{
+			name:          "User Relationships filtered by subspace and type returned correctly",
+			path:          []string{types.QueryUserRelationships, suite.testData.user.String()},
+			params:        &types.QueryUserRelationshipsParams{Subspace: suite.testData.subspace, Type: types.RelationshipTypeFriend},
+			relationships: relationships,
+			expResult: &types.RelationshipsResponse{
+				Relationships: types.Relationships{relationships[1]},
+			},
+			expErr: nil,
 		},
 	}
 
@@ -48,8 +75,13 @@ func (suite *KeeperTestSuite) Test_queryUserRelationships() {
 				_ = suite.keeper.StoreRelationship(suite.ctx, suite.testData.user, rel)
 			}
 
+			var req abci.RequestQuery
+			if test.params != nil {
+				req.Data = suite.keeper.Cdc.MustMarshalJSON(test.params)
+			}
+
 			querier := keeper.NewQuerier(suite.keeper)
-			result, err := querier(suite.ctx, test.path, abci.RequestQuery{})
+			result, err := querier(suite.ctx, test.path, req)
 
 			if test.expResult != nil {
 				suite.Nil(err)
@@ -70,23 +102,27 @@ func (suite *KeeperTestSuite) Test_queryUserRelationships() {
 func (suite *KeeperTestSuite) Test_queryRelationships() {
 	addr1, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
 	suite.NoError(err)
-	addr2, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
-	suite.NoError(err)
 
 	tests := []struct {
 		name          string
 		path          []string
-		relationships []sdk.AccAddress
-		expResult     map[string][]sdk.AccAddress
+		relationships types.Relationships
+		expResult     map[string]types.Relationships
 		expErr        error
 	}{
 		{
-			name:          "Relationships returned correctly",
-			path:          []string{types.QueryRelationships},
-			relationships: []sdk.AccAddress{addr1, addr2},
-			expResult: map[string][]sdk.AccAddress{
-				suite.testData.user.String():      {addr1, addr2},
-				suite.testData.otherUser.String(): {addr1, addr2},
+			name: "Relationships returned correctly",
+			path: []string{types.QueryRelationships},
+			relationships: types.Relationships{
+				types.NewRelationship(addr1, suite.testData.subspace, types.RelationshipTypeFollow),
+			},
+			expResult: map[string]types.Relationships{
+				suite.testData.user.String(): {
+					types.NewRelationship(addr1, suite.testData.subspace, types.RelationshipTypeFollow),
+				},
+				suite.testData.otherUser.String(): {
+					types.NewRelationship(addr1, suite.testData.subspace, types.RelationshipTypeFollow),
+				},
 			},
 		},
 	}
@@ -105,13 +141,431 @@ func (suite *KeeperTestSuite) Test_queryRelationships() {
 
 			if test.expResult != nil {
 				suite.Nil(err)
+
+				// Maps are not serialized in a deterministic order, so the result is compared once decoded
+				var actual map[string]types.Relationships
+				suite.keeper.Cdc.MustUnmarshalJSON(result, &actual)
+				suite.Equal(test.expResult, actual)
+			}
+
+			if result == nil {
+				suite.NotNil(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_queryRelationshipRequests() {
+	thirdUser, err := sdk.AccAddressFromBech32("cosmos16vphdl9nhm26murvfrrp8gdsknvfrxctl6y29h")
+	suite.NoError(err)
+
+	incoming := types.NewRelationshipRequest(thirdUser, suite.testData.user,
+		suite.testData.subspace, types.RelationshipTypeFriend)
+	outgoing := types.NewRelationshipRequest(suite.testData.user, suite.testData.otherUser,
+		suite.testData.subspace, types.RelationshipTypeCloseFriend)
+
+	tests := []struct {
+		name      string
+		path      []string
+		expResult *types.RelationshipRequestsResponse
+		expErr    error
+	}{
+		{
+			name:   "Invalid bech32 address returns error",
+			path:   []string{types.QueryRelationshipRequests, "invalidAddress"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid bech32 address: invalidAddress"),
+		},
+		{
+			name: "Relationship requests returned correctly",
+			path: []string{types.QueryRelationshipRequests, suite.testData.user.String()},
+			expResult: &types.RelationshipRequestsResponse{
+				Incoming: types.RelationshipRequests{incoming},
+				Outgoing: types.RelationshipRequests{outgoing},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.NoError(suite.keeper.SaveRelationshipRequest(suite.ctx, incoming))
+			suite.NoError(suite.keeper.SaveRelationshipRequest(suite.ctx, outgoing))
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, abci.RequestQuery{})
+
+			if test.expResult != nil {
+				suite.NoError(err)
 				expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expResult)
 				suite.NoError(err)
 				suite.Equal(string(expectedIndented), string(result))
+			} else {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
 			}
+		})
+	}
+}
 
-			if result == nil {
-				suite.NotNil(err)
+func (suite *KeeperTestSuite) Test_queryUserBlocks() {
+	block := types.NewUserBlock(suite.testData.user, suite.testData.otherUser, "spam")
+
+	tests := []struct {
+		name      string
+		path      []string
+		expResult *types.UserBlocks
+		expErr    error
+	}{
+		{
+			name:   "Invalid bech32 address returns error",
+			path:   []string{types.QueryUserBlocks, "invalidAddress"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid bech32 address: invalidAddress"),
+		},
+		{
+			name:      "User blocks returned correctly",
+			path:      []string{types.QueryUserBlocks, suite.testData.user.String()},
+			expResult: &types.UserBlocks{block},
+		},
+		{
+			name:      "Empty user blocks returned correctly",
+			path:      []string{types.QueryUserBlocks, suite.testData.otherUser.String()},
+			expResult: &types.UserBlocks{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.NoError(suite.keeper.SaveUserBlock(suite.ctx, block))
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, abci.RequestQuery{})
+
+			if test.expResult != nil {
+				suite.NoError(err)
+				expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expResult)
+				suite.NoError(err)
+				suite.Equal(string(expectedIndented), string(result))
+			} else {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_queryFollowers() {
+	addr, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	suite.NoError(err)
+
+	followers := types.Followers{
+		types.NewFollower(suite.testData.user, suite.testData.subspace, types.RelationshipTypeFollow),
+		types.NewFollower(addr, suite.testData.otherSubspace, types.RelationshipTypeFriend),
+	}
+
+	tests := []struct {
+		name      string
+		path      []string
+		params    *types.QueryFollowersParams
+		expResult *types.Followers
+		expErr    error
+	}{
+		{
+			name:   "Invalid bech32 address returns error",
+			path:   []string{types.QueryFollowers, "invalidAddress"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid bech32 address: invalidAddress"),
+		},
+		{
+			name:      "All followers returned correctly",
+			path:      []string{types.QueryFollowers, suite.testData.otherUser.String()},
+			expResult: &types.Followers{followers[1], followers[0]},
+		},
+		{
+			name:      "Followers filtered by subspace returned correctly",
+			path:      []string{types.QueryFollowers, suite.testData.otherUser.String()},
+			params:    &types.QueryFollowersParams{Subspace: suite.testData.subspace},
+			expResult: &types.Followers{followers[0]},
+		},
+		{
+			name:      "Followers filtered by type returned correctly",
+			path:      []string{types.QueryFollowers, suite.testData.otherUser.String()},
+			params:    &types.QueryFollowersParams{Type: types.RelationshipTypeFriend},
+			expResult: &types.Followers{followers[1]},
+		},
+		{
+			name:      "Paginated followers returned correctly",
+			path:      []string{types.QueryFollowers, suite.testData.otherUser.String()},
+			params:    &types.QueryFollowersParams{Page: 2, Limit: 1},
+			expResult: &types.Followers{followers[0]},
+		},
+		{
+			name:      "Out of range page returns empty followers",
+			path:      []string{types.QueryFollowers, suite.testData.otherUser.String()},
+			params:    &types.QueryFollowersParams{Page: 3, Limit: 1},
+			expResult: &types.Followers{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user,
+				types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFollow)))
+			suite.NoError(suite.keeper.StoreRelationship(suite.ctx, addr,
+				types.NewRelationship(suite.testData.otherUser, suite.testData.otherSubspace, types.RelationshipTypeFriend)))
+
+			var data []byte
+			if test.params != nil {
+				data = suite.keeper.Cdc.MustMarshalJSON(test.params)
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, abci.RequestQuery{Data: data})
+
+			if test.expResult != nil {
+				suite.NoError(err)
+				expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expResult)
+				suite.NoError(err)
+				suite.Equal(string(expectedIndented), string(result))
+			} else {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_queryRelationshipsCounts() {
+	tests := []struct {
+		name      string
+		path      []string
+		params    *types.QueryRelationshipsCountsParams
+		expResult *types.RelationshipsCounts
+		expErr    error
+	}{
+		{
+			name:   "Invalid bech32 address returns error",
+			path:   []string{types.QueryRelationshipsCounts, "invalidAddress"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid bech32 address: invalidAddress"),
+		},
+		{
+			name:      "Counts returned correctly",
+			path:      []string{types.QueryRelationshipsCounts, suite.testData.user.String()},
+			expResult: &types.RelationshipsCounts{Followers: 0, Following: 2},
+		},
+		{
+			name:      "Subspace counts returned correctly",
+			path:      []string{types.QueryRelationshipsCounts, suite.testData.otherUser.String()},
+			params:    &types.QueryRelationshipsCountsParams{Subspace: suite.testData.subspace},
+			expResult: &types.RelationshipsCounts{Followers: 1, Following: 0},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user,
+				types.NewRelationship(suite.testData.otherUser, suite.testData.subspace, types.RelationshipTypeFollow)))
+			suite.NoError(suite.keeper.StoreRelationship(suite.ctx, suite.testData.user,
+				types.NewRelationship(suite.testData.otherUser, suite.testData.otherSubspace, types.RelationshipTypeFollow)))
+
+			var data []byte
+			if test.params != nil {
+				data = suite.keeper.Cdc.MustMarshalJSON(test.params)
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, abci.RequestQuery{Data: data})
+
+			if test.expResult != nil {
+				suite.NoError(err)
+				expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expResult)
+				suite.NoError(err)
+				suite.Equal(string(expectedIndented), string(result))
+			} else {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_queryMutuals() {
+	third, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	suite.NoError(err)
+	fourth, err := sdk.AccAddressFromBech32("cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4")
+	suite.NoError(err)
+
+	tests := []struct {
+		name      string
+		path      []string
+		expResult []sdk.AccAddress
+		expErr    error
+	}{
+		{
+			name:   "Missing address returns error",
+			path:   []string{types.QueryMutuals, suite.testData.user.String()},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "two addresses must be provided"),
+		},
+		{
+			name:   "Invalid bech32 address returns error",
+			path:   []string{types.QueryMutuals, suite.testData.user.String(), "invalidAddress"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid bech32 address: invalidAddress"),
+		},
+		{
+			name:      "Mutuals returned correctly",
+			path:      []string{types.QueryMutuals, suite.testData.otherUser.String(), third.String()},
+			expResult: []sdk.AccAddress{fourth},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.setupGraph()
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, abci.RequestQuery{})
+
+			if test.expResult != nil {
+				suite.NoError(err)
+				expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expResult)
+				suite.NoError(err)
+				suite.Equal(string(expectedIndented), string(result))
+			} else {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_querySuggestions() {
+	tests := []struct {
+		name   string
+		path   []string
+		params *types.QuerySuggestionsParams
+		expLen int
+		expErr error
+	}{
+		{
+			name:   "Invalid bech32 address returns error",
+			path:   []string{types.QuerySuggestions, "invalidAddress"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid bech32 address: invalidAddress"),
+		},
+		{
+			name:   "Default limit is used",
+			path:   []string{types.QuerySuggestions, suite.testData.user.String()},
+			expLen: 2,
+		},
+		{
+			name:   "Given limit is used",
+			path:   []string{types.QuerySuggestions, suite.testData.user.String()},
+			params: &types.QuerySuggestionsParams{Limit: 1},
+			expLen: 1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.setupGraph()
+
+			var data []byte
+			if test.params != nil {
+				data = suite.keeper.Cdc.MustMarshalJSON(test.params)
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, abci.RequestQuery{Data: data})
+
+			if test.expErr == nil {
+				suite.NoError(err)
+				var suggestions types.Suggestions
+				suite.NoError(suite.keeper.Cdc.UnmarshalJSON(result, &suggestions))
+				suite.Len(suggestions, test.expLen)
+			} else {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_queryUsersPath() {
+	sixth, err := sdk.AccAddressFromBech32("cosmos15lt0mflt6j9a9auj7yl3p20xec4xvljge0zhae")
+	suite.NoError(err)
+
+	tests := []struct {
+		name      string
+		path      []string
+		params    *types.QueryUsersPathParams
+		expResult *types.UsersPath
+		expErr    error
+	}{
+		{
+			name:   "Invalid bech32 address returns error",
+			path:   []string{types.QueryUsersPath, "invalidAddress", sixth.String()},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid bech32 address: invalidAddress"),
+		},
+		{
+			name:   "Too many hops return error",
+			path:   []string{types.QueryUsersPath, suite.testData.user.String(), sixth.String()},
+			params: &types.QueryUsersPathParams{MaxHops: types.MaxPathMaxHops + 1},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "max hops cannot be greater than 6"),
+		},
+		{
+			name: "Default max hops are used",
+			path: []string{types.QueryUsersPath, suite.testData.user.String(), sixth.String()},
+			expResult: &types.UsersPath{
+				From: suite.testData.user, To: sixth, MaxHops: types.DefaultPathMaxHops, Connected: true, Distance: 3,
+			},
+		},
+		{
+			name:   "Given max hops are used",
+			path:   []string{types.QueryUsersPath, suite.testData.user.String(), sixth.String()},
+			params: &types.QueryUsersPathParams{MaxHops: 2},
+			expResult: &types.UsersPath{
+				From: suite.testData.user, To: sixth, MaxHops: 2, Connected: false, Distance: 0,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.setupGraph()
+
+			var data []byte
+			if test.params != nil {
+				data = suite.keeper.Cdc.MustMarshalJSON(test.params)
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, abci.RequestQuery{Data: data})
+
+			if test.expResult != nil {
+				suite.NoError(err)
+				expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expResult)
+				suite.NoError(err)
+				suite.Equal(string(expectedIndented), string(result))
+			} else {
+				suite.Error(err)
 				suite.Equal(test.expErr.Error(), err.Error())
 				suite.Nil(result)
 			}
@@ -0,0 +1,16 @@
+package v0100
+
+// DONTCOVER
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "relationships"
+)
+
+// GenesisState contains the data of a v0.10.0 genesis state for the relationships module
+type GenesisState struct {
+	UsersRelationships map[string][]sdk.AccAddress `json:"users_relationships"`
+}
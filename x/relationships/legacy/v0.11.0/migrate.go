@@ -0,0 +1,47 @@
+package v0110
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v0100relationships "github.com/desmos-labs/desmos/x/relationships/legacy/v0.10.0"
+)
+
+// Migrate accepts exported genesis state from v0.10.0 and migrates it to v0.11.0
+// genesis state. Since legacy relationships did not have a subspace nor a type, they are all
+// migrated as follow relationships inside the given subspace.
+// Relationships towards the user himself and duplicated relationships are removed, as they are not valid anymore
+func Migrate(oldGenState v0100relationships.GenesisState, subspace string) GenesisState {
+	usersRelationships := make(map[string][]Relationship, len(oldGenState.UsersRelationships))
+	for user, recipients := range oldGenState.UsersRelationships {
+		if relationships := ConvertRelationships(user, recipients, subspace); len(relationships) > 0 {
+			usersRelationships[user] = relationships
+		}
+	}
+
+	return GenesisState{
+		UsersRelationships:   usersRelationships,
+		RelationshipRequests: nil,
+		UsersBlocks:          nil,
+	}
+}
+
+// ConvertRelationships converts the v0.10.0 recipients of the given user into
+// v0.11.0 follow relationships inside the given subspace
+func ConvertRelationships(user string, recipients []sdk.AccAddress, subspace string) []Relationship {
+	var relationships []Relationship
+	found := map[string]bool{}
+	for _, recipient := range recipients {
+		if recipient.Empty() || recipient.String() == user || found[recipient.String()] {
+			continue
+		}
+		found[recipient.String()] = true
+
+		relationships = append(relationships, Relationship{
+			Recipient: recipient,
+			Subspace:  subspace,
+			Type:      RelationshipTypeFollow,
+		})
+	}
+
+	return relationships
+}
@@ -0,0 +1,44 @@
+package v0110_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v0100relationships "github.com/desmos-labs/desmos/x/relationships/legacy/v0.10.0"
+	v0110relationships "github.com/desmos-labs/desmos/x/relationships/legacy/v0.11.0"
+)
+
+func TestMigrate(t *testing.T) {
+	user, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	otherUser, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	require.NoError(t, err)
+
+	thirdUser, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	require.NoError(t, err)
+
+	v0100GenState := v0100relationships.GenesisState{
+		UsersRelationships: map[string][]sdk.AccAddress{
+			user.String():      {otherUser, thirdUser, otherUser, user},
+			otherUser.String(): {user},
+			thirdUser.String(): {thirdUser},
+		},
+	}
+
+	expected := v0110relationships.GenesisState{
+		UsersRelationships: map[string][]v0110relationships.Relationship{
+			user.String(): {
+				{Recipient: otherUser, Subspace: v0110relationships.DefaultSubspace, Type: "follow"},
+				{Recipient: thirdUser, Subspace: v0110relationships.DefaultSubspace, Type: "follow"},
+			},
+			otherUser.String(): {
+				{Recipient: user, Subspace: v0110relationships.DefaultSubspace, Type: "follow"},
+			},
+		},
+	}
+
+	require.Equal(t, expected, v0110relationships.Migrate(v0100GenState, v0110relationships.DefaultSubspace))
+}
@@ -0,0 +1,46 @@
+package v0110
+
+// DONTCOVER
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "relationships"
+
+	// DefaultSubspace is the subspace inside which the legacy relationships are migrated by default.
+	// It corresponds to the SHA-256 hash of the plain-text "desmos"
+	DefaultSubspace = "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+
+	RelationshipTypeFollow = "follow"
+)
+
+// GenesisState contains the data of a v0.11.0 genesis state for the relationships module
+type GenesisState struct {
+	UsersRelationships   map[string][]Relationship `json:"users_relationships"`
+	RelationshipRequests []RelationshipRequest     `json:"relationship_requests"`
+	UsersBlocks          []UserBlock               `json:"users_blocks"`
+}
+
+// Relationship represents a relationship that a user has created towards a recipient inside a subspace
+type Relationship struct {
+	Recipient sdk.AccAddress `json:"recipient"`
+	Subspace  string         `json:"subspace"`
+	Type      string         `json:"type"`
+}
+
+// RelationshipRequest represents a request to create a mutual relationship
+type RelationshipRequest struct {
+	Sender   sdk.AccAddress `json:"sender"`
+	Receiver sdk.AccAddress `json:"receiver"`
+	Subspace string         `json:"subspace"`
+	Type     string         `json:"type"`
+}
+
+// UserBlock represents the block that a user has put on another one
+type UserBlock struct {
+	Blocker sdk.AccAddress `json:"blocker"`
+	Blocked sdk.AccAddress `json:"blocked"`
+	Reason  string         `json:"reason,omitempty"`
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/desmos-labs/desmos/x/relationships/types"
 	"github.com/tendermint/tendermint/libs/kv"
 )
@@ -14,10 +13,26 @@ import (
 func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 	switch {
 	case bytes.HasPrefix(kvA.Key, types.RelationshipsStorePrefix):
-		var relationshipsA, relationshipsB []sdk.AccAddress
-		cdc.MustUnmarshalBinaryBare(kvA.Value, &relationshipsA)
-		cdc.MustUnmarshalBinaryBare(kvB.Value, &relationshipsB)
-		return fmt.Sprintf("Relationships: %s\nRelationships: %s\n", relationshipsA, relationshipsB)
+		var relationshipA, relationshipB types.Relationship
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &relationshipA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &relationshipB)
+		return fmt.Sprintf("Relationship: %s\nRelationship: %s\n", relationshipA, relationshipB)
+	case bytes.HasPrefix(kvA.Key, types.OutgoingRelationshipRequestsStorePrefix),
+		bytes.HasPrefix(kvA.Key, types.IncomingRelationshipRequestsStorePrefix):
+		var requestA, requestB types.RelationshipRequest
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &requestA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &requestB)
+		return fmt.Sprintf("Relationship request: %s\nRelationship request: %s\n", requestA, requestB)
+	case bytes.HasPrefix(kvA.Key, types.FollowersStorePrefix):
+		var followerA, followerB types.Follower
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &followerA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &followerB)
+		return fmt.Sprintf("Follower: %s\nFollower: %s\n", followerA, followerB)
+	case bytes.HasPrefix(kvA.Key, types.UsersBlocksStorePrefix):
+		var blockA, blockB types.UserBlock
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &blockA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &blockB)
+		return fmt.Sprintf("User block: %s\nUser block: %s\n", blockA, blockB)
 	default:
 		panic(fmt.Sprintf("invalid relationships key %X", kvA.Key))
 	}
@@ -18,7 +18,22 @@ var (
 	anotherKey      = ed25519.GenPrivKey().PubKey()
 	anotherUserAddr = sdk.AccAddress(anotherKey.Address())
 
-	relationships = []sdk.AccAddress{accountCreatorAddr, anotherUserAddr}
+	relationship = types.NewRelationship(
+		anotherUserAddr,
+		"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e",
+		types.RelationshipTypeFollow,
+	)
+
+	request = types.NewRelationshipRequest(
+		accountCreatorAddr,
+		anotherUserAddr,
+		"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e",
+		types.RelationshipTypeFriend,
+	)
+
+	follower = types.NewFollower(accountCreatorAddr, relationship.Subspace, relationship.Type)
+
+	block = types.NewUserBlock(accountCreatorAddr, anotherUserAddr, "spam")
 )
 
 func makeTestCodec() (cdc *codec.Codec) {
@@ -33,14 +48,33 @@ func TestDecodeStore(t *testing.T) {
 	cdc := makeTestCodec()
 
 	kvPairs := kv.Pairs{
-		kv.Pair{Key: types.RelationshipsStoreKey(accountCreatorAddr), Value: cdc.MustMarshalBinaryBare(&relationships)},
+		kv.Pair{
+			Key:   types.RelationshipsStoreKey(accountCreatorAddr, relationship.Subspace, relationship.Recipient),
+			Value: cdc.MustMarshalBinaryBare(&relationship),
+		},
+		kv.Pair{
+			Key:   types.OutgoingRelationshipRequestStoreKey(accountCreatorAddr, request.Subspace, request.Receiver),
+			Value: cdc.MustMarshalBinaryBare(&request),
+		},
+		kv.Pair{
+			Key:   types.FollowerStoreKey(anotherUserAddr, follower.Subspace, accountCreatorAddr),
+			Value: cdc.MustMarshalBinaryBare(&follower),
+		},
+		kv.Pair{
+			Key:   types.UserBlockStoreKey(accountCreatorAddr, anotherUserAddr),
+			Value: cdc.MustMarshalBinaryBare(&block),
+		},
+		kv.Pair{Key: []byte("other"), Value: []byte("value")},
 	}
 
 	tests := []struct {
 		name        string
 		expectedLog string
 	}{
-		{"Relationships", fmt.Sprintf("Relationships: %s\nRelationships: %s\n", relationships, relationships)},
+		{"Relationship", fmt.Sprintf("Relationship: %s\nRelationship: %s\n", relationship, relationship)},
+		{"Relationship request", fmt.Sprintf("Relationship request: %s\nRelationship request: %s\n", request, request)},
+		{"Follower", fmt.Sprintf("Follower: %s\nFollower: %s\n", follower, follower)},
+		{"User block", fmt.Sprintf("User block: %s\nUser block: %s\n", block, block)},
 		{"other", ""},
 	}
 
@@ -15,23 +15,95 @@ func RandomizedGenState(simsState *module.SimulationState) {
 
 	profileGenesis := types.NewGenesisState(
 		userRelationshipsMap,
+		randomRelationshipRequests(simsState),
+		randomUsersBlocks(simsState),
 	)
 
 	simsState.GenState[types.ModuleName] = simsState.Cdc.MustMarshalJSON(profileGenesis)
 }
 
 // randomRelationships returns randomly generated genesis relationships and their associated users - IDs map
-func randomRelationships(simState *module.SimulationState) map[string][]sdk.AccAddress {
+func randomRelationships(simState *module.SimulationState) map[string]types.Relationships {
 	relationshipsNumber := simState.Rand.Intn(sim.RandIntBetween(simState.Rand, 1, 100))
-	usersRelationships := map[string][]sdk.AccAddress{}
+	usersRelationships := map[string]types.Relationships{}
 
 	for index := 0; index < relationshipsNumber; index++ {
 		sender, _ := sim.RandomAcc(simState.Rand, simState.Accounts)
 		receiver, _ := sim.RandomAcc(simState.Rand, simState.Accounts)
 		if !sender.Equals(receiver) {
-			usersRelationships[sender.Address.String()] = []sdk.AccAddress{receiver.Address}
+			usersRelationships[sender.Address.String()] = types.Relationships{types.NewRelationship(
+				receiver.Address,
+				RandomSubspace(simState.Rand),
+				types.RelationshipTypeFollow,
+			)}
 		}
 	}
 
 	return usersRelationships
 }
+
+// randomRelationshipRequests returns randomly generated genesis relationship requests
+func randomRelationshipRequests(simState *module.SimulationState) types.RelationshipRequests {
+	requestsNumber := simState.Rand.Intn(sim.RandIntBetween(simState.Rand, 1, 30))
+	requests := types.RelationshipRequests{}
+
+	for index := 0; index < requestsNumber; index++ {
+		sender, _ := sim.RandomAcc(simState.Rand, simState.Accounts)
+		receiver, _ := sim.RandomAcc(simState.Rand, simState.Accounts)
+		if sender.Equals(receiver) {
+			continue
+		}
+
+		request := types.NewRelationshipRequest(
+			sender.Address,
+			receiver.Address,
+			RandomSubspace(simState.Rand),
+			RandomMutualRelationshipType(simState.Rand),
+		)
+
+		// Avoid duplicated requests that would make the genesis initialization fail
+		if !containsRequest(requests, request) {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests
+}
+
+// containsRequest tells whether the given requests contain one having the same sender, receiver and subspace of request
+func containsRequest(requests types.RelationshipRequests, request types.RelationshipRequest) bool {
+	for _, r := range requests {
+		if r.Sender.Equals(request.Sender) && r.Receiver.Equals(request.Receiver) && r.Subspace == request.Subspace {
+			return true
+		}
+	}
+	return false
+}
+
+// randomUsersBlocks returns randomly generated genesis users blocks
+func randomUsersBlocks(simState *module.SimulationState) types.UserBlocks {
+	blocksNumber := simState.Rand.Intn(sim.RandIntBetween(simState.Rand, 1, 30))
+	blocks := types.UserBlocks{}
+
+	for index := 0; index < blocksNumber; index++ {
+		blocker, _ := sim.RandomAcc(simState.Rand, simState.Accounts)
+		blocked, _ := sim.RandomAcc(simState.Rand, simState.Accounts)
+		if blocker.Equals(blocked) || containsBlock(blocks, blocker.Address, blocked.Address) {
+			continue
+		}
+
+		blocks = append(blocks, types.NewUserBlock(blocker.Address, blocked.Address, RandomBlockReason(simState.Rand)))
+	}
+
+	return blocks
+}
+
+// containsBlock tells whether the given blocks contain one created by the blocker towards the blocked user
+func containsBlock(blocks types.UserBlocks, blocker, blocked sdk.AccAddress) bool {
+	for _, block := range blocks {
+		if block.Blocker.Equals(blocker) && block.Blocked.Equals(blocked) {
+			return true
+		}
+	}
+	return false
+}
@@ -13,8 +13,14 @@ import (
 )
 
 const (
-	OpWeightMsgCreateRelationship = "op_weight_msg_create_relationship"
-	OpWeightMsgDeleteRelationship = "op_weight_msg_delete_relationship"
+	OpWeightMsgCreateRelationship         = "op_weight_msg_create_relationship"
+	OpWeightMsgDeleteRelationship         = "op_weight_msg_delete_relationship"
+	OpWeightMsgRequestRelationship        = "op_weight_msg_request_relationship"
+	OpWeightMsgAcceptRelationshipRequest  = "op_weight_msg_accept_relationship_request"
+	OpWeightMsgDeclineRelationshipRequest = "op_weight_msg_decline_relationship_request"
+	OpWeightMsgCancelRelationshipRequest  = "op_weight_msg_cancel_relationship_request"
+	OpWeightMsgBlockUser                  = "op_weight_msg_block_user"
+	OpWeightMsgUnblockUser                = "op_weight_msg_unblock_user"
 
 	DefaultGasValue = 200000
 )
@@ -35,6 +41,48 @@ func WeightedOperations(appParams sim.AppParams, cdc *codec.Codec, k keeper.Keep
 		},
 	)
 
+	var weightMsgRequestRelationship int
+	appParams.GetOrGenerate(cdc, OpWeightMsgRequestRelationship, &weightMsgRequestRelationship, nil,
+		func(_ *rand.Rand) {
+			weightMsgRequestRelationship = params.DefaultWeightMsgRequestRelationship
+		},
+	)
+
+	var weightMsgAcceptRelationshipRequest int
+	appParams.GetOrGenerate(cdc, OpWeightMsgAcceptRelationshipRequest, &weightMsgAcceptRelationshipRequest, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptRelationshipRequest = params.DefaultWeightMsgAcceptRelationshipRequest
+		},
+	)
+
+	var weightMsgDeclineRelationshipRequest int
+	appParams.GetOrGenerate(cdc, OpWeightMsgDeclineRelationshipRequest, &weightMsgDeclineRelationshipRequest, nil,
+		func(_ *rand.Rand) {
+			weightMsgDeclineRelationshipRequest = params.DefaultWeightMsgDeclineRelationshipRequest
+		},
+	)
+
+	var weightMsgCancelRelationshipRequest int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelRelationshipRequest, &weightMsgCancelRelationshipRequest, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelRelationshipRequest = params.DefaultWeightMsgCancelRelationshipRequest
+		},
+	)
+
+	var weightMsgBlockUser int
+	appParams.GetOrGenerate(cdc, OpWeightMsgBlockUser, &weightMsgBlockUser, nil,
+		func(_ *rand.Rand) {
+			weightMsgBlockUser = params.DefaultWeightMsgBlockUser
+		},
+	)
+
+	var weightMsgUnblockUser int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUnblockUser, &weightMsgUnblockUser, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnblockUser = params.DefaultWeightMsgUnblockUser
+		},
+	)
+
 	return sim.WeightedOperations{
 		sim.NewWeightedOperation(
 			weightMsgCreateRelationship,
@@ -44,5 +92,29 @@ func WeightedOperations(appParams sim.AppParams, cdc *codec.Codec, k keeper.Keep
 			weightMsgDeleteRelationship,
 			SimulateMsgDeleteRelationship(k, ak),
 		),
+		sim.NewWeightedOperation(
+			weightMsgRequestRelationship,
+			SimulateMsgRequestRelationship(k, ak),
+		),
+		sim.NewWeightedOperation(
+			weightMsgAcceptRelationshipRequest,
+			SimulateMsgAcceptRelationshipRequest(k, ak),
+		),
+		sim.NewWeightedOperation(
+			weightMsgDeclineRelationshipRequest,
+			SimulateMsgDeclineRelationshipRequest(k, ak),
+		),
+		sim.NewWeightedOperation(
+			weightMsgCancelRelationshipRequest,
+			SimulateMsgCancelRelationshipRequest(k, ak),
+		),
+		sim.NewWeightedOperation(
+			weightMsgBlockUser,
+			SimulateMsgBlockUser(k, ak),
+		),
+		sim.NewWeightedOperation(
+			weightMsgUnblockUser,
+			SimulateMsgUnblockUser(k, ak),
+		),
 	}
 }
@@ -0,0 +1,70 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	sim "github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/desmos-labs/desmos/x/relationships/keeper"
+	"github.com/desmos-labs/desmos/x/relationships/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// SimulateMsgBlockUser tests and runs a single msg block user
+// nolint: funlen
+func SimulateMsgBlockUser(k keeper.Keeper, ak auth.AccountKeeper) sim.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string) (OperationMsg sim.OperationMsg, futureOps []sim.FutureOperation, err error) {
+
+		if len(accs) == 0 {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		blocker, _ := sim.RandomAcc(r, accs)
+		blocked, _ := sim.RandomAcc(r, accs)
+
+		// skip if the two address are equals or the user has already been blocked
+		if blocker.Equals(blocked) || k.IsUserBlocked(ctx, blocker.Address, blocked.Address) {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgBlockUser(blocker.Address, blocked.Address, RandomBlockReason(r))
+		if err := sendRelationshipRequestMsg(r, app, ak, msg, blocker.Address, ctx, chainID, []crypto.PrivKey{blocker.PrivKey}); err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgUnblockUser tests and runs a single msg unblock user
+// nolint: funlen
+func SimulateMsgUnblockUser(k keeper.Keeper, ak auth.AccountKeeper) sim.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string) (OperationMsg sim.OperationMsg, futureOps []sim.FutureOperation, err error) {
+
+		if len(accs) == 0 {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		blocker, _ := sim.RandomAcc(r, accs)
+
+		// skip the test if the user has not blocked anyone
+		blocks := k.GetUserBlocks(ctx, blocker.Address)
+		if len(blocks) == 0 {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		block := blocks[r.Intn(len(blocks))]
+		msg := types.NewMsgUnblockUser(blocker.Address, block.Blocked)
+		if err := sendRelationshipRequestMsg(r, app, ak, msg, blocker.Address, ctx, chainID, []crypto.PrivKey{blocker.PrivKey}); err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
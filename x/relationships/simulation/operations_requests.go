@@ -0,0 +1,190 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	sim "github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/desmos-labs/desmos/x/relationships/keeper"
+	"github.com/desmos-labs/desmos/x/relationships/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// SimulateMsgRequestRelationship tests and runs a single msg request relationship
+// nolint: funlen
+func SimulateMsgRequestRelationship(k keeper.Keeper, ak auth.AccountKeeper) sim.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string) (OperationMsg sim.OperationMsg, futureOps []sim.FutureOperation, err error) {
+
+		sender, request, skip := randomRelationshipRequestFields(r, ctx, accs, k)
+		if skip {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgRequestRelationship(sender.Address, request.Receiver, request.Subspace, request.Type)
+		if err := sendRelationshipRequestMsg(r, app, ak, msg, sender.Address, ctx, chainID, []crypto.PrivKey{sender.PrivKey}); err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// randomRelationshipRequestFields returns random relationship request fields
+func randomRelationshipRequestFields(
+	r *rand.Rand, ctx sdk.Context, accs []sim.Account, k keeper.Keeper,
+) (sim.Account, types.RelationshipRequest, bool) {
+	if len(accs) == 0 {
+		return sim.Account{}, types.RelationshipRequest{}, true
+	}
+
+	// Get random accounts
+	sender, _ := sim.RandomAcc(r, accs)
+	receiver, _ := sim.RandomAcc(r, accs)
+
+	// skip if the two address are equals or the receiver has blocked the sender
+	if sender.Equals(receiver) || k.IsUserBlocked(ctx, receiver.Address, sender.Address) {
+		return sim.Account{}, types.RelationshipRequest{}, true
+	}
+
+	subspace := RandomSubspace(r)
+
+	// skip if the users are already related or a request between them already exists
+	if _, found := k.GetRelationship(ctx, sender.Address, subspace, receiver.Address); found {
+		return sim.Account{}, types.RelationshipRequest{}, true
+	}
+
+	if _, found := k.GetRelationshipRequest(ctx, sender.Address, subspace, receiver.Address); found {
+		return sim.Account{}, types.RelationshipRequest{}, true
+	}
+
+	if _, found := k.GetRelationshipRequest(ctx, receiver.Address, subspace, sender.Address); found {
+		return sim.Account{}, types.RelationshipRequest{}, true
+	}
+
+	request := types.NewRelationshipRequest(sender.Address, receiver.Address, subspace, RandomMutualRelationshipType(r))
+	return sender, request, false
+}
+
+// SimulateMsgAcceptRelationshipRequest tests and runs a single msg accept relationship request
+// nolint: funlen
+func SimulateMsgAcceptRelationshipRequest(k keeper.Keeper, ak auth.AccountKeeper) sim.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string) (OperationMsg sim.OperationMsg, futureOps []sim.FutureOperation, err error) {
+
+		receiver, request, skip := randomIncomingRequestFields(r, ctx, accs, k)
+		if skip {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgAcceptRelationshipRequest(receiver.Address, request.Sender, request.Subspace)
+		if err := sendRelationshipRequestMsg(r, app, ak, msg, receiver.Address, ctx, chainID, []crypto.PrivKey{receiver.PrivKey}); err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgDeclineRelationshipRequest tests and runs a single msg decline relationship request
+// nolint: funlen
+func SimulateMsgDeclineRelationshipRequest(k keeper.Keeper, ak auth.AccountKeeper) sim.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string) (OperationMsg sim.OperationMsg, futureOps []sim.FutureOperation, err error) {
+
+		receiver, request, skip := randomIncomingRequestFields(r, ctx, accs, k)
+		if skip {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgDeclineRelationshipRequest(receiver.Address, request.Sender, request.Subspace)
+		if err := sendRelationshipRequestMsg(r, app, ak, msg, receiver.Address, ctx, chainID, []crypto.PrivKey{receiver.PrivKey}); err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// randomIncomingRequestFields returns a random account along with one of the relationship requests it has received
+func randomIncomingRequestFields(
+	r *rand.Rand, ctx sdk.Context, accs []sim.Account, k keeper.Keeper,
+) (sim.Account, types.RelationshipRequest, bool) {
+	if len(accs) == 0 {
+		return sim.Account{}, types.RelationshipRequest{}, true
+	}
+
+	receiver, _ := sim.RandomAcc(r, accs)
+
+	// skip the test if the user has not received any request
+	requests := k.GetIncomingRelationshipRequests(ctx, receiver.Address)
+	if len(requests) == 0 {
+		return sim.Account{}, types.RelationshipRequest{}, true
+	}
+
+	return receiver, requests[r.Intn(len(requests))], false
+}
+
+// SimulateMsgCancelRelationshipRequest tests and runs a single msg cancel relationship request
+// nolint: funlen
+func SimulateMsgCancelRelationshipRequest(k keeper.Keeper, ak auth.AccountKeeper) sim.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string) (OperationMsg sim.OperationMsg, futureOps []sim.FutureOperation, err error) {
+
+		if len(accs) == 0 {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		sender, _ := sim.RandomAcc(r, accs)
+
+		// skip the test if the user has not sent any request
+		requests := k.GetOutgoingRelationshipRequests(ctx, sender.Address)
+		if len(requests) == 0 {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		request := requests[r.Intn(len(requests))]
+		msg := types.NewMsgCancelRelationshipRequest(sender.Address, request.Receiver, request.Subspace)
+		if err := sendRelationshipRequestMsg(r, app, ak, msg, sender.Address, ctx, chainID, []crypto.PrivKey{sender.PrivKey}); err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// sendRelationshipRequestMsg sends a transaction containing the given relationship request message
+// signed by the provided random account
+func sendRelationshipRequestMsg(r *rand.Rand, app *baseapp.BaseApp, ak auth.AccountKeeper,
+	msg sdk.Msg, signer sdk.AccAddress, ctx sdk.Context, chainID string, privkeys []crypto.PrivKey,
+) error {
+	account := ak.GetAccount(ctx, signer)
+	coins := account.SpendableCoins(ctx.BlockTime())
+
+	fees, err := sim.RandomFees(r, ctx, coins)
+	if err != nil {
+		return err
+	}
+
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		DefaultGasValue,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		privkeys...,
+	)
+
+	_, _, err = app.Deliver(tx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
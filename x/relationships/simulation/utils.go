@@ -5,11 +5,49 @@ package simulation
 import (
 	"math/rand"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/relationships/types"
+)
+
+var (
+	subspaces = []string{
+		"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e",
+		"2bdf5932925584b9a86470bea60adce69041608a447f84a3317723aa5678ec88",
+		"3d59f7548e1af2151b64135003ce63c0a484c26b9b8b166a7b1c1805ec34b00a",
+	}
+
+	blockReasons = []string{
+		"",
+		"spam",
+		"harassment",
+		"impersonation",
+	}
+
+	mutualRelationshipTypes = []types.RelationshipType{
+		types.RelationshipTypeFriend,
+		types.RelationshipTypeCloseFriend,
+	}
 )
 
 // RandomRelationship picks and returns a random relationships from an array
-func RandomRelationship(r *rand.Rand, relationships []sdk.AccAddress) sdk.AccAddress {
+func RandomRelationship(r *rand.Rand, relationships types.Relationships) types.Relationship {
 	idx := r.Intn(len(relationships))
 	return relationships[idx]
 }
+
+// RandomSubspace returns a random subspace from the above random subspaces
+func RandomSubspace(r *rand.Rand) string {
+	idx := r.Intn(len(subspaces))
+	return subspaces[idx]
+}
+
+// RandomMutualRelationshipType returns a random relationship type among the ones that must be requested
+func RandomMutualRelationshipType(r *rand.Rand) types.RelationshipType {
+	idx := r.Intn(len(mutualRelationshipTypes))
+	return mutualRelationshipTypes[idx]
+}
+
+// RandomBlockReason returns a random block reason from the above random reasons
+func RandomBlockReason(r *rand.Rand) string {
+	idx := r.Intn(len(blockReasons))
+	return blockReasons[idx]
+}
@@ -9,33 +9,118 @@ import (
 )
 
 const (
-	ModuleName               = models.ModuleName
-	RouterKey                = models.RouterKey
-	StoreKey                 = models.StoreKey
-	ActionCreateRelationship = models.ActionCreateRelationship
-	ActionDeleteRelationship = models.ActionDeleteRelationship
-	QuerierRoute             = models.QuerierRoute
-	QueryUserRelationships   = models.QueryUserRelationships
-	QueryRelationships       = models.QueryRelationships
+	ModuleName                       = models.ModuleName
+	RouterKey                        = models.RouterKey
+	StoreKey                         = models.StoreKey
+	ActionCreateRelationship         = models.ActionCreateRelationship
+	ActionDeleteRelationship         = models.ActionDeleteRelationship
+	ActionRequestRelationship        = models.ActionRequestRelationship
+	ActionAcceptRelationshipRequest  = models.ActionAcceptRelationshipRequest
+	ActionDeclineRelationshipRequest = models.ActionDeclineRelationshipRequest
+	ActionCancelRelationshipRequest  = models.ActionCancelRelationshipRequest
+	ActionBlockUser                  = models.ActionBlockUser
+	ActionUnblockUser                = models.ActionUnblockUser
+	QuerierRoute                     = models.QuerierRoute
+	QueryUserRelationships           = models.QueryUserRelationships
+	QueryRelationships               = models.QueryRelationships
+	QueryRelationshipRequests        = models.QueryRelationshipRequests
+	QueryUserBlocks                  = models.QueryUserBlocks
+	QueryFollowers                   = models.QueryFollowers
+	QueryRelationshipsCounts         = models.QueryRelationshipsCounts
+	QueryMutuals                     = models.QueryMutuals
+	QuerySuggestions                 = models.QuerySuggestions
+	QueryUsersPath                   = models.QueryUsersPath
+	GraphQueriesGasLimit             = models.GraphQueriesGasLimit
+	MaxGraphFanOut                   = models.MaxGraphFanOut
+	DefaultSuggestionsLimit          = models.DefaultSuggestionsLimit
+	MaxSuggestionsLimit              = models.MaxSuggestionsLimit
+	DefaultPathMaxHops               = models.DefaultPathMaxHops
+	MaxPathMaxHops                   = models.MaxPathMaxHops
+	MaxPathVisitedUsers              = models.MaxPathVisitedUsers
+
+	RelationshipTypeFollow      = models.RelationshipTypeFollow
+	RelationshipTypeFriend      = models.RelationshipTypeFriend
+	RelationshipTypeCloseFriend = models.RelationshipTypeCloseFriend
 )
 
 var (
 	// functions aliases
-	NewMsgCreateRelationship = msgs.NewMsgCreateRelationship
-	NewMsgDeleteRelationship = msgs.NewMsgDeleteRelationship
-	RegisterMessagesCodec    = msgs.RegisterMessagesCodec
-	RelationshipsStoreKey    = models.RelationshipsStoreKey
-	RegisterModelsCodec      = models.RegisterModelsCodec
-	NewRelationshipResponse  = models.NewRelationshipResponse
+	NewMsgCreateRelationship            = msgs.NewMsgCreateRelationship
+	NewMsgDeleteRelationship            = msgs.NewMsgDeleteRelationship
+	NewMsgRequestRelationship           = msgs.NewMsgRequestRelationship
+	NewMsgAcceptRelationshipRequest     = msgs.NewMsgAcceptRelationshipRequest
+	NewMsgDeclineRelationshipRequest    = msgs.NewMsgDeclineRelationshipRequest
+	NewMsgCancelRelationshipRequest     = msgs.NewMsgCancelRelationshipRequest
+	NewMsgBlockUser                     = msgs.NewMsgBlockUser
+	NewMsgUnblockUser                   = msgs.NewMsgUnblockUser
+	RegisterMessagesCodec               = msgs.RegisterMessagesCodec
+	RelationshipsStoreKey               = models.RelationshipsStoreKey
+	UserRelationshipsPrefix             = models.UserRelationshipsPrefix
+	UserSubspaceRelationshipsPrefix     = models.UserSubspaceRelationshipsPrefix
+	NewRelationship                     = models.NewRelationship
+	NewRelationshipRequest              = models.NewRelationshipRequest
+	NewRelationshipRequestsResponse     = models.NewRelationshipRequestsResponse
+	OutgoingRelationshipRequestsPrefix  = models.OutgoingRelationshipRequestsPrefix
+	OutgoingRelationshipRequestStoreKey = models.OutgoingRelationshipRequestStoreKey
+	IncomingRelationshipRequestsPrefix  = models.IncomingRelationshipRequestsPrefix
+	IncomingRelationshipRequestStoreKey = models.IncomingRelationshipRequestStoreKey
+	NewUserBlock                        = models.NewUserBlock
+	UserBlocksPrefix                    = models.UserBlocksPrefix
+	UserBlockStoreKey                   = models.UserBlockStoreKey
+	NewFollower                         = models.NewFollower
+	NewRelationshipsCounts              = models.NewRelationshipsCounts
+	UserFollowersPrefix                 = models.UserFollowersPrefix
+	UserSubspaceFollowersPrefix         = models.UserSubspaceFollowersPrefix
+	FollowerStoreKey                    = models.FollowerStoreKey
+	NewQueryFollowersParams             = models.NewQueryFollowersParams
+	NewQueryRelationshipsCountsParams   = models.NewQueryRelationshipsCountsParams
+	NewQueryMutualsParams               = models.NewQueryMutualsParams
+	NewQuerySuggestionsParams           = models.NewQuerySuggestionsParams
+	NewQueryUsersPathParams             = models.NewQueryUsersPathParams
+	NewSuggestion                       = models.NewSuggestion
+	NewUsersPath                        = models.NewUsersPath
+	NewQueryUserRelationshipsParams     = models.NewQueryUserRelationshipsParams
+	RegisterModelsCodec                 = models.RegisterModelsCodec
+	NewRelationshipResponse             = models.NewRelationshipResponse
 
 	// variable aliases
-	RelationshipsStorePrefix = models.RelationshipsStorePrefix
-	ModelsCdc                = models.ModelsCdc
-	MsgsCodec                = msgs.MsgsCodec
+	RelationshipsStorePrefix                = models.RelationshipsStorePrefix
+	OutgoingRelationshipRequestsStorePrefix = models.OutgoingRelationshipRequestsStorePrefix
+	IncomingRelationshipRequestsStorePrefix = models.IncomingRelationshipRequestsStorePrefix
+	UsersBlocksStorePrefix                  = models.UsersBlocksStorePrefix
+	FollowersStorePrefix                    = models.FollowersStorePrefix
+	ModelsCdc                               = models.ModelsCdc
+	MsgsCodec                               = msgs.MsgsCodec
 )
 
 type (
-	RelationshipsResponse = models.RelationshipsResponse
-	MsgCreateRelationship = msgs.MsgCreateRelationship
-	MsgDeleteRelationship = msgs.MsgDeleteRelationship
+	RelationshipsResponse          = models.RelationshipsResponse
+	Relationship                   = models.Relationship
+	Relationships                  = models.Relationships
+	RelationshipType               = models.RelationshipType
+	QueryUserRelationshipsParams   = models.QueryUserRelationshipsParams
+	QueryFollowersParams           = models.QueryFollowersParams
+	QueryRelationshipsCountsParams = models.QueryRelationshipsCountsParams
+	MsgCreateRelationship          = msgs.MsgCreateRelationship
+	MsgDeleteRelationship          = msgs.MsgDeleteRelationship
+	MsgRequestRelationship         = msgs.MsgRequestRelationship
+	MsgAcceptRelationshipRequest   = msgs.MsgAcceptRelationshipRequest
+	MsgDeclineRelationshipRequest  = msgs.MsgDeclineRelationshipRequest
+	MsgCancelRelationshipRequest   = msgs.MsgCancelRelationshipRequest
+	MsgBlockUser                   = msgs.MsgBlockUser
+	MsgUnblockUser                 = msgs.MsgUnblockUser
+	UserBlock                      = models.UserBlock
+	UserBlocks                     = models.UserBlocks
+	Follower                       = models.Follower
+	Followers                      = models.Followers
+	RelationshipsCounts            = models.RelationshipsCounts
+	QueryMutualsParams             = models.QueryMutualsParams
+	QuerySuggestionsParams         = models.QuerySuggestionsParams
+	QueryUsersPathParams           = models.QueryUsersPathParams
+	Suggestion                     = models.Suggestion
+	Suggestions                    = models.Suggestions
+	UsersPath                      = models.UsersPath
+	RelationshipRequest            = models.RelationshipRequest
+	RelationshipRequests           = models.RelationshipRequests
+	RelationshipRequestsResponse   = models.RelationshipRequestsResponse
 )
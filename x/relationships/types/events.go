@@ -5,7 +5,24 @@ const (
 	EventTypeRelationshipCreated  = "relationship_created"
 	EventTypeRelationshipsDeleted = "relationships_deleted"
 
+	// Relationship requests events
+	EventTypeRelationshipRequested       = "relationship_requested"
+	EventTypeRelationshipRequestAccepted = "relationship_request_accepted"
+	EventTypeRelationshipRequestDeclined = "relationship_request_declined"
+	EventTypeRelationshipRequestCanceled = "relationship_request_canceled"
+
+	// User blocks events
+	EventTypeUserBlocked   = "user_blocked"
+	EventTypeUserUnblocked = "user_unblocked"
+
 	// Relationships attributes
 	AttributeRelationshipSender   = "relationship_sender"
 	AttributeRelationshipReceiver = "relationship_receiver"
+	AttributeRelationshipSubspace = "relationship_subspace"
+	AttributeRelationshipType     = "relationship_type"
+
+	// User blocks attributes
+	AttributeUserBlockBlocker = "blocker"
+	AttributeUserBlockBlocked = "blocked"
+	AttributeUserBlockReason  = "reason"
 )
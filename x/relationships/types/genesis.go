@@ -8,30 +8,68 @@ import (
 
 // GenesisState contains the data of the genesis state for the profile module
 type GenesisState struct {
-	UsersRelationships map[string][]sdk.AccAddress `json:"users_relationships"`
+	UsersRelationships   map[string]Relationships `json:"users_relationships"`
+	RelationshipRequests RelationshipRequests     `json:"relationship_requests"`
+	UsersBlocks          UserBlocks               `json:"users_blocks"`
 }
 
 // NewGenesisState creates a new genesis state
-func NewGenesisState(usersRelationships map[string][]sdk.AccAddress) GenesisState {
+func NewGenesisState(
+	usersRelationships map[string]Relationships, requests RelationshipRequests, usersBlocks UserBlocks,
+) GenesisState {
 	return GenesisState{
-		UsersRelationships: usersRelationships,
+		UsersRelationships:   usersRelationships,
+		RelationshipRequests: requests,
+		UsersBlocks:          usersBlocks,
 	}
 }
 
 // DefaultGenesisState returns a default GenesisState
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		UsersRelationships: map[string][]sdk.AccAddress{},
+		UsersRelationships:   map[string]Relationships{},
+		RelationshipRequests: RelationshipRequests{},
+		UsersBlocks:          UserBlocks{},
 	}
 }
 
 // ValidateGenesis validates the given genesis state and returns an error if something is invalid
 func ValidateGenesis(data GenesisState) error {
-	for _, relationships := range data.UsersRelationships {
-		for _, address := range relationships {
-			if address.Empty() {
-				return fmt.Errorf("invalid address %s", address)
+	for user, relationships := range data.UsersRelationships {
+		address, err := sdk.AccAddressFromBech32(user)
+		if err != nil {
+			return fmt.Errorf("invalid user address %s", user)
+		}
+
+		// Each recipient can be related to the user only once inside each subspace
+		related := map[string]bool{}
+		for _, relationship := range relationships {
+			if err := relationship.Validate(); err != nil {
+				return err
+			}
+
+			if relationship.Recipient.Equals(address) {
+				return fmt.Errorf("user %s cannot have a relationship with himself", user)
+			}
+
+			key := relationship.Subspace + relationship.Recipient.String()
+			if related[key] {
+				return fmt.Errorf("duplicated relationship between %s and %s inside subspace %s",
+					user, relationship.Recipient, relationship.Subspace)
 			}
+			related[key] = true
+		}
+	}
+
+	for _, request := range data.RelationshipRequests {
+		if err := request.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, block := range data.UsersBlocks {
+		if err := block.Validate(); err != nil {
+			return err
 		}
 	}
 
@@ -9,13 +9,17 @@ import (
 )
 
 func TestNewGenesis(t *testing.T) {
-	usersRelationships := map[string][]sdk.AccAddress{}
+	usersRelationships := map[string]types.Relationships{}
+	requests := types.RelationshipRequests{}
+	blocks := types.UserBlocks{}
 
 	expGenState := types.GenesisState{
-		UsersRelationships: usersRelationships,
+		UsersRelationships:   usersRelationships,
+		RelationshipRequests: requests,
+		UsersBlocks:          blocks,
 	}
 
-	actualGenState := types.NewGenesisState(usersRelationships)
+	actualGenState := types.NewGenesisState(usersRelationships, requests, blocks)
 	require.Equal(t, expGenState, actualGenState)
 }
 
@@ -26,6 +30,8 @@ func TestValidateGenesis(t *testing.T) {
 	otherUser, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
 	require.NoError(t, err)
 
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+
 	tests := []struct {
 		name        string
 		genesis     types.GenesisState
@@ -36,23 +42,89 @@ func TestValidateGenesis(t *testing.T) {
 			genesis:     types.DefaultGenesisState(),
 			shouldError: false,
 		},
+		{
+			name: "Genesis with invalid user address returns error",
+			genesis: types.GenesisState{
+				UsersRelationships: map[string]types.Relationships{
+					"invalid": {types.NewRelationship(user, subspace, types.RelationshipTypeFollow)},
+				},
+			},
+			shouldError: true,
+		},
 		{
 			name: "Genesis with invalid relationship return error",
 			genesis: types.GenesisState{
-				UsersRelationships: map[string][]sdk.AccAddress{
-					user.String():      {sdk.AccAddress{}},
-					otherUser.String(): {user},
+				UsersRelationships: map[string]types.Relationships{
+					user.String():      {types.NewRelationship(sdk.AccAddress{}, subspace, types.RelationshipTypeFollow)},
+					otherUser.String(): {types.NewRelationship(user, subspace, types.RelationshipTypeFollow)},
+				},
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with invalid relationship type return error",
+			genesis: types.GenesisState{
+				UsersRelationships: map[string]types.Relationships{
+					user.String(): {types.NewRelationship(otherUser, subspace, "enemy")},
+				},
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with self relationship returns error",
+			genesis: types.GenesisState{
+				UsersRelationships: map[string]types.Relationships{
+					user.String(): {types.NewRelationship(user, subspace, types.RelationshipTypeFollow)},
 				},
 			},
 			shouldError: true,
 		},
+		{
+			name: "Genesis with duplicated relationship returns error",
+			genesis: types.GenesisState{
+				UsersRelationships: map[string]types.Relationships{
+					user.String(): {
+						types.NewRelationship(otherUser, subspace, types.RelationshipTypeFollow),
+						types.NewRelationship(otherUser, subspace, types.RelationshipTypeFriend),
+					},
+				},
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with invalid relationship request returns error",
+			genesis: types.GenesisState{
+				UsersRelationships: map[string]types.Relationships{},
+				RelationshipRequests: types.RelationshipRequests{
+					types.NewRelationshipRequest(user, otherUser, subspace, types.RelationshipTypeFollow),
+				},
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with invalid user block returns error",
+			genesis: types.GenesisState{
+				UsersRelationships: map[string]types.Relationships{},
+				UsersBlocks:        types.UserBlocks{types.NewUserBlock(user, user, "spam")},
+			},
+			shouldError: true,
+		},
 		{
 			name: "Valid Genesis returns no errors",
 			genesis: types.GenesisState{
-				UsersRelationships: map[string][]sdk.AccAddress{
-					user.String():      {otherUser},
-					otherUser.String(): {user},
+				UsersRelationships: map[string]types.Relationships{
+					user.String(): {
+						types.NewRelationship(otherUser, subspace, types.RelationshipTypeFollow),
+						types.NewRelationship(otherUser, "2bdf5932925584b9a86470bea60adce69041608a447f84a3317723aa5678ec88",
+							types.RelationshipTypeFollow),
+					},
+					otherUser.String(): {types.NewRelationship(user, subspace, types.RelationshipTypeFriend)},
+				},
+				RelationshipRequests: types.RelationshipRequests{
+					types.NewRelationshipRequest(user, otherUser,
+						"2bdf5932925584b9a86470bea60adce69041608a447f84a3317723aa5678ec88", types.RelationshipTypeCloseFriend),
 				},
+				UsersBlocks: types.UserBlocks{types.NewUserBlock(otherUser, user, "spam")},
 			},
 			shouldError: false,
 		},
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RelationshipsHooks defines the hooks that other modules can implement to react to the relationships events
+type RelationshipsHooks interface {
+	// AfterUserBlocked is called after the blocker has blocked the blocked user
+	AfterUserBlocked(ctx sdk.Context, blocker, blocked sdk.AccAddress)
+}
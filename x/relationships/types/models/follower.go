@@ -0,0 +1,75 @@
+package models
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Follower represents a user that has created a relationship towards another one inside a subspace.
+// Followers are stored inside a reverse index so that the users related to someone can be listed without
+// iterating over all the relationships
+type Follower struct {
+	User     sdk.AccAddress   `json:"user" yaml:"user"`
+	Subspace string           `json:"subspace" yaml:"subspace"`
+	Type     RelationshipType `json:"type" yaml:"type"`
+}
+
+// NewFollower is a constructor function for Follower
+func NewFollower(user sdk.AccAddress, subspace string, relType RelationshipType) Follower {
+	return Follower{
+		User:     user,
+		Subspace: subspace,
+		Type:     relType,
+	}
+}
+
+// String implements fmt.Stringer
+func (follower Follower) String() string {
+	return fmt.Sprintf("Follower:\n[User] %s [Subspace] %s [Type] %s",
+		follower.User,
+		follower.Subspace,
+		follower.Type,
+	)
+}
+
+// Followers represents a slice of Follower objects
+type Followers []Follower
+
+// Filter returns the followers belonging to the given subspace and having the given type.
+// Empty subspace and type values are not used to filter the followers
+func (followers Followers) Filter(subspace string, relType RelationshipType) Followers {
+	filtered := Followers{}
+	for _, follower := range followers {
+		if subspace != "" && follower.Subspace != subspace {
+			continue
+		}
+
+		if relType != "" && follower.Type != relType {
+			continue
+		}
+
+		filtered = append(filtered, follower)
+	}
+	return filtered
+}
+
+// RelationshipsCounts contains the number of users that have a relationship with a user (followers)
+// and the number of users with which the same user has a relationship (following)
+type RelationshipsCounts struct {
+	Followers uint64 `json:"followers" yaml:"followers"`
+	Following uint64 `json:"following" yaml:"following"`
+}
+
+// NewRelationshipsCounts is a constructor function for RelationshipsCounts
+func NewRelationshipsCounts(followers, following uint64) RelationshipsCounts {
+	return RelationshipsCounts{
+		Followers: followers,
+		Following: following,
+	}
+}
+
+// String implements fmt.Stringer
+func (counts RelationshipsCounts) String() string {
+	return fmt.Sprintf("Relationships counts:\n[Followers] %d [Following] %d", counts.Followers, counts.Following)
+}
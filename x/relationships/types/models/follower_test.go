@@ -0,0 +1,61 @@
+package models_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/relationships/types/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFollowers_Filter(t *testing.T) {
+	user, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	otherUser, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	require.NoError(t, err)
+
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	otherSubspace := "2bdf5932925584b9a86470bea60adce69041608a447f84a3317723aa5678ec88"
+
+	followers := models.Followers{
+		models.NewFollower(user, subspace, models.RelationshipTypeFollow),
+		models.NewFollower(otherUser, subspace, models.RelationshipTypeFriend),
+		models.NewFollower(user, otherSubspace, models.RelationshipTypeCloseFriend),
+	}
+
+	tests := []struct {
+		name     string
+		subspace string
+		relType  models.RelationshipType
+		expected models.Followers
+	}{
+		{
+			name:     "Empty filters return all the followers",
+			expected: followers,
+		},
+		{
+			name:     "Subspace filter returns the followers inside the subspace",
+			subspace: subspace,
+			expected: models.Followers{followers[0], followers[1]},
+		},
+		{
+			name:     "Type filter returns the followers having the type",
+			relType:  models.RelationshipTypeCloseFriend,
+			expected: models.Followers{followers[2]},
+		},
+		{
+			name:     "Subspace and type filters return no followers",
+			subspace: otherSubspace,
+			relType:  models.RelationshipTypeFollow,
+			expected: models.Followers{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, followers.Filter(test.subspace, test.relType))
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package models
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// GraphQueriesGasLimit represents the maximum amount of gas that can be consumed by a single
+	// social graph query, so that full nodes can serve them safely
+	GraphQueriesGasLimit uint64 = 10000000
+
+	// MaxGraphFanOut represents the maximum number of related users that are
+	// considered for each user while traversing the social graph
+	MaxGraphFanOut = 100
+
+	// DefaultSuggestionsLimit represents the default number of suggestions returned
+	DefaultSuggestionsLimit = 10
+
+	// MaxSuggestionsLimit represents the maximum number of suggestions that can be returned
+	MaxSuggestionsLimit = 50
+
+	// DefaultPathMaxHops represents the default number of hops used when looking for a path between two users
+	DefaultPathMaxHops uint64 = 3
+
+	// MaxPathMaxHops represents the maximum number of hops that can be used when looking for a path between two users
+	MaxPathMaxHops uint64 = 6
+
+	// MaxPathVisitedUsers represents the maximum number of users that can be visited
+	// while looking for a path between two users
+	MaxPathVisitedUsers = 1000
+)
+
+// Suggestion represents a user that might be followed, along with the number of
+// already followed users that have a relationship with him
+type Suggestion struct {
+	User    sdk.AccAddress `json:"user" yaml:"user"`
+	Overlap uint64         `json:"overlap" yaml:"overlap"`
+}
+
+// NewSuggestion is a constructor function for Suggestion
+func NewSuggestion(user sdk.AccAddress, overlap uint64) Suggestion {
+	return Suggestion{
+		User:    user,
+		Overlap: overlap,
+	}
+}
+
+// String implements fmt.Stringer
+func (suggestion Suggestion) String() string {
+	return fmt.Sprintf("Suggestion:\n[User] %s [Overlap] %d", suggestion.User, suggestion.Overlap)
+}
+
+// Suggestions represents a slice of Suggestion objects
+type Suggestions []Suggestion
+
+// Len implements sort.Interface
+func (suggestions Suggestions) Len() int {
+	return len(suggestions)
+}
+
+// Less implements sort.Interface.
+// Suggestions are ordered by decreasing overlap, and then by address
+func (suggestions Suggestions) Less(i, j int) bool {
+	if suggestions[i].Overlap != suggestions[j].Overlap {
+		return suggestions[i].Overlap > suggestions[j].Overlap
+	}
+	return suggestions[i].User.String() < suggestions[j].User.String()
+}
+
+// Swap implements sort.Interface
+func (suggestions Suggestions) Swap(i, j int) {
+	suggestions[i], suggestions[j] = suggestions[j], suggestions[i]
+}
+
+// UsersPath contains the result of looking for a chain of relationships going from a user to another one.
+// Truncated tells whether the search has reached one of the graph limits before visiting all
+// the users within the given number of hops, in which case a longer or missing path might not be definitive
+type UsersPath struct {
+	From      sdk.AccAddress `json:"from" yaml:"from"`
+	To        sdk.AccAddress `json:"to" yaml:"to"`
+	MaxHops   uint64         `json:"max_hops" yaml:"max_hops"`
+	Connected bool           `json:"connected" yaml:"connected"`
+	Distance  uint64         `json:"distance" yaml:"distance"`
+	Truncated bool           `json:"truncated" yaml:"truncated"`
+}
+
+// NewUsersPath is a constructor function for UsersPath
+func NewUsersPath(from, to sdk.AccAddress, maxHops uint64, connected bool, distance uint64, truncated bool) UsersPath {
+	return UsersPath{
+		From:      from,
+		To:        to,
+		MaxHops:   maxHops,
+		Connected: connected,
+		Distance:  distance,
+		Truncated: truncated,
+	}
+}
+
+// String implements fmt.Stringer
+func (path UsersPath) String() string {
+	return fmt.Sprintf("Users path:\n[From] %s [To] %s [Max hops] %d [Connected] %t [Distance] %d [Truncated] %t",
+		path.From, path.To, path.MaxHops, path.Connected, path.Distance, path.Truncated,
+	)
+}
@@ -0,0 +1,35 @@
+package models_test
+
+import (
+	"sort"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/relationships/types/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSuggestions_Sort(t *testing.T) {
+	user, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	otherUser, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	require.NoError(t, err)
+
+	thirdUser, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	require.NoError(t, err)
+
+	suggestions := models.Suggestions{
+		models.NewSuggestion(otherUser, 1),
+		models.NewSuggestion(thirdUser, 3),
+		models.NewSuggestion(user, 1),
+	}
+	sort.Sort(suggestions)
+
+	expected := models.Suggestions{
+		models.NewSuggestion(thirdUser, 3),
+		models.NewSuggestion(user, 1),
+		models.NewSuggestion(otherUser, 1),
+	}
+	require.Equal(t, expected, suggestions)
+}
@@ -7,20 +7,105 @@ const (
 	RouterKey  = ModuleName
 	StoreKey   = ModuleName
 
-	ActionCreateRelationship = "create_relationship"
-	ActionDeleteRelationship = "delete_relationship"
+	ActionCreateRelationship         = "create_relationship"
+	ActionDeleteRelationship         = "delete_relationship"
+	ActionRequestRelationship        = "request_relationship"
+	ActionAcceptRelationshipRequest  = "accept_relationship_request"
+	ActionDeclineRelationshipRequest = "decline_relationship_request"
+	ActionCancelRelationshipRequest  = "cancel_relationship_request"
+	ActionBlockUser                  = "block_user"
+	ActionUnblockUser                = "unblock_user"
 
 	// Queries
-	QuerierRoute           = ModuleName
-	QueryUserRelationships = "user_relationships"
-	QueryRelationships     = "relationships"
+	QuerierRoute              = ModuleName
+	QueryUserRelationships    = "user_relationships"
+	QueryRelationships        = "relationships"
+	QueryRelationshipRequests = "relationship_requests"
+	QueryUserBlocks           = "user_blocks"
+	QueryFollowers            = "followers"
+	QueryRelationshipsCounts  = "relationships_counts"
+	QueryMutuals              = "mutuals"
+	QuerySuggestions          = "suggestions"
+	QueryUsersPath            = "users_path"
 )
 
 var (
-	RelationshipsStorePrefix = []byte("relationships")
+	RelationshipsStorePrefix = []byte("user_relationship")
+
+	// FollowersStorePrefix is the prefix used to store the reverse index of the relationships,
+	// that allows to list all the users that have created a relationship towards a recipient
+	FollowersStorePrefix = []byte("followers")
+
+	OutgoingRelationshipRequestsStorePrefix = []byte("outgoing_relationship_request")
+	IncomingRelationshipRequestsStorePrefix = []byte("incoming_relationship_request")
+
+	UsersBlocksStorePrefix = []byte("users_blocks")
 )
 
-// RelationshipsStoreKey turns a user address to a key used to store a Address -> []Address couple
-func RelationshipsStoreKey(user sdk.AccAddress) []byte {
+// UserRelationshipsPrefix returns the prefix used to store all the relationships created by the given user
+func UserRelationshipsPrefix(user sdk.AccAddress) []byte {
 	return append(RelationshipsStorePrefix, []byte(user)...)
 }
+
+// UserSubspaceRelationshipsPrefix returns the prefix used to store all the relationships
+// created by the given user inside the given subspace
+func UserSubspaceRelationshipsPrefix(user sdk.AccAddress, subspace string) []byte {
+	return append(UserRelationshipsPrefix(user), []byte(subspace)...)
+}
+
+// RelationshipsStoreKey turns a user address, a subspace and a receiver address into the key
+// used to store the relationship between the user and the receiver inside the subspace
+func RelationshipsStoreKey(user sdk.AccAddress, subspace string, receiver sdk.AccAddress) []byte {
+	return append(UserSubspaceRelationshipsPrefix(user, subspace), []byte(receiver)...)
+}
+
+// UserFollowersPrefix returns the prefix used to store all the followers of the given user
+func UserFollowersPrefix(recipient sdk.AccAddress) []byte {
+	return append(FollowersStorePrefix, []byte(recipient)...)
+}
+
+// UserSubspaceFollowersPrefix returns the prefix used to store all the followers of the given user
+// inside the given subspace
+func UserSubspaceFollowersPrefix(recipient sdk.AccAddress, subspace string) []byte {
+	return append(UserFollowersPrefix(recipient), []byte(subspace)...)
+}
+
+// FollowerStoreKey turns a recipient address, a subspace and a user address into the key used to store
+// the reverse index entry of the relationship that the user has created towards the recipient inside the subspace
+func FollowerStoreKey(recipient sdk.AccAddress, subspace string, user sdk.AccAddress) []byte {
+	return append(UserSubspaceFollowersPrefix(recipient, subspace), []byte(user)...)
+}
+
+// OutgoingRelationshipRequestsPrefix returns the prefix used to store all the relationship requests sent by the given user
+func OutgoingRelationshipRequestsPrefix(sender sdk.AccAddress) []byte {
+	return append(OutgoingRelationshipRequestsStorePrefix, []byte(sender)...)
+}
+
+// OutgoingRelationshipRequestStoreKey turns a sender address, a subspace and a receiver address into the key
+// used to store the relationship request that the sender has sent to the receiver inside the subspace
+func OutgoingRelationshipRequestStoreKey(sender sdk.AccAddress, subspace string, receiver sdk.AccAddress) []byte {
+	return append(append(OutgoingRelationshipRequestsPrefix(sender), []byte(subspace)...), []byte(receiver)...)
+}
+
+// IncomingRelationshipRequestsPrefix returns the prefix used to store all the relationship requests
+// that the given user has received
+func IncomingRelationshipRequestsPrefix(receiver sdk.AccAddress) []byte {
+	return append(IncomingRelationshipRequestsStorePrefix, []byte(receiver)...)
+}
+
+// IncomingRelationshipRequestStoreKey turns a receiver address, a subspace and a sender address into the key
+// used to store the relationship request that the receiver has received from the sender inside the subspace
+func IncomingRelationshipRequestStoreKey(receiver sdk.AccAddress, subspace string, sender sdk.AccAddress) []byte {
+	return append(append(IncomingRelationshipRequestsPrefix(receiver), []byte(subspace)...), []byte(sender)...)
+}
+
+// UserBlocksPrefix returns the prefix used to store all the blocks created by the given user
+func UserBlocksPrefix(blocker sdk.AccAddress) []byte {
+	return append(UsersBlocksStorePrefix, []byte(blocker)...)
+}
+
+// UserBlockStoreKey turns a blocker address and a blocked address into the key
+// used to store the block that the blocker has created towards the blocked user
+func UserBlockStoreKey(blocker, blocked sdk.AccAddress) []byte {
+	return append(UserBlocksPrefix(blocker), []byte(blocked)...)
+}
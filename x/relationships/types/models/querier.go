@@ -0,0 +1,95 @@
+package models
+
+// QueryUserRelationshipsParams contains the params used to filter the relationships
+// returned by the 'custom/relationships/user_relationships' query.
+// Empty values are not used to filter the relationships
+type QueryUserRelationshipsParams struct {
+	Subspace string           `json:"subspace" yaml:"subspace"`
+	Type     RelationshipType `json:"type" yaml:"type"`
+}
+
+// NewQueryUserRelationshipsParams is a constructor function for QueryUserRelationshipsParams
+func NewQueryUserRelationshipsParams(subspace string, relType RelationshipType) QueryUserRelationshipsParams {
+	return QueryUserRelationshipsParams{
+		Subspace: subspace,
+		Type:     relType,
+	}
+}
+
+// QueryFollowersParams contains the params used to filter and paginate the followers
+// returned by the 'custom/relationships/followers' query.
+// Empty subspace and type values are not used to filter the followers
+type QueryFollowersParams struct {
+	Subspace string           `json:"subspace" yaml:"subspace"`
+	Type     RelationshipType `json:"type" yaml:"type"`
+	Page     int              `json:"page" yaml:"page"`
+	Limit    int              `json:"limit" yaml:"limit"`
+}
+
+// NewQueryFollowersParams is a constructor function for QueryFollowersParams
+func NewQueryFollowersParams(subspace string, relType RelationshipType, page, limit int) QueryFollowersParams {
+	return QueryFollowersParams{
+		Subspace: subspace,
+		Type:     relType,
+		Page:     page,
+		Limit:    limit,
+	}
+}
+
+// QueryRelationshipsCountsParams contains the params used by the 'custom/relationships/relationships_counts' query.
+// An empty subspace counts the relationships inside all the subspaces
+type QueryRelationshipsCountsParams struct {
+	Subspace string `json:"subspace" yaml:"subspace"`
+}
+
+// NewQueryRelationshipsCountsParams is a constructor function for QueryRelationshipsCountsParams
+func NewQueryRelationshipsCountsParams(subspace string) QueryRelationshipsCountsParams {
+	return QueryRelationshipsCountsParams{
+		Subspace: subspace,
+	}
+}
+
+// QueryMutualsParams contains the params used by the 'custom/relationships/mutuals' query.
+// An empty subspace considers the relationships inside all the subspaces
+type QueryMutualsParams struct {
+	Subspace string `json:"subspace" yaml:"subspace"`
+}
+
+// NewQueryMutualsParams is a constructor function for QueryMutualsParams
+func NewQueryMutualsParams(subspace string) QueryMutualsParams {
+	return QueryMutualsParams{
+		Subspace: subspace,
+	}
+}
+
+// QuerySuggestionsParams contains the params used by the 'custom/relationships/suggestions' query.
+// An empty subspace considers the relationships inside all the subspaces, while a zero limit
+// returns the default number of suggestions
+type QuerySuggestionsParams struct {
+	Subspace string `json:"subspace" yaml:"subspace"`
+	Limit    int    `json:"limit" yaml:"limit"`
+}
+
+// NewQuerySuggestionsParams is a constructor function for QuerySuggestionsParams
+func NewQuerySuggestionsParams(subspace string, limit int) QuerySuggestionsParams {
+	return QuerySuggestionsParams{
+		Subspace: subspace,
+		Limit:    limit,
+	}
+}
+
+// QueryUsersPathParams contains the params used by the 'custom/relationships/users_path' query.
+// An empty subspace considers the relationships inside all the subspaces, while zero max hops
+// uses the default number of hops
+type QueryUsersPathParams struct {
+	Subspace string `json:"subspace" yaml:"subspace"`
+	MaxHops  uint64 `json:"max_hops" yaml:"max_hops"`
+}
+
+// NewQueryUsersPathParams is a constructor function for QueryUsersPathParams
+func NewQueryUsersPathParams(subspace string, maxHops uint64) QueryUsersPathParams {
+	return QueryUsersPathParams{
+		Subspace: subspace,
+		MaxHops:  maxHops,
+	}
+}
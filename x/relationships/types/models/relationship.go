@@ -0,0 +1,104 @@
+package models
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	postsCommon "github.com/desmos-labs/desmos/x/posts/types/models/common"
+)
+
+// RelationshipType represents the kind of relationship that a user has with another one
+type RelationshipType string
+
+const (
+	RelationshipTypeFollow      RelationshipType = "follow"
+	RelationshipTypeFriend      RelationshipType = "friend"
+	RelationshipTypeCloseFriend RelationshipType = "close_friend"
+)
+
+// IsValid tells whether the relationship type is one of the supported ones
+func (relType RelationshipType) IsValid() bool {
+	switch relType {
+	case RelationshipTypeFollow, RelationshipTypeFriend, RelationshipTypeCloseFriend:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsMutual tells whether the relationship type requires the recipient to accept it.
+// Mutual relationships are created using a relationship request, and are stored for both the involved users
+func (relType RelationshipType) IsMutual() bool {
+	return relType == RelationshipTypeFriend || relType == RelationshipTypeCloseFriend
+}
+
+// Relationship represents a relationship that a user has created towards a recipient inside a subspace.
+// A user can have at most one relationship with the same recipient inside each subspace
+type Relationship struct {
+	Recipient sdk.AccAddress   `json:"recipient" yaml:"recipient"`
+	Subspace  string           `json:"subspace" yaml:"subspace"`
+	Type      RelationshipType `json:"type" yaml:"type"`
+}
+
+// NewRelationship is a constructor function for Relationship
+func NewRelationship(recipient sdk.AccAddress, subspace string, relType RelationshipType) Relationship {
+	return Relationship{
+		Recipient: recipient,
+		Subspace:  subspace,
+		Type:      relType,
+	}
+}
+
+// String implements fmt.Stringer
+func (relationship Relationship) String() string {
+	return fmt.Sprintf("Relationship:\n[Recipient] %s [Subspace] %s [Type] %s",
+		relationship.Recipient,
+		relationship.Subspace,
+		relationship.Type,
+	)
+}
+
+// Equals allows to check whether the contents of the relationship are the same of other
+func (relationship Relationship) Equals(other Relationship) bool {
+	return relationship.Recipient.Equals(other.Recipient) &&
+		relationship.Subspace == other.Subspace &&
+		relationship.Type == other.Type
+}
+
+// Validate check the validity of the Relationship
+func (relationship Relationship) Validate() error {
+	if relationship.Recipient.Empty() {
+		return fmt.Errorf("invalid recipient address: %s", relationship.Recipient)
+	}
+
+	if !postsCommon.IsValidSubspace(relationship.Subspace) {
+		return fmt.Errorf("relationship subspace must be a valid sha-256 hash")
+	}
+
+	if !relationship.Type.IsValid() {
+		return fmt.Errorf("invalid relationship type: %s", relationship.Type)
+	}
+
+	return nil
+}
+
+// Relationships represents a slice of Relationship objects
+type Relationships []Relationship
+
+// Filter returns the relationships belonging to the given subspace and having the given type.
+// Empty subspace and type values are not used to filter the relationships
+func (relationships Relationships) Filter(subspace string, relType RelationshipType) Relationships {
+	filtered := Relationships{}
+	for _, relationship := range relationships {
+		if subspace != "" && relationship.Subspace != subspace {
+			continue
+		}
+
+		if relType != "" && relationship.Type != relType {
+			continue
+		}
+
+		filtered = append(filtered, relationship)
+	}
+	return filtered
+}
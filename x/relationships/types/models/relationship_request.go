@@ -0,0 +1,88 @@
+package models
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	postsCommon "github.com/desmos-labs/desmos/x/posts/types/models/common"
+)
+
+// RelationshipRequest represents a pending request that a sender has made to the receiver in order to
+// create a mutual relationship between them inside a subspace.
+// The relationship is created only once the receiver accepts the request
+type RelationshipRequest struct {
+	Sender   sdk.AccAddress   `json:"sender" yaml:"sender"`
+	Receiver sdk.AccAddress   `json:"receiver" yaml:"receiver"`
+	Subspace string           `json:"subspace" yaml:"subspace"`
+	Type     RelationshipType `json:"type" yaml:"type"`
+}
+
+// NewRelationshipRequest is a constructor function for RelationshipRequest
+func NewRelationshipRequest(
+	sender, receiver sdk.AccAddress, subspace string, relType RelationshipType,
+) RelationshipRequest {
+	return RelationshipRequest{
+		Sender:   sender,
+		Receiver: receiver,
+		Subspace: subspace,
+		Type:     relType,
+	}
+}
+
+// String implements fmt.Stringer
+func (request RelationshipRequest) String() string {
+	return fmt.Sprintf("Relationship request:\n[Sender] %s [Receiver] %s [Subspace] %s [Type] %s",
+		request.Sender,
+		request.Receiver,
+		request.Subspace,
+		request.Type,
+	)
+}
+
+// Validate check the validity of the RelationshipRequest
+func (request RelationshipRequest) Validate() error {
+	if request.Sender.Empty() {
+		return fmt.Errorf("invalid sender address: %s", request.Sender)
+	}
+
+	if request.Receiver.Empty() {
+		return fmt.Errorf("invalid receiver address: %s", request.Receiver)
+	}
+
+	if request.Sender.Equals(request.Receiver) {
+		return fmt.Errorf("sender and receiver must be different")
+	}
+
+	if !postsCommon.IsValidSubspace(request.Subspace) {
+		return fmt.Errorf("relationship subspace must be a valid sha-256 hash")
+	}
+
+	if !request.Type.IsMutual() {
+		return fmt.Errorf("relationship type %s cannot be requested", request.Type)
+	}
+
+	return nil
+}
+
+// RelationshipRequests represents a slice of RelationshipRequest objects
+type RelationshipRequests []RelationshipRequest
+
+// RelationshipRequestsResponse contains the relationship requests that a user has received
+// and the ones that he has sent and are still pending
+type RelationshipRequestsResponse struct {
+	Incoming RelationshipRequests `json:"incoming" yaml:"incoming"`
+	Outgoing RelationshipRequests `json:"outgoing" yaml:"outgoing"`
+}
+
+// NewRelationshipRequestsResponse is a constructor function for RelationshipRequestsResponse
+func NewRelationshipRequestsResponse(incoming, outgoing RelationshipRequests) RelationshipRequestsResponse {
+	return RelationshipRequestsResponse{
+		Incoming: incoming,
+		Outgoing: outgoing,
+	}
+}
+
+// String implements fmt.Stringer
+func (response RelationshipRequestsResponse) String() string {
+	return fmt.Sprintf("Incoming: %s\nOutgoing: %s", response.Incoming, response.Outgoing)
+}
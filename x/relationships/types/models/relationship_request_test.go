@@ -0,0 +1,64 @@
+package models_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/relationships/types/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelationshipRequest_Validate(t *testing.T) {
+	sender, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	receiver, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	require.NoError(t, err)
+
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+
+	tests := []struct {
+		name    string
+		request models.RelationshipRequest
+		expErr  error
+	}{
+		{
+			name:    "Empty sender returns error",
+			request: models.NewRelationshipRequest(nil, receiver, subspace, models.RelationshipTypeFriend),
+			expErr:  fmt.Errorf("invalid sender address: "),
+		},
+		{
+			name:    "Empty receiver returns error",
+			request: models.NewRelationshipRequest(sender, nil, subspace, models.RelationshipTypeFriend),
+			expErr:  fmt.Errorf("invalid receiver address: "),
+		},
+		{
+			name:    "Equal sender and receiver returns error",
+			request: models.NewRelationshipRequest(sender, sender, subspace, models.RelationshipTypeFriend),
+			expErr:  fmt.Errorf("sender and receiver must be different"),
+		},
+		{
+			name:    "Invalid subspace returns error",
+			request: models.NewRelationshipRequest(sender, receiver, "1234", models.RelationshipTypeFriend),
+			expErr:  fmt.Errorf("relationship subspace must be a valid sha-256 hash"),
+		},
+		{
+			name:    "Non mutual type returns error",
+			request: models.NewRelationshipRequest(sender, receiver, subspace, models.RelationshipTypeFollow),
+			expErr:  fmt.Errorf("relationship type follow cannot be requested"),
+		},
+		{
+			name:    "Valid request returns no error",
+			request: models.NewRelationshipRequest(sender, receiver, subspace, models.RelationshipTypeCloseFriend),
+			expErr:  nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.request.Validate())
+		})
+	}
+}
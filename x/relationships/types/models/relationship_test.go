@@ -0,0 +1,98 @@
+package models_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/relationships/types/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelationshipType_IsValid(t *testing.T) {
+	require.True(t, models.RelationshipTypeFollow.IsValid())
+	require.True(t, models.RelationshipTypeFriend.IsValid())
+	require.True(t, models.RelationshipTypeCloseFriend.IsValid())
+	require.False(t, models.RelationshipType("").IsValid())
+	require.False(t, models.RelationshipType("enemy").IsValid())
+}
+
+func TestRelationshipType_IsMutual(t *testing.T) {
+	require.False(t, models.RelationshipTypeFollow.IsMutual())
+	require.True(t, models.RelationshipTypeFriend.IsMutual())
+	require.True(t, models.RelationshipTypeCloseFriend.IsMutual())
+}
+
+func TestRelationship_Validate(t *testing.T) {
+	recipient, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	require.NoError(t, err)
+
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+
+	tests := []struct {
+		name         string
+		relationship models.Relationship
+		expErr       error
+	}{
+		{
+			name:         "Empty recipient returns error",
+			relationship: models.NewRelationship(nil, subspace, models.RelationshipTypeFollow),
+			expErr:       fmt.Errorf("invalid recipient address: "),
+		},
+		{
+			name:         "Invalid subspace returns error",
+			relationship: models.NewRelationship(recipient, "1234", models.RelationshipTypeFollow),
+			expErr:       fmt.Errorf("relationship subspace must be a valid sha-256 hash"),
+		},
+		{
+			name:         "Invalid type returns error",
+			relationship: models.NewRelationship(recipient, subspace, "enemy"),
+			expErr:       fmt.Errorf("invalid relationship type: enemy"),
+		},
+		{
+			name:         "Valid relationship returns no error",
+			relationship: models.NewRelationship(recipient, subspace, models.RelationshipTypeCloseFriend),
+			expErr:       nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.relationship.Validate())
+		})
+	}
+}
+
+func TestRelationship_Equals(t *testing.T) {
+	recipient, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	require.NoError(t, err)
+
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	relationship := models.NewRelationship(recipient, subspace, models.RelationshipTypeFollow)
+
+	require.True(t, relationship.Equals(models.NewRelationship(recipient, subspace, models.RelationshipTypeFollow)))
+	require.False(t, relationship.Equals(models.NewRelationship(recipient, subspace, models.RelationshipTypeFriend)))
+	require.False(t, relationship.Equals(models.NewRelationship(recipient,
+		"2bdf5932925584b9a86470bea60adce69041608a447f84a3317723aa5678ec88", models.RelationshipTypeFollow)))
+}
+
+func TestRelationships_Filter(t *testing.T) {
+	recipient, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	require.NoError(t, err)
+
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	otherSubspace := "2bdf5932925584b9a86470bea60adce69041608a447f84a3317723aa5678ec88"
+
+	relationships := models.Relationships{
+		models.NewRelationship(recipient, subspace, models.RelationshipTypeFollow),
+		models.NewRelationship(recipient, otherSubspace, models.RelationshipTypeFollow),
+		models.NewRelationship(recipient, otherSubspace, models.RelationshipTypeFriend),
+	}
+
+	require.Equal(t, relationships, relationships.Filter("", ""))
+	require.Equal(t, models.Relationships{relationships[1], relationships[2]}, relationships.Filter(otherSubspace, ""))
+	require.Equal(t, models.Relationships{relationships[0], relationships[1]}, relationships.Filter("", models.RelationshipTypeFollow))
+	require.Equal(t, models.Relationships{relationships[2]}, relationships.Filter(otherSubspace, models.RelationshipTypeFriend))
+	require.Equal(t, models.Relationships{}, relationships.Filter(subspace, models.RelationshipTypeCloseFriend))
+}
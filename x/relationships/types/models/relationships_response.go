@@ -3,15 +3,13 @@ package models
 import (
 	"fmt"
 	"strings"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 type RelationshipsResponse struct {
-	Relationships []sdk.AccAddress `json:"relationships,omitempty" yaml:"relationships,omitempty"`
+	Relationships Relationships `json:"relationships,omitempty" yaml:"relationships,omitempty"`
 }
 
-func NewRelationshipResponse(relationships []sdk.AccAddress) RelationshipsResponse {
+func NewRelationshipResponse(relationships Relationships) RelationshipsResponse {
 	return RelationshipsResponse{relationships}
 }
 
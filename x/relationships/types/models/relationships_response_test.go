@@ -15,7 +15,13 @@ func TestRelationshipsResponse_String(t *testing.T) {
 	address2, err := sdk.AccAddressFromBech32("cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4")
 	require.NoError(t, err)
 
-	relationshipsResponse := models.NewRelationshipResponse([]sdk.AccAddress{address1, address2})
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	relationshipsResponse := models.NewRelationshipResponse(models.Relationships{
+		models.NewRelationship(address1, subspace, models.RelationshipTypeFollow),
+		models.NewRelationship(address2, subspace, models.RelationshipTypeFriend),
+	})
 
-	require.Equal(t, "Relationships: [cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47 cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4]", relationshipsResponse.String())
+	require.Equal(t, `Relationships: [Relationship:
+[Recipient] cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47 [Subspace] 4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e [Type] follow Relationship:
+[Recipient] cosmos1s3nh6tafl4amaxkke9kdejhp09lk93g9ev39r4 [Subspace] 4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e [Type] friend]`, relationshipsResponse.String())
 }
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UserBlock represents the fact that the Blocker has blocked the Blocked user, optionally specifying
+// the reason why. Blocked users cannot comment on, nor react to, the posts of the blocker,
+// and cannot create relationships with the blocker
+type UserBlock struct {
+	Blocker sdk.AccAddress `json:"blocker" yaml:"blocker"`
+	Blocked sdk.AccAddress `json:"blocked" yaml:"blocked"`
+	Reason  string         `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
+
+// NewUserBlock is a constructor function for UserBlock
+func NewUserBlock(blocker, blocked sdk.AccAddress, reason string) UserBlock {
+	return UserBlock{
+		Blocker: blocker,
+		Blocked: blocked,
+		Reason:  reason,
+	}
+}
+
+// String implements fmt.Stringer
+func (block UserBlock) String() string {
+	return fmt.Sprintf("User block:\n[Blocker] %s [Blocked] %s [Reason] %s",
+		block.Blocker,
+		block.Blocked,
+		block.Reason,
+	)
+}
+
+// Validate check the validity of the UserBlock
+func (block UserBlock) Validate() error {
+	if block.Blocker.Empty() {
+		return fmt.Errorf("invalid blocker address: %s", block.Blocker)
+	}
+
+	if block.Blocked.Empty() {
+		return fmt.Errorf("invalid blocked address: %s", block.Blocked)
+	}
+
+	if block.Blocker.Equals(block.Blocked) {
+		return fmt.Errorf("blocker and blocked must be different")
+	}
+
+	return nil
+}
+
+// UserBlocks represents a slice of UserBlock objects
+type UserBlocks []UserBlock
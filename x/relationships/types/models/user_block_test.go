@@ -0,0 +1,57 @@
+package models_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/relationships/types/models"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserBlock_Validate(t *testing.T) {
+	blocker, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	blocked, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		block  models.UserBlock
+		expErr error
+	}{
+		{
+			name:   "Empty blocker returns error",
+			block:  models.NewUserBlock(nil, blocked, "spam"),
+			expErr: fmt.Errorf("invalid blocker address: "),
+		},
+		{
+			name:   "Empty blocked returns error",
+			block:  models.NewUserBlock(blocker, nil, "spam"),
+			expErr: fmt.Errorf("invalid blocked address: "),
+		},
+		{
+			name:   "Equal blocker and blocked returns error",
+			block:  models.NewUserBlock(blocker, blocker, "spam"),
+			expErr: fmt.Errorf("blocker and blocked must be different"),
+		},
+		{
+			name:   "Valid block without reason returns no error",
+			block:  models.NewUserBlock(blocker, blocked, ""),
+			expErr: nil,
+		},
+		{
+			name:   "Valid block returns no error",
+			block:  models.NewUserBlock(blocker, blocked, "spam"),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.block.Validate())
+		})
+	}
+}
@@ -13,4 +13,10 @@ func init() {
 func RegisterMessagesCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateRelationship{}, "desmos/MsgCreateRelationship", nil)
 	cdc.RegisterConcrete(MsgDeleteRelationship{}, "desmos/MsgDeleteRelationship", nil)
+	cdc.RegisterConcrete(MsgRequestRelationship{}, "desmos/MsgRequestRelationship", nil)
+	cdc.RegisterConcrete(MsgAcceptRelationshipRequest{}, "desmos/MsgAcceptRelationshipRequest", nil)
+	cdc.RegisterConcrete(MsgDeclineRelationshipRequest{}, "desmos/MsgDeclineRelationshipRequest", nil)
+	cdc.RegisterConcrete(MsgCancelRelationshipRequest{}, "desmos/MsgCancelRelationshipRequest", nil)
+	cdc.RegisterConcrete(MsgBlockUser{}, "desmos/MsgBlockUser", nil)
+	cdc.RegisterConcrete(MsgUnblockUser{}, "desmos/MsgUnblockUser", nil)
 }
@@ -5,24 +5,39 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	postsCommon "github.com/desmos-labs/desmos/x/posts/types/models/common"
 	"github.com/desmos-labs/desmos/x/relationships/types/models"
 )
 
-// Creates a relationship between the sender and
-// the receiver.
+// Creates a relationship of the given type between the sender and
+// the receiver inside the given subspace.
 // An example of relationship is the follow on Twitter or the subscribe on YouTube.
 type MsgCreateRelationship struct {
-	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
-	Receiver sdk.AccAddress `json:"receiver" yaml:"receiver"`
+	Sender           sdk.AccAddress          `json:"sender" yaml:"sender"`
+	Receiver         sdk.AccAddress          `json:"receiver" yaml:"receiver"`
+	Subspace         string                  `json:"subspace" yaml:"subspace"`
+	RelationshipType models.RelationshipType `json:"relationship_type" yaml:"relationship_type"`
+	ActingAs         sdk.AccAddress          `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
 }
 
-func NewMsgCreateRelationship(sender, receiver sdk.AccAddress) MsgCreateRelationship {
+func NewMsgCreateRelationship(
+	sender, receiver sdk.AccAddress, subspace string, relType models.RelationshipType,
+) MsgCreateRelationship {
 	return MsgCreateRelationship{
-		Sender:   sender,
-		Receiver: receiver,
+		Sender:           sender,
+		Receiver:         receiver,
+		Subspace:         subspace,
+		RelationshipType: relType,
 	}
 }
 
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgCreateRelationship) WithActingAs(owner sdk.AccAddress) MsgCreateRelationship {
+	msg.ActingAs = owner
+	return msg
+}
+
 // Route should return the name of the module
 func (msg MsgCreateRelationship) Route() string { return models.RouterKey }
 
@@ -45,6 +60,20 @@ func (msg MsgCreateRelationship) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender and receiver must be different")
 	}
 
+	relationship := models.NewRelationship(msg.Receiver, msg.Subspace, msg.RelationshipType)
+	if err := relationship.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.RelationshipType.IsMutual() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("%s relationships must be requested to the receiver", msg.RelationshipType))
+	}
+
+	if !msg.ActingAs.Empty() && msg.ActingAs.Equals(msg.Sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the sender")
+	}
+
 	return nil
 }
 
@@ -63,15 +92,25 @@ func (msg MsgCreateRelationship) GetSigners() []sdk.AccAddress {
 type MsgDeleteRelationship struct {
 	Sender       sdk.AccAddress `json:"sender" yaml:"sender"`
 	Counterparty sdk.AccAddress `json:"counterparty" yaml:"counterparty"`
+	Subspace     string         `json:"subspace" yaml:"subspace"`
+	ActingAs     sdk.AccAddress `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
 }
 
-func NewMsgDeleteRelationship(sender, receiver sdk.AccAddress) MsgDeleteRelationship {
+func NewMsgDeleteRelationship(sender, receiver sdk.AccAddress, subspace string) MsgDeleteRelationship {
 	return MsgDeleteRelationship{
 		Sender:       sender,
 		Counterparty: receiver,
+		Subspace:     subspace,
 	}
 }
 
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgDeleteRelationship) WithActingAs(owner sdk.AccAddress) MsgDeleteRelationship {
+	msg.ActingAs = owner
+	return msg
+}
+
 // Route should return the name of the module
 func (msg MsgDeleteRelationship) Route() string { return models.RouterKey }
 
@@ -94,6 +133,14 @@ func (msg MsgDeleteRelationship) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender and receiver must be different")
 	}
 
+	if !postsCommon.IsValidSubspace(msg.Subspace) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relationship subspace must be a valid sha-256 hash")
+	}
+
+	if !msg.ActingAs.Empty() && msg.ActingAs.Equals(msg.Sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the sender")
+	}
+
 	return nil
 }
 
@@ -0,0 +1,125 @@
+package msgs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/desmos-labs/desmos/x/relationships/types/models"
+)
+
+// ----------------------
+// --- MsgBlockUser
+// ----------------------
+
+// MsgBlockUser allows the Blocker to block the Blocked user, optionally specifying the reason why.
+// Blocked users cannot comment on, nor react to, the blocker's posts and cannot create relationships with the blocker
+type MsgBlockUser struct {
+	Blocker  sdk.AccAddress `json:"blocker" yaml:"blocker"`
+	Blocked  sdk.AccAddress `json:"blocked" yaml:"blocked"`
+	Reason   string         `json:"reason,omitempty" yaml:"reason,omitempty"`
+	ActingAs sdk.AccAddress `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
+}
+
+func NewMsgBlockUser(blocker, blocked sdk.AccAddress, reason string) MsgBlockUser {
+	return MsgBlockUser{
+		Blocker: blocker,
+		Blocked: blocked,
+		Reason:  reason,
+	}
+}
+
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgBlockUser) WithActingAs(owner sdk.AccAddress) MsgBlockUser {
+	msg.ActingAs = owner
+	return msg
+}
+
+// Route should return the name of the module
+func (msg MsgBlockUser) Route() string { return models.RouterKey }
+
+// Type should return the action
+func (msg MsgBlockUser) Type() string { return models.ActionBlockUser }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgBlockUser) ValidateBasic() error {
+	return validateBlockAddresses(msg.Blocker, msg.Blocked, msg.ActingAs)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgBlockUser) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgsCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBlockUser) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Blocker}
+}
+
+// ----------------------
+// --- MsgUnblockUser
+// ----------------------
+
+// MsgUnblockUser allows the Blocker to remove the block that he has previously created towards the Blocked user
+type MsgUnblockUser struct {
+	Blocker  sdk.AccAddress `json:"blocker" yaml:"blocker"`
+	Blocked  sdk.AccAddress `json:"blocked" yaml:"blocked"`
+	ActingAs sdk.AccAddress `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
+}
+
+func NewMsgUnblockUser(blocker, blocked sdk.AccAddress) MsgUnblockUser {
+	return MsgUnblockUser{
+		Blocker: blocker,
+		Blocked: blocked,
+	}
+}
+
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgUnblockUser) WithActingAs(owner sdk.AccAddress) MsgUnblockUser {
+	msg.ActingAs = owner
+	return msg
+}
+
+// Route should return the name of the module
+func (msg MsgUnblockUser) Route() string { return models.RouterKey }
+
+// Type should return the action
+func (msg MsgUnblockUser) Type() string { return models.ActionUnblockUser }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUnblockUser) ValidateBasic() error {
+	return validateBlockAddresses(msg.Blocker, msg.Blocked, msg.ActingAs)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUnblockUser) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgsCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUnblockUser) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Blocker}
+}
+
+// validateBlockAddresses checks the validity of the addresses contained inside a block related message
+func validateBlockAddresses(blocker, blocked, actingAs sdk.AccAddress) error {
+	if blocker.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid blocker address: %s", blocker))
+	}
+
+	if blocked.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid blocked address: %s", blocked))
+	}
+
+	if blocker.Equals(blocked) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "blocker and blocked must be different")
+	}
+
+	if !actingAs.Empty() && actingAs.Equals(blocker) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the blocker")
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package msgs_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/desmos-labs/desmos/x/relationships/types/msgs"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------
+// --- MsgBlockUser
+// ----------------------
+
+var msgBlockUser = msgs.NewMsgBlockUser(user, otherUser, "spam")
+
+func TestMsgBlockUser_Route(t *testing.T) {
+	require.Equal(t, "relationships", msgBlockUser.Route())
+}
+
+func TestMsgBlockUser_Type(t *testing.T) {
+	require.Equal(t, "block_user", msgBlockUser.Type())
+}
+
+func TestMsgBlockUser_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   msgs.MsgBlockUser
+		error error
+	}{
+		{
+			name:  "Empty blocker returns error",
+			msg:   msgs.NewMsgBlockUser(nil, otherUser, "spam"),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid blocker address: "),
+		},
+		{
+			name:  "Empty blocked returns error",
+			msg:   msgs.NewMsgBlockUser(user, nil, "spam"),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid blocked address: "),
+		},
+		{
+			name:  "Equals blocker and blocked returns error",
+			msg:   msgs.NewMsgBlockUser(user, user, "spam"),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "blocker and blocked must be different"),
+		},
+		{
+			name:  "Acting as the blocker returns error",
+			msg:   msgBlockUser.WithActingAs(user),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the blocker"),
+		},
+		{
+			name:  "Empty reason returns no error",
+			msg:   msgs.NewMsgBlockUser(user, otherUser, ""),
+			error: nil,
+		},
+		{
+			name:  "No errors message",
+			msg:   msgBlockUser,
+			error: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			returnedError := test.msg.ValidateBasic()
+			if test.error == nil {
+				require.Nil(t, returnedError)
+			} else {
+				require.NotNil(t, returnedError)
+				require.Equal(t, test.error.Error(), returnedError.Error())
+			}
+		})
+	}
+}
+
+func TestMsgBlockUser_GetSignBytes(t *testing.T) {
+	actual := msgBlockUser.GetSignBytes()
+	expected := `{"type":"desmos/MsgBlockUser","value":{"blocked":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","blocker":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","reason":"spam"}}`
+	require.Equal(t, expected, string(actual))
+}
+
+func TestMsgBlockUser_GetSigners(t *testing.T) {
+	actual := msgBlockUser.GetSigners()
+	require.Equal(t, 1, len(actual))
+	require.Equal(t, msgBlockUser.Blocker, actual[0])
+}
+
+// ----------------------
+// --- MsgUnblockUser
+// ----------------------
+
+var msgUnblockUser = msgs.NewMsgUnblockUser(user, otherUser)
+
+func TestMsgUnblockUser_Route(t *testing.T) {
+	require.Equal(t, "relationships", msgUnblockUser.Route())
+}
+
+func TestMsgUnblockUser_Type(t *testing.T) {
+	require.Equal(t, "unblock_user", msgUnblockUser.Type())
+}
+
+func TestMsgUnblockUser_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   msgs.MsgUnblockUser
+		error error
+	}{
+		{
+			name:  "Empty blocker returns error",
+			msg:   msgs.NewMsgUnblockUser(nil, otherUser),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid blocker address: "),
+		},
+		{
+			name:  "Empty blocked returns error",
+			msg:   msgs.NewMsgUnblockUser(user, nil),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid blocked address: "),
+		},
+		{
+			name:  "Equals blocker and blocked returns error",
+			msg:   msgs.NewMsgUnblockUser(user, user),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "blocker and blocked must be different"),
+		},
+		{
+			name:  "No errors message",
+			msg:   msgUnblockUser,
+			error: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			returnedError := test.msg.ValidateBasic()
+			if test.error == nil {
+				require.Nil(t, returnedError)
+			} else {
+				require.NotNil(t, returnedError)
+				require.Equal(t, test.error.Error(), returnedError.Error())
+			}
+		})
+	}
+}
+
+func TestMsgUnblockUser_GetSignBytes(t *testing.T) {
+	actual := msgUnblockUser.GetSignBytes()
+	expected := `{"type":"desmos/MsgUnblockUser","value":{"blocked":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","blocker":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"}}`
+	require.Equal(t, expected, string(actual))
+}
+
+func TestMsgUnblockUser_GetSigners(t *testing.T) {
+	actual := msgUnblockUser.GetSigners()
+	require.Equal(t, 1, len(actual))
+	require.Equal(t, msgUnblockUser.Blocker, actual[0])
+}
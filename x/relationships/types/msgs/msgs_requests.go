@@ -0,0 +1,285 @@
+package msgs
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	postsCommon "github.com/desmos-labs/desmos/x/posts/types/models/common"
+	"github.com/desmos-labs/desmos/x/relationships/types/models"
+)
+
+// ----------------------
+// --- MsgRequestRelationship
+// ----------------------
+
+// MsgRequestRelationship allows the Sender to ask the Receiver to create a mutual relationship
+// of the given type inside the given subspace.
+// An example of mutual relationship is the friendship on Facebook.
+type MsgRequestRelationship struct {
+	Sender           sdk.AccAddress          `json:"sender" yaml:"sender"`
+	Receiver         sdk.AccAddress          `json:"receiver" yaml:"receiver"`
+	Subspace         string                  `json:"subspace" yaml:"subspace"`
+	RelationshipType models.RelationshipType `json:"relationship_type" yaml:"relationship_type"`
+	ActingAs         sdk.AccAddress          `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
+}
+
+func NewMsgRequestRelationship(
+	sender, receiver sdk.AccAddress, subspace string, relType models.RelationshipType,
+) MsgRequestRelationship {
+	return MsgRequestRelationship{
+		Sender:           sender,
+		Receiver:         receiver,
+		Subspace:         subspace,
+		RelationshipType: relType,
+	}
+}
+
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgRequestRelationship) WithActingAs(owner sdk.AccAddress) MsgRequestRelationship {
+	msg.ActingAs = owner
+	return msg
+}
+
+// Route should return the name of the module
+func (msg MsgRequestRelationship) Route() string { return models.RouterKey }
+
+// Type should return the action
+func (msg MsgRequestRelationship) Type() string {
+	return models.ActionRequestRelationship
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRequestRelationship) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid sender address: %s", msg.Sender))
+	}
+
+	if msg.Receiver.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid receiver address: %s", msg.Receiver))
+	}
+
+	if msg.Sender.Equals(msg.Receiver) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender and receiver must be different")
+	}
+
+	request := models.NewRelationshipRequest(msg.Sender, msg.Receiver, msg.Subspace, msg.RelationshipType)
+	if err := request.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if !msg.ActingAs.Empty() && msg.ActingAs.Equals(msg.Sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the sender")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRequestRelationship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgsCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRequestRelationship) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// ----------------------
+// --- MsgAcceptRelationshipRequest
+// ----------------------
+
+// MsgAcceptRelationshipRequest allows the Receiver of a relationship request to accept it,
+// creating the mutual relationship with the Sender of the request
+type MsgAcceptRelationshipRequest struct {
+	Receiver sdk.AccAddress `json:"receiver" yaml:"receiver"`
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Subspace string         `json:"subspace" yaml:"subspace"`
+	ActingAs sdk.AccAddress `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
+}
+
+func NewMsgAcceptRelationshipRequest(receiver, sender sdk.AccAddress, subspace string) MsgAcceptRelationshipRequest {
+	return MsgAcceptRelationshipRequest{
+		Receiver: receiver,
+		Sender:   sender,
+		Subspace: subspace,
+	}
+}
+
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgAcceptRelationshipRequest) WithActingAs(owner sdk.AccAddress) MsgAcceptRelationshipRequest {
+	msg.ActingAs = owner
+	return msg
+}
+
+// Route should return the name of the module
+func (msg MsgAcceptRelationshipRequest) Route() string { return models.RouterKey }
+
+// Type should return the action
+func (msg MsgAcceptRelationshipRequest) Type() string {
+	return models.ActionAcceptRelationshipRequest
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAcceptRelationshipRequest) ValidateBasic() error {
+	return validateRequestAnswer(msg.Receiver, msg.Sender, msg.Subspace, msg.ActingAs)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAcceptRelationshipRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgsCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAcceptRelationshipRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Receiver}
+}
+
+// ----------------------
+// --- MsgDeclineRelationshipRequest
+// ----------------------
+
+// MsgDeclineRelationshipRequest allows the Receiver of a relationship request to decline it
+type MsgDeclineRelationshipRequest struct {
+	Receiver sdk.AccAddress `json:"receiver" yaml:"receiver"`
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Subspace string         `json:"subspace" yaml:"subspace"`
+	ActingAs sdk.AccAddress `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
+}
+
+func NewMsgDeclineRelationshipRequest(receiver, sender sdk.AccAddress, subspace string) MsgDeclineRelationshipRequest {
+	return MsgDeclineRelationshipRequest{
+		Receiver: receiver,
+		Sender:   sender,
+		Subspace: subspace,
+	}
+}
+
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgDeclineRelationshipRequest) WithActingAs(owner sdk.AccAddress) MsgDeclineRelationshipRequest {
+	msg.ActingAs = owner
+	return msg
+}
+
+// Route should return the name of the module
+func (msg MsgDeclineRelationshipRequest) Route() string { return models.RouterKey }
+
+// Type should return the action
+func (msg MsgDeclineRelationshipRequest) Type() string {
+	return models.ActionDeclineRelationshipRequest
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDeclineRelationshipRequest) ValidateBasic() error {
+	return validateRequestAnswer(msg.Receiver, msg.Sender, msg.Subspace, msg.ActingAs)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDeclineRelationshipRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgsCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeclineRelationshipRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Receiver}
+}
+
+// validateRequestAnswer checks the validity of the fields of a message used by the receiver
+// of a relationship request to answer it
+func validateRequestAnswer(receiver, sender sdk.AccAddress, subspace string, actingAs sdk.AccAddress) error {
+	if receiver.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid receiver address: %s", receiver))
+	}
+
+	if sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid sender address: %s", sender))
+	}
+
+	if receiver.Equals(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender and receiver must be different")
+	}
+
+	if !postsCommon.IsValidSubspace(subspace) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relationship subspace must be a valid sha-256 hash")
+	}
+
+	if !actingAs.Empty() && actingAs.Equals(receiver) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the receiver")
+	}
+
+	return nil
+}
+
+// ----------------------
+// --- MsgCancelRelationshipRequest
+// ----------------------
+
+// MsgCancelRelationshipRequest allows the Sender of a relationship request to cancel it
+// before the Receiver has answered it
+type MsgCancelRelationshipRequest struct {
+	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
+	Receiver sdk.AccAddress `json:"receiver" yaml:"receiver"`
+	Subspace string         `json:"subspace" yaml:"subspace"`
+	ActingAs sdk.AccAddress `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
+}
+
+func NewMsgCancelRelationshipRequest(sender, receiver sdk.AccAddress, subspace string) MsgCancelRelationshipRequest {
+	return MsgCancelRelationshipRequest{
+		Sender:   sender,
+		Receiver: receiver,
+		Subspace: subspace,
+	}
+}
+
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgCancelRelationshipRequest) WithActingAs(owner sdk.AccAddress) MsgCancelRelationshipRequest {
+	msg.ActingAs = owner
+	return msg
+}
+
+// Route should return the name of the module
+func (msg MsgCancelRelationshipRequest) Route() string { return models.RouterKey }
+
+// Type should return the action
+func (msg MsgCancelRelationshipRequest) Type() string {
+	return models.ActionCancelRelationshipRequest
+}
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelRelationshipRequest) ValidateBasic() error {
+	if msg.Sender.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid sender address: %s", msg.Sender))
+	}
+
+	if msg.Receiver.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid receiver address: %s", msg.Receiver))
+	}
+
+	if msg.Sender.Equals(msg.Receiver) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender and receiver must be different")
+	}
+
+	if !postsCommon.IsValidSubspace(msg.Subspace) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relationship subspace must be a valid sha-256 hash")
+	}
+
+	if !msg.ActingAs.Empty() && msg.ActingAs.Equals(msg.Sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the sender")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelRelationshipRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgsCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelRelationshipRequest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
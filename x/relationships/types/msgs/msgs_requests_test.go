@@ -0,0 +1,307 @@
+package msgs_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/desmos-labs/desmos/x/relationships/types/models"
+	"github.com/desmos-labs/desmos/x/relationships/types/msgs"
+	"github.com/stretchr/testify/require"
+)
+
+// ----------------------
+// --- MsgRequestRelationship
+// ----------------------
+
+var msgRequestRelationship = msgs.NewMsgRequestRelationship(user, otherUser, subspace, models.RelationshipTypeFriend)
+
+func TestMsgRequestRelationship_Route(t *testing.T) {
+	require.Equal(t, "relationships", msgRequestRelationship.Route())
+}
+
+func TestMsgRequestRelationship_Type(t *testing.T) {
+	require.Equal(t, "request_relationship", msgRequestRelationship.Type())
+}
+
+func TestMsgRequestRelationship_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   msgs.MsgRequestRelationship
+		error error
+	}{
+		{
+			name:  "Empty sender returns error",
+			msg:   msgs.NewMsgRequestRelationship(nil, otherUser, subspace, models.RelationshipTypeFriend),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address: "),
+		},
+		{
+			name:  "Empty receiver returns error",
+			msg:   msgs.NewMsgRequestRelationship(user, nil, subspace, models.RelationshipTypeFriend),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid receiver address: "),
+		},
+		{
+			name:  "Equals sender and receiver returns error",
+			msg:   msgs.NewMsgRequestRelationship(user, user, subspace, models.RelationshipTypeFriend),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender and receiver must be different"),
+		},
+		{
+			name:  "Invalid subspace returns error",
+			msg:   msgs.NewMsgRequestRelationship(user, otherUser, "1234", models.RelationshipTypeFriend),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relationship subspace must be a valid sha-256 hash"),
+		},
+		{
+			name:  "Non mutual relationship type returns error",
+			msg:   msgs.NewMsgRequestRelationship(user, otherUser, subspace, models.RelationshipTypeFollow),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relationship type follow cannot be requested"),
+		},
+		{
+			name:  "Acting as the sender returns error",
+			msg:   msgRequestRelationship.WithActingAs(user),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the sender"),
+		},
+		{
+			name:  "No errors message",
+			msg:   msgRequestRelationship,
+			error: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			returnedError := test.msg.ValidateBasic()
+			if test.error == nil {
+				require.Nil(t, returnedError)
+			} else {
+				require.NotNil(t, returnedError)
+				require.Equal(t, test.error.Error(), returnedError.Error())
+			}
+		})
+	}
+}
+
+func TestMsgRequestRelationship_GetSignBytes(t *testing.T) {
+	actual := msgRequestRelationship.GetSignBytes()
+	expected := `{"type":"desmos/MsgRequestRelationship","value":{"receiver":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","relationship_type":"friend","sender":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","subspace":"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"}}`
+	require.Equal(t, expected, string(actual))
+}
+
+func TestMsgRequestRelationship_GetSigners(t *testing.T) {
+	actual := msgRequestRelationship.GetSigners()
+	require.Equal(t, 1, len(actual))
+	require.Equal(t, msgRequestRelationship.Sender, actual[0])
+}
+
+// ----------------------
+// --- MsgAcceptRelationshipRequest
+// ----------------------
+
+var msgAcceptRelationshipRequest = msgs.NewMsgAcceptRelationshipRequest(otherUser, user, subspace)
+
+func TestMsgAcceptRelationshipRequest_Route(t *testing.T) {
+	require.Equal(t, "relationships", msgAcceptRelationshipRequest.Route())
+}
+
+func TestMsgAcceptRelationshipRequest_Type(t *testing.T) {
+	require.Equal(t, "accept_relationship_request", msgAcceptRelationshipRequest.Type())
+}
+
+func TestMsgAcceptRelationshipRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   msgs.MsgAcceptRelationshipRequest
+		error error
+	}{
+		{
+			name:  "Empty receiver returns error",
+			msg:   msgs.NewMsgAcceptRelationshipRequest(nil, user, subspace),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid receiver address: "),
+		},
+		{
+			name:  "Empty sender returns error",
+			msg:   msgs.NewMsgAcceptRelationshipRequest(otherUser, nil, subspace),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address: "),
+		},
+		{
+			name:  "Equals sender and receiver returns error",
+			msg:   msgs.NewMsgAcceptRelationshipRequest(user, user, subspace),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender and receiver must be different"),
+		},
+		{
+			name:  "Invalid subspace returns error",
+			msg:   msgs.NewMsgAcceptRelationshipRequest(otherUser, user, "1234"),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relationship subspace must be a valid sha-256 hash"),
+		},
+		{
+			name:  "Acting as the receiver returns error",
+			msg:   msgAcceptRelationshipRequest.WithActingAs(otherUser),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the receiver"),
+		},
+		{
+			name:  "No errors message",
+			msg:   msgAcceptRelationshipRequest,
+			error: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			returnedError := test.msg.ValidateBasic()
+			if test.error == nil {
+				require.Nil(t, returnedError)
+			} else {
+				require.NotNil(t, returnedError)
+				require.Equal(t, test.error.Error(), returnedError.Error())
+			}
+		})
+	}
+}
+
+func TestMsgAcceptRelationshipRequest_GetSignBytes(t *testing.T) {
+	actual := msgAcceptRelationshipRequest.GetSignBytes()
+	expected := `{"type":"desmos/MsgAcceptRelationshipRequest","value":{"receiver":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","sender":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","subspace":"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"}}`
+	require.Equal(t, expected, string(actual))
+}
+
+func TestMsgAcceptRelationshipRequest_GetSigners(t *testing.T) {
+	actual := msgAcceptRelationshipRequest.GetSigners()
+	require.Equal(t, 1, len(actual))
+	require.Equal(t, msgAcceptRelationshipRequest.Receiver, actual[0])
+}
+
+// ----------------------
+// --- MsgDeclineRelationshipRequest
+// ----------------------
+
+var msgDeclineRelationshipRequest = msgs.NewMsgDeclineRelationshipRequest(otherUser, user, subspace)
+
+func TestMsgDeclineRelationshipRequest_Route(t *testing.T) {
+	require.Equal(t, "relationships", msgDeclineRelationshipRequest.Route())
+}
+
+func TestMsgDeclineRelationshipRequest_Type(t *testing.T) {
+	require.Equal(t, "decline_relationship_request", msgDeclineRelationshipRequest.Type())
+}
+
+func TestMsgDeclineRelationshipRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   msgs.MsgDeclineRelationshipRequest
+		error error
+	}{
+		{
+			name:  "Empty receiver returns error",
+			msg:   msgs.NewMsgDeclineRelationshipRequest(nil, user, subspace),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid receiver address: "),
+		},
+		{
+			name:  "Invalid subspace returns error",
+			msg:   msgs.NewMsgDeclineRelationshipRequest(otherUser, user, "1234"),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relationship subspace must be a valid sha-256 hash"),
+		},
+		{
+			name:  "No errors message",
+			msg:   msgDeclineRelationshipRequest,
+			error: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			returnedError := test.msg.ValidateBasic()
+			if test.error == nil {
+				require.Nil(t, returnedError)
+			} else {
+				require.NotNil(t, returnedError)
+				require.Equal(t, test.error.Error(), returnedError.Error())
+			}
+		})
+	}
+}
+
+func TestMsgDeclineRelationshipRequest_GetSignBytes(t *testing.T) {
+	actual := msgDeclineRelationshipRequest.GetSignBytes()
+	expected := `{"type":"desmos/MsgDeclineRelationshipRequest","value":{"receiver":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","sender":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","subspace":"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"}}`
+	require.Equal(t, expected, string(actual))
+}
+
+func TestMsgDeclineRelationshipRequest_GetSigners(t *testing.T) {
+	actual := msgDeclineRelationshipRequest.GetSigners()
+	require.Equal(t, 1, len(actual))
+	require.Equal(t, msgDeclineRelationshipRequest.Receiver, actual[0])
+}
+
+// ----------------------
+// --- MsgCancelRelationshipRequest
+// ----------------------
+
+var msgCancelRelationshipRequest = msgs.NewMsgCancelRelationshipRequest(user, otherUser, subspace)
+
+func TestMsgCancelRelationshipRequest_Route(t *testing.T) {
+	require.Equal(t, "relationships", msgCancelRelationshipRequest.Route())
+}
+
+func TestMsgCancelRelationshipRequest_Type(t *testing.T) {
+	require.Equal(t, "cancel_relationship_request", msgCancelRelationshipRequest.Type())
+}
+
+func TestMsgCancelRelationshipRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name  string
+		msg   msgs.MsgCancelRelationshipRequest
+		error error
+	}{
+		{
+			name:  "Empty sender returns error",
+			msg:   msgs.NewMsgCancelRelationshipRequest(nil, otherUser, subspace),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address: "),
+		},
+		{
+			name:  "Empty receiver returns error",
+			msg:   msgs.NewMsgCancelRelationshipRequest(user, nil, subspace),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid receiver address: "),
+		},
+		{
+			name:  "Equals sender and receiver returns error",
+			msg:   msgs.NewMsgCancelRelationshipRequest(user, user, subspace),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender and receiver must be different"),
+		},
+		{
+			name:  "Invalid subspace returns error",
+			msg:   msgs.NewMsgCancelRelationshipRequest(user, otherUser, "1234"),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relationship subspace must be a valid sha-256 hash"),
+		},
+		{
+			name:  "No errors message",
+			msg:   msgCancelRelationshipRequest,
+			error: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			returnedError := test.msg.ValidateBasic()
+			if test.error == nil {
+				require.Nil(t, returnedError)
+			} else {
+				require.NotNil(t, returnedError)
+				require.Equal(t, test.error.Error(), returnedError.Error())
+			}
+		})
+	}
+}
+
+func TestMsgCancelRelationshipRequest_GetSignBytes(t *testing.T) {
+	actual := msgCancelRelationshipRequest.GetSignBytes()
+	expected := `{"type":"desmos/MsgCancelRelationshipRequest","value":{"receiver":"cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47","sender":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","subspace":"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"}}`
+	require.Equal(t, expected, string(actual))
+}
+
+func TestMsgCancelRelationshipRequest_GetSigners(t *testing.T) {
+	actual := msgCancelRelationshipRequest.GetSigners()
+	require.Equal(t, 1, len(actual))
+	require.Equal(t, msgCancelRelationshipRequest.Sender, actual[0])
+}
@@ -3,6 +3,7 @@ package msgs_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/desmos-labs/desmos/x/relationships/types/models"
 	msgs "github.com/desmos-labs/desmos/x/relationships/types/msgs"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -11,13 +12,17 @@ import (
 var (
 	user, _               = sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
 	otherUser, _          = sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	subspace              = "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
 	msgCreateRelationship = msgs.MsgCreateRelationship{
-		Sender:   user,
-		Receiver: user,
+		Sender:           user,
+		Receiver:         user,
+		Subspace:         subspace,
+		RelationshipType: models.RelationshipTypeFollow,
 	}
 
 	msgDeleteRelationships = msgs.MsgDeleteRelationship{
-		Sender: user,
+		Sender:   user,
+		Subspace: subspace,
 	}
 )
 
@@ -41,28 +46,49 @@ func TestMsgCreateRelationship_ValidateBasic(t *testing.T) {
 		{
 			name: "Empty sender returns error",
 			msg: msgs.NewMsgCreateRelationship(
-				nil, nil,
+				nil, nil, subspace, models.RelationshipTypeFollow,
 			),
 			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address: "),
 		},
 		{
 			name: "Empty receiver returns error",
 			msg: msgs.NewMsgCreateRelationship(
-				user, nil,
+				user, nil, subspace, models.RelationshipTypeFollow,
 			),
 			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid receiver address: "),
 		},
 		{
 			name: "Equals sender and receiver",
 			msg: msgs.NewMsgCreateRelationship(
-				user, user,
+				user, user, subspace, models.RelationshipTypeFollow,
 			),
 			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender and receiver must be different"),
 		},
+		{
+			name: "Invalid subspace returns error",
+			msg: msgs.NewMsgCreateRelationship(
+				user, otherUser, "1234", models.RelationshipTypeFollow,
+			),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relationship subspace must be a valid sha-256 hash"),
+		},
+		{
+			name: "Invalid relationship type returns error",
+			msg: msgs.NewMsgCreateRelationship(
+				user, otherUser, subspace, "enemy",
+			),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid relationship type: enemy"),
+		},
+		{
+			name: "Mutual relationship type returns error",
+			msg: msgs.NewMsgCreateRelationship(
+				user, otherUser, subspace, models.RelationshipTypeFriend,
+			),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "friend relationships must be requested to the receiver"),
+		},
 		{
 			name: "No errors message",
 			msg: msgs.NewMsgCreateRelationship(
-				user, otherUser,
+				user, otherUser, subspace, models.RelationshipTypeFollow,
 			),
 			error: nil,
 		},
@@ -84,7 +110,7 @@ func TestMsgCreateRelationship_ValidateBasic(t *testing.T) {
 
 func TestMsgCreateRelationship_GetSignBytes(t *testing.T) {
 	actual := msgCreateRelationship.GetSignBytes()
-	expected := `{"type":"desmos/MsgCreateRelationship","value":{"receiver":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","sender":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"}}`
+	expected := `{"type":"desmos/MsgCreateRelationship","value":{"receiver":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","relationship_type":"follow","sender":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","subspace":"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"}}`
 	require.Equal(t, expected, string(actual))
 }
 
@@ -115,28 +141,35 @@ func TestMsgDeleteRelationships_ValidateBasic(t *testing.T) {
 		{
 			name: "Empty sender returns error",
 			msg: msgs.NewMsgDeleteRelationship(
-				nil, user,
+				nil, user, subspace,
 			),
 			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sender address: "),
 		},
 		{
 			name: "Empty receiver returns error",
 			msg: msgs.NewMsgDeleteRelationship(
-				user, nil,
+				user, nil, subspace,
 			),
 			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid counterparty address: "),
 		},
 		{
 			name: "Equals sender and receiver",
 			msg: msgs.NewMsgDeleteRelationship(
-				user, user,
+				user, user, subspace,
 			),
 			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender and receiver must be different"),
 		},
+		{
+			name: "Invalid subspace returns error",
+			msg: msgs.NewMsgDeleteRelationship(
+				user, otherUser, "1234",
+			),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "relationship subspace must be a valid sha-256 hash"),
+		},
 		{
 			name: "No errors message",
 			msg: msgs.NewMsgDeleteRelationship(
-				user, otherUser,
+				user, otherUser, subspace,
 			),
 			error: nil,
 		},
@@ -158,7 +191,7 @@ func TestMsgDeleteRelationships_ValidateBasic(t *testing.T) {
 
 func TestMsgDeleteRelationships_GetSignBytes(t *testing.T) {
 	actual := msgDeleteRelationships.GetSignBytes()
-	expected := `{"type":"desmos/MsgDeleteRelationship","value":{"counterparty":"","sender":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"}}`
+	expected := `{"type":"desmos/MsgDeleteRelationship","value":{"counterparty":"","sender":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","subspace":"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"}}`
 	require.Equal(t, expected, string(actual))
 }
 
@@ -7,8 +7,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	posts "github.com/desmos-labs/desmos/x/posts/types"
 	"github.com/desmos-labs/desmos/x/reports/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 // GetQueryCmd adds the query commands
@@ -22,6 +26,13 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 	}
 	postQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryPostReports(cdc),
+		GetCmdQueryTargetReports(cdc),
+		GetCmdQueryKindReports(cdc),
+		GetCmdQuerySubspaceReports(cdc),
+		GetCmdQueryReporterReports(cdc),
+		GetCmdQueryModerators(cdc),
+		GetCmdQueryReasons(cdc),
+		GetCmdQueryHiddenPosts(cdc),
 	)...)
 	return postQueryCmd
 }
@@ -49,3 +60,213 @@ func GetCmdQueryPostReports(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// GetCmdQueryTargetReports queries the reports of a post, a profile, a registered reaction or a subspace
+func GetCmdQueryTargetReports(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "target [kind] [id]",
+		Short: "Returns all the reports of the target having the given kind and ID",
+		Long: fmt.Sprintf(`
+Returns all the reports of the target having the given kind and ID, sorted by id.
+The kind can be either "%s", "%s", "%s" or "%s".
+Profiles are identified by the address of their creator, while registered reactions
+are identified by their subspace and shortcode separated by a slash.
+
+E.g.
+%s query reports target %s desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud
+%s query reports target %s 4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e/:like:
+`, types.TargetKindPost, types.TargetKindProfile, types.TargetKindReaction, types.TargetKindSubspace,
+			version.ClientName, types.TargetKindProfile, version.ClientName, types.TargetKindReaction),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			target, err := types.ParseReportTarget(fmt.Sprintf("%s/%s", args[0], args[1]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryTargetReports, target)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.TargetReportsQueryResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryKindReports queries the reports of all the targets having a kind
+func GetCmdQueryKindReports(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "kind [kind]",
+		Short: "Returns the reports of all the targets having the given kind, by default only the open ones",
+		Long: fmt.Sprintf(`
+Returns the reports of all the targets having the given kind, sorted by target.
+The kind can be either "%s", "%s", "%s" or "%s".
+The reports can be filtered by status, and are returned using pagination.
+
+E.g.
+%s query reports kind %s --status=open --page=2 --limit=50
+`, types.TargetKindPost, types.TargetKindProfile, types.TargetKindReaction, types.TargetKindSubspace,
+			version.ClientName, types.TargetKindProfile),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryKindReports, args[0])
+			return queryIndexedReports(cdc, route)
+		},
+	}
+
+	addIndexedReportsFlags(cmd)
+	return cmd
+}
+
+// queryIndexedReports queries the reports using the given route, filtering and paginating them
+// based on the command flags
+func queryIndexedReports(cdc *codec.Codec, route string) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	params := types.NewQueryIndexedReportsParams(
+		types.ReportStatus(viper.GetString(flagStatus)),
+		viper.GetInt(flagPage),
+		viper.GetInt(flagNumLimit),
+	)
+
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	var out types.TargetReports
+	cdc.MustUnmarshalJSON(res, &out)
+	return cliCtx.PrintOutput(out)
+}
+
+// addIndexedReportsFlags adds the flags used to filter and paginate the reports to the given command
+func addIndexedReportsFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagStatus, string(types.ReportStatusOpen), "Status of the reports to be returned")
+	cmd.Flags().Int(flagPage, 1, "Page of the reports to be returned")
+	cmd.Flags().Int(flagNumLimit, 100, "Number of reports to be returned per page")
+}
+
+// GetCmdQuerySubspaceReports queries the reports of the posts inside a subspace
+func GetCmdQuerySubspaceReports(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subspace [subspace]",
+		Short: "Returns the reports of the targets inside the given subspace, by default only the open ones",
+		Long: fmt.Sprintf(`
+Returns the reports of the posts and registered reactions inside the given subspace,
+as well as the reports of the subspace itself, sorted by id.
+The reports can be filtered by status, and are returned using pagination.
+
+E.g.
+%s query reports subspace 4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e --status=open --page=2 --limit=50
+`, version.ClientName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QuerySubspaceReports, args[0])
+			return queryIndexedReports(cdc, route)
+		},
+	}
+
+	addIndexedReportsFlags(cmd)
+	return cmd
+}
+
+// GetCmdQueryReporterReports queries the reports created by a user
+func GetCmdQueryReporterReports(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reporter [address]",
+		Short: "Returns the reports created by the given user, by default only the open ones",
+		Long: fmt.Sprintf(`
+Returns the reports created by the given user, sorted by id.
+The reports can be filtered by status, and are returned using pagination.
+Resolved reports contain the note left by the moderator that has resolved them.
+
+E.g.
+%s query reports reporter desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud --status=dismissed
+`, version.ClientName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryReporterReports, args[0])
+			return queryIndexedReports(cdc, route)
+		},
+	}
+
+	addIndexedReportsFlags(cmd)
+	return cmd
+}
+
+// GetCmdQueryModerators queries the users allowed to resolve reports
+func GetCmdQueryModerators(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "moderators",
+		Short: "Returns the users allowed to resolve reports",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryModerators)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out []sdk.AccAddress
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryReasons queries the registered report reasons
+func GetCmdQueryReasons(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reasons",
+		Short: "Returns the reasons that can be used when reporting a post",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryReasons)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.Reasons
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdQueryHiddenPosts queries the ids of the posts hidden pending review
+func GetCmdQueryHiddenPosts(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "hidden-posts",
+		Short: "Returns the ids of the posts that have been hidden after being reported",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHiddenPosts)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out posts.PostIDs
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
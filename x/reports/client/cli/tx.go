@@ -3,6 +3,8 @@ package cli
 import (
 	"bufio"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -16,6 +18,14 @@ import (
 	posts "github.com/desmos-labs/desmos/x/posts/types"
 	"github.com/desmos-labs/desmos/x/reports/types"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagActingAs = "acting-as"
+	flagStatus   = "status"
+	flagPage     = "page"
+	flagNumLimit = "limit"
 )
 
 // GetTxCmd set the tx commands
@@ -30,14 +40,28 @@ func GetTxCmd(_ string, cdc *codec.Codec) *cobra.Command {
 
 	postsTxCmd.AddCommand(flags.PostCommands(
 		GetCmdReportPost(cdc),
+		GetCmdReport(cdc),
+		GetCmdResolveReport(cdc),
+		GetCmdUnhidePost(cdc),
 	)...)
 
 	return postsTxCmd
 }
 
+// getActingAs parses the address of the profile on whose behalf the message should be performed.
+// If no address is specified, it returns `nil` instead.
+func getActingAs() (sdk.AccAddress, error) {
+	actingAs := viper.GetString(flagActingAs)
+	if len(strings.TrimSpace(actingAs)) == 0 {
+		return nil, nil
+	}
+
+	return sdk.AccAddressFromBech32(actingAs)
+}
+
 // GetCmdReportPost is the CLI command for reporting a post
 func GetCmdReportPost(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "create [post-id] [reports-type] [reports-message]",
 		Short: "reports a post",
 		Long: fmt.Sprintf(`
@@ -59,6 +83,123 @@ E.g.
 
 			msg := types.NewMsgReportPost(postID, args[1], args[2], cliCtx.GetFromAddress())
 
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
+
+	return cmd
+}
+
+// GetCmdReport is the CLI command for reporting a post, a profile, a registered reaction or a subspace
+func GetCmdReport(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report [kind] [id] [reports-type] [reports-message]",
+		Short: "reports a post, a profile, a registered reaction or a subspace",
+		Long: fmt.Sprintf(`
+Report an existent target specifying its kind and ID, the reports's type and message.
+The kind can be either "%s", "%s", "%s" or "%s".
+Profiles are identified by the address of their creator, while registered reactions
+are identified by their subspace and shortcode separated by a slash.
+
+E.g.
+%s tx reports report %s desmos13p5pamrljhza3fp4es5m3llgmnde5fzcpq6nud scam "this profile is impersonating someone"
+%s tx reports report %s 4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e/:like: spam "this reaction is spam"
+`, types.TargetKindPost, types.TargetKindProfile, types.TargetKindReaction, types.TargetKindSubspace,
+			version.ClientName, types.TargetKindProfile, version.ClientName, types.TargetKindReaction),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			target := types.NewReportTarget(types.TargetKind(args[0]), args[1])
+			if err := target.Validate(); err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+			}
+
+			msg := types.NewMsgReport(target, args[2], args[3], cliCtx.GetFromAddress())
+
+			actingAs, err := getActingAs()
+			if err != nil {
+				return err
+			}
+			msg = msg.WithActingAs(actingAs)
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagActingAs, "", "Address of the profile on whose behalf the message is performed")
+
+	return cmd
+}
+
+// GetCmdResolveReport is the CLI command for resolving a report
+func GetCmdResolveReport(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "resolve [report-id] [status] [note]",
+		Short: "resolve a report as a moderator",
+		Long: fmt.Sprintf(`
+Resolve an open report specifying its ID, the resolution status and a note for the reporter.
+The status can be either "%s", "%s" or "%s".
+Abusive reports have their deposit burned, while the deposit of the other reports is refunded.
+
+E.g.
+%s tx reports resolve 1 %s "the post has been removed"
+`, types.ReportStatusDismissed, types.ReportStatusActioned, types.ReportStatusAbusive,
+			version.ClientName, types.ReportStatusActioned),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			reportID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid report id: %s", args[0]))
+			}
+
+			msg := types.NewMsgResolveReport(reportID, types.ReportStatus(args[1]), args[2], cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdUnhidePost is the CLI command for clearing a hidden post
+func GetCmdUnhidePost(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "unhide [post-id] [note]",
+		Short: "clear a post hidden after being reported as a moderator",
+		Long: fmt.Sprintf(`
+Clear a post that has been automatically hidden after being reported by too many users.
+All the open reports of the post will be dismissed using the given note.
+
+E.g.
+%s tx reports unhide a4469741bb0c0622627810082a5f2e4e54fbbb888f25a4771a5eebc697d30cfc "the post does not violate the rules"
+`, version.ClientName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			msg := types.NewMsgUnhidePost(posts.PostID(args[0]), args[1], cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
@@ -11,6 +11,13 @@ import (
 )
 
 func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/reports/moderators", queryModeratorsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/reports/reasons", queryReasonsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/reports/hidden-posts", queryHiddenPostsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/reports/subspace/{subspace}", queryIndexedReportsHandlerFn(cliCtx, types.QuerySubspaceReports, "subspace")).Methods("GET")
+	r.HandleFunc("/reports/kinds/{kind}", queryIndexedReportsHandlerFn(cliCtx, types.QueryKindReports, "kind")).Methods("GET")
+	r.HandleFunc("/reports/targets/{kind}/{id:.+}", queryTargetReportsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc("/reports/reporter/{address}", queryIndexedReportsHandlerFn(cliCtx, types.QueryReporterReports, "address")).Methods("GET")
 	r.HandleFunc("/reports/{postID}", queryPostReportsHandlerFn(cliCtx)).Methods("GET")
 }
 
@@ -30,3 +37,97 @@ func queryPostReportsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+// HTTP request handler to query the reports of the target having the given kind and id
+func queryTargetReportsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		target := types.NewReportTarget(types.TargetKind(vars["kind"]), vars["id"])
+
+		route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryTargetReports, target)
+		res, _, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the paginated list of reports using the given query path,
+// whose parameter is read from the given route variable
+func queryIndexedReportsHandlerFn(cliCtx context.CLIContext, query, variable string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := types.NewQueryIndexedReportsParams(
+			types.ReportStatus(r.URL.Query().Get("status")),
+			page,
+			limit,
+		)
+
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, query, vars[variable])
+		res, _, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the users allowed to resolve reports
+func queryModeratorsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryModerators)
+		res, _, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the registered report reasons
+func queryReasonsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryReasons)
+		res, _, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// HTTP request handler to query the ids of the posts hidden pending review
+func queryHiddenPostsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryHiddenPosts)
+		res, _, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
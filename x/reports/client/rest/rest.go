@@ -2,7 +2,9 @@ package rest
 
 import (
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/desmos-labs/desmos/x/reports/types"
 	"github.com/gorilla/mux"
 )
 
@@ -13,7 +15,19 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 }
 
 type ReportPostReq struct {
-	BaseReq       rest.BaseReq `json:"base_req"`
-	ReportType    string       `json:"report_type"`
-	ReportMessage string       `json:"report_message"`
+	BaseReq       rest.BaseReq   `json:"base_req"`
+	ReportType    string         `json:"report_type"`
+	ReportMessage string         `json:"report_message"`
+	ActingAs      sdk.AccAddress `json:"acting_as,omitempty"`
+}
+
+type ResolveReportReq struct {
+	BaseReq rest.BaseReq       `json:"base_req"`
+	Status  types.ReportStatus `json:"status"`
+	Note    string             `json:"note"`
+}
+
+type UnhidePostReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Note    string       `json:"note"`
 }
@@ -1,8 +1,9 @@
 package reports
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	posts "github.com/desmos-labs/desmos/x/posts/types"
 	reportsKeeper "github.com/desmos-labs/desmos/x/reports/keeper"
 	reportsTypes "github.com/desmos-labs/desmos/x/reports/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -12,18 +13,34 @@ import (
 func ExportGenesis(ctx sdk.Context, k reportsKeeper.Keeper) reportsTypes.GenesisState {
 	return reportsTypes.GenesisState{
 		Reports: k.GetReportsMap(ctx),
+		Params:  k.GetParams(ctx),
 	}
 }
 
 // InitGenesis initializes the chain state based on the given GenesisState
 func InitGenesis(ctx sdk.Context, keeper reportsKeeper.Keeper, data reportsTypes.GenesisState) []abci.ValidatorUpdate {
-	for postID, reports := range data.Reports {
-		postID, err := posts.ParsePostID(postID)
-		if err != nil {
-			panic(err)
-		}
-		for _, report := range reports {
-			keeper.SaveReport(ctx, postID, report)
+	keeper.SetParams(ctx, data.Params)
+
+	// Sort the targets so that reports without an id are assigned the same ids on every node
+	targets := make([]string, 0, len(data.Reports))
+	for target := range data.Reports {
+		targets = append(targets, target)
+	}
+	sort.Strings(targets)
+
+	// Store the reports having an id first, so that the ones without it are assigned the following ids
+	for _, withID := range []bool{true, false} {
+		for _, value := range targets {
+			target, err := reportsTypes.ParseReportTarget(value)
+			if err != nil {
+				panic(err)
+			}
+
+			for _, report := range data.Reports[value] {
+				if (report.ID != 0) == withID {
+					keeper.SaveReport(ctx, target, report)
+				}
+			}
 		}
 	}
 
@@ -7,15 +7,24 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	postsK "github.com/desmos-labs/desmos/x/posts/keeper"
 	postsT "github.com/desmos-labs/desmos/x/posts/types"
+	profilesK "github.com/desmos-labs/desmos/x/profiles/keeper"
+	profilesT "github.com/desmos-labs/desmos/x/profiles/types"
+	relationshipsK "github.com/desmos-labs/desmos/x/relationships/keeper"
+	relationshipsT "github.com/desmos-labs/desmos/x/relationships/types"
 	"github.com/desmos-labs/desmos/x/reports/keeper"
 	"github.com/desmos-labs/desmos/x/reports/types"
 	"github.com/desmos-labs/desmos/x/reports/types/models/common"
+	subscriptionsK "github.com/desmos-labs/desmos/x/subscriptions/keeper"
+	subscriptionsT "github.com/desmos-labs/desmos/x/subscriptions/types"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
-	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 	db "github.com/tendermint/tm-db"
 )
@@ -23,15 +32,20 @@ import (
 type KeeperTestSuite struct {
 	suite.Suite
 
-	cdc         *codec.Codec
-	ctx         sdk.Context
-	keeper      keeper.Keeper
-	postsKeeper postsK.Keeper
-	testData    TestData
+	cdc            *codec.Codec
+	ctx            sdk.Context
+	keeper         keeper.Keeper
+	postsKeeper    postsK.Keeper
+	profilesKeeper profilesK.Keeper
+	stakingKeeper  staking.Keeper
+	bankKeeper     bank.Keeper
+	supplyKeeper   supply.Keeper
+	testData       TestData
 }
 
 type TestData struct {
 	creator          sdk.AccAddress
+	moderator        sdk.AccAddress
 	postID           postsT.PostID
 	timeZone         *time.Location
 	postCreationDate time.Time
@@ -41,6 +55,12 @@ func (suite *KeeperTestSuite) SetupTest() {
 	// define store keys
 	postsKey := sdk.NewKVStoreKey(postsT.StoreKey)
 	reportsKey := sdk.NewKVStoreKey(common.StoreKey)
+	profilesKey := sdk.NewKVStoreKey(profilesT.StoreKey)
+	relationshipsKey := sdk.NewKVStoreKey(relationshipsT.StoreKey)
+	subscriptionsKey := sdk.NewKVStoreKey(subscriptionsT.StoreKey)
+	authKey := sdk.NewKVStoreKey(auth.StoreKey)
+	supplyKey := sdk.NewKVStoreKey(supply.StoreKey)
+	stakingKey := sdk.NewKVStoreKey(staking.StoreKey)
 	paramsKey := sdk.NewKVStoreKey("params")
 	paramsTKey := sdk.NewTransientStoreKey("transient_params")
 
@@ -49,6 +69,12 @@ func (suite *KeeperTestSuite) SetupTest() {
 	ms := store.NewCommitMultiStore(memDB)
 	ms.MountStoreWithDB(postsKey, sdk.StoreTypeIAVL, memDB)
 	ms.MountStoreWithDB(reportsKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(profilesKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(relationshipsKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(subscriptionsKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(stakingKey, sdk.StoreTypeIAVL, memDB)
 	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, memDB)
 	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, memDB)
 	if err := ms.LoadLatestVersion(); err != nil {
@@ -60,14 +86,34 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 	// define keepers
 	paramsKeeper := params.NewKeeper(suite.cdc, paramsKey, paramsTKey)
-	suite.postsKeeper = postsK.NewKeeper(suite.cdc, postsKey, paramsKeeper.Subspace("postsT"))
-	suite.keeper = keeper.NewKeeper(suite.postsKeeper, suite.cdc, reportsKey)
+	suite.profilesKeeper = profilesK.NewKeeper(suite.cdc, profilesKey, paramsKeeper.Subspace(profilesT.DefaultParamspace))
+	relationshipsKeeper := relationshipsK.NewKeeper(suite.profilesKeeper, suite.cdc, relationshipsKey)
+	accountKeeper := auth.NewAccountKeeper(suite.cdc, authKey, paramsKeeper.Subspace(auth.DefaultParamspace),
+		auth.ProtoBaseAccount)
+	suite.bankKeeper = bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), nil)
+	suite.supplyKeeper = supply.NewKeeper(suite.cdc, supplyKey, accountKeeper, suite.bankKeeper, map[string][]string{
+		staking.BondedPoolName:    {supply.Burner, supply.Staking},
+		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+		types.ModuleName:          {supply.Burner},
+		subscriptionsT.ModuleName: nil,
+	})
+	subscriptionsKeeper := subscriptionsK.NewKeeper(relationshipsKeeper, suite.supplyKeeper, suite.cdc, subscriptionsKey,
+		paramsKeeper.Subspace(subscriptionsT.DefaultParamspace))
+	suite.postsKeeper = postsK.NewKeeper(suite.profilesKeeper, relationshipsKeeper, subscriptionsKeeper, suite.bankKeeper,
+		suite.cdc, postsKey, paramsKeeper.Subspace("postsT"))
+	suite.stakingKeeper = staking.NewKeeper(suite.cdc, stakingKey, suite.supplyKeeper,
+		paramsKeeper.Subspace(staking.DefaultParamspace))
+	suite.keeper = keeper.NewKeeper(suite.postsKeeper, suite.profilesKeeper, suite.stakingKeeper, suite.supplyKeeper,
+		suite.cdc, reportsKey, paramsKeeper.Subspace(types.DefaultParamspace))
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
 
 	// setup data
 	suite.testData.postID = "19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af"
 	// nolint - errcheck
 	suite.testData.creator, _ = sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
 	// nolint - errcheck
+	suite.testData.moderator, _ = sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	// nolint - errcheck
 	suite.testData.timeZone, _ = time.LoadLocation("UTC")
 	suite.testData.postCreationDate = time.Date(2020, 1, 1, 15, 15, 00, 000, suite.testData.timeZone)
 }
@@ -80,9 +126,18 @@ func testCodec() *codec.Codec {
 	var cdc = codec.New()
 
 	// register the different types
-	cdc.RegisterInterface((*crypto.PubKey)(nil), nil)
+	codec.RegisterCrypto(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
 	types.RegisterCodec(cdc)
 
 	cdc.Seal()
 	return cdc
 }
+
+// setModerators sets the given users as the reports moderators, leaving the other params unchanged
+func (suite *KeeperTestSuite) setModerators(moderators []sdk.AccAddress) {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.Moderators = moderators
+	suite.keeper.SetParams(suite.ctx, params)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -17,6 +18,12 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case types.MsgReportPost:
 			return handleMsgReportPost(ctx, keeper, msg)
+		case types.MsgReport:
+			return handleMsgReport(ctx, keeper, msg)
+		case types.MsgResolveReport:
+			return handleMsgResolveReport(ctx, keeper, msg)
+		case types.MsgUnhidePost:
+			return handleMsgUnhidePost(ctx, keeper, msg)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
 				fmt.Sprintf("unrecognized posts message type: %v", msg.Type()))
@@ -26,22 +33,196 @@ func NewHandler(keeper Keeper) sdk.Handler {
 
 // handleMsgReportPost handles the reports of a post
 func handleMsgReportPost(ctx sdk.Context, keeper Keeper, msg types.MsgReportPost) (*sdk.Result, error) {
-	// check if the post to reports exists
-	if exist := keeper.CheckPostExistence(ctx, msg.PostID); !exist {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("post with ID: %s doesn't exist", msg.PostID))
+	return handleReport(ctx, keeper, types.NewPostTarget(msg.PostID), msg.Report, msg.ActingAs, msg.Type())
+}
+
+// handleMsgReport handles the reports of a generic target
+func handleMsgReport(ctx sdk.Context, keeper Keeper, msg types.MsgReport) (*sdk.Result, error) {
+	return handleReport(ctx, keeper, msg.Target, msg.Report, msg.ActingAs, msg.Type())
+}
+
+// handleReport creates the given report of the given target, performed by the report user
+// on behalf of the actingAs profile, if any
+func handleReport(
+	ctx sdk.Context, keeper Keeper, target types.ReportTarget, report types.Report, actingAs sdk.AccAddress, msgType string,
+) (*sdk.Result, error) {
+	// Resolve the account on whose behalf the message is performed
+	signer := report.User
+	actor, err := keeper.ProfilesKeeper.GetActingAccount(ctx, signer, actingAs, msgType)
+	if err != nil {
+		return nil, err
+	}
+	report.User = actor
+
+	// check if the target to report exists
+	if err := keeper.CheckTargetExistence(ctx, target); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// check that the deposit is not charged to a user other than the signer, since grantees cannot move the granter funds
+	params := keeper.GetParams(ctx)
+	if !params.ReportDeposit.IsZero() && !actor.Equals(signer) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf(
+			"%s cannot report on behalf of %s while reports require a deposit", signer, actor))
+	}
+
+	// check if the report references a registered reason
+	if _, registered := params.Reasons.Find(report.Type); !registered {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("report reason %s is not registered", report.Type))
+	}
+
+	// check if the user has already reported the target for the same reason
+	if keeper.HasOpenReport(ctx, target, actor, report.Type) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf(
+			"%s has already reported the %s with id %s for reason %s", actor, target.Kind, target.ID, report.Type))
+	}
+
+	// check if the user has reached the reports rate limit
+	rateLimit := params.RateLimit
+	since := ctx.BlockTime().Add(-rateLimit.Period)
+	if sdk.NewInt(keeper.CountReporterReportsSince(ctx, actor, since)).GTE(rateLimit.MaxReports) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("%s has reached the limit of %s reports every %s", actor, rateLimit.MaxReports, rateLimit.Period))
+	}
+
+	// Collect the report deposit
+	if err := keeper.ChargeReportDeposit(ctx, actor, params.ReportDeposit); err != nil {
+		return nil, err
 	}
 
-	keeper.SaveReport(ctx, msg.PostID, msg.Report)
+	// Store the report as a new open one
+	report.ID = 0
+	report.Created = ctx.BlockTime()
+	report.Status = types.ReportStatusOpen
+	report.Resolution = nil
+	report.Deposit = params.ReportDeposit
+	report = keeper.SaveReport(ctx, target, report)
+
+	postID, isPost := target.PostID()
+	if !isPost {
+		createEvent := sdk.NewEvent(
+			types.EventTypeTargetReported,
+			sdk.NewAttribute(types.AttributeKeyTargetKind, string(target.Kind)),
+			sdk.NewAttribute(types.AttributeKeyTargetID, target.ID),
+			sdk.NewAttribute(types.AttributeKeyReportOwner, report.User.String()),
+			sdk.NewAttribute(types.AttributeKeyReportID, strconv.FormatUint(report.ID, 10)),
+		)
+		ctx.EventManager().EmitEvent(createEvent)
+
+		result := sdk.Result{
+			Data:   []byte(fmt.Sprintf("%s with id %s reported correctly", target.Kind, target.ID)),
+			Events: ctx.EventManager().Events(),
+		}
+		return &result, nil
+	}
 
 	createEvent := sdk.NewEvent(
 		types.EventTypePostReported,
-		sdk.NewAttribute(types.AttributeKeyPostID, msg.PostID.String()),
-		sdk.NewAttribute(types.AttributeKeyReportOwner, msg.Report.User.String()),
+		sdk.NewAttribute(types.AttributeKeyPostID, postID.String()),
+		sdk.NewAttribute(types.AttributeKeyReportOwner, report.User.String()),
+		sdk.NewAttribute(types.AttributeKeyReportID, strconv.FormatUint(report.ID, 10)),
 	)
 	ctx.EventManager().EmitEvent(createEvent)
 
+	// Hide the post if it has been reported by too many users
+	if weight, hidden := keeper.HidePostIfNeeded(ctx, postID); hidden {
+		hideEvent := sdk.NewEvent(
+			types.EventTypePostHidden,
+			sdk.NewAttribute(types.AttributeKeyPostID, postID.String()),
+			sdk.NewAttribute(types.AttributeKeyReportsWeight, weight.String()),
+		)
+		ctx.EventManager().EmitEvent(hideEvent)
+	}
+
+	result := sdk.Result{
+		Data:   []byte(fmt.Sprintf("post with ID: %s reported correctly", postID)),
+		Events: ctx.EventManager().Events(),
+	}
+	return &result, nil
+}
+
+// handleMsgResolveReport handles the resolution of a report by a moderator
+func handleMsgResolveReport(ctx sdk.Context, keeper Keeper, msg types.MsgResolveReport) (*sdk.Result, error) {
+	if !keeper.IsModerator(ctx, msg.Moderator) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not a reports moderator", msg.Moderator))
+	}
+
+	target, report, found := keeper.GetReport(ctx, msg.ReportID)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("report with id %d doesn't exist", msg.ReportID))
+	}
+
+	if !report.IsOpen() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("report with id %d has already been resolved", msg.ReportID))
+	}
+
+	resolution := types.NewReportResolution(msg.Moderator, msg.Note, ctx.BlockTime())
+	report.Status = msg.Status
+	report.Resolution = &resolution
+	keeper.SaveReport(ctx, target, report)
+
+	// Burn the deposit of abusive reports and refund the others
+	if err := keeper.SettleReportDeposit(ctx, report); err != nil {
+		return nil, err
+	}
+
+	resolveEvent := sdk.NewEvent(
+		types.EventTypeReportResolved,
+		sdk.NewAttribute(types.AttributeKeyReportID, strconv.FormatUint(report.ID, 10)),
+		sdk.NewAttribute(types.AttributeKeyTargetKind, string(target.Kind)),
+		sdk.NewAttribute(types.AttributeKeyTargetID, target.ID),
+		sdk.NewAttribute(types.AttributeKeyReportOwner, report.User.String()),
+		sdk.NewAttribute(types.AttributeKeyModerator, msg.Moderator.String()),
+		sdk.NewAttribute(types.AttributeKeyReportStatus, string(report.Status)),
+	)
+	ctx.EventManager().EmitEvent(resolveEvent)
+
+	result := sdk.Result{
+		Data:   []byte(fmt.Sprintf("report with id %d resolved correctly", report.ID)),
+		Events: ctx.EventManager().Events(),
+	}
+	return &result, nil
+}
+
+// handleMsgUnhidePost handles the clearing of a hidden post by a moderator
+func handleMsgUnhidePost(ctx sdk.Context, keeper Keeper, msg types.MsgUnhidePost) (*sdk.Result, error) {
+	if !keeper.IsModerator(ctx, msg.Moderator) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf("%s is not a reports moderator", msg.Moderator))
+	}
+
+	if !keeper.PostKeeper.IsPostHidden(ctx, msg.PostID) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("post with id %s is not hidden", msg.PostID))
+	}
+
+	keeper.PostKeeper.UnhidePost(ctx, msg.PostID)
+
+	// Dismiss all the open reports so that they do not hide the post again
+	dismissed := 0
+	resolution := types.NewReportResolution(msg.Moderator, msg.Note, ctx.BlockTime())
+	for _, report := range keeper.GetPostReports(ctx, msg.PostID) {
+		if !report.IsOpen() {
+			continue
+		}
+
+		report.Status = types.ReportStatusDismissed
+		report.Resolution = &resolution
+		keeper.SaveReport(ctx, types.NewPostTarget(msg.PostID), report)
+		if err := keeper.RefundReportDeposit(ctx, report); err != nil {
+			return nil, err
+		}
+		dismissed++
+	}
+
+	unhideEvent := sdk.NewEvent(
+		types.EventTypePostUnhidden,
+		sdk.NewAttribute(types.AttributeKeyPostID, msg.PostID.String()),
+		sdk.NewAttribute(types.AttributeKeyModerator, msg.Moderator.String()),
+		sdk.NewAttribute(types.AttributeKeyDismissedReports, strconv.Itoa(dismissed)),
+	)
+	ctx.EventManager().EmitEvent(unhideEvent)
+
 	result := sdk.Result{
-		Data:   []byte(fmt.Sprintf("post with ID: %s reported correctly", msg.PostID)),
+		Data:   []byte(fmt.Sprintf("post with id %s unhidden correctly", msg.PostID)),
 		Events: ctx.EventManager().Events(),
 	}
 	return &result, nil
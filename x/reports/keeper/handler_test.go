@@ -2,15 +2,19 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	posts "github.com/desmos-labs/desmos/x/posts/types"
+	profiles "github.com/desmos-labs/desmos/x/profiles/types"
 	"github.com/desmos-labs/desmos/x/reports/keeper"
 	"github.com/desmos-labs/desmos/x/reports/types"
 )
 
 func (suite *KeeperTestSuite) Test_handleMsgReportPost() {
-	msgReport := types.NewMsgReportPost(suite.testData.postID, "type", "message", suite.testData.creator)
+	msgReport := types.NewMsgReportPost(suite.testData.postID, "scam", "message", suite.testData.creator)
 	existentPost := posts.Post{
 		PostID:       suite.testData.postID,
 		Message:      "Post",
@@ -20,11 +24,15 @@ func (suite *KeeperTestSuite) Test_handleMsgReportPost() {
 		Creator:      suite.testData.creator,
 	}
 
+	rateLimit := types.NewRateLimitParams(sdk.NewInt(1), time.Hour)
+
 	tests := []struct {
-		name         string
-		msg          types.MsgReportPost
-		existentPost *posts.Post
-		expErr       error
+		name            string
+		msg             types.MsgReportPost
+		existentPost    *posts.Post
+		existingReports types.Reports
+		rateLimit       *types.RateLimitParams
+		expErr          error
 	}{
 		{
 			name:         "post not found",
@@ -32,6 +40,33 @@ func (suite *KeeperTestSuite) Test_handleMsgReportPost() {
 			existentPost: nil,
 			expErr:       sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("post with ID: %s doesn't exist", suite.testData.postID)),
 		},
+		{
+			name:         "reason not registered",
+			msg:          types.NewMsgReportPost(suite.testData.postID, "Scam", "message", suite.testData.creator),
+			existentPost: &existentPost,
+			expErr:       sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "report reason Scam is not registered"),
+		},
+		{
+			name:         "duplicated report",
+			msg:          msgReport,
+			existentPost: &existentPost,
+			existingReports: types.Reports{
+				types.NewReport("scam", "other message", suite.testData.creator),
+			},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf(
+				"%s has already reported the post with id %s for reason scam", suite.testData.creator, suite.testData.postID)),
+		},
+		{
+			name:         "rate limit reached",
+			msg:          msgReport,
+			existentPost: &existentPost,
+			existingReports: types.Reports{
+				types.NewReport("spam", "message", suite.testData.creator),
+			},
+			rateLimit: &rateLimit,
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf(
+				"%s has reached the limit of 1 reports every 1h0m0s", suite.testData.creator)),
+		},
 		{
 			name:         "message handled correctly",
 			msg:          msgReport,
@@ -44,11 +79,21 @@ func (suite *KeeperTestSuite) Test_handleMsgReportPost() {
 		test := test
 		suite.Run(test.name, func() {
 			suite.SetupTest() // reset
+			suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
 
 			if test.existentPost != nil {
 				// Save the post
 				suite.postsKeeper.SavePost(suite.ctx, *test.existentPost)
 			}
+			for _, report := range test.existingReports {
+				report.Created = suite.testData.postCreationDate
+				suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), report)
+			}
+			if test.rateLimit != nil {
+				params := suite.keeper.GetParams(suite.ctx)
+				params.RateLimit = *test.rateLimit
+				suite.keeper.SetParams(suite.ctx, params)
+			}
 
 			handler := keeper.NewHandler(suite.keeper)
 			res, err := handler(suite.ctx, test.msg)
@@ -66,12 +111,466 @@ func (suite *KeeperTestSuite) Test_handleMsgReportPost() {
 					types.EventTypePostReported,
 					sdk.NewAttribute(types.AttributeKeyPostID, suite.testData.postID.String()),
 					sdk.NewAttribute(types.AttributeKeyReportOwner, test.msg.Report.User.String()),
+					sdk.NewAttribute(types.AttributeKeyReportID, "1"),
 				)
 
 				suite.Len(res.Events, 1)
 				suite.Contains(res.Events, createReportEv)
+
+				//Check the stored report
+				expReport := types.Report{
+					ID: 1, Type: "scam", Message: "message", User: suite.testData.creator,
+					Created: suite.testData.postCreationDate, Status: types.ReportStatusOpen,
+				}
+				suite.Equal(types.Reports{expReport}, suite.keeper.GetPostReports(suite.ctx, suite.testData.postID))
+			}
+
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgReportPost_rateLimitWindow() {
+	suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
+	suite.postsKeeper.SavePost(suite.ctx, posts.Post{
+		PostID:       suite.testData.postID,
+		Message:      "Post",
+		Created:      suite.testData.postCreationDate,
+		Subspace:     "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e",
+		OptionalData: map[string]string{},
+		Creator:      suite.testData.creator,
+	})
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.RateLimit = types.NewRateLimitParams(sdk.NewInt(1), time.Hour)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	report := types.NewReport("spam", "message", suite.testData.creator)
+	report.Created = suite.testData.postCreationDate.Add(-2 * time.Hour)
+	suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), report)
+
+	handler := keeper.NewHandler(suite.keeper)
+	_, err := handler(suite.ctx, types.NewMsgReportPost(suite.testData.postID, "scam", "message", suite.testData.creator))
+	suite.NoError(err)
+	suite.Len(suite.keeper.GetPostReports(suite.ctx, suite.testData.postID), 2)
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgReportPost_deposit() {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))
+	suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
+	suite.postsKeeper.SavePost(suite.ctx, posts.Post{
+		PostID:       suite.testData.postID,
+		Message:      "Post",
+		Created:      suite.testData.postCreationDate,
+		Subspace:     "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e",
+		OptionalData: map[string]string{},
+		Creator:      suite.testData.creator,
+	})
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.ReportDeposit = deposit
+	suite.keeper.SetParams(suite.ctx, params)
+
+	handler := keeper.NewHandler(suite.keeper)
+	msg := types.NewMsgReportPost(suite.testData.postID, "scam", "message", suite.testData.creator)
+
+	// Reporting without enough funds should fail
+	_, err := handler(suite.ctx, msg)
+	suite.Error(err)
+	suite.Empty(suite.keeper.GetPostReports(suite.ctx, suite.testData.postID))
+
+	// Reporting with enough funds should lock the deposit
+	err = suite.bankKeeper.SetCoins(suite.ctx, suite.testData.creator, sdk.NewCoins(sdk.NewInt64Coin("udaric", 150)))
+	suite.NoError(err)
+
+	_, err = handler(suite.ctx, msg)
+	suite.NoError(err)
+
+	reports := suite.keeper.GetPostReports(suite.ctx, suite.testData.postID)
+	suite.Len(reports, 1)
+	suite.Equal(deposit, reports[0].Deposit)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("udaric", 50)), suite.bankKeeper.GetCoins(suite.ctx, suite.testData.creator))
+	suite.Equal(deposit, suite.supplyKeeper.GetModuleAccount(suite.ctx, types.ModuleName).GetCoins())
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgReportPost_depositActingAs() {
+	grantee, err := sdk.AccAddressFromBech32("cosmos1q4hx350dh0843wr3csctxr87at3zcvd9qehqvg")
+	suite.NoError(err)
+
+	owner := suite.testData.creator
+	suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
+	suite.postsKeeper.SavePost(suite.ctx, posts.Post{
+		PostID:       suite.testData.postID,
+		Message:      "Post",
+		Created:      suite.testData.postCreationDate,
+		Subspace:     "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e",
+		OptionalData: map[string]string{},
+		Creator:      owner,
+	})
+	suite.profilesKeeper.SaveGrant(suite.ctx, profiles.NewGrant(owner, grantee, []string{types.ActionReportPost},
+		suite.testData.postCreationDate.Add(time.Hour)))
+
+	ownerCoins := sdk.NewCoins(sdk.NewInt64Coin("udaric", 150))
+	suite.NoError(suite.bankKeeper.SetCoins(suite.ctx, owner, ownerCoins))
+
+	handler := keeper.NewHandler(suite.keeper)
+	msg := types.NewMsgReportPost(suite.testData.postID, "scam", "message", grantee).WithActingAs(owner)
+
+	// Reporting on behalf of the owner while a deposit is required should fail without charging the owner
+	params := suite.keeper.GetParams(suite.ctx)
+	params.ReportDeposit = sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))
+	suite.keeper.SetParams(suite.ctx, params)
+
+	_, err = handler(suite.ctx, msg)
+	suite.Error(err)
+	suite.Equal(sdkerrors.Wrap(sdkerrors.ErrUnauthorized, fmt.Sprintf(
+		"%s cannot report on behalf of %s while reports require a deposit", grantee, owner)).Error(), err.Error())
+	suite.Empty(suite.keeper.GetPostReports(suite.ctx, suite.testData.postID))
+	suite.Equal(ownerCoins, suite.bankKeeper.GetCoins(suite.ctx, owner))
+
+	// Reporting on behalf of the owner without a deposit should work
+	params.ReportDeposit = nil
+	suite.keeper.SetParams(suite.ctx, params)
+
+	_, err = handler(suite.ctx, msg)
+	suite.NoError(err)
+
+	reports := suite.keeper.GetPostReports(suite.ctx, suite.testData.postID)
+	suite.Len(reports, 1)
+	suite.Equal(owner, reports[0].User)
+	suite.Equal(ownerCoins, suite.bankKeeper.GetCoins(suite.ctx, owner))
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgReport() {
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	reaction := posts.NewReaction(suite.testData.creator, ":reaction:", "https://smile.jpg", subspace)
+	profile := profiles.NewProfile("dtag", suite.testData.moderator, suite.testData.postCreationDate)
+
+	tests := []struct {
+		name   string
+		msg    types.MsgReport
+		expErr error
+	}{
+		{
+			name: "profile not found",
+			msg: types.NewMsgReport(types.NewProfileTarget(suite.testData.creator), "scam", "message",
+				suite.testData.moderator),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("profile of %s doesn't exist", suite.testData.creator)),
+		},
+		{
+			name: "reaction not registered",
+			msg: types.NewMsgReport(types.NewReactionTarget(":other:", subspace), "scam", "message",
+				suite.testData.creator),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("reaction with shortcode :other: isn't registered inside subspace %s", subspace)),
+		},
+		{
+			name: "profile reported correctly",
+			msg: types.NewMsgReport(types.NewProfileTarget(suite.testData.moderator), "scam", "message",
+				suite.testData.creator),
+		},
+		{
+			name: "reaction reported correctly",
+			msg: types.NewMsgReport(types.NewReactionTarget(":reaction:", subspace), "scam", "message",
+				suite.testData.creator),
+		},
+		{
+			name:   "subspace reported correctly",
+			msg:    types.NewMsgReport(types.NewSubspaceTarget(subspace), "scam", "message", suite.testData.creator),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
+			suite.postsKeeper.RegisterReaction(suite.ctx, reaction)
+			suite.NoError(suite.profilesKeeper.SaveProfile(suite.ctx, profile))
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expErr != nil {
+				suite.Nil(res)
+				suite.Equal(test.expErr.Error(), err.Error())
+				return
 			}
 
+			suite.NoError(err)
+			target := test.msg.Target
+			suite.Equal([]byte(fmt.Sprintf("%s with id %s reported correctly", target.Kind, target.ID)), res.Data)
+
+			createReportEv := sdk.NewEvent(
+				types.EventTypeTargetReported,
+				sdk.NewAttribute(types.AttributeKeyTargetKind, string(target.Kind)),
+				sdk.NewAttribute(types.AttributeKeyTargetID, target.ID),
+				sdk.NewAttribute(types.AttributeKeyReportOwner, suite.testData.creator.String()),
+				sdk.NewAttribute(types.AttributeKeyReportID, "1"),
+			)
+			suite.Len(res.Events, 1)
+			suite.Contains(res.Events, createReportEv)
+
+			expReport := types.Report{
+				ID: 1, Type: "scam", Message: "message", User: suite.testData.creator,
+				Created: suite.testData.postCreationDate, Status: types.ReportStatusOpen,
+			}
+			suite.Equal(types.Reports{expReport}, suite.keeper.GetTargetReports(suite.ctx, target))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgResolveReport() {
+	report := types.Report{
+		ID: 1, Type: "type", Message: "message", User: suite.testData.creator,
+		Created: suite.testData.postCreationDate, Status: types.ReportStatusOpen,
+	}
+	resolution := types.NewReportResolution(suite.testData.moderator, "note", suite.testData.postCreationDate)
+	resolvedReport := report
+	resolvedReport.Status = types.ReportStatusDismissed
+	resolvedReport.Resolution = &resolution
+
+	tests := []struct {
+		name           string
+		msg            types.MsgResolveReport
+		moderators     []sdk.AccAddress
+		existingReport *types.Report
+		expErr         error
+		expReport      *types.Report
+	}{
+		{
+			name:       "signer is not a moderator",
+			msg:        types.NewMsgResolveReport(1, types.ReportStatusDismissed, "note", suite.testData.creator),
+			moderators: []sdk.AccAddress{suite.testData.moderator},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				fmt.Sprintf("%s is not a reports moderator", suite.testData.creator)),
+		},
+		{
+			name:       "report not found",
+			msg:        types.NewMsgResolveReport(1, types.ReportStatusDismissed, "note", suite.testData.moderator),
+			moderators: []sdk.AccAddress{suite.testData.moderator},
+			expErr:     sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "report with id 1 doesn't exist"),
+		},
+		{
+			name:           "report already resolved",
+			msg:            types.NewMsgResolveReport(1, types.ReportStatusActioned, "note", suite.testData.moderator),
+			moderators:     []sdk.AccAddress{suite.testData.moderator},
+			existingReport: &resolvedReport,
+			expErr:         sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "report with id 1 has already been resolved"),
+		},
+		{
+			name:           "message handled correctly",
+			msg:            types.NewMsgResolveReport(1, types.ReportStatusDismissed, "note", suite.testData.moderator),
+			moderators:     []sdk.AccAddress{suite.testData.moderator},
+			existingReport: &report,
+			expReport:      &resolvedReport,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
+			suite.setModerators(test.moderators)
+			if test.existingReport != nil {
+				suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), *test.existingReport)
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expErr != nil {
+				suite.Nil(res)
+				suite.Equal(test.expErr.Error(), err.Error())
+				return
+			}
+
+			suite.NoError(err)
+			suite.Equal([]byte("report with id 1 resolved correctly"), res.Data)
+
+			resolveReportEv := sdk.NewEvent(
+				types.EventTypeReportResolved,
+				sdk.NewAttribute(types.AttributeKeyReportID, "1"),
+				sdk.NewAttribute(types.AttributeKeyTargetKind, string(types.TargetKindPost)),
+				sdk.NewAttribute(types.AttributeKeyTargetID, suite.testData.postID.String()),
+				sdk.NewAttribute(types.AttributeKeyReportOwner, suite.testData.creator.String()),
+				sdk.NewAttribute(types.AttributeKeyModerator, suite.testData.moderator.String()),
+				sdk.NewAttribute(types.AttributeKeyReportStatus, string(types.ReportStatusDismissed)),
+			)
+			suite.Len(res.Events, 1)
+			suite.Contains(res.Events, resolveReportEv)
+
+			_, stored, found := suite.keeper.GetReport(suite.ctx, 1)
+			suite.True(found)
+			suite.Equal(*test.expReport, stored)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgResolveReport_deposit() {
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))
+
+	tests := []struct {
+		name           string
+		status         types.ReportStatus
+		expUserCoins   sdk.Coins
+		expTotalSupply sdk.Coins
+	}{
+		{
+			name:           "abusive report deposit is burned",
+			status:         types.ReportStatusAbusive,
+			expUserCoins:   nil,
+			expTotalSupply: nil,
+		},
+		{
+			name:           "dismissed report deposit is refunded",
+			status:         types.ReportStatusDismissed,
+			expUserCoins:   deposit,
+			expTotalSupply: deposit,
+		},
+		{
+			name:           "actioned report deposit is refunded",
+			status:         types.ReportStatusActioned,
+			expUserCoins:   deposit,
+			expTotalSupply: deposit,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
+			suite.setModerators([]sdk.AccAddress{suite.testData.moderator})
+
+			// Lock the deposit inside the module account
+			suite.supplyKeeper.SetSupply(suite.ctx, supply.NewSupply(deposit))
+			err := suite.bankKeeper.SetCoins(suite.ctx, suite.testData.creator, deposit)
+			suite.NoError(err)
+			suite.NoError(suite.keeper.ChargeReportDeposit(suite.ctx, suite.testData.creator, deposit))
+
+			report := types.NewReport("spam", "message", suite.testData.creator)
+			report.Created = suite.testData.postCreationDate
+			report.Deposit = deposit
+			suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), report)
+
+			handler := keeper.NewHandler(suite.keeper)
+			_, err = handler(suite.ctx, types.NewMsgResolveReport(1, test.status, "note", suite.testData.moderator))
+			suite.NoError(err)
+
+			suite.Equal(test.expUserCoins, suite.bankKeeper.GetCoins(suite.ctx, suite.testData.creator))
+			suite.True(suite.supplyKeeper.GetModuleAccount(suite.ctx, types.ModuleName).GetCoins().IsZero())
+			suite.Equal(test.expTotalSupply, suite.supplyKeeper.GetSupply(suite.ctx).GetTotal())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgReportPost_hidesPost() {
+	suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
+
+	reporter, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	suite.NoError(err)
+
+	suite.postsKeeper.SavePost(suite.ctx, posts.Post{
+		PostID:       suite.testData.postID,
+		Message:      "Post",
+		Created:      suite.testData.postCreationDate,
+		Subspace:     "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e",
+		OptionalData: map[string]string{},
+		Creator:      suite.testData.creator,
+	})
+	suite.keeper.SetParams(suite.ctx, types.NewParams(types.DefaultReasons, types.NewHidingParams(
+		sdk.NewDec(2), time.Hour, types.WeightingNone, sdk.NewInt(1), time.Hour,
+	), types.DefaultRateLimitParams(), types.DefaultReportDeposit, types.DefaultModerators))
+	suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), types.NewReport("spam", "message", reporter))
+
+	handler := keeper.NewHandler(suite.keeper)
+	res, err := handler(suite.ctx, types.NewMsgReportPost(suite.testData.postID, "scam", "message", suite.testData.creator))
+	suite.NoError(err)
+
+	hideEvent := sdk.NewEvent(
+		types.EventTypePostHidden,
+		sdk.NewAttribute(types.AttributeKeyPostID, suite.testData.postID.String()),
+		sdk.NewAttribute(types.AttributeKeyReportsWeight, sdk.NewDec(2).String()),
+	)
+	suite.Len(res.Events, 2)
+	suite.Contains(res.Events, hideEvent)
+	suite.True(suite.postsKeeper.IsPostHidden(suite.ctx, suite.testData.postID))
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgUnhidePost() {
+	report := types.Report{
+		ID: 1, Type: "spam", Message: "message", User: suite.testData.creator,
+		Created: suite.testData.postCreationDate, Status: types.ReportStatusOpen,
+	}
+	resolution := types.NewReportResolution(suite.testData.moderator, "note", suite.testData.postCreationDate)
+	dismissedReport := report
+	dismissedReport.Status = types.ReportStatusDismissed
+	dismissedReport.Resolution = &resolution
+
+	tests := []struct {
+		name       string
+		msg        types.MsgUnhidePost
+		hidden     bool
+		expErr     error
+		expReports types.Reports
+	}{
+		{
+			name: "signer is not a moderator",
+			msg:  types.NewMsgUnhidePost(suite.testData.postID, "note", suite.testData.creator),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				fmt.Sprintf("%s is not a reports moderator", suite.testData.creator)),
+		},
+		{
+			name: "post is not hidden",
+			msg:  types.NewMsgUnhidePost(suite.testData.postID, "note", suite.testData.moderator),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("post with id %s is not hidden", suite.testData.postID)),
+		},
+		{
+			name:       "message handled correctly",
+			msg:        types.NewMsgUnhidePost(suite.testData.postID, "note", suite.testData.moderator),
+			hidden:     true,
+			expReports: types.Reports{dismissedReport},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
+			suite.setModerators([]sdk.AccAddress{suite.testData.moderator})
+			suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), report)
+			if test.hidden {
+				suite.postsKeeper.HidePost(suite.ctx, suite.testData.postID)
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				return
+			}
+
+			suite.NoError(err)
+			suite.Equal([]byte(fmt.Sprintf("post with id %s unhidden correctly", suite.testData.postID)), res.Data)
+
+			unhideEvent := sdk.NewEvent(
+				types.EventTypePostUnhidden,
+				sdk.NewAttribute(types.AttributeKeyPostID, suite.testData.postID.String()),
+				sdk.NewAttribute(types.AttributeKeyModerator, suite.testData.moderator.String()),
+				sdk.NewAttribute(types.AttributeKeyDismissedReports, "1"),
+			)
+			suite.Len(res.Events, 1)
+			suite.Contains(res.Events, unhideEvent)
+
+			suite.False(suite.postsKeeper.IsPostHidden(suite.ctx, suite.testData.postID))
+			suite.Equal(test.expReports, suite.keeper.GetPostReports(suite.ctx, suite.testData.postID))
 		})
 	}
 }
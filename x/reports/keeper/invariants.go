@@ -1,23 +1,31 @@
 package keeper
 
 import (
-	"bytes"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	posts "github.com/desmos-labs/desmos/x/posts/types"
 	"github.com/desmos-labs/desmos/x/reports/types"
 )
 
 // RegisterInvariants registers all posts invariants
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
-	ir.RegisterRoute(types.ModuleName, "valid-reports-ids",
-		ValidReportsIDs(keeper))
+	ir.RegisterRoute(types.ModuleName, "valid-reports-targets",
+		ValidReportsTargets(keeper))
+	ir.RegisterRoute(types.ModuleName, "valid-reports",
+		ValidReportsInvariant(keeper))
+	ir.RegisterRoute(types.ModuleName, "reports-deposits",
+		ReportsDepositsInvariant(keeper))
 }
 
 func AllInvariants(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		if res, stop := ValidReportsIDs(k)(ctx); stop {
+		if res, stop := ValidReportsTargets(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := ValidReportsInvariant(k)(ctx); stop {
+			return res, stop
+		}
+		if res, stop := ReportsDepositsInvariant(k)(ctx); stop {
 			return res, stop
 		}
 
@@ -25,30 +33,86 @@ func AllInvariants(k Keeper) sdk.Invariant {
 	}
 }
 
-// formatOutputIDs concatenate the ids given into a unique string
-func formatOutputIDs(ids posts.PostIDs) (outputIDs string) {
-	for _, id := range ids {
-		outputIDs += id.String() + "\n"
+// formatOutputTargets concatenate the targets given into a unique string
+func formatOutputTargets(targets []types.ReportTarget) (outputTargets string) {
+	for _, target := range targets {
+		outputTargets += target.String() + "\n"
 	}
-	return outputIDs
+	return outputTargets
 }
 
-// ValidReportsIDs checks that all reports are associated with a valid postID that correspond to an existent post
-func ValidReportsIDs(k Keeper) sdk.Invariant {
+// ValidReportsTargets checks that all reports are associated with a valid target
+func ValidReportsTargets(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		var invalidIDs posts.PostIDs
+		var invalidTargets []types.ReportTarget
 		store := ctx.KVStore(k.StoreKey)
 		iterator := sdk.KVStorePrefixIterator(store, types.ReportsStorePrefix)
 		defer iterator.Close()
 		for ; iterator.Valid(); iterator.Next() {
-			postID := posts.PostID(bytes.TrimPrefix(iterator.Key(), types.ReportsStorePrefix))
-			if valid := postID.Valid(); !valid {
-				invalidIDs = append(invalidIDs, postID)
+			target := types.ParseReportStoreKey(iterator.Key())
+			if err := target.Validate(); err != nil {
+				invalidTargets = append(invalidTargets, target)
 			}
 		}
 
-		return sdk.FormatInvariant(types.ModuleName, "invalid reports' IDs",
-			fmt.Sprintf("The following list contains invalid postIDs:\n %s",
-				formatOutputIDs(invalidIDs))), invalidIDs != nil
+		return sdk.FormatInvariant(types.ModuleName, "invalid reports' targets",
+			fmt.Sprintf("The following list contains invalid targets:\n %s",
+				formatOutputTargets(invalidTargets))), invalidTargets != nil
+	}
+}
+
+// formatOutputReports concatenate the reports given into a unique string
+func formatOutputReports(reports types.TargetReports) (outputReports string) {
+	for _, report := range reports {
+		outputReports += fmt.Sprintf("%s - %d\n", report.Target, report.Report.ID)
+	}
+	return outputReports
+}
+
+// ValidReportsInvariant checks that all the stored reports are valid, have an id and a status,
+// and can be found using their id
+func ValidReportsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var invalidReports types.TargetReports
+		lastID := k.GetLastReportID(ctx)
+		store := ctx.KVStore(k.StoreKey)
+		iterator := sdk.KVStorePrefixIterator(store, types.ReportsStorePrefix)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			var report types.Report
+			k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &report)
+
+			target := types.ParseReportStoreKey(iterator.Key())
+			storedTarget, _, found := k.GetReport(ctx, report.ID)
+			if report.Validate() != nil || report.ID == 0 || report.ID > lastID || !report.Status.IsValid() ||
+				!found || !storedTarget.Equals(target) {
+				invalidReports = append(invalidReports, types.NewTargetReport(target, report))
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "invalid reports",
+			fmt.Sprintf("The following list contains invalid reports:\n %s",
+				formatOutputReports(invalidReports))), invalidReports != nil
+	}
+}
+
+// ReportsDepositsInvariant checks that the reports module account holds at least the deposits of all the open reports
+func ReportsDepositsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedDeposits sdk.Coins
+		for _, reports := range k.GetReportsMap(ctx) {
+			for _, report := range reports {
+				if report.IsOpen() {
+					expectedDeposits = expectedDeposits.Add(report.Deposit...)
+				}
+			}
+		}
+
+		moduleCoins := k.SupplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+		broken := !moduleCoins.IsAllGTE(expectedDeposits)
+
+		return sdk.FormatInvariant(types.ModuleName, "reports deposits",
+			fmt.Sprintf("\tsum of open reports deposits: %s\n\tmodule account coins: %s\n",
+				expectedDeposits, moduleCoins)), broken
 	}
 }
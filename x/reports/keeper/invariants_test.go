@@ -13,6 +13,9 @@ func (suite *KeeperTestSuite) TestInvariants() {
 	suite.NoError(err)
 	postID := posts.PostID("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af")
 	report := models.NewReport("type", "message", creator)
+	invalidReport := models.Report{ID: 1, Type: "type", Message: "message", User: creator, Status: "closed"}
+	depositReport := models.NewReport("type", "message", creator)
+	depositReport.Deposit = sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))
 
 	tests := []struct {
 		name        string
@@ -29,11 +32,27 @@ func (suite *KeeperTestSuite) TestInvariants() {
 			expResponse: "Every invariant condition is fulfilled correctly",
 		},
 		{
-			name:        "ValidReportIDs invariant violated",
+			name:        "ValidReportsTargets invariant violated",
 			postID:      "123",
 			report:      report,
 			expBool:     true,
-			expResponse: "reports: invalid reports' IDs invariant\nThe following list contains invalid postIDs:\n 123\n\n",
+			expResponse: "reports: invalid reports' targets invariant\nThe following list contains invalid targets:\n post/123\n\n",
+		},
+		{
+			name:    "ValidReports invariant violated",
+			postID:  postID,
+			report:  invalidReport,
+			expBool: true,
+			expResponse: "reports: invalid reports invariant\nThe following list contains invalid reports:\n " +
+				"post/19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af - 1\n\n",
+		},
+		{
+			name:    "ReportsDeposits invariant violated",
+			postID:  postID,
+			report:  depositReport,
+			expBool: true,
+			expResponse: "reports: reports deposits invariant\n" +
+				"\tsum of open reports deposits: 100udaric\n\tmodule account coins: \n\n",
 		},
 	}
 
@@ -42,7 +61,7 @@ func (suite *KeeperTestSuite) TestInvariants() {
 		suite.Run(test.name, func() {
 			suite.SetupTest() // reset
 			// nolint: errcheck
-			suite.keeper.SaveReport(suite.ctx, test.postID, test.report)
+			suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(test.postID), test.report)
 
 			res, stop := keeper.AllInvariants(suite.keeper)(suite.ctx)
 
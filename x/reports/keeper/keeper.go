@@ -1,29 +1,51 @@
 package keeper
 
 import (
-	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
 	postsK "github.com/desmos-labs/desmos/x/posts/keeper"
 	posts "github.com/desmos-labs/desmos/x/posts/types"
+	profilesK "github.com/desmos-labs/desmos/x/profiles/keeper"
 	"github.com/desmos-labs/desmos/x/reports/types"
-	"github.com/desmos-labs/desmos/x/reports/types/models"
 )
 
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
-	PostKeeper postsK.Keeper // Post's keeper to perform checks on the postIDs
-	StoreKey   sdk.StoreKey  // Unexposed key to access store from sdk.Context
-	Cdc        *codec.Codec  // The wire codec for binary encoding/decoding.
+	PostKeeper     postsK.Keeper    // Post's keeper to perform checks on the postIDs
+	ProfilesKeeper profilesK.Keeper // Profiles' keeper to resolve the accounts acting on behalf of others
+	StakingKeeper  staking.Keeper   // Staking keeper to weight the reporters by their stake
+	SupplyKeeper   supply.Keeper    // Supply keeper to handle the reports deposits
+	StoreKey       sdk.StoreKey     // Unexposed key to access store from sdk.Context
+	Cdc            *codec.Codec     // The wire codec for binary encoding/decoding.
+
+	// The reference to the ParamsStore to get and set reports specific params
+	paramSubspace params.Subspace
 }
 
 // NewKeeper creates new instances of the reports Keeper
-func NewKeeper(pk postsK.Keeper, cdc *codec.Codec, storeKey sdk.StoreKey) Keeper {
+func NewKeeper(
+	pk postsK.Keeper, prk profilesK.Keeper, sk staking.Keeper, supplyKeeper supply.Keeper,
+	cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace,
+) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		PostKeeper: pk,
-		StoreKey:   storeKey,
-		Cdc:        cdc,
+		PostKeeper:     pk,
+		ProfilesKeeper: prk,
+		StakingKeeper:  sk,
+		SupplyKeeper:   supplyKeeper,
+		StoreKey:       storeKey,
+		Cdc:            cdc,
+		paramSubspace:  paramSpace,
 	}
 }
 
@@ -34,34 +56,184 @@ func (k Keeper) CheckPostExistence(ctx sdk.Context, postID posts.PostID) bool {
 	return exist
 }
 
-// SaveReport allows to save the given reports inside the current context.
-// It assumes that the given reports has already been validated.
-// If the same reports has already been inserted, nothing will be changed.
-func (k Keeper) SaveReport(ctx sdk.Context, postID posts.PostID, report types.Report) {
+// CheckTargetExistence checks whether the entity identified by the given target exists inside the current context.
+// Subspaces are not stored on chain, so any valid subspace is considered existing
+func (k Keeper) CheckTargetExistence(ctx sdk.Context, target types.ReportTarget) error {
+	switch target.Kind {
+	case types.TargetKindPost:
+		postID, _ := target.PostID()
+		if !k.CheckPostExistence(ctx, postID) {
+			return fmt.Errorf("post with ID: %s doesn't exist", postID)
+		}
+
+	case types.TargetKindProfile:
+		user, err := sdk.AccAddressFromBech32(target.ID)
+		if err != nil {
+			return err
+		}
+		if _, found := k.ProfilesKeeper.GetProfile(ctx, user); !found {
+			return fmt.Errorf("profile of %s doesn't exist", user)
+		}
+
+	case types.TargetKindReaction:
+		shortcode, subspace, _ := target.Reaction()
+		if _, found := k.PostKeeper.GetRegisteredReaction(ctx, shortcode, subspace); !found {
+			return fmt.Errorf("reaction with shortcode %s isn't registered inside subspace %s", shortcode, subspace)
+		}
+	}
+
+	return nil
+}
+
+// getTargetSubspace returns the subspace inside which the given target lives, if any
+func (k Keeper) getTargetSubspace(ctx sdk.Context, target types.ReportTarget) (string, bool) {
+	switch target.Kind {
+	case types.TargetKindPost:
+		postID, _ := target.PostID()
+		post, found := k.PostKeeper.GetPost(ctx, postID)
+		return post.Subspace, found
+
+	case types.TargetKindReaction:
+		_, subspace, ok := target.Reaction()
+		return subspace, ok
+
+	case types.TargetKindSubspace:
+		return target.ID, true
+
+	default:
+		return "", false
+	}
+}
+
+// GetLastReportID returns the id of the last report that has been stored.
+// If no report has been stored yet, 0 is returned instead
+func (k Keeper) GetLastReportID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.StoreKey)
+	bz := store.Get(types.LastReportIDStoreKey)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setLastReportID sets the given id as the id of the last report that has been stored
+func (k Keeper) setLastReportID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.LastReportIDStoreKey, sdk.Uint64ToBigEndian(id))
+}
+
+// SaveReport allows to save the given report of the given target inside the current context,
+// returning the stored report. It assumes that the given report has already been validated.
+// Reports without an id are considered new ones: they are assigned the next available id,
+// the current block time as creation date when missing, and the open status.
+// Reports having an id replace the report stored with the same id, if any
+func (k Keeper) SaveReport(ctx sdk.Context, target types.ReportTarget, report types.Report) types.Report {
+	if report.ID == 0 {
+		report.ID = k.GetLastReportID(ctx) + 1
+		if report.Created.IsZero() {
+			report.Created = ctx.BlockTime()
+		}
+	}
+
+	if report.Status == "" {
+		report.Status = types.ReportStatusOpen
+	}
+
+	if report.ID > k.GetLastReportID(ctx) {
+		k.setLastReportID(ctx, report.ID)
+	}
+
 	store := ctx.KVStore(k.StoreKey)
-	key := models.ReportStoreKey(postID)
+	store.Set(types.ReportStoreKey(target, report.ID), k.Cdc.MustMarshalBinaryBare(&report))
 
-	// Get the list of reports related to the given postID
-	var reports models.Reports
-	k.Cdc.MustUnmarshalBinaryBare(store.Get(key), &reports)
+	// Index the report so that it can be found using its id, its target subspace and its reporter,
+	// along with the reporter and its creation date to count the reports created within a period
+	reportKey := types.ReportStoreKey(target, report.ID)
+	store.Set(types.ReportIDStoreKey(report.ID), reportKey)
+	store.Set(types.ReporterReportStoreKey(report.User, report.ID), reportKey)
+	store.Set(types.ReporterTimeReportStoreKey(report.User, report.Created, report.ID), reportKey)
+	if subspace, found := k.getTargetSubspace(ctx, target); found {
+		store.Set(types.SubspaceReportStoreKey(subspace, report.ID), reportKey)
+	}
 
-	// try to append the given reports
-	reports = append(reports, report)
-	store.Set(key, k.Cdc.MustMarshalBinaryBare(&reports))
+	return report
+}
+
+// getReport returns the report stored using the given key along with the target it refers to
+func (k Keeper) getReport(ctx sdk.Context, reportKey []byte) (types.ReportTarget, types.Report, bool) {
+	bz := ctx.KVStore(k.StoreKey).Get(reportKey)
+	if bz == nil {
+		return types.ReportTarget{}, types.Report{}, false
+	}
 
+	var report types.Report
+	k.Cdc.MustUnmarshalBinaryBare(bz, &report)
+	return types.ParseReportStoreKey(reportKey), report, true
 }
 
-// GetPostReports returns the list of reports associated with the given postID.
-// If no reports is associated with the given postID the function will returns an empty list.
-func (k Keeper) GetPostReports(ctx sdk.Context, postID posts.PostID) (reports types.Reports) {
+// GetReport returns the report having the given id along with the target it refers to.
+// If no report with the given id exists, false is returned instead
+func (k Keeper) GetReport(ctx sdk.Context, id uint64) (types.ReportTarget, types.Report, bool) {
+	reportKey := ctx.KVStore(k.StoreKey).Get(types.ReportIDStoreKey(id))
+	if reportKey == nil {
+		return types.ReportTarget{}, types.Report{}, false
+	}
+
+	return k.getReport(ctx, reportKey)
+}
+
+// GetTargetReports returns the list of reports associated with the given target, sorted by id.
+// If no reports is associated with the given target the function will returns an empty list.
+func (k Keeper) GetTargetReports(ctx sdk.Context, target types.ReportTarget) (reports types.Reports) {
 	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TargetReportsPrefix(target))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var report types.Report
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &report)
+		reports = append(reports, report)
+	}
 
-	// Get the list of reports related to the given postID
-	k.Cdc.MustUnmarshalBinaryBare(store.Get(models.ReportStoreKey(postID)), &reports)
 	return reports
 }
 
-// GetReportsMap allows to returns the list of reports that have been stored inside the given context
+// GetPostReports returns the list of reports associated with the given postID, sorted by id.
+// If no reports is associated with the given postID the function will returns an empty list.
+func (k Keeper) GetPostReports(ctx sdk.Context, postID posts.PostID) types.Reports {
+	return k.GetTargetReports(ctx, types.NewPostTarget(postID))
+}
+
+// HasOpenReport tells whether the given user has an open report for the given target
+// that references the given reason
+func (k Keeper) HasOpenReport(ctx sdk.Context, target types.ReportTarget, user sdk.AccAddress, reason string) bool {
+	for _, report := range k.GetTargetReports(ctx, target) {
+		if report.IsOpen() && report.User.Equals(user) && report.Type == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// CountReporterReportsSince returns the number of reports that the given user has created
+// starting from the given time. Only the index entries of the reports created after such time are iterated
+func (k Keeper) CountReporterReportsSince(ctx sdk.Context, reporter sdk.AccAddress, since time.Time) int64 {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := store.Iterator(
+		types.ReporterTimeReportStoreKey(reporter, since, 0),
+		sdk.PrefixEndBytes(types.ReporterTimeReportsPrefix(reporter)),
+	)
+	defer iterator.Close()
+
+	var count int64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// GetReportsMap allows to returns the list of reports that have been stored inside the given context,
+// associated with the string representation of the target they refer to
 func (k Keeper) GetReportsMap(ctx sdk.Context) map[string]types.Reports {
 	store := ctx.KVStore(k.StoreKey)
 
@@ -70,11 +242,85 @@ func (k Keeper) GetReportsMap(ctx sdk.Context) map[string]types.Reports {
 
 	reportsData := map[string]types.Reports{}
 	for ; iterator.Valid(); iterator.Next() {
-		var reports types.Reports
-		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &reports)
-		idBytes := bytes.TrimPrefix(iterator.Key(), types.ReportsStorePrefix)
-		reportsData[string(idBytes)] = reports
+		var report types.Report
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &report)
+
+		target := types.ParseReportStoreKey(iterator.Key())
+		reportsData[target.String()] = append(reportsData[target.String()], report)
 	}
 
 	return reportsData
 }
+
+// GetKindReports returns the reports of all the targets having the given kind, sorted by target.
+// If a status is given, only the reports having such status are returned
+func (k Keeper) GetKindReports(ctx sdk.Context, kind types.TargetKind, status types.ReportStatus) types.TargetReports {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KindReportsPrefix(kind))
+	defer iterator.Close()
+
+	reports := types.TargetReports{}
+	for ; iterator.Valid(); iterator.Next() {
+		var report types.Report
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &report)
+		if status != "" && report.Status != status {
+			continue
+		}
+
+		target := types.ParseReportStoreKey(iterator.Key())
+		reports = append(reports, types.NewTargetReport(target, report))
+	}
+
+	return reports
+}
+
+// getIndexedReports returns the reports referenced by the index entries having the given prefix,
+// sorted by id. If a status is given, only the reports having such status are returned
+func (k Keeper) getIndexedReports(ctx sdk.Context, prefix []byte, status types.ReportStatus) types.TargetReports {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	reports := types.TargetReports{}
+	for ; iterator.Valid(); iterator.Next() {
+		// Index entries contain the key of the report they refer to
+		target, report, found := k.getReport(ctx, iterator.Value())
+		if !found {
+			continue
+		}
+
+		if status == "" || report.Status == status {
+			reports = append(reports, types.NewTargetReport(target, report))
+		}
+	}
+
+	return reports
+}
+
+// GetSubspaceReports returns the reports of the posts, reactions and subspace itself having the given subspace,
+// sorted by id. If a status is given, only the reports having such status are returned
+func (k Keeper) GetSubspaceReports(ctx sdk.Context, subspace string, status types.ReportStatus) types.TargetReports {
+	return k.getIndexedReports(ctx, types.SubspaceReportsPrefix(subspace), status)
+}
+
+// GetReporterReports returns the reports created by the given user, sorted by id.
+// If a status is given, only the reports having such status are returned
+func (k Keeper) GetReporterReports(ctx sdk.Context, reporter sdk.AccAddress, status types.ReportStatus) types.TargetReports {
+	return k.getIndexedReports(ctx, types.ReporterReportsPrefix(reporter), status)
+}
+
+// GetModerators returns the users allowed to resolve reports, that are set inside the module params
+func (k Keeper) GetModerators(ctx sdk.Context) (moderators []sdk.AccAddress) {
+	k.paramSubspace.GetIfExists(ctx, types.ModeratorsKey, &moderators)
+	return moderators
+}
+
+// IsModerator tells whether the given user is allowed to resolve reports
+func (k Keeper) IsModerator(ctx sdk.Context, user sdk.AccAddress) bool {
+	for _, moderator := range k.GetModerators(ctx) {
+		if moderator.Equals(user) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/x/reports/types"
+)
+
+// ChargeReportDeposit transfers the given deposit from the reporter to the reports module account
+func (k Keeper) ChargeReportDeposit(ctx sdk.Context, reporter sdk.AccAddress, deposit sdk.Coins) error {
+	if deposit.IsZero() {
+		return nil
+	}
+	return k.SupplyKeeper.SendCoinsFromAccountToModule(ctx, reporter, types.ModuleName, deposit)
+}
+
+// RefundReportDeposit gives the deposit of the given report back to its creator
+func (k Keeper) RefundReportDeposit(ctx sdk.Context, report types.Report) error {
+	if report.Deposit.IsZero() {
+		return nil
+	}
+	return k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, report.User, report.Deposit)
+}
+
+// BurnReportDeposit burns the deposit of the given report, slashing its creator
+func (k Keeper) BurnReportDeposit(ctx sdk.Context, report types.Report) error {
+	if report.Deposit.IsZero() {
+		return nil
+	}
+	return k.SupplyKeeper.BurnCoins(ctx, types.ModuleName, report.Deposit)
+}
+
+// SettleReportDeposit handles the deposit of the given resolved report, burning it
+// when the report has been marked as abusive and refunding it otherwise
+func (k Keeper) SettleReportDeposit(ctx sdk.Context, report types.Report) error {
+	if report.Status == types.ReportStatusAbusive {
+		return k.BurnReportDeposit(ctx, report)
+	}
+	return k.RefundReportDeposit(ctx, report)
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	posts "github.com/desmos-labs/desmos/x/posts/types"
+	"github.com/desmos-labs/desmos/x/reports/types"
+)
+
+// GetReporterWeight returns the weight, between 0 and 1, that the reports created by the given user
+// have when deciding whether to hide a post, based on the weighting set inside the given params
+func (k Keeper) GetReporterWeight(ctx sdk.Context, reporter sdk.AccAddress, params types.HidingParams) sdk.Dec {
+	var weight sdk.Dec
+	switch params.Weighting {
+	case types.WeightingStake:
+		stake := sdk.ZeroDec()
+		for _, delegation := range k.StakingKeeper.GetAllDelegatorDelegations(ctx, reporter) {
+			validator, found := k.StakingKeeper.GetValidator(ctx, delegation.ValidatorAddress)
+			if found {
+				stake = stake.Add(validator.TokensFromShares(delegation.Shares))
+			}
+		}
+		weight = stake.QuoInt(params.MinStake)
+
+	case types.WeightingAccountAge:
+		profile, found := k.ProfilesKeeper.GetProfile(ctx, reporter)
+		if !found || !ctx.BlockTime().After(profile.CreationDate) {
+			return sdk.ZeroDec()
+		}
+		age := ctx.BlockTime().Sub(profile.CreationDate)
+		weight = sdk.NewDec(int64(age)).QuoInt64(int64(params.MinAccountAge))
+
+	default:
+		weight = sdk.OneDec()
+	}
+
+	if weight.GT(sdk.OneDec()) {
+		return sdk.OneDec()
+	}
+	return weight
+}
+
+// GetPostReportsWeight returns the sum of the weights of the distinct users that have created
+// an open report for the post having the given id within the time window set inside the given params
+func (k Keeper) GetPostReportsWeight(ctx sdk.Context, postID posts.PostID, params types.HidingParams) sdk.Dec {
+	windowStart := ctx.BlockTime().Add(-params.TimeWindow)
+
+	total := sdk.ZeroDec()
+	reporters := map[string]bool{}
+	for _, report := range k.GetPostReports(ctx, postID) {
+		if !report.IsOpen() || report.Created.Before(windowStart) || reporters[report.User.String()] {
+			continue
+		}
+
+		reporters[report.User.String()] = true
+		total = total.Add(k.GetReporterWeight(ctx, report.User, params))
+	}
+
+	return total
+}
+
+// HidePostIfNeeded hides the post having the given id when the weight of its recent open reports
+// reaches the threshold set inside the params.
+// It returns the reports weight along with a boolean telling whether the post has been hidden by this call
+func (k Keeper) HidePostIfNeeded(ctx sdk.Context, postID posts.PostID) (sdk.Dec, bool) {
+	params := k.GetParams(ctx).HidingParams
+	if !params.IsEnabled() || k.PostKeeper.IsPostHidden(ctx, postID) {
+		return sdk.ZeroDec(), false
+	}
+
+	weight := k.GetPostReportsWeight(ctx, postID, params)
+	if weight.LT(params.Threshold) {
+		return weight, false
+	}
+
+	k.PostKeeper.HidePost(ctx, postID)
+	return weight, true
+}
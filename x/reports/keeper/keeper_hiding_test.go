@@ -0,0 +1,186 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	profiles "github.com/desmos-labs/desmos/x/profiles/types"
+	"github.com/desmos-labs/desmos/x/reports/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_GetReporterWeight() {
+	suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
+
+	// Delegate 500 tokens from the creator
+	valPubKey := ed25519.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(valPubKey.Address())
+	validator := staking.NewValidator(valAddr, valPubKey, staking.Description{})
+	validator.Tokens = sdk.NewInt(1000)
+	validator.DelegatorShares = sdk.NewDec(1000)
+	suite.stakingKeeper.SetValidator(suite.ctx, validator)
+	suite.stakingKeeper.SetDelegation(suite.ctx, staking.NewDelegation(suite.testData.creator, valAddr, sdk.NewDec(500)))
+
+	// Create a profile for the creator 10 days ago
+	profile := profiles.NewProfile("dtag", suite.testData.creator, suite.testData.postCreationDate.Add(-time.Hour*24*10))
+	suite.NoError(suite.profilesKeeper.SaveProfile(suite.ctx, profile))
+
+	tests := []struct {
+		name      string
+		reporter  sdk.AccAddress
+		params    types.HidingParams
+		expWeight sdk.Dec
+	}{
+		{
+			name:      "no weighting returns one",
+			reporter:  suite.testData.moderator,
+			params:    types.NewHidingParams(sdk.NewDec(5), time.Hour, types.WeightingNone, sdk.NewInt(1000), time.Hour),
+			expWeight: sdk.OneDec(),
+		},
+		{
+			name:      "stake weighting returns the stake ratio",
+			reporter:  suite.testData.creator,
+			params:    types.NewHidingParams(sdk.NewDec(5), time.Hour, types.WeightingStake, sdk.NewInt(1000), time.Hour),
+			expWeight: sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			name:      "stake weighting is capped to one",
+			reporter:  suite.testData.creator,
+			params:    types.NewHidingParams(sdk.NewDec(5), time.Hour, types.WeightingStake, sdk.NewInt(100), time.Hour),
+			expWeight: sdk.OneDec(),
+		},
+		{
+			name:      "stake weighting returns zero without delegations",
+			reporter:  suite.testData.moderator,
+			params:    types.NewHidingParams(sdk.NewDec(5), time.Hour, types.WeightingStake, sdk.NewInt(1000), time.Hour),
+			expWeight: sdk.ZeroDec(),
+		},
+		{
+			name:      "account age weighting returns the age ratio",
+			reporter:  suite.testData.creator,
+			params:    types.NewHidingParams(sdk.NewDec(5), time.Hour, types.WeightingAccountAge, sdk.NewInt(1), time.Hour*24*40),
+			expWeight: sdk.NewDecWithPrec(25, 2),
+		},
+		{
+			name:      "account age weighting is capped to one",
+			reporter:  suite.testData.creator,
+			params:    types.NewHidingParams(sdk.NewDec(5), time.Hour, types.WeightingAccountAge, sdk.NewInt(1), time.Hour),
+			expWeight: sdk.OneDec(),
+		},
+		{
+			name:      "account age weighting returns zero without a profile",
+			reporter:  suite.testData.moderator,
+			params:    types.NewHidingParams(sdk.NewDec(5), time.Hour, types.WeightingAccountAge, sdk.NewInt(1), time.Hour),
+			expWeight: sdk.ZeroDec(),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			weight := suite.keeper.GetReporterWeight(suite.ctx, test.reporter, test.params)
+			suite.True(test.expWeight.Equal(weight), "expected %s, got %s", test.expWeight, weight)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetPostReportsWeight() {
+	suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
+
+	reporter, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	suite.NoError(err)
+
+	oldReporter, err := sdk.AccAddressFromBech32("cosmos1jlhazemxvu0zn9y77j6afwmpf60zveqw5480l2")
+	suite.NoError(err)
+
+	resolution := types.NewReportResolution(suite.testData.moderator, "note", suite.testData.postCreationDate)
+	reports := types.Reports{
+		// Two reports from the same user count once
+		types.NewReport("spam", "message", suite.testData.creator),
+		types.NewReport("scam", "message", suite.testData.creator),
+		types.NewReport("spam", "message", reporter),
+		// Resolved reports are not counted
+		{
+			Type: "spam", Message: "message", User: suite.testData.moderator,
+			Status: types.ReportStatusDismissed, Resolution: &resolution,
+		},
+		// Reports created outside the time window are not counted
+		{
+			Type: "spam", Message: "message", User: oldReporter,
+			Created: suite.testData.postCreationDate.Add(-time.Hour * 2),
+		},
+	}
+	for _, report := range reports {
+		suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), report)
+	}
+
+	params := types.NewHidingParams(sdk.NewDec(5), time.Hour, types.WeightingNone, sdk.NewInt(1), time.Hour)
+	weight := suite.keeper.GetPostReportsWeight(suite.ctx, suite.testData.postID, params)
+	suite.True(sdk.NewDec(2).Equal(weight), "expected 2, got %s", weight)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_HidePostIfNeeded() {
+	tests := []struct {
+		name      string
+		params    types.HidingParams
+		hidden    bool
+		reporters int
+		expWeight sdk.Dec
+		expHidden bool
+	}{
+		{
+			name:      "hiding disabled does not hide the post",
+			params:    types.NewHidingParams(sdk.ZeroDec(), time.Hour, types.WeightingNone, sdk.NewInt(1), time.Hour),
+			reporters: 3,
+			expWeight: sdk.ZeroDec(),
+			expHidden: false,
+		},
+		{
+			name:      "already hidden post is not hidden again",
+			params:    types.NewHidingParams(sdk.NewDec(2), time.Hour, types.WeightingNone, sdk.NewInt(1), time.Hour),
+			hidden:    true,
+			reporters: 3,
+			expWeight: sdk.ZeroDec(),
+			expHidden: false,
+		},
+		{
+			name:      "threshold not reached does not hide the post",
+			params:    types.NewHidingParams(sdk.NewDec(2), time.Hour, types.WeightingNone, sdk.NewInt(1), time.Hour),
+			reporters: 1,
+			expWeight: sdk.OneDec(),
+			expHidden: false,
+		},
+		{
+			name:      "threshold reached hides the post",
+			params:    types.NewHidingParams(sdk.NewDec(2), time.Hour, types.WeightingNone, sdk.NewInt(1), time.Hour),
+			reporters: 2,
+			expWeight: sdk.NewDec(2),
+			expHidden: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.ctx = suite.ctx.WithBlockTime(suite.testData.postCreationDate)
+			suite.keeper.SetParams(suite.ctx, types.NewParams(types.DefaultReasons, test.params, types.DefaultRateLimitParams(), types.DefaultReportDeposit, types.DefaultModerators))
+
+			if test.hidden {
+				suite.postsKeeper.HidePost(suite.ctx, suite.testData.postID)
+			}
+
+			for i := 0; i < test.reporters; i++ {
+				reporter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+				suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), types.NewReport("spam", "message", reporter))
+			}
+
+			weight, hidden := suite.keeper.HidePostIfNeeded(suite.ctx, suite.testData.postID)
+			suite.True(test.expWeight.Equal(weight), "expected %s, got %s", test.expWeight, weight)
+			suite.Equal(test.expHidden, hidden)
+			suite.Equal(test.expHidden || test.hidden, suite.postsKeeper.IsPostHidden(suite.ctx, suite.testData.postID))
+		})
+	}
+}
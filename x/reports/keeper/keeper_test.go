@@ -1,7 +1,12 @@
 package keeper_test
 
 import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	posts "github.com/desmos-labs/desmos/x/posts/types"
+	profiles "github.com/desmos-labs/desmos/x/profiles/types"
 
 	"github.com/desmos-labs/desmos/x/reports/types"
 	"github.com/desmos-labs/desmos/x/reports/types/models"
@@ -51,18 +56,170 @@ func (suite *KeeperTestSuite) TestKeeper_CheckExistence() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeper_CheckTargetExistence() {
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	suite.postsKeeper.SavePost(suite.ctx, posts.Post{
+		PostID: suite.testData.postID, Message: "Post", Created: suite.testData.postCreationDate,
+		Subspace: subspace, Creator: suite.testData.creator,
+	})
+	suite.postsKeeper.RegisterReaction(suite.ctx, posts.NewReaction(suite.testData.creator, ":reaction:",
+		"https://smile.jpg", subspace))
+	profile := profiles.NewProfile("dtag", suite.testData.creator, suite.testData.postCreationDate)
+	suite.NoError(suite.profilesKeeper.SaveProfile(suite.ctx, profile))
+
+	otherPostID := posts.PostID("f1b909289cd23188c19da17ae5d5a05ad65623b0fad756e5e03c8c936ca876fd")
+
+	tests := []struct {
+		name   string
+		target types.ReportTarget
+		expErr error
+	}{
+		{
+			name:   "non existing post returns error",
+			target: types.NewPostTarget(otherPostID),
+			expErr: fmt.Errorf("post with ID: %s doesn't exist", otherPostID),
+		},
+		{
+			name:   "non existing profile returns error",
+			target: types.NewProfileTarget(suite.testData.moderator),
+			expErr: fmt.Errorf("profile of %s doesn't exist", suite.testData.moderator),
+		},
+		{
+			name:   "non registered reaction returns error",
+			target: types.NewReactionTarget(":other:", subspace),
+			expErr: fmt.Errorf("reaction with shortcode :other: isn't registered inside subspace %s", subspace),
+		},
+		{
+			name:   "existing post returns no error",
+			target: types.NewPostTarget(suite.testData.postID),
+		},
+		{
+			name:   "existing profile returns no error",
+			target: types.NewProfileTarget(suite.testData.creator),
+		},
+		{
+			name:   "registered reaction returns no error",
+			target: types.NewReactionTarget(":reaction:", subspace),
+		},
+		{
+			name:   "subspace returns no error",
+			target: types.NewSubspaceTarget(subspace),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.Equal(test.expErr, suite.keeper.CheckTargetExistence(suite.ctx, test.target))
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestKeeper_SaveReport() {
-	expReports := models.Reports{models.NewReport("type", "message", suite.testData.creator)}
-	report := models.NewReport("type", "message", suite.testData.creator)
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	existentPost := posts.Post{
+		PostID:   suite.testData.postID,
+		Message:  "Post",
+		Created:  suite.testData.postCreationDate,
+		Subspace: subspace,
+		Creator:  suite.testData.creator,
+	}
+	reportDate := suite.testData.postCreationDate.Add(time.Hour)
+
+	tests := []struct {
+		name           string
+		existingReport *types.Report
+		report         types.Report
+		expReport      types.Report
+		expLastID      uint64
+	}{
+		{
+			name:   "new report is assigned an id, a creation date and the open status",
+			report: models.NewReport("type", "message", suite.testData.creator),
+			expReport: types.Report{
+				ID: 1, Type: "type", Message: "message", User: suite.testData.creator,
+				Created: reportDate, Status: types.ReportStatusOpen,
+			},
+			expLastID: 1,
+		},
+		{
+			name: "new report is assigned the following id",
+			existingReport: &types.Report{
+				ID: 5, Type: "type", Message: "message", User: suite.testData.creator,
+				Created: suite.testData.postCreationDate, Status: types.ReportStatusOpen,
+			},
+			report: models.NewReport("scam", "message", suite.testData.creator),
+			expReport: types.Report{
+				ID: 6, Type: "scam", Message: "message", User: suite.testData.creator,
+				Created: reportDate, Status: types.ReportStatusOpen,
+			},
+			expLastID: 6,
+		},
+		{
+			name: "existing report is replaced",
+			existingReport: &types.Report{
+				ID: 1, Type: "type", Message: "message", User: suite.testData.creator,
+				Created: suite.testData.postCreationDate, Status: types.ReportStatusOpen,
+			},
+			report: types.Report{
+				ID: 1, Type: "type", Message: "message", User: suite.testData.creator,
+				Created: suite.testData.postCreationDate, Status: types.ReportStatusDismissed,
+				Resolution: &types.ReportResolution{
+					Moderator: suite.testData.moderator, Note: "note", Resolved: reportDate,
+				},
+			},
+			expReport: types.Report{
+				ID: 1, Type: "type", Message: "message", User: suite.testData.creator,
+				Created: suite.testData.postCreationDate, Status: types.ReportStatusDismissed,
+				Resolution: &types.ReportResolution{
+					Moderator: suite.testData.moderator, Note: "note", Resolved: reportDate,
+				},
+			},
+			expLastID: 1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.ctx = suite.ctx.WithBlockTime(reportDate)
+			suite.postsKeeper.SavePost(suite.ctx, existentPost)
+			if test.existingReport != nil {
+				suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), *test.existingReport)
+			}
+
+			stored := suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), test.report)
+			suite.Equal(test.expReport, stored)
+			suite.Equal(test.expLastID, suite.keeper.GetLastReportID(suite.ctx))
 
-	store := suite.ctx.KVStore(suite.keeper.StoreKey)
+			target, report, found := suite.keeper.GetReport(suite.ctx, test.expReport.ID)
+			suite.True(found)
+			suite.Equal(types.NewPostTarget(suite.testData.postID), target)
+			suite.Equal(test.expReport, report)
 
-	suite.keeper.SaveReport(suite.ctx, suite.testData.postID, report)
+			store := suite.ctx.KVStore(suite.keeper.StoreKey)
+			suite.True(store.Has(types.SubspaceReportStoreKey(subspace, test.expReport.ID)))
+			suite.True(store.Has(types.ReporterReportStoreKey(suite.testData.creator, test.expReport.ID)))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetReport() {
+	report := types.Report{
+		ID: 1, Type: "type", Message: "message", User: suite.testData.creator,
+		Created: suite.testData.postCreationDate, Status: types.ReportStatusOpen,
+	}
+
+	suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), report)
 
-	var reports models.Reports
-	suite.keeper.Cdc.MustUnmarshalBinaryBare(store.Get(types.ReportStoreKey(suite.testData.postID)), &reports)
-	suite.Equal(expReports, reports)
+	target, stored, found := suite.keeper.GetReport(suite.ctx, 1)
+	suite.True(found)
+	suite.Equal(types.NewPostTarget(suite.testData.postID), target)
+	suite.Equal(report, stored)
 
+	_, _, found = suite.keeper.GetReport(suite.ctx, 2)
+	suite.False(found)
 }
 
 func (suite *KeeperTestSuite) TestKeeper_GetPostReports() {
@@ -73,7 +230,8 @@ func (suite *KeeperTestSuite) TestKeeper_GetPostReports() {
 		{
 			name: "Returns a non-empty reports array",
 			expReports: models.Reports{
-				{Type: "type", Message: "message", User: suite.testData.creator},
+				{ID: 1, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
+				{ID: 2, Type: "scam", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
 			},
 		},
 		{
@@ -86,9 +244,8 @@ func (suite *KeeperTestSuite) TestKeeper_GetPostReports() {
 		test := test
 		suite.Run(test.name, func() {
 			suite.SetupTest() // reset
-			store := suite.ctx.KVStore(suite.keeper.StoreKey)
-			if test.expReports != nil {
-				store.Set(types.ReportStoreKey(suite.testData.postID), suite.keeper.Cdc.MustMarshalBinaryBare(&test.expReports))
+			for _, report := range test.expReports {
+				suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), report)
 			}
 
 			actualRep := suite.keeper.GetPostReports(suite.ctx, suite.testData.postID)
@@ -97,9 +254,66 @@ func (suite *KeeperTestSuite) TestKeeper_GetPostReports() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestKeeper_GetTargetReports() {
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	reports := types.Reports{
+		{ID: 1, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
+		{ID: 2, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
+		{ID: 3, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
+	}
+
+	suite.keeper.SaveReport(suite.ctx, types.NewProfileTarget(suite.testData.moderator), reports[0])
+	suite.keeper.SaveReport(suite.ctx, types.NewReactionTarget(":reaction:", subspace), reports[1])
+	suite.keeper.SaveReport(suite.ctx, types.NewSubspaceTarget(subspace), reports[2])
+
+	suite.Equal(types.Reports{reports[0]},
+		suite.keeper.GetTargetReports(suite.ctx, types.NewProfileTarget(suite.testData.moderator)))
+	suite.Equal(types.Reports{reports[1]},
+		suite.keeper.GetTargetReports(suite.ctx, types.NewReactionTarget(":reaction:", subspace)))
+	suite.Equal(types.Reports{reports[2]},
+		suite.keeper.GetTargetReports(suite.ctx, types.NewSubspaceTarget(subspace)))
+	suite.Nil(suite.keeper.GetTargetReports(suite.ctx, types.NewProfileTarget(suite.testData.creator)))
+
+	// Reactions and subspaces reports are indexed by subspace, while profiles ones are not
+	suite.Equal(types.TargetReports{
+		types.NewTargetReport(types.NewReactionTarget(":reaction:", subspace), reports[1]),
+		types.NewTargetReport(types.NewSubspaceTarget(subspace), reports[2]),
+	}, suite.keeper.GetSubspaceReports(suite.ctx, subspace, ""))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetKindReports() {
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	resolution := types.NewReportResolution(suite.testData.moderator, "note", suite.testData.postCreationDate)
+	reports := types.Reports{
+		{ID: 1, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
+		{ID: 2, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusDismissed,
+			Resolution: &resolution},
+		{ID: 3, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
+	}
+
+	suite.keeper.SaveReport(suite.ctx, types.NewProfileTarget(suite.testData.moderator), reports[0])
+	suite.keeper.SaveReport(suite.ctx, types.NewProfileTarget(suite.testData.moderator), reports[1])
+	suite.keeper.SaveReport(suite.ctx, types.NewSubspaceTarget(subspace), reports[2])
+
+	suite.Equal(types.TargetReports{
+		types.NewTargetReport(types.NewProfileTarget(suite.testData.moderator), reports[0]),
+		types.NewTargetReport(types.NewProfileTarget(suite.testData.moderator), reports[1]),
+	}, suite.keeper.GetKindReports(suite.ctx, types.TargetKindProfile, ""))
+
+	suite.Equal(types.TargetReports{
+		types.NewTargetReport(types.NewProfileTarget(suite.testData.moderator), reports[0]),
+	}, suite.keeper.GetKindReports(suite.ctx, types.TargetKindProfile, types.ReportStatusOpen))
+
+	suite.Equal(types.TargetReports{
+		types.NewTargetReport(types.NewSubspaceTarget(subspace), reports[2]),
+	}, suite.keeper.GetKindReports(suite.ctx, types.TargetKindSubspace, ""))
+
+	suite.Equal(types.TargetReports{}, suite.keeper.GetKindReports(suite.ctx, types.TargetKindReaction, ""))
+}
+
 func (suite *KeeperTestSuite) TestKeeper_GetReportsMap() {
 	reports := models.Reports{
-		{Type: "type", Message: "message", User: suite.testData.creator},
+		{ID: 1, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
 	}
 	tests := []struct {
 		name            string
@@ -110,7 +324,7 @@ func (suite *KeeperTestSuite) TestKeeper_GetReportsMap() {
 			name:            "Returns a non-empty reports map",
 			existingReports: reports,
 			expReportsMap: map[string]models.Reports{
-				suite.testData.postID.String(): reports,
+				types.NewPostTarget(suite.testData.postID).String(): reports,
 			},
 		},
 		{
@@ -124,9 +338,8 @@ func (suite *KeeperTestSuite) TestKeeper_GetReportsMap() {
 		test := test
 		suite.Run(test.name, func() {
 			suite.SetupTest() // reset
-			store := suite.ctx.KVStore(suite.keeper.StoreKey)
-			if test.existingReports != nil {
-				store.Set(types.ReportStoreKey(suite.testData.postID), suite.keeper.Cdc.MustMarshalBinaryBare(&test.existingReports))
+			for _, report := range test.existingReports {
+				suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), report)
 			}
 
 			actualRep := suite.keeper.GetReportsMap(suite.ctx)
@@ -134,3 +347,119 @@ func (suite *KeeperTestSuite) TestKeeper_GetReportsMap() {
 		})
 	}
 }
+
+// setupIndexedReports stores two posts inside different subspaces, along with reports
+// having different statuses created by different users
+func (suite *KeeperTestSuite) setupIndexedReports() (string, posts.PostID, types.Reports) {
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	otherPostID := posts.PostID("f1b909289cd23188c19da17ae5d5a05ad65623b0fad756e5e03c8c936ca876fd")
+	suite.postsKeeper.SavePost(suite.ctx, posts.Post{
+		PostID: suite.testData.postID, Message: "Post", Created: suite.testData.postCreationDate,
+		Subspace: subspace, Creator: suite.testData.creator,
+	})
+	suite.postsKeeper.SavePost(suite.ctx, posts.Post{
+		PostID: otherPostID, Message: "Other post", Created: suite.testData.postCreationDate,
+		Subspace: "2bdf5932925584b9a86470bea60adce69041608a447f84a3317723aa5678ec88", Creator: suite.testData.creator,
+	})
+
+	resolution := types.NewReportResolution(suite.testData.moderator, "note", suite.testData.postCreationDate)
+	reports := types.Reports{
+		{ID: 1, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
+		{ID: 2, Type: "type", Message: "message", User: suite.testData.moderator, Status: types.ReportStatusOpen},
+		{ID: 3, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusDismissed,
+			Resolution: &resolution},
+		{ID: 4, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
+	}
+
+	suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), reports[0])
+	suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), reports[1])
+	suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), reports[2])
+	suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(otherPostID), reports[3])
+
+	return subspace, otherPostID, reports
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetSubspaceReports() {
+	subspace, _, reports := suite.setupIndexedReports()
+
+	suite.Equal(types.TargetReports{
+		types.NewTargetReport(types.NewPostTarget(suite.testData.postID), reports[0]),
+		types.NewTargetReport(types.NewPostTarget(suite.testData.postID), reports[1]),
+	}, suite.keeper.GetSubspaceReports(suite.ctx, subspace, types.ReportStatusOpen))
+
+	suite.Equal(types.TargetReports{
+		types.NewTargetReport(types.NewPostTarget(suite.testData.postID), reports[0]),
+		types.NewTargetReport(types.NewPostTarget(suite.testData.postID), reports[1]),
+		types.NewTargetReport(types.NewPostTarget(suite.testData.postID), reports[2]),
+	}, suite.keeper.GetSubspaceReports(suite.ctx, subspace, ""))
+
+	suite.Equal(types.TargetReports{}, suite.keeper.GetSubspaceReports(suite.ctx, subspace, types.ReportStatusActioned))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetReporterReports() {
+	_, otherPostID, reports := suite.setupIndexedReports()
+
+	suite.Equal(types.TargetReports{
+		types.NewTargetReport(types.NewPostTarget(suite.testData.postID), reports[0]),
+		types.NewTargetReport(types.NewPostTarget(otherPostID), reports[3]),
+	}, suite.keeper.GetReporterReports(suite.ctx, suite.testData.creator, types.ReportStatusOpen))
+
+	suite.Equal(types.TargetReports{
+		types.NewTargetReport(types.NewPostTarget(suite.testData.postID), reports[2]),
+	}, suite.keeper.GetReporterReports(suite.ctx, suite.testData.creator, types.ReportStatusDismissed))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_HasOpenReport() {
+	_, otherPostID, _ := suite.setupIndexedReports()
+
+	suite.True(suite.keeper.HasOpenReport(suite.ctx, types.NewPostTarget(suite.testData.postID), suite.testData.creator, "type"))
+	suite.True(suite.keeper.HasOpenReport(suite.ctx, types.NewPostTarget(otherPostID), suite.testData.creator, "type"))
+	suite.False(suite.keeper.HasOpenReport(suite.ctx, types.NewPostTarget(suite.testData.postID), suite.testData.creator, "other"))
+	suite.False(suite.keeper.HasOpenReport(suite.ctx, types.NewPostTarget(otherPostID), suite.testData.moderator, "type"))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_CountReporterReportsSince() {
+	suite.setupIndexedReports()
+
+	suite.Equal(int64(3), suite.keeper.CountReporterReportsSince(suite.ctx, suite.testData.creator, time.Time{}))
+	suite.Equal(int64(1), suite.keeper.CountReporterReportsSince(suite.ctx, suite.testData.moderator, time.Time{}))
+	suite.Equal(int64(0), suite.keeper.CountReporterReportsSince(suite.ctx, suite.testData.creator,
+		suite.testData.postCreationDate))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_CountReporterReportsSince_TimeWindow() {
+	reporter, err := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	suite.NoError(err)
+
+	target := types.NewPostTarget(suite.testData.postID)
+	created := suite.testData.postCreationDate
+	for index := 0; index < 3; index++ {
+		report := types.NewReport("type", "message", reporter)
+		report.Created = created.Add(time.Duration(index) * time.Hour)
+		suite.keeper.SaveReport(suite.ctx, target, report)
+	}
+
+	// Resolving a report must not change the number of reports created by the reporter
+	_, report, found := suite.keeper.GetReport(suite.ctx, 3)
+	suite.True(found)
+	resolution := types.NewReportResolution(suite.testData.moderator, "note", created.Add(time.Hour*3))
+	report.Status = types.ReportStatusDismissed
+	report.Resolution = &resolution
+	suite.keeper.SaveReport(suite.ctx, target, report)
+
+	suite.Equal(int64(3), suite.keeper.CountReporterReportsSince(suite.ctx, reporter, created))
+	suite.Equal(int64(2), suite.keeper.CountReporterReportsSince(suite.ctx, reporter, created.Add(time.Hour)))
+	suite.Equal(int64(1), suite.keeper.CountReporterReportsSince(suite.ctx, reporter, created.Add(time.Hour*2)))
+	suite.Equal(int64(0), suite.keeper.CountReporterReportsSince(suite.ctx, reporter, created.Add(time.Hour*3)))
+	suite.Equal(int64(0), suite.keeper.CountReporterReportsSince(suite.ctx, suite.testData.creator, created))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_Moderators() {
+	suite.Nil(suite.keeper.GetModerators(suite.ctx))
+	suite.False(suite.keeper.IsModerator(suite.ctx, suite.testData.moderator))
+
+	suite.setModerators([]sdk.AccAddress{suite.testData.moderator})
+	suite.Equal([]sdk.AccAddress{suite.testData.moderator}, suite.keeper.GetModerators(suite.ctx))
+	suite.True(suite.keeper.IsModerator(suite.ctx, suite.testData.moderator))
+	suite.False(suite.keeper.IsModerator(suite.ctx, suite.testData.creator))
+}
@@ -0,0 +1,34 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/reports/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SetParams() {
+	params := types.NewParams(types.Reasons{
+		types.NewReason("spam", "The post is spam"),
+		types.NewReason(types.ReasonOther, "Other"),
+	},
+		types.NewHidingParams(sdk.NewDec(3), time.Hour, types.WeightingStake, sdk.NewInt(100), time.Hour),
+		types.NewRateLimitParams(sdk.NewInt(5), time.Hour),
+		sdk.NewCoins(sdk.NewInt64Coin("desmos", 10)),
+		[]sdk.AccAddress{suite.testData.moderator},
+	)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	actualParams := suite.keeper.GetParams(suite.ctx)
+
+	suite.Equal(params, actualParams)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetParams() {
+	params := types.DefaultParams()
+	suite.keeper.SetParams(suite.ctx, params)
+
+	actualParams := suite.keeper.GetParams(suite.ctx)
+
+	suite.Equal(params, actualParams)
+}
@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	posts "github.com/desmos-labs/desmos/x/posts/types"
+	postsCommon "github.com/desmos-labs/desmos/x/posts/types/models/common"
 	"github.com/desmos-labs/desmos/x/reports/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
@@ -17,6 +20,20 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryReports:
 			return queryReports(ctx, path[1:], req, keeper)
+		case types.QueryTargetReports:
+			return queryTargetReports(ctx, path[1:], req, keeper)
+		case types.QueryKindReports:
+			return queryKindReports(ctx, path[1:], req, keeper)
+		case types.QuerySubspaceReports:
+			return querySubspaceReports(ctx, path[1:], req, keeper)
+		case types.QueryReporterReports:
+			return queryReporterReports(ctx, path[1:], req, keeper)
+		case types.QueryModerators:
+			return queryModerators(ctx, path[1:], req, keeper)
+		case types.QueryReasons:
+			return queryReasons(ctx, path[1:], req, keeper)
+		case types.QueryHiddenPosts:
+			return queryHiddenPosts(ctx, path[1:], req, keeper)
 		default:
 			return nil, fmt.Errorf("unknown post query endpoint")
 		}
@@ -50,3 +67,168 @@ func queryReports(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Ke
 
 	return bz, nil
 }
+
+// queryTargetReports handles the request of listing all the reports related to the given target.
+// The path contains the target kind followed by its id, which can contain slashes
+func queryTargetReports(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "missing report target")
+	}
+
+	target, err := types.ParseReportTarget(strings.Join(path, "/"))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, err.Error())
+	}
+
+	reports := keeper.GetTargetReports(ctx, target)
+	if reports == nil {
+		reports = types.Reports{}
+	}
+
+	response := types.NewTargetReportsResponse(target, reports)
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &response)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryKindReports handles the request of listing the reports of all the targets having the given kind
+// and the requested status
+func queryKindReports(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	kind := types.TargetKind(path[0])
+	if !kind.IsValid() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("invalid report target kind: %s", kind))
+	}
+
+	params, err := parseIndexedReportsParams(req, keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := keeper.GetKindReports(ctx, kind, params.Status)
+	return marshalIndexedReports(reports, params, keeper), nil
+}
+
+// parseIndexedReportsParams reads the params used to filter and paginate the reports from the given request.
+// When no status is specified, only the open reports are returned
+func parseIndexedReportsParams(req abci.RequestQuery, keeper Keeper) (types.QueryIndexedReportsParams, error) {
+	var params types.QueryIndexedReportsParams
+	if len(req.Data) != 0 {
+		if err := keeper.Cdc.UnmarshalJSON(req.Data, &params); err != nil {
+			return params, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	if params.Status == "" {
+		params.Status = types.ReportStatusOpen
+	}
+
+	if !params.Status.IsValid() {
+		return params, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("invalid report status: %s", params.Status))
+	}
+
+	// Default page
+	if params.Page == 0 {
+		params.Page = 1
+	}
+
+	return params, nil
+}
+
+// marshalIndexedReports paginates the given reports using the given params and marshals them
+func marshalIndexedReports(reports types.TargetReports, params types.QueryIndexedReportsParams, keeper Keeper) []byte {
+	start, end := client.Paginate(len(reports), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		reports = types.TargetReports{}
+	} else {
+		reports = reports[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &reports)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz
+}
+
+// querySubspaceReports handles the request of listing the reports of the targets inside the given subspace
+// having the requested status
+func querySubspaceReports(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	subspace := path[0]
+	if !postsCommon.IsValidSubspace(subspace) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, fmt.Sprintf("invalid subspace: %s", subspace))
+	}
+
+	params, err := parseIndexedReportsParams(req, keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := keeper.GetSubspaceReports(ctx, subspace, params.Status)
+	return marshalIndexedReports(reports, params, keeper), nil
+}
+
+// queryReporterReports handles the request of listing the reports created by the given user
+// having the requested status
+func queryReporterReports(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	reporter, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("Invalid bech32 address: %s", path[0]))
+	}
+
+	params, err := parseIndexedReportsParams(req, keeper)
+	if err != nil {
+		return nil, err
+	}
+
+	reports := keeper.GetReporterReports(ctx, reporter, params.Status)
+	return marshalIndexedReports(reports, params, keeper), nil
+}
+
+// queryModerators handles the request of listing the users allowed to resolve reports
+func queryModerators(ctx sdk.Context, _ []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	moderators := keeper.GetModerators(ctx)
+	if moderators == nil {
+		moderators = []sdk.AccAddress{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &moderators)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryReasons handles the request of listing the registered reasons that can be used to report a post
+func queryReasons(ctx sdk.Context, _ []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	reasons := keeper.GetParams(ctx).Reasons
+	if reasons == nil {
+		reasons = types.Reasons{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &reasons)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+// queryHiddenPosts handles the request of listing the ids of the posts that have been hidden pending review
+func queryHiddenPosts(ctx sdk.Context, _ []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	ids := keeper.PostKeeper.GetHiddenPostsIDs(ctx)
+	if ids == nil {
+		ids = posts.PostIDs{}
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.Cdc, &ids)
+	if err != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
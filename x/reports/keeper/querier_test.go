@@ -2,14 +2,18 @@ package keeper_test
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	posts "github.com/desmos-labs/desmos/x/posts/types"
 	"github.com/desmos-labs/desmos/x/reports/keeper"
 	"github.com/desmos-labs/desmos/x/reports/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 func (suite *KeeperTestSuite) Test_queryReports() {
-	reports := types.Reports{types.NewReport("type", "message", suite.testData.creator)}
+	reports := types.Reports{
+		{ID: 1, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
+	}
 	tests := []struct {
 		name          string
 		path          []string
@@ -44,7 +48,7 @@ func (suite *KeeperTestSuite) Test_queryReports() {
 		suite.Run(test.name, func() {
 			suite.SetupTest() // reset
 			for _, rep := range test.storedReports {
-				suite.keeper.SaveReport(suite.ctx, suite.testData.postID, rep)
+				suite.keeper.SaveReport(suite.ctx, types.NewPostTarget(suite.testData.postID), rep)
 			}
 
 			querier := keeper.NewQuerier(suite.keeper)
@@ -66,3 +70,308 @@ func (suite *KeeperTestSuite) Test_queryReports() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) Test_querySubspaceReports() {
+	tests := []struct {
+		name        string
+		path        []string
+		params      *types.QueryIndexedReportsParams
+		expErr      error
+		expResponse func(reports types.Reports, otherPostID posts.PostID) types.TargetReports
+	}{
+		{
+			name:   "Invalid subspace",
+			path:   []string{types.QuerySubspaceReports, "1234"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "invalid subspace: 1234"),
+		},
+		{
+			name:   "Invalid status",
+			path:   []string{types.QuerySubspaceReports, "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"},
+			params: &types.QueryIndexedReportsParams{Status: "closed"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "invalid report status: closed"),
+		},
+		{
+			name: "Open reports are returned by default",
+			path: []string{types.QuerySubspaceReports, "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"},
+			expResponse: func(reports types.Reports, _ posts.PostID) types.TargetReports {
+				return types.TargetReports{
+					types.NewTargetReport(types.NewPostTarget(suite.testData.postID), reports[0]),
+					types.NewTargetReport(types.NewPostTarget(suite.testData.postID), reports[1]),
+				}
+			},
+		},
+		{
+			name:   "Reports are filtered by status and paginated",
+			path:   []string{types.QuerySubspaceReports, "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"},
+			params: &types.QueryIndexedReportsParams{Status: types.ReportStatusOpen, Page: 2, Limit: 1},
+			expResponse: func(reports types.Reports, _ posts.PostID) types.TargetReports {
+				return types.TargetReports{types.NewTargetReport(types.NewPostTarget(suite.testData.postID), reports[1])}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			_, otherPostID, reports := suite.setupIndexedReports()
+
+			var data []byte
+			if test.params != nil {
+				data = suite.keeper.Cdc.MustMarshalJSON(test.params)
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, abci.RequestQuery{Data: data})
+
+			if test.expErr != nil {
+				suite.Nil(result)
+				suite.Equal(test.expErr.Error(), err.Error())
+				return
+			}
+
+			suite.NoError(err)
+			expResponse := test.expResponse(reports, otherPostID)
+			expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &expResponse)
+			suite.NoError(err)
+			suite.Equal(string(expectedIndented), string(result))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_queryReporterReports() {
+	tests := []struct {
+		name        string
+		path        []string
+		params      *types.QueryIndexedReportsParams
+		expErr      error
+		expResponse func(reports types.Reports, otherPostID posts.PostID) types.TargetReports
+	}{
+		{
+			name:   "Invalid address",
+			path:   []string{types.QueryReporterReports, "invalid"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "Invalid bech32 address: invalid"),
+		},
+		{
+			name: "Open reports are returned by default",
+			path: []string{types.QueryReporterReports, suite.testData.creator.String()},
+			expResponse: func(reports types.Reports, otherPostID posts.PostID) types.TargetReports {
+				return types.TargetReports{
+					types.NewTargetReport(types.NewPostTarget(suite.testData.postID), reports[0]),
+					types.NewTargetReport(types.NewPostTarget(otherPostID), reports[3]),
+				}
+			},
+		},
+		{
+			name:   "Resolved reports are returned along with their resolution",
+			path:   []string{types.QueryReporterReports, suite.testData.creator.String()},
+			params: &types.QueryIndexedReportsParams{Status: types.ReportStatusDismissed},
+			expResponse: func(reports types.Reports, _ posts.PostID) types.TargetReports {
+				return types.TargetReports{types.NewTargetReport(types.NewPostTarget(suite.testData.postID), reports[2])}
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			_, otherPostID, reports := suite.setupIndexedReports()
+
+			var data []byte
+			if test.params != nil {
+				data = suite.keeper.Cdc.MustMarshalJSON(test.params)
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, abci.RequestQuery{Data: data})
+
+			if test.expErr != nil {
+				suite.Nil(result)
+				suite.Equal(test.expErr.Error(), err.Error())
+				return
+			}
+
+			suite.NoError(err)
+			expResponse := test.expResponse(reports, otherPostID)
+			expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &expResponse)
+			suite.NoError(err)
+			suite.Equal(string(expectedIndented), string(result))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_queryTargetReports() {
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	reports := types.Reports{
+		{ID: 1, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
+	}
+	tests := []struct {
+		name          string
+		path          []string
+		storedReports types.Reports
+		expErr        error
+		expResponse   types.TargetReportsQueryResponse
+	}{
+		{
+			name:   "Missing target",
+			path:   []string{types.QueryTargetReports, "profile"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "missing report target"),
+		},
+		{
+			name:   "Invalid target",
+			path:   []string{types.QueryTargetReports, "profile", "1234"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "invalid profile target id: 1234"),
+		},
+		{
+			name:          "Non empty reports of a reaction",
+			path:          []string{types.QueryTargetReports, "reaction", subspace, ":reaction:"},
+			storedReports: reports,
+			expResponse:   types.NewTargetReportsResponse(types.NewReactionTarget(":reaction:", subspace), reports),
+		},
+		{
+			name:        "Empty reports of a profile",
+			path:        []string{types.QueryTargetReports, "profile", suite.testData.creator.String()},
+			expResponse: types.NewTargetReportsResponse(types.NewProfileTarget(suite.testData.creator), types.Reports{}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			for _, rep := range test.storedReports {
+				suite.keeper.SaveReport(suite.ctx, types.NewReactionTarget(":reaction:", subspace), rep)
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, abci.RequestQuery{})
+
+			if test.expErr != nil {
+				suite.Nil(result)
+				suite.Equal(test.expErr.Error(), err.Error())
+				return
+			}
+
+			suite.NoError(err)
+			expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expResponse)
+			suite.NoError(err)
+			suite.Equal(string(expectedIndented), string(result))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_queryKindReports() {
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	reports := types.Reports{
+		{ID: 1, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
+		{ID: 2, Type: "type", Message: "message", User: suite.testData.creator, Status: types.ReportStatusOpen},
+	}
+	tests := []struct {
+		name        string
+		path        []string
+		params      *types.QueryIndexedReportsParams
+		expErr      error
+		expResponse types.TargetReports
+	}{
+		{
+			name:   "Invalid kind",
+			path:   []string{types.QueryKindReports, "comment"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "invalid report target kind: comment"),
+		},
+		{
+			name: "Reports of the given kind are returned",
+			path: []string{types.QueryKindReports, "profile"},
+			expResponse: types.TargetReports{
+				types.NewTargetReport(types.NewProfileTarget(suite.testData.moderator), reports[0]),
+			},
+		},
+		{
+			name:        "Reports are filtered by status",
+			path:        []string{types.QueryKindReports, "subspace"},
+			params:      &types.QueryIndexedReportsParams{Status: types.ReportStatusDismissed},
+			expResponse: types.TargetReports{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.keeper.SaveReport(suite.ctx, types.NewProfileTarget(suite.testData.moderator), reports[0])
+			suite.keeper.SaveReport(suite.ctx, types.NewSubspaceTarget(subspace), reports[1])
+
+			var data []byte
+			if test.params != nil {
+				data = suite.keeper.Cdc.MustMarshalJSON(test.params)
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.path, abci.RequestQuery{Data: data})
+
+			if test.expErr != nil {
+				suite.Nil(result)
+				suite.Equal(test.expErr.Error(), err.Error())
+				return
+			}
+
+			suite.NoError(err)
+			expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expResponse)
+			suite.NoError(err)
+			suite.Equal(string(expectedIndented), string(result))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_queryModerators() {
+	querier := keeper.NewQuerier(suite.keeper)
+	path := []string{types.QueryModerators}
+
+	result, err := querier(suite.ctx, path, abci.RequestQuery{})
+	suite.NoError(err)
+	expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &[]sdk.AccAddress{})
+	suite.NoError(err)
+	suite.Equal(string(expectedIndented), string(result))
+
+	moderators := []sdk.AccAddress{suite.testData.moderator}
+	suite.setModerators(moderators)
+	result, err = querier(suite.ctx, path, abci.RequestQuery{})
+	suite.NoError(err)
+	expectedIndented, err = codec.MarshalJSONIndent(suite.keeper.Cdc, &moderators)
+	suite.NoError(err)
+	suite.Equal(string(expectedIndented), string(result))
+}
+
+func (suite *KeeperTestSuite) Test_queryReasons() {
+	reasons := types.Reasons{
+		types.NewReason("spam", "The post is spam"),
+		types.NewReason(types.ReasonOther, "Other"),
+	}
+	suite.keeper.SetParams(suite.ctx, types.NewParams(reasons, types.DefaultHidingParams(), types.DefaultRateLimitParams(), types.DefaultReportDeposit, types.DefaultModerators))
+
+	querier := keeper.NewQuerier(suite.keeper)
+	result, err := querier(suite.ctx, []string{types.QueryReasons}, abci.RequestQuery{})
+	suite.NoError(err)
+
+	expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &reasons)
+	suite.NoError(err)
+	suite.Equal(string(expectedIndented), string(result))
+}
+
+func (suite *KeeperTestSuite) Test_queryHiddenPosts() {
+	querier := keeper.NewQuerier(suite.keeper)
+	path := []string{types.QueryHiddenPosts}
+
+	result, err := querier(suite.ctx, path, abci.RequestQuery{})
+	suite.NoError(err)
+	expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &posts.PostIDs{})
+	suite.NoError(err)
+	suite.Equal(string(expectedIndented), string(result))
+
+	suite.postsKeeper.HidePost(suite.ctx, suite.testData.postID)
+	result, err = querier(suite.ctx, path, abci.RequestQuery{})
+	suite.NoError(err)
+	expectedIndented, err = codec.MarshalJSONIndent(suite.keeper.Cdc, &posts.PostIDs{suite.testData.postID})
+	suite.NoError(err)
+	suite.Equal(string(expectedIndented), string(result))
+}
@@ -0,0 +1,23 @@
+package v0100
+
+// DONTCOVER
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "reports"
+)
+
+// GenesisState contains the data of a v0.10.0 genesis state for the reports module
+type GenesisState struct {
+	Reports map[string][]Report `json:"reports"`
+}
+
+// Report is the struct of a post's reports
+type Report struct {
+	Type    string         `json:"type"`
+	Message string         `json:"message"`
+	User    sdk.AccAddress `json:"user"`
+}
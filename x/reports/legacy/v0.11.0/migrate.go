@@ -0,0 +1,85 @@
+package v0110
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v0100reports "github.com/desmos-labs/desmos/x/reports/legacy/v0.10.0"
+)
+
+// Migrate accepts exported genesis state from v0.10.0 and migrates it to v0.11.0
+// genesis state. This migration maps all the free-form report types to the default
+// registered reasons, moving the ones that cannot be matched into the "other" reason.
+// Reports, which were keyed by the id of the post they referred to, are now keyed by their post target.
+// The given moderators are set as the users allowed to resolve the reports, as they can be changed only through governance
+func Migrate(oldGenState v0100reports.GenesisState, moderators []sdk.AccAddress) GenesisState {
+	reports := make(map[string][]Report, len(oldGenState.Reports))
+	for postID, postReports := range oldGenState.Reports {
+		reports[PostTarget(postID)] = ConvertReports(postReports)
+	}
+
+	return GenesisState{
+		Reports: reports,
+		Params: Params{
+			Reasons:       DefaultReasons,
+			HidingParams:  DefaultHidingParams,
+			RateLimit:     DefaultRateLimitParams,
+			ReportDeposit: nil,
+			Moderators:    moderators,
+		},
+	}
+}
+
+// PostTarget returns the string representation of the report target identifying the post having the given id
+func PostTarget(postID string) string {
+	return TargetKindPost + "/" + postID
+}
+
+// ConvertReports converts v0.10.0 reports into v0.11.0 reports.
+// Since each user can have only one open report for the same reason, the reports of the same user
+// that are converted into the same reason are merged into the first one, joining their messages
+func ConvertReports(oldReports []v0100reports.Report) []Report {
+	reports := make([]Report, 0, len(oldReports))
+	indexes := map[string]int{}
+	for _, oldReport := range oldReports {
+		report := ConvertReport(oldReport)
+
+		key := report.User.String() + "/" + report.Type
+		if index, found := indexes[key]; found {
+			reports[index].Message += "\n" + report.Message
+			continue
+		}
+
+		indexes[key] = len(reports)
+		reports = append(reports, report)
+	}
+	return reports
+}
+
+// ConvertReport converts the given v0.10.0 report into a v0.11.0 report.
+// If the report type matches a default reason, that reason is used.
+// Otherwise the report is moved into the "other" reason, and its original type
+// is kept as a prefix of the message
+func ConvertReport(report v0100reports.Report) Report {
+	reasonID := NormalizeReasonID(report.Type)
+	for _, reason := range DefaultReasons {
+		if reason.ID == reasonID {
+			return Report{Type: reasonID, Message: report.Message, User: report.User}
+		}
+	}
+
+	return Report{
+		Type:    ReasonOther,
+		Message: fmt.Sprintf("[%s] %s", strings.TrimSpace(report.Type), report.Message),
+		User:    report.User,
+	}
+}
+
+// NormalizeReasonID converts the given free-form report type into a reason id
+func NormalizeReasonID(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	return strings.Join(strings.FieldsFunc(value, func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_'
+	}), "_")
+}
@@ -0,0 +1,52 @@
+package v0110_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v0100reports "github.com/desmos-labs/desmos/x/reports/legacy/v0.10.0"
+	v0110reports "github.com/desmos-labs/desmos/x/reports/legacy/v0.11.0"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	user, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	moderator, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	require.NoError(t, err)
+
+	postID := "19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af"
+
+	v0100GenState := v0100reports.GenesisState{
+		Reports: map[string][]v0100reports.Report{
+			postID: {
+				{Type: "Spam", Message: "message", User: user},
+				{Type: "Fake news", Message: "message", User: user},
+				{Type: "offensive", Message: "message", User: user},
+				{Type: "spam ", Message: "another message", User: user},
+				{Type: "rude", Message: "another message", User: user},
+				{Type: "spam", Message: "message", User: moderator},
+			},
+		},
+	}
+
+	expected := v0110reports.GenesisState{
+		Reports: map[string][]v0110reports.Report{
+			"post/" + postID: {
+				{Type: "spam", Message: "message\nanother message", User: user},
+				{Type: "fake_news", Message: "message", User: user},
+				{Type: "other", Message: "[offensive] message\n[rude] another message", User: user},
+				{Type: "spam", Message: "message", User: moderator},
+			},
+		},
+		Params: v0110reports.Params{
+			Reasons:      v0110reports.DefaultReasons,
+			HidingParams: v0110reports.DefaultHidingParams,
+			RateLimit:    v0110reports.DefaultRateLimitParams,
+			Moderators:   []sdk.AccAddress{moderator},
+		},
+	}
+
+	require.Equal(t, expected, v0110reports.Migrate(v0100GenState, []sdk.AccAddress{moderator}))
+}
@@ -0,0 +1,95 @@
+package v0110
+
+// DONTCOVER
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ReasonOther = "other"
+)
+
+var (
+	DefaultReasons = []Reason{
+		{ID: "nudity", Description: "The post contains nudity"},
+		{ID: "violence", Description: "The post contains or incites violence"},
+		{ID: "intimidation", Description: "The post intimidates or threatens someone"},
+		{ID: "suicide_or_self_harm", Description: "The post promotes suicide or self-harm"},
+		{ID: "fake_news", Description: "The post spreads false information"},
+		{ID: "spam", Description: "The post is spam"},
+		{ID: "unauthorized_sale", Description: "The post sells unauthorized goods"},
+		{ID: "hatred_incitement", Description: "The post incites hatred"},
+		{ID: "promotion_of_drug_use", Description: "The post promotes drug use"},
+		{ID: "non_consensual_intimate_images", Description: "The post contains non-consensual intimate images"},
+		{ID: "pornography", Description: "The post contains pornography"},
+		{ID: "children_abuse", Description: "The post contains children abuse"},
+		{ID: "animals_abuse", Description: "The post contains animals abuse"},
+		{ID: "bullying", Description: "The post bullies someone"},
+		{ID: "scam", Description: "The post is a scam"},
+		{ID: ReasonOther, Description: "The post violates the rules for a reason not listed"},
+	}
+
+	DefaultHidingParams = HidingParams{
+		Threshold:     sdk.NewDec(5),
+		TimeWindow:    time.Hour * 24,
+		Weighting:     "none",
+		MinStake:      sdk.NewInt(1000000),
+		MinAccountAge: time.Hour * 24 * 30,
+	}
+
+	DefaultRateLimitParams = RateLimitParams{
+		MaxReports: sdk.NewInt(10),
+		Period:     time.Hour * 24,
+	}
+)
+
+// TargetKindPost represents the kind of the report targets identifying a post
+const TargetKindPost = "post"
+
+// GenesisState contains the data of a v0.11.0 genesis state for the reports module.
+// Reports are keyed by the string representation of the target they refer to
+type GenesisState struct {
+	Reports map[string][]Report `json:"reports"`
+	Params  Params              `json:"params"`
+}
+
+// Report is the struct of a post's reports.
+// Migrated reports have no id, as it is assigned when initializing the chain
+type Report struct {
+	Type    string         `json:"type"`
+	Message string         `json:"message"`
+	User    sdk.AccAddress `json:"user"`
+}
+
+// Reason represents a reason that can be used when reporting a post
+type Reason struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+}
+
+// Params contains the parameters of the reports module
+type Params struct {
+	Reasons       []Reason         `json:"reasons"`
+	HidingParams  HidingParams     `json:"hiding_params"`
+	RateLimit     RateLimitParams  `json:"rate_limit"`
+	ReportDeposit sdk.Coins        `json:"report_deposit"`
+	Moderators    []sdk.AccAddress `json:"moderators"`
+}
+
+// HidingParams contains the parameters used to automatically hide the reported posts
+type HidingParams struct {
+	Threshold     sdk.Dec       `json:"threshold"`
+	TimeWindow    time.Duration `json:"time_window"`
+	Weighting     string        `json:"weighting"`
+	MinStake      sdk.Int       `json:"min_stake"`
+	MinAccountAge time.Duration `json:"min_account_age"`
+}
+
+// RateLimitParams contains the parameters used to limit the number of reports a user can create
+type RateLimitParams struct {
+	MaxReports sdk.Int       `json:"max_reports"`
+	Period     time.Duration `json:"period"`
+}
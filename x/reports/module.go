@@ -163,9 +163,9 @@ func (AppModule) ProposalContents(_ module.SimulationState) []sim.WeightedPropos
 	return nil
 }
 
-// RandomizedParams creates randomized posts param changes for the simulator.
-func (AppModule) RandomizedParams(_ *rand.Rand) []sim.ParamChange {
-	return nil
+// RandomizedParams creates randomized reports param changes for the simulator.
+func (AppModule) RandomizedParams(r *rand.Rand) []sim.ParamChange {
+	return simulation.ParamChanges(r)
 }
 
 // RegisterStoreDecoder performs a no-op.
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -13,10 +14,19 @@ import (
 func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 	switch {
 	case bytes.HasPrefix(kvA.Key, types.ReportsStorePrefix):
-		var reportsA, reportsB types.Reports
-		cdc.MustUnmarshalBinaryBare(kvA.Value, &reportsA)
-		cdc.MustUnmarshalBinaryBare(kvB.Value, &reportsB)
-		return fmt.Sprintf("ReportsA: %s\nReportsB: %s\n", reportsA, reportsB)
+		var reportA, reportB types.Report
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &reportA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &reportB)
+		return fmt.Sprintf("ReportA: %s\nReportB: %s\n", types.Reports{reportA}, types.Reports{reportB})
+	case bytes.HasPrefix(kvA.Key, types.ReportIDStorePrefix),
+		bytes.HasPrefix(kvA.Key, types.SubspaceReportsStorePrefix),
+		bytes.HasPrefix(kvA.Key, types.ReporterReportsStorePrefix),
+		bytes.HasPrefix(kvA.Key, types.ReporterTimeReportsStorePrefix):
+		return fmt.Sprintf("TargetA: %s\nTargetB: %s\n",
+			types.ParseReportStoreKey(kvA.Value), types.ParseReportStoreKey(kvB.Value))
+	case bytes.Equal(kvA.Key, types.LastReportIDStoreKey):
+		return fmt.Sprintf("LastReportIDA: %d\nLastReportIDB: %d\n",
+			binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
 	default:
 		panic(fmt.Sprintf("invalid account key %X", kvA.Key))
 	}
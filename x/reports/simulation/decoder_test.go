@@ -33,20 +33,32 @@ func makeTestCodec() (cdc *codec.Codec) {
 func TestDecodeStore(t *testing.T) {
 	cdc := makeTestCodec()
 
-	reports := types.Reports{
-		types.NewReport("offense", "it offends me", reportCreatorAddr),
-		types.NewReport("scam", "it's a scam", reportCreatorAddr),
-	}
+	report := types.NewReport("offense", "it offends me", reportCreatorAddr)
+	report.ID = 1
+	report.Status = types.ReportStatusOpen
+	target := types.NewPostTarget(id)
 
 	kvPairs := kv.Pairs{
-		kv.Pair{Key: types.ReportStoreKey(id), Value: cdc.MustMarshalBinaryBare(&reports)},
+		kv.Pair{Key: types.ReportStoreKey(target, 1), Value: cdc.MustMarshalBinaryBare(&report)},
+		kv.Pair{Key: types.ReportIDStoreKey(1), Value: types.ReportStoreKey(target, 1)},
+		kv.Pair{Key: types.ReporterReportStoreKey(reportCreatorAddr, 1), Value: types.ReportStoreKey(target, 1)},
+		kv.Pair{
+			Key:   types.ReporterTimeReportStoreKey(reportCreatorAddr, report.Created, 1),
+			Value: types.ReportStoreKey(target, 1),
+		},
+		kv.Pair{Key: types.LastReportIDStoreKey, Value: sdk.Uint64ToBigEndian(1)},
+		kv.Pair{Key: []byte("invalid"), Value: []byte("invalid")},
 	}
 
 	tests := []struct {
 		name        string
 		expectedLog string
 	}{
-		{"Report", fmt.Sprintf("ReportsA: %s\nReportsB: %s\n", reports, reports)},
+		{"Report", fmt.Sprintf("ReportA: %s\nReportB: %s\n", types.Reports{report}, types.Reports{report})},
+		{"Report ID", fmt.Sprintf("TargetA: %s\nTargetB: %s\n", target, target)},
+		{"Reporter report", fmt.Sprintf("TargetA: %s\nTargetB: %s\n", target, target)},
+		{"Reporter time report", fmt.Sprintf("TargetA: %s\nTargetB: %s\n", target, target)},
+		{"Last report ID", "LastReportIDA: 1\nLastReportIDB: 1\n"},
 		{"other", ""},
 	}
 
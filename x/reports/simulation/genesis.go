@@ -11,12 +11,19 @@ import (
 )
 
 func RandomizedGenState(simState *module.SimulationState) {
-	reports := randomReports(simState)
-	reportsGenesis := types.NewGenesisState(reports)
+	params := types.NewParams(
+		RandomReasons(simState.Rand),
+		RandomHidingParams(simState.Rand),
+		RandomRateLimitParams(simState.Rand),
+		RandomReportDeposit(simState.Rand),
+		randomModerators(simState),
+	)
+	reports := randomReports(simState, params.Reasons)
+	reportsGenesis := types.NewGenesisState(reports, params)
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(reportsGenesis)
 }
 
-func randomReports(simState *module.SimulationState) (reportsMap map[string]types.Reports) {
+func randomReports(simState *module.SimulationState, reasons types.Reasons) (reportsMap map[string]types.Reports) {
 	reportsMapLen := simState.Rand.Intn(50)
 
 	reportsMap = make(map[string]types.Reports, reportsMapLen)
@@ -26,13 +33,28 @@ func randomReports(simState *module.SimulationState) (reportsMap map[string]type
 		for j := 0; j < reportsLen; j++ {
 			privKey := ed25519.GenPrivKey().PubKey()
 			reports[j] = types.NewReport(
-				RandomReportTypes(simState.Rand),
+				RandomReportTypes(simState.Rand, reasons),
 				RandomReportMessage(simState.Rand),
 				sdk.AccAddress(privKey.Address()),
 			)
 		}
-		reportsMap[RandomPostID(simState.Rand).String()] = reports
+		reportsMap[RandomReportTarget(simState.Rand).String()] = reports
 	}
 
 	return reportsMap
 }
+
+// randomModerators returns a random subset of the simulation accounts to be used as reports moderators
+func randomModerators(simState *module.SimulationState) []sdk.AccAddress {
+	moderatorsLen := simState.Rand.Intn(5) + 1
+	if moderatorsLen > len(simState.Accounts) {
+		moderatorsLen = len(simState.Accounts)
+	}
+
+	moderators := make([]sdk.AccAddress, moderatorsLen)
+	for index, accIndex := range simState.Rand.Perm(len(simState.Accounts))[:moderatorsLen] {
+		moderators[index] = simState.Accounts[accIndex].Address
+	}
+
+	return moderators
+}
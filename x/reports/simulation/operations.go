@@ -16,9 +16,12 @@ import (
 )
 
 const (
-	OpWeightMsgReportPost = "op_weight_msg_report_post"
+	OpWeightMsgReportPost    = "op_weight_msg_report_post"
+	OpWeightMsgReport        = "op_weight_msg_report"
+	OpWeightMsgResolveReport = "op_weight_msg_resolve_report"
+	OpWeightMsgUnhidePost    = "op_weight_msg_unhide_post"
 
-	DefaultGasValue = 200000
+	DefaultGasValue = 800000
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -30,10 +33,43 @@ func WeightedOperations(appParams sim.AppParams, cdc *codec.Codec, k keeper.Keep
 		},
 	)
 
+	var weightMsgReport int
+	appParams.GetOrGenerate(cdc, OpWeightMsgReport, &weightMsgReport, nil,
+		func(_ *rand.Rand) {
+			weightMsgReport = params.DefaultWeightMsgReport
+		},
+	)
+
+	var weightMsgResolveReport int
+	appParams.GetOrGenerate(cdc, OpWeightMsgResolveReport, &weightMsgResolveReport, nil,
+		func(_ *rand.Rand) {
+			weightMsgResolveReport = params.DefaultWeightMsgResolveReport
+		},
+	)
+
+	var weightMsgUnhidePost int
+	appParams.GetOrGenerate(cdc, OpWeightMsgUnhidePost, &weightMsgUnhidePost, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnhidePost = params.DefaultWeightMsgUnhidePost
+		},
+	)
+
 	return sim.WeightedOperations{
 		sim.NewWeightedOperation(
 			weightMsgReportPost,
 			SimulateMsgReportPost(ak, k, pk),
 		),
+		sim.NewWeightedOperation(
+			weightMsgReport,
+			SimulateMsgReport(ak, k, pk),
+		),
+		sim.NewWeightedOperation(
+			weightMsgResolveReport,
+			SimulateMsgResolveReport(ak, k),
+		),
+		sim.NewWeightedOperation(
+			weightMsgUnhidePost,
+			SimulateMsgUnhidePost(ak, k),
+		),
 	}
 }
@@ -4,6 +4,7 @@ package simulation
 
 import (
 	"math/rand"
+	"sort"
 
 	"github.com/desmos-labs/desmos/x/reports/keeper"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	postskeeper "github.com/desmos-labs/desmos/x/posts/keeper"
+	posts "github.com/desmos-labs/desmos/x/posts/types"
 	"github.com/desmos-labs/desmos/x/reports/types"
 )
 
@@ -25,7 +27,7 @@ func SimulateMsgReportPost(ak auth.AccountKeeper, k keeper.Keeper, pk postskeepe
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
 		accs []sim.Account, chainID string,
 	) (sim.OperationMsg, []sim.FutureOperation, error) {
-		data, skip := randomReportPostFields(r, ctx, accs, ak, pk)
+		data, skip := randomReportPostFields(r, ctx, accs, k, ak, pk)
 		if skip {
 			return sim.NoOpMsg(types.ModuleName), nil, nil
 		}
@@ -37,7 +39,8 @@ func SimulateMsgReportPost(ak auth.AccountKeeper, k keeper.Keeper, pk postskeepe
 			data.Creator.Address,
 		)
 
-		err := sendMsgReportPost(r, app, ak, msg, ctx, chainID, []crypto.PrivKey{data.Creator.PrivKey})
+		deposit := k.GetParams(ctx).ReportDeposit
+		err := sendReportMsg(r, app, ak, msg, msg.Report.User, deposit, ctx, chainID, []crypto.PrivKey{data.Creator.PrivKey})
 		if err != nil {
 			return sim.NoOpMsg(types.ModuleName), nil, err
 		}
@@ -46,13 +49,15 @@ func SimulateMsgReportPost(ak auth.AccountKeeper, k keeper.Keeper, pk postskeepe
 	}
 }
 
-// sendMsgReportPost sends a transaction with a MsgReportPost from a provided random account.
-func sendMsgReportPost(
-	r *rand.Rand, app *baseapp.BaseApp, ak auth.AccountKeeper,
-	msg types.MsgReportPost, ctx sdk.Context, chainID string, privkeys []crypto.PrivKey,
+// sendReportMsg sends a transaction with the given report message from the provided reporter account.
+func sendReportMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ak auth.AccountKeeper, msg sdk.Msg, reporter sdk.AccAddress,
+	deposit sdk.Coins, ctx sdk.Context, chainID string, privkeys []crypto.PrivKey,
 ) error {
-	account := ak.GetAccount(ctx, msg.Report.User)
-	coins := account.SpendableCoins(ctx.BlockTime())
+	account := ak.GetAccount(ctx, reporter)
+
+	// Make sure the fees leave enough coins to pay the report deposit
+	coins := account.SpendableCoins(ctx.BlockTime()).Sub(deposit)
 
 	fees, err := sim.RandomFees(r, ctx, coins)
 	if err != nil {
@@ -78,20 +83,283 @@ func sendMsgReportPost(
 }
 
 func randomReportPostFields(
-	r *rand.Rand, ctx sdk.Context, accs []sim.Account, ak auth.AccountKeeper, pk postskeeper.Keeper,
+	r *rand.Rand, ctx sdk.Context, accs []sim.Account, k keeper.Keeper, ak auth.AccountKeeper, pk postskeeper.Keeper,
 ) (*ReportsData, bool) {
 	posts := pk.GetPosts(ctx)
 	if posts == nil {
 		return nil, true
 	}
 
-	reportsData := RandomReportsData(r, posts, accs)
-	acc := ak.GetAccount(ctx, reportsData.Creator.Address)
-
-	// Skip the operation without error as the account is not valid
-	if acc == nil {
+	reportsData := RandomReportsData(r, posts, accs, k.GetParams(ctx).Reasons)
+	if !canReport(ctx, k, ak, reportsData.Creator.Address, types.NewPostTarget(reportsData.PostID), reportsData.Type) {
 		return nil, true
 	}
 
 	return &reportsData, false
 }
+
+// canReport tells whether the given reporter can report the given target for the given reason
+func canReport(
+	ctx sdk.Context, k keeper.Keeper, ak auth.AccountKeeper, reporter sdk.AccAddress, target types.ReportTarget, reason string,
+) bool {
+	// The account is not valid
+	acc := ak.GetAccount(ctx, reporter)
+	if acc == nil {
+		return false
+	}
+
+	// The account has already reported the target for the same reason
+	if k.HasOpenReport(ctx, target, reporter, reason) {
+		return false
+	}
+
+	// The account has reached the reports rate limit
+	params := k.GetParams(ctx)
+	since := ctx.BlockTime().Add(-params.RateLimit.Period)
+	if sdk.NewInt(k.CountReporterReportsSince(ctx, reporter, since)).GTE(params.RateLimit.MaxReports) {
+		return false
+	}
+
+	// The account cannot pay the report deposit
+	return acc.SpendableCoins(ctx.BlockTime()).IsAllGTE(params.ReportDeposit)
+}
+
+// SimulateMsgReport tests and runs a single msg report of a random profile, registered reaction or subspace
+// created by a random account.
+func SimulateMsgReport(ak auth.AccountKeeper, k keeper.Keeper, pk postskeeper.Keeper) sim.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string,
+	) (sim.OperationMsg, []sim.FutureOperation, error) {
+		reporter, target, skip := randomReportFields(r, ctx, accs, k, pk)
+		if skip {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		reason := RandomReportTypes(r, k.GetParams(ctx).Reasons)
+		if !canReport(ctx, k, ak, reporter.Address, target, reason) {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgReport(target, reason, RandomReportMessage(r), reporter.Address)
+
+		deposit := k.GetParams(ctx).ReportDeposit
+		err := sendReportMsg(r, app, ak, msg, reporter.Address, deposit, ctx, chainID, []crypto.PrivKey{reporter.PrivKey})
+		if err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// randomReportFields returns a random reporter along with an existing profile, registered reaction
+// or subspace to be reported
+func randomReportFields(
+	r *rand.Rand, ctx sdk.Context, accs []sim.Account, k keeper.Keeper, pk postskeeper.Keeper,
+) (sim.Account, types.ReportTarget, bool) {
+	reporter, _ := sim.RandomAcc(r, accs)
+
+	switch r.Intn(3) {
+	case 0:
+		profiles := k.ProfilesKeeper.GetProfiles(ctx)
+		if len(profiles) == 0 {
+			return sim.Account{}, types.ReportTarget{}, true
+		}
+		profile := profiles[r.Intn(len(profiles))]
+		return reporter, types.NewProfileTarget(profile.Creator), false
+
+	case 1:
+		reactions := pk.GetRegisteredReactions(ctx)
+		if len(reactions) == 0 {
+			return sim.Account{}, types.ReportTarget{}, true
+		}
+		reaction := reactions[r.Intn(len(reactions))]
+		return reporter, types.NewReactionTarget(reaction.ShortCode, reaction.Subspace), false
+
+	default:
+		posts := pk.GetPosts(ctx)
+		if len(posts) == 0 {
+			return sim.Account{}, types.ReportTarget{}, true
+		}
+		return reporter, types.NewSubspaceTarget(posts[r.Intn(len(posts))].Subspace), false
+	}
+}
+
+// SimulateMsgResolveReport tests and runs a single msg resolve report created by a random moderator.
+// nolint: funlen
+func SimulateMsgResolveReport(ak auth.AccountKeeper, k keeper.Keeper) sim.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string,
+	) (sim.OperationMsg, []sim.FutureOperation, error) {
+		moderator, reportID, skip := randomResolveReportFields(r, ctx, accs, k)
+		if skip {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgResolveReport(
+			reportID,
+			RandomResolutionStatus(r),
+			RandomResolutionNote(r),
+			moderator.Address,
+		)
+
+		err := sendMsgResolveReport(r, app, ak, msg, ctx, chainID, []crypto.PrivKey{moderator.PrivKey})
+		if err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// sendMsgResolveReport sends a transaction with a MsgResolveReport from a provided moderator account.
+func sendMsgResolveReport(
+	r *rand.Rand, app *baseapp.BaseApp, ak auth.AccountKeeper,
+	msg types.MsgResolveReport, ctx sdk.Context, chainID string, privkeys []crypto.PrivKey,
+) error {
+	account := ak.GetAccount(ctx, msg.Moderator)
+	coins := account.SpendableCoins(ctx.BlockTime())
+
+	fees, err := sim.RandomFees(r, ctx, coins)
+	if err != nil {
+		return err
+	}
+
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		DefaultGasValue,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		privkeys...,
+	)
+
+	_, _, err = app.Deliver(tx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// randomResolveReportFields returns a random moderator among the simulation accounts
+// along with the id of a random open report
+func randomResolveReportFields(
+	r *rand.Rand, ctx sdk.Context, accs []sim.Account, k keeper.Keeper,
+) (sim.Account, uint64, bool) {
+	var moderators []sim.Account
+	for _, acc := range accs {
+		if k.IsModerator(ctx, acc.Address) {
+			moderators = append(moderators, acc)
+		}
+	}
+
+	// Skip the operation without error as there is no moderator
+	if len(moderators) == 0 {
+		return sim.Account{}, 0, true
+	}
+
+	var openReports []uint64
+	for _, reports := range k.GetReportsMap(ctx) {
+		for _, report := range reports {
+			if report.IsOpen() {
+				openReports = append(openReports, report.ID)
+			}
+		}
+	}
+
+	// Skip the operation without error as there is no report to be resolved
+	if len(openReports) == 0 {
+		return sim.Account{}, 0, true
+	}
+
+	// Sort the ids as they are read from a map
+	sort.Slice(openReports, func(i, j int) bool { return openReports[i] < openReports[j] })
+
+	moderator := moderators[r.Intn(len(moderators))]
+	return moderator, openReports[r.Intn(len(openReports))], false
+}
+
+// SimulateMsgUnhidePost tests and runs a single msg unhide post created by a random moderator.
+// nolint: funlen
+func SimulateMsgUnhidePost(ak auth.AccountKeeper, k keeper.Keeper) sim.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string,
+	) (sim.OperationMsg, []sim.FutureOperation, error) {
+		moderator, postID, skip := randomUnhidePostFields(r, ctx, accs, k)
+		if skip {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgUnhidePost(postID, RandomResolutionNote(r), moderator.Address)
+
+		err := sendMsgUnhidePost(r, app, ak, msg, ctx, chainID, []crypto.PrivKey{moderator.PrivKey})
+		if err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// sendMsgUnhidePost sends a transaction with a MsgUnhidePost from a provided moderator account.
+func sendMsgUnhidePost(
+	r *rand.Rand, app *baseapp.BaseApp, ak auth.AccountKeeper,
+	msg types.MsgUnhidePost, ctx sdk.Context, chainID string, privkeys []crypto.PrivKey,
+) error {
+	account := ak.GetAccount(ctx, msg.Moderator)
+	coins := account.SpendableCoins(ctx.BlockTime())
+
+	fees, err := sim.RandomFees(r, ctx, coins)
+	if err != nil {
+		return err
+	}
+
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		DefaultGasValue,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		privkeys...,
+	)
+
+	_, _, err = app.Deliver(tx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// randomUnhidePostFields returns a random moderator among the simulation accounts
+// along with the id of a random hidden post
+func randomUnhidePostFields(
+	r *rand.Rand, ctx sdk.Context, accs []sim.Account, k keeper.Keeper,
+) (sim.Account, posts.PostID, bool) {
+	var moderators []sim.Account
+	for _, acc := range accs {
+		if k.IsModerator(ctx, acc.Address) {
+			moderators = append(moderators, acc)
+		}
+	}
+
+	// Skip the operation without error as there is no moderator
+	if len(moderators) == 0 {
+		return sim.Account{}, "", true
+	}
+
+	// Skip the operation without error as there is no hidden post
+	hiddenPosts := k.PostKeeper.GetHiddenPostsIDs(ctx)
+	if len(hiddenPosts) == 0 {
+		return sim.Account{}, "", true
+	}
+
+	moderator := moderators[r.Intn(len(moderators))]
+	return moderator, hiddenPosts[r.Intn(len(hiddenPosts))], false
+}
@@ -0,0 +1,36 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/desmos-labs/desmos/x/reports/types"
+)
+
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.ReasonsParamsKey),
+			func(r *rand.Rand) string {
+				return string(types.ModelsCdc.MustMarshalJSON(RandomReasons(r)))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.HidingParamsKey),
+			func(r *rand.Rand) string {
+				return string(types.ModelsCdc.MustMarshalJSON(RandomHidingParams(r)))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.RateLimitKey),
+			func(r *rand.Rand) string {
+				return string(types.ModelsCdc.MustMarshalJSON(RandomRateLimitParams(r)))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ReportDepositKey),
+			func(r *rand.Rand) string {
+				return string(types.ModelsCdc.MustMarshalJSON(RandomReportDeposit(r)))
+			},
+		),
+	}
+}
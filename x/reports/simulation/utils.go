@@ -6,12 +6,15 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"math/rand"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/desmos-labs/desmos/x/posts/simulation"
 
 	sim "github.com/cosmos/cosmos-sdk/x/simulation"
 
 	posts "github.com/desmos-labs/desmos/x/posts/types"
+	"github.com/desmos-labs/desmos/x/reports/types"
 )
 
 var (
@@ -22,22 +25,16 @@ var (
 		"it'' racism",
 	}
 
-	repTypes = []string{
-		"nudity",
-		"violence",
-		"intimidation",
-		"suicide or self-harm",
-		"fake news",
-		"spam",
-		"unauthorized sale",
-		"hatred incitement",
-		"promotion of drug use",
-		"non-consensual intimate images",
-		"pornography",
-		"children abuse",
-		"animals abuse",
-		"bullying",
-		"scam",
+	notes = []string{
+		"the post has been removed",
+		"the post does not violate the rules",
+		"the author has been warned",
+	}
+
+	weightings = []string{
+		types.WeightingNone,
+		types.WeightingStake,
+		types.WeightingAccountAge,
 	}
 )
 
@@ -49,7 +46,7 @@ type ReportsData struct {
 }
 
 // RandomReportsData returns a randomly generated ReportsData based on the given random posts and accounts list
-func RandomReportsData(r *rand.Rand, posts []posts.Post, accs []sim.Account) ReportsData {
+func RandomReportsData(r *rand.Rand, posts []posts.Post, accs []sim.Account, reasons types.Reasons) ReportsData {
 	post, _ := simulation.RandomPost(r, posts)
 	simAccount, _ := sim.RandomAcc(r, accs)
 
@@ -57,7 +54,7 @@ func RandomReportsData(r *rand.Rand, posts []posts.Post, accs []sim.Account) Rep
 		Creator: simAccount,
 		PostID:  post.PostID,
 		Message: RandomReportMessage(r),
-		Type:    RandomReportTypes(r),
+		Type:    RandomReportTypes(r, reasons),
 	}
 }
 
@@ -72,10 +69,77 @@ func RandomPostID(r *rand.Rand) posts.PostID {
 	return posts.PostID(hex.EncodeToString(hash[:]))
 }
 
+// RandomReportTarget returns a randomly generated post, profile or subspace target
+func RandomReportTarget(r *rand.Rand) types.ReportTarget {
+	switch r.Intn(3) {
+	case 0:
+		return types.NewProfileTarget(sim.RandomAccounts(r, 1)[0].Address)
+	case 1:
+		return types.NewSubspaceTarget(RandomPostID(r).String())
+	default:
+		return types.NewPostTarget(RandomPostID(r))
+	}
+}
+
 func RandomReportMessage(r *rand.Rand) string {
 	return messages[r.Intn(len(messages))]
 }
 
-func RandomReportTypes(r *rand.Rand) string {
-	return repTypes[r.Intn(len(repTypes))]
+// RandomReportTypes returns the id of a random reason among the given ones
+func RandomReportTypes(r *rand.Rand, reasons types.Reasons) string {
+	return reasons[r.Intn(len(reasons))].ID
+}
+
+// RandomReasons returns a random subset of the default reasons, always containing the other reason
+func RandomReasons(r *rand.Rand) types.Reasons {
+	var reasons types.Reasons
+	for _, reason := range types.DefaultReasons {
+		if reason.ID == types.ReasonOther || r.Intn(2) == 0 {
+			reasons = append(reasons, reason)
+		}
+	}
+	return reasons
+}
+
+// RandomHidingParams returns randomly generated hiding params
+func RandomHidingParams(r *rand.Rand) types.HidingParams {
+	return types.NewHidingParams(
+		sdk.NewDec(int64(sim.RandIntBetween(r, 0, 5))),
+		time.Duration(sim.RandIntBetween(r, 1, 48))*time.Hour,
+		weightings[r.Intn(len(weightings))],
+		sdk.NewInt(int64(sim.RandIntBetween(r, 1, 1000000))),
+		time.Duration(sim.RandIntBetween(r, 1, 720))*time.Hour,
+	)
+}
+
+// RandomRateLimitParams returns randomly generated rate limit params
+func RandomRateLimitParams(r *rand.Rand) types.RateLimitParams {
+	return types.NewRateLimitParams(
+		sdk.NewInt(int64(sim.RandIntBetween(r, 1, 20))),
+		time.Duration(sim.RandIntBetween(r, 1, 48))*time.Hour,
+	)
+}
+
+// RandomReportDeposit returns a random deposit, that can also be empty
+func RandomReportDeposit(r *rand.Rand) sdk.Coins {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(sim.RandIntBetween(r, 1, 1000))))
+}
+
+func RandomResolutionNote(r *rand.Rand) string {
+	return notes[r.Intn(len(notes))]
+}
+
+// RandomResolutionStatus returns a random status that can be used to resolve a report
+func RandomResolutionStatus(r *rand.Rand) types.ReportStatus {
+	switch r.Intn(3) {
+	case 0:
+		return types.ReportStatusDismissed
+	case 1:
+		return types.ReportStatusActioned
+	default:
+		return types.ReportStatusAbusive
+	}
 }
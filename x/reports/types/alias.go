@@ -10,33 +10,95 @@ import (
 )
 
 const (
-	ModuleName       = common.ModuleName
-	RouterKey        = common.RouterKey
-	StoreKey         = common.StoreKey
-	ActionReportPost = common.ActionReportPost
-	QuerierRoute     = common.QuerierRoute
-	QueryReports     = common.QueryReports
+	ModuleName            = common.ModuleName
+	RouterKey             = common.RouterKey
+	StoreKey              = common.StoreKey
+	ActionReportPost      = common.ActionReportPost
+	ActionReport          = common.ActionReport
+	ActionResolveReport   = common.ActionResolveReport
+	ActionUnhidePost      = common.ActionUnhidePost
+	QuerierRoute          = common.QuerierRoute
+	QueryReports          = common.QueryReports
+	QueryTargetReports    = common.QueryTargetReports
+	QueryKindReports      = common.QueryKindReports
+	QuerySubspaceReports  = common.QuerySubspaceReports
+	QueryReporterReports  = common.QueryReporterReports
+	QueryModerators       = common.QueryModerators
+	QueryHiddenPosts      = common.QueryHiddenPosts
+	ReportStatusOpen      = models.ReportStatusOpen
+	ReportStatusDismissed = models.ReportStatusDismissed
+	ReportStatusActioned  = models.ReportStatusActioned
+	ReportStatusAbusive   = models.ReportStatusAbusive
+	ReasonOther           = models.ReasonOther
+	TargetKindPost        = models.TargetKindPost
+	TargetKindProfile     = models.TargetKindProfile
+	TargetKindReaction    = models.TargetKindReaction
+	TargetKindSubspace    = models.TargetKindSubspace
+	QueryReasons          = common.QueryReasons
 )
 
 var (
 	// functions aliases
-	RegisterModelsCodec   = models.RegisterModelsCodec
-	ReportStoreKey        = models.ReportStoreKey
-	NewReportResponse     = models.NewReportResponse
-	NewReport             = models.NewReport
-	NewMsgReportPost      = msgs.NewMsgReportPost
-	RegisterMessagesCodec = msgs.RegisterMessagesCodec
+	RegisterModelsCodec          = models.RegisterModelsCodec
+	KindReportsPrefix            = models.KindReportsPrefix
+	TargetReportsPrefix          = models.TargetReportsPrefix
+	ReportStoreKey               = models.ReportStoreKey
+	ParseReportStoreKey          = models.ParseReportStoreKey
+	ReportIDStoreKey             = models.ReportIDStoreKey
+	SubspaceReportsPrefix        = models.SubspaceReportsPrefix
+	SubspaceReportStoreKey       = models.SubspaceReportStoreKey
+	ReporterReportsPrefix        = models.ReporterReportsPrefix
+	ReporterReportStoreKey       = models.ReporterReportStoreKey
+	ReporterTimeReportsPrefix    = models.ReporterTimeReportsPrefix
+	ReporterTimeReportStoreKey   = models.ReporterTimeReportStoreKey
+	NewReportResponse            = models.NewReportResponse
+	NewTargetReportsResponse     = models.NewTargetReportsResponse
+	NewTargetReport              = models.NewTargetReport
+	NewReportTarget              = models.NewReportTarget
+	NewPostTarget                = models.NewPostTarget
+	NewProfileTarget             = models.NewProfileTarget
+	NewReactionTarget            = models.NewReactionTarget
+	NewSubspaceTarget            = models.NewSubspaceTarget
+	ParseReportTarget            = models.ParseReportTarget
+	NewReport                    = models.NewReport
+	NewReason                    = models.NewReason
+	NormalizeReasonID            = models.NormalizeReasonID
+	NewReportResolution          = models.NewReportResolution
+	NewQueryIndexedReportsParams = models.NewQueryIndexedReportsParams
+	NewMsgReportPost             = msgs.NewMsgReportPost
+	NewMsgReport                 = msgs.NewMsgReport
+	NewMsgResolveReport          = msgs.NewMsgResolveReport
+	NewMsgUnhidePost             = msgs.NewMsgUnhidePost
+	RegisterMessagesCodec        = msgs.RegisterMessagesCodec
 
 	// variable aliases
-	ModelsCdc              = models.ModelsCdc
-	ReportsStorePrefix     = common.ReportsStorePrefix
-	ReportsTypeStorePrefix = common.ReportsTypeStorePrefix
-	MsgsCodec              = msgs.MsgsCodec
+	ModelsCdc                      = models.ModelsCdc
+	ReportsStorePrefix             = common.ReportsStorePrefix
+	ReportsTypeStorePrefix         = common.ReportsTypeStorePrefix
+	ReportIDStorePrefix            = common.ReportIDStorePrefix
+	SubspaceReportsStorePrefix     = common.SubspaceReportsStorePrefix
+	ReporterReportsStorePrefix     = common.ReporterReportsStorePrefix
+	ReporterTimeReportsStorePrefix = common.ReporterTimeReportsStorePrefix
+	LastReportIDStoreKey           = common.LastReportIDStoreKey
+	MsgsCodec                      = msgs.MsgsCodec
 )
 
 type (
-	ReportsQueryResponse = models.ReportsQueryResponse
-	Report               = models.Report
-	Reports              = models.Reports
-	MsgReportPost        = msgs.MsgReportPost
+	ReportsQueryResponse       = models.ReportsQueryResponse
+	TargetReportsQueryResponse = models.TargetReportsQueryResponse
+	TargetReport               = models.TargetReport
+	TargetReports              = models.TargetReports
+	ReportTarget               = models.ReportTarget
+	TargetKind                 = models.TargetKind
+	Report                     = models.Report
+	Reports                    = models.Reports
+	Reason                     = models.Reason
+	Reasons                    = models.Reasons
+	ReportStatus               = models.ReportStatus
+	ReportResolution           = models.ReportResolution
+	QueryIndexedReportsParams  = models.QueryIndexedReportsParams
+	MsgReportPost              = msgs.MsgReportPost
+	MsgReport                  = msgs.MsgReport
+	MsgResolveReport           = msgs.MsgResolveReport
+	MsgUnhidePost              = msgs.MsgUnhidePost
 )
@@ -2,9 +2,20 @@ package types
 
 // Reports module event types
 const (
-	EventTypePostReported = "post_reported"
+	EventTypePostReported   = "post_reported"
+	EventTypeTargetReported = "target_reported"
+	EventTypeReportResolved = "report_resolved"
+	EventTypePostHidden     = "post_hidden"
+	EventTypePostUnhidden   = "post_unhidden"
 
 	// Reports attributes
-	AttributeKeyPostID      = "post_id"
-	AttributeKeyReportOwner = "report_owner"
+	AttributeKeyPostID           = "post_id"
+	AttributeKeyTargetKind       = "target_kind"
+	AttributeKeyTargetID         = "target_id"
+	AttributeKeyReportOwner      = "report_owner"
+	AttributeKeyReportID         = "report_id"
+	AttributeKeyReportStatus     = "report_status"
+	AttributeKeyModerator        = "moderator"
+	AttributeKeyReportsWeight    = "reports_weight"
+	AttributeKeyDismissedReports = "dismissed_reports"
 )
@@ -1,28 +1,75 @@
 package types
 
-// GenesisState contains the data of the genesis state for the posts module
+import (
+	"fmt"
+)
+
+// GenesisState contains the data of the genesis state for the posts module.
+// Reports are associated with the string representation of the target they refer to
 type GenesisState struct {
 	Reports map[string]Reports `json:"reports" yaml:"reports"`
+	Params  Params             `json:"params" yaml:"params"`
 }
 
 // NewGenesisState creates a new genesis state
-func NewGenesisState(reports map[string]Reports) GenesisState {
+func NewGenesisState(reports map[string]Reports, params Params) GenesisState {
 	return GenesisState{
 		Reports: reports,
+		Params:  params,
 	}
 }
 
 // DefaultGenesisState returns a default GenesisState
 func DefaultGenesisState() GenesisState {
-	return GenesisState{}
+	return GenesisState{
+		Params: DefaultParams(),
+	}
 }
 
-// ValidateGenesis validates the given genesis state and returns an error if something is invalid
+// ValidateGenesis validates the given genesis state and returns an error if something is invalid.
+// Reports without an id are allowed, and are assigned a new id when the genesis is imported.
+// Reports without a status are considered open, as they are assigned such status when the genesis is imported
 func ValidateGenesis(data GenesisState) error {
-	for _, reports := range data.Reports {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	ids := map[uint64]bool{}
+	for target, reports := range data.Reports {
+		if _, err := ParseReportTarget(target); err != nil {
+			return err
+		}
+
 		if err := reports.Validate(); err != nil {
 			return err
 		}
+
+		openReports := map[string]bool{}
+		for _, report := range reports {
+			if _, registered := data.Params.Reasons.Find(report.Type); !registered {
+				return fmt.Errorf("report reason %s is not registered", report.Type)
+			}
+
+			// Each user can have only one open report for the same target and reason
+			if report.Status == "" || report.IsOpen() {
+				key := report.User.String() + "/" + report.Type
+				if openReports[key] {
+					return fmt.Errorf("duplicated open report of %s for reason %s on target %s",
+						report.User, report.Type, target)
+				}
+				openReports[key] = true
+			}
+
+			if report.ID == 0 {
+				continue
+			}
+
+			if ids[report.ID] {
+				return fmt.Errorf("duplicated report id: %d", report.ID)
+			}
+			ids[report.ID] = true
+		}
 	}
+
 	return nil
 }
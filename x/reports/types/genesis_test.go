@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	posts "github.com/desmos-labs/desmos/x/posts/types"
@@ -11,8 +12,9 @@ import (
 
 func TestNewGenesis(t *testing.T) {
 	reports := map[string]types.Reports{}
-	expGenState := types.GenesisState{Reports: reports}
-	actualGenState := types.NewGenesisState(reports)
+	params := types.DefaultParams()
+	expGenState := types.GenesisState{Reports: reports, Params: params}
+	actualGenState := types.NewGenesisState(reports, params)
 	require.Equal(t, expGenState, actualGenState)
 }
 
@@ -30,18 +32,117 @@ func TestValidateGenesis(t *testing.T) {
 			genesis:     types.DefaultGenesisState(),
 			shouldError: false,
 		},
+		{
+			name: "Genesis with invalid params returns error",
+			genesis: types.GenesisState{
+				Params: types.NewParams(types.Reasons{types.NewReason("spam", "The post is spam")}, types.DefaultHidingParams(), types.DefaultRateLimitParams(), types.DefaultReportDeposit, types.DefaultModerators),
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with unregistered report reason returns error",
+			genesis: types.GenesisState{
+				Reports: map[string]types.Reports{
+					types.NewPostTarget(postID).String(): {types.NewReport("Spam", "message", creator)},
+				},
+				Params: types.DefaultParams(),
+			},
+			shouldError: true,
+		},
 		{
 			name: "Genesis with invalid reports returns error",
 			genesis: types.GenesisState{
 				Reports: map[string]types.Reports{
-					postID.String(): {
+					types.NewPostTarget(postID).String(): {
 						types.NewReport("scam", "message", creator),
 						types.NewReport("", "message", creator),
 					},
 				},
+				Params: types.DefaultParams(),
 			},
 			shouldError: true,
 		},
+		{
+			name: "Genesis with duplicated report ids returns error",
+			genesis: types.GenesisState{
+				Reports: map[string]types.Reports{
+					types.NewPostTarget(postID).String(): {
+						{ID: 1, Type: "scam", Message: "message", User: creator, Status: types.ReportStatusOpen},
+						{ID: 1, Type: "spam", Message: "message", User: creator, Status: types.ReportStatusOpen},
+					},
+				},
+				Params: types.DefaultParams(),
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with invalid report target returns error",
+			genesis: types.GenesisState{
+				Reports: map[string]types.Reports{
+					postID.String(): {types.NewReport("scam", "message", creator)},
+				},
+				Params: types.DefaultParams(),
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with duplicated open reports returns error",
+			genesis: types.GenesisState{
+				Reports: map[string]types.Reports{
+					types.NewPostTarget(postID).String(): {
+						{ID: 1, Type: "scam", Message: "message", User: creator, Status: types.ReportStatusOpen},
+						types.NewReport("scam", "another message", creator),
+					},
+				},
+				Params: types.DefaultParams(),
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with a resolved and an open report for the same reason does not error",
+			genesis: types.GenesisState{
+				Reports: map[string]types.Reports{
+					types.NewPostTarget(postID).String(): {
+						{
+							ID: 1, Type: "scam", Message: "message", User: creator, Status: types.ReportStatusDismissed,
+							Resolution: &types.ReportResolution{
+								Moderator: creator,
+								Note:      "note",
+								Resolved:  time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+							},
+						},
+						types.NewReport("scam", "another message", creator),
+					},
+				},
+				Params: types.DefaultParams(),
+			},
+			shouldError: false,
+		},
+		{
+			name: "Genesis with invalid moderator returns error",
+			genesis: types.GenesisState{
+				Params: types.NewParams(types.DefaultReasons, types.DefaultHidingParams(), types.DefaultRateLimitParams(),
+					types.DefaultReportDeposit, []sdk.AccAddress{creator, {}}),
+			},
+			shouldError: true,
+		},
+		{
+			name: "Genesis with reports with and without ids does not error",
+			genesis: types.GenesisState{
+				Reports: map[string]types.Reports{
+					types.NewPostTarget(postID).String(): {
+						{ID: 1, Type: "scam", Message: "message", User: creator, Status: types.ReportStatusOpen},
+						types.NewReport("spam", "message", creator),
+					},
+					types.NewProfileTarget(creator).String(): {
+						{ID: 2, Type: "scam", Message: "message", User: creator, Status: types.ReportStatusOpen},
+					},
+				},
+				Params: types.NewParams(types.DefaultReasons, types.DefaultHidingParams(), types.DefaultRateLimitParams(),
+					types.DefaultReportDeposit, []sdk.AccAddress{creator}),
+			},
+			shouldError: false,
+		},
 	}
 
 	for _, test := range tests {
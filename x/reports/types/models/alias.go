@@ -8,16 +8,31 @@ import (
 )
 
 const (
-	ModuleName       = common.ModuleName
-	RouterKey        = common.RouterKey
-	StoreKey         = common.StoreKey
-	ActionReportPost = common.ActionReportPost
-	QuerierRoute     = common.QuerierRoute
-	QueryReports     = common.QueryReports
+	ModuleName           = common.ModuleName
+	RouterKey            = common.RouterKey
+	StoreKey             = common.StoreKey
+	ActionReportPost     = common.ActionReportPost
+	ActionReport         = common.ActionReport
+	ActionResolveReport  = common.ActionResolveReport
+	ActionUnhidePost     = common.ActionUnhidePost
+	QuerierRoute         = common.QuerierRoute
+	QueryReports         = common.QueryReports
+	QueryTargetReports   = common.QueryTargetReports
+	QueryKindReports     = common.QueryKindReports
+	QuerySubspaceReports = common.QuerySubspaceReports
+	QueryReporterReports = common.QueryReporterReports
+	QueryModerators      = common.QueryModerators
+	QueryReasons         = common.QueryReasons
+	QueryHiddenPosts     = common.QueryHiddenPosts
 )
 
 var (
 	// variable aliases
-	ReportsStorePrefix     = common.ReportsStorePrefix
-	ReportsTypeStorePrefix = common.ReportsTypeStorePrefix
+	ReportsStorePrefix             = common.ReportsStorePrefix
+	ReportsTypeStorePrefix         = common.ReportsTypeStorePrefix
+	ReportIDStorePrefix            = common.ReportIDStorePrefix
+	SubspaceReportsStorePrefix     = common.SubspaceReportsStorePrefix
+	ReporterReportsStorePrefix     = common.ReporterReportsStorePrefix
+	ReporterTimeReportsStorePrefix = common.ReporterTimeReportsStorePrefix
+	LastReportIDStoreKey           = common.LastReportIDStoreKey
 )
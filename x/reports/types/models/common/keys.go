@@ -5,14 +5,29 @@ const (
 	RouterKey  = ModuleName
 	StoreKey   = ModuleName
 
-	ActionReportPost = "report_post"
+	ActionReportPost    = "report_post"
+	ActionReport        = "report"
+	ActionResolveReport = "resolve_report"
+	ActionUnhidePost    = "unhide_post"
 
 	// Queries
-	QuerierRoute = ModuleName
-	QueryReports = "reports"
+	QuerierRoute         = ModuleName
+	QueryReports         = "reports"
+	QueryTargetReports   = "target_reports"
+	QueryKindReports     = "kind_reports"
+	QuerySubspaceReports = "subspace_reports"
+	QueryReporterReports = "reporter_reports"
+	QueryModerators      = "moderators"
+	QueryReasons         = "reasons"
+	QueryHiddenPosts     = "hidden_posts"
 )
 
 var (
-	ReportsStorePrefix     = []byte("reports")
-	ReportsTypeStorePrefix = []byte("report_type")
+	ReportsStorePrefix             = []byte("reports")
+	ReportsTypeStorePrefix         = []byte("report_type")
+	ReportIDStorePrefix            = []byte("report_id")
+	SubspaceReportsStorePrefix     = []byte("subspace_reports")
+	ReporterReportsStorePrefix     = []byte("reporter_reports")
+	ReporterTimeReportsStorePrefix = []byte("reporter_time_reports")
+	LastReportIDStoreKey           = []byte("last_report_id")
 )
@@ -1,10 +1,68 @@
 package models
 
 import (
-	posts "github.com/desmos-labs/desmos/x/posts/types"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// ReportsStoreKey turn an id to a key used to store a reports inside the reports store
-func ReportStoreKey(id posts.PostID) []byte {
-	return append(ReportsStorePrefix, []byte(id)...)
+// KindReportsPrefix returns the prefix used to store all the reports of the targets having the given kind
+func KindReportsPrefix(kind TargetKind) []byte {
+	return append(ReportsStorePrefix, []byte(string(kind)+targetSeparator)...)
+}
+
+// TargetReportsPrefix returns the prefix used to store all the reports of the given target.
+// The target id is terminated by a separator so that the prefix of a target never matches the reports of another one
+func TargetReportsPrefix(target ReportTarget) []byte {
+	return append(ReportsStorePrefix, []byte(target.String()+targetSeparator)...)
+}
+
+// ReportStoreKey turns a target and a report id into the key used to store the report inside the reports store
+func ReportStoreKey(target ReportTarget, reportID uint64) []byte {
+	return append(TargetReportsPrefix(target), sdk.Uint64ToBigEndian(reportID)...)
+}
+
+// ParseReportStoreKey returns the target of the report stored using the given key.
+// The returned target is not validated
+func ParseReportStoreKey(key []byte) ReportTarget {
+	// The target is always stored between the prefix and the separator preceding the report id
+	return splitReportTarget(string(key[len(ReportsStorePrefix) : len(key)-8-len(targetSeparator)]))
+}
+
+// ReportIDStoreKey turns a report id into the key used to index the store key of the report having such id
+func ReportIDStoreKey(reportID uint64) []byte {
+	return append(ReportIDStorePrefix, sdk.Uint64ToBigEndian(reportID)...)
+}
+
+// SubspaceReportsPrefix returns the prefix used to index all the reports of the targets inside the given subspace
+func SubspaceReportsPrefix(subspace string) []byte {
+	return append(SubspaceReportsStorePrefix, []byte(subspace)...)
+}
+
+// SubspaceReportStoreKey returns the key used to index the report having the given id inside the given subspace
+func SubspaceReportStoreKey(subspace string, reportID uint64) []byte {
+	return append(SubspaceReportsPrefix(subspace), sdk.Uint64ToBigEndian(reportID)...)
+}
+
+// ReporterReportsPrefix returns the prefix used to index all the reports created by the given user
+func ReporterReportsPrefix(reporter sdk.AccAddress) []byte {
+	return append(ReporterReportsStorePrefix, reporter...)
+}
+
+// ReporterReportStoreKey returns the key used to index the report having the given id created by the given user
+func ReporterReportStoreKey(reporter sdk.AccAddress, reportID uint64) []byte {
+	return append(ReporterReportsPrefix(reporter), sdk.Uint64ToBigEndian(reportID)...)
+}
+
+// ReporterTimeReportsPrefix returns the prefix used to index all the reports created by the given user
+// sorted by their creation date
+func ReporterTimeReportsPrefix(reporter sdk.AccAddress) []byte {
+	return append(ReporterTimeReportsStorePrefix, reporter...)
+}
+
+// ReporterTimeReportStoreKey returns the key used to index the report having the given id and creation date
+// created by the given user
+func ReporterTimeReportStoreKey(reporter sdk.AccAddress, created time.Time, reportID uint64) []byte {
+	key := append(ReporterTimeReportsPrefix(reporter), sdk.FormatTimeBytes(created)...)
+	return append(key, sdk.Uint64ToBigEndian(reportID)...)
 }
@@ -0,0 +1,19 @@
+package models
+
+// QueryIndexedReportsParams contains the params used to filter and paginate the reports returned by the
+// 'custom/reports/subspace_reports', 'custom/reports/reporter_reports' and 'custom/reports/kind_reports' queries.
+// An empty status returns only the open reports
+type QueryIndexedReportsParams struct {
+	Status ReportStatus `json:"status" yaml:"status"`
+	Page   int          `json:"page" yaml:"page"`
+	Limit  int          `json:"limit" yaml:"limit"`
+}
+
+// NewQueryIndexedReportsParams is a constructor function for QueryIndexedReportsParams
+func NewQueryIndexedReportsParams(status ReportStatus, page, limit int) QueryIndexedReportsParams {
+	return QueryIndexedReportsParams{
+		Status: status,
+		Page:   page,
+		Limit:  limit,
+	}
+}
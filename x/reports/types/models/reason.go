@@ -0,0 +1,91 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ReasonOther is the id of the reason used for the reports that do not fit into any other registered reason
+const ReasonOther = "other"
+
+var reasonIDRegEx = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+
+// Reason represents a registered reason that can be used to report a post
+type Reason struct {
+	ID          string `json:"id" yaml:"id"`                   // Identifies the reason
+	Description string `json:"description" yaml:"description"` // Describes when the reason should be used
+}
+
+// NewReason returns a Reason
+func NewReason(id, description string) Reason {
+	return Reason{
+		ID:          id,
+		Description: description,
+	}
+}
+
+// Validate implements validator
+func (reason Reason) Validate() error {
+	if !reasonIDRegEx.MatchString(reason.ID) {
+		return fmt.Errorf("invalid reason id: %s", reason.ID)
+	}
+
+	if len(strings.TrimSpace(reason.Description)) == 0 {
+		return fmt.Errorf("reason description cannot be empty")
+	}
+
+	return nil
+}
+
+// NormalizeReasonID returns the given free-form report type converted to the format used by the reasons ids,
+// so that for example "Fake News" becomes "fake_news"
+func NormalizeReasonID(value string) string {
+	value = strings.ToLower(strings.TrimSpace(value))
+	return strings.Join(strings.FieldsFunc(value, func(r rune) bool {
+		return r == ' ' || r == '-' || r == '_'
+	}), "_")
+}
+
+// Reasons represents a slice of Reason objects
+type Reasons []Reason
+
+// String implements fmt.Stringer
+func (reasons Reasons) String() string {
+	out := "ID - Description\n"
+	for _, reason := range reasons {
+		out += fmt.Sprintf("%s - %s\n", reason.ID, reason.Description)
+	}
+	return strings.TrimSpace(out)
+}
+
+// Find returns the reason having the given id, and a boolean telling whether it exists
+func (reasons Reasons) Find(id string) (Reason, bool) {
+	for _, reason := range reasons {
+		if reason.ID == id {
+			return reason, true
+		}
+	}
+	return Reason{}, false
+}
+
+// Validate implements validator
+func (reasons Reasons) Validate() error {
+	ids := map[string]bool{}
+	for _, reason := range reasons {
+		if err := reason.Validate(); err != nil {
+			return err
+		}
+
+		if ids[reason.ID] {
+			return fmt.Errorf("duplicated reason id: %s", reason.ID)
+		}
+		ids[reason.ID] = true
+	}
+
+	if !ids[ReasonOther] {
+		return fmt.Errorf("reasons must contain the %s reason", ReasonOther)
+	}
+
+	return nil
+}
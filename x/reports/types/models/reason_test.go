@@ -0,0 +1,110 @@
+package models_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/reports/types"
+)
+
+func TestReason_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		reason types.Reason
+		expErr error
+	}{
+		{
+			name:   "empty id returns error",
+			reason: types.NewReason("", "description"),
+			expErr: fmt.Errorf("invalid reason id: "),
+		},
+		{
+			name:   "upper case id returns error",
+			reason: types.NewReason("Spam", "description"),
+			expErr: fmt.Errorf("invalid reason id: Spam"),
+		},
+		{
+			name:   "id with spaces returns error",
+			reason: types.NewReason("fake news", "description"),
+			expErr: fmt.Errorf("invalid reason id: fake news"),
+		},
+		{
+			name:   "empty description returns error",
+			reason: types.NewReason("spam", " "),
+			expErr: fmt.Errorf("reason description cannot be empty"),
+		},
+		{
+			name:   "valid reason returns no error",
+			reason: types.NewReason("fake_news", "description"),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.reason.Validate())
+		})
+	}
+}
+
+func TestNormalizeReasonID(t *testing.T) {
+	require.Equal(t, "spam", types.NormalizeReasonID("SPAM"))
+	require.Equal(t, "fake_news", types.NormalizeReasonID(" Fake News "))
+	require.Equal(t, "suicide_or_self_harm", types.NormalizeReasonID("suicide or self-harm"))
+}
+
+func TestReasons_Find(t *testing.T) {
+	reasons := types.Reasons{types.NewReason("spam", "The post is spam")}
+
+	reason, found := reasons.Find("spam")
+	require.True(t, found)
+	require.Equal(t, reasons[0], reason)
+
+	_, found = reasons.Find("Spam")
+	require.False(t, found)
+}
+
+func TestReasons_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		reasons types.Reasons
+		expErr  error
+	}{
+		{
+			name:    "invalid reason returns error",
+			reasons: types.Reasons{types.NewReason("spam", "")},
+			expErr:  fmt.Errorf("reason description cannot be empty"),
+		},
+		{
+			name: "duplicated reason returns error",
+			reasons: types.Reasons{
+				types.NewReason("spam", "The post is spam"),
+				types.NewReason("spam", "The post is spam"),
+			},
+			expErr: fmt.Errorf("duplicated reason id: spam"),
+		},
+		{
+			name:    "missing other reason returns error",
+			reasons: types.Reasons{types.NewReason("spam", "The post is spam")},
+			expErr:  fmt.Errorf("reasons must contain the other reason"),
+		},
+		{
+			name: "valid reasons returns no error",
+			reasons: types.Reasons{
+				types.NewReason("spam", "The post is spam"),
+				types.NewReason("other", "Other"),
+			},
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.reasons.Validate())
+		})
+	}
+}
@@ -3,15 +3,80 @@ package models
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// Report is the struct of a post's reports
+// ReportStatus represents the moderation status of a report
+type ReportStatus string
+
+const (
+	ReportStatusOpen      ReportStatus = "open"
+	ReportStatusDismissed ReportStatus = "dismissed"
+	ReportStatusActioned  ReportStatus = "actioned"
+	ReportStatusAbusive   ReportStatus = "abusive"
+)
+
+// IsValid tells whether the report status is one of the supported ones
+func (status ReportStatus) IsValid() bool {
+	switch status {
+	case ReportStatusOpen, ReportStatusDismissed, ReportStatusActioned, ReportStatusAbusive:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsResolution tells whether the report status can be used by a moderator to resolve a report
+func (status ReportStatus) IsResolution() bool {
+	return status == ReportStatusDismissed || status == ReportStatusActioned || status == ReportStatusAbusive
+}
+
+// ReportResolution contains the details of how a moderator has resolved a report
+type ReportResolution struct {
+	Moderator sdk.AccAddress `json:"moderator" yaml:"moderator"` // Identifies the moderator that has resolved the report
+	Note      string         `json:"note" yaml:"note"`           // Contains the moderator feedback to the reporter
+	Resolved  time.Time      `json:"resolved" yaml:"resolved"`   // Identifies when the report has been resolved
+}
+
+// NewReportResolution returns a ReportResolution
+func NewReportResolution(moderator sdk.AccAddress, note string, resolved time.Time) ReportResolution {
+	return ReportResolution{
+		Moderator: moderator,
+		Note:      note,
+		Resolved:  resolved,
+	}
+}
+
+// Validate implements validator
+func (resolution ReportResolution) Validate() error {
+	if resolution.Moderator.Empty() {
+		return fmt.Errorf("invalid moderator address: %s", resolution.Moderator)
+	}
+
+	if len(strings.TrimSpace(resolution.Note)) == 0 {
+		return fmt.Errorf("resolution note cannot be empty")
+	}
+
+	if resolution.Resolved.IsZero() {
+		return fmt.Errorf("invalid resolution date")
+	}
+
+	return nil
+}
+
+// Report is the struct of a post's reports.
+// The id, creation date and status are assigned by the chain when the report is stored
 type Report struct {
-	Type    string         `json:"type" yaml:"type"`       // Identifies the type of the reports
-	Message string         `json:"message" yaml:"message"` // Contains the user message
-	User    sdk.AccAddress `json:"user" yaml:"user"`       // Identifies the reporting user
+	ID         uint64            `json:"id,omitempty" yaml:"id,omitempty"`                 // Identifies the report
+	Type       string            `json:"type" yaml:"type"`                                 // Identifies the type of the reports
+	Message    string            `json:"message" yaml:"message"`                           // Contains the user message
+	User       sdk.AccAddress    `json:"user" yaml:"user"`                                 // Identifies the reporting user
+	Created    time.Time         `json:"created,omitempty" yaml:"created,omitempty"`       // Identifies when the report has been created
+	Status     ReportStatus      `json:"status,omitempty" yaml:"status,omitempty"`         // Identifies the moderation status of the report
+	Resolution *ReportResolution `json:"resolution,omitempty" yaml:"resolution,omitempty"` // Contains the moderator resolution, if any
+	Deposit    sdk.Coins         `json:"deposit,omitempty" yaml:"deposit,omitempty"`       // Contains the deposit paid by the reporter, if any
 }
 
 // NewReport returns a Report
@@ -23,6 +88,11 @@ func NewReport(t string, message string, user sdk.AccAddress) Report {
 	}
 }
 
+// IsOpen tells whether the report is still waiting for a moderator to resolve it
+func (r Report) IsOpen() bool {
+	return r.Status == ReportStatusOpen
+}
+
 // Validate implements validator
 func (r Report) Validate() error {
 	if len(strings.TrimSpace(r.Type)) == 0 {
@@ -37,6 +107,28 @@ func (r Report) Validate() error {
 		return fmt.Errorf("invalid user address: %s", r.User)
 	}
 
+	if r.Status != "" && !r.Status.IsValid() {
+		return fmt.Errorf("invalid report status: %s", r.Status)
+	}
+
+	if !r.Deposit.IsValid() {
+		return fmt.Errorf("invalid report deposit: %s", r.Deposit)
+	}
+
+	if r.Status.IsResolution() && r.Resolution == nil {
+		return fmt.Errorf("resolved report must have a resolution")
+	}
+
+	if r.Resolution != nil {
+		if !r.Status.IsResolution() {
+			return fmt.Errorf("only resolved reports can have a resolution")
+		}
+
+		if err := r.Resolution.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -44,10 +136,10 @@ type Reports []Report
 
 // String implements stringer
 func (reports Reports) String() string {
-	out := "Type - Message - Sender\n"
+	out := "ID - Type - Message - Sender - Status\n"
 	for _, rep := range reports {
-		out += fmt.Sprintf("%s - %s - %s\n",
-			rep.Type, rep.Message, rep.User)
+		out += fmt.Sprintf("%d - %s - %s - %s - %s\n",
+			rep.ID, rep.Type, rep.Message, rep.User, rep.Status)
 	}
 	return strings.TrimSpace(out)
 }
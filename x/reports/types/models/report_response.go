@@ -45,3 +45,47 @@ func (response *ReportsQueryResponse) UnmarshalJSON(data []byte) error {
 	*response = ReportsQueryResponse(temp)
 	return nil
 }
+
+// TargetReportsQueryResponse contains the reports of a target
+type TargetReportsQueryResponse struct {
+	Target  ReportTarget `json:"target" yaml:"target"`
+	Reports Reports      `json:"reports" yaml:"reports"`
+}
+
+// NewTargetReportsResponse is a constructor function for TargetReportsQueryResponse
+func NewTargetReportsResponse(target ReportTarget, reports Reports) TargetReportsQueryResponse {
+	return TargetReportsQueryResponse{
+		Target:  target,
+		Reports: reports,
+	}
+}
+
+// String implements fmt.Stringer
+func (response TargetReportsQueryResponse) String() string {
+	out := fmt.Sprintf("Target: %s\n Reports: %s\n", response.Target, response.Reports)
+	return strings.TrimSpace(out)
+}
+
+// TargetReport contains a report along with the target it refers to
+type TargetReport struct {
+	Target ReportTarget `json:"target" yaml:"target"`
+	Report Report       `json:"report" yaml:"report"`
+}
+
+// NewTargetReport is a constructor function for TargetReport
+func NewTargetReport(target ReportTarget, report Report) TargetReport {
+	return TargetReport{
+		Target: target,
+		Report: report,
+	}
+}
+
+// String implements fmt.Stringer
+func (targetReport TargetReport) String() string {
+	return fmt.Sprintf("Target: %s\n Report: %d - %s - %s - %s - %s",
+		targetReport.Target, targetReport.Report.ID, targetReport.Report.Type, targetReport.Report.Message,
+		targetReport.Report.User, targetReport.Report.Status)
+}
+
+// TargetReports represents a slice of TargetReport objects
+type TargetReports []TargetReport
@@ -3,6 +3,7 @@ package models_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	posts "github.com/desmos-labs/desmos/x/posts/types"
@@ -14,23 +15,25 @@ func TestReportsQueryResponse_String(t *testing.T) {
 	creator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
 	postID := posts.PostID("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af")
 	require.NoError(t, err)
+	date := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
 	reports := types.Reports{
-		types.NewReport("scam", "it's a trap", creator),
-		types.NewReport("violence", "it's a trap", creator),
+		{ID: 1, Type: "scam", Message: "it's a trap", User: creator, Created: date, Status: types.ReportStatusOpen},
+		{ID: 2, Type: "violence", Message: "it's a trap", User: creator, Created: date, Status: types.ReportStatusOpen},
 	}
 
 	repQueryResp := types.NewReportResponse(postID, reports)
 
-	require.Equal(t, "Post ID: 19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af\n Reports: Type - Message - Sender\nscam - it's a trap - cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns\nviolence - it's a trap - cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns", repQueryResp.String())
+	require.Equal(t, "Post ID: 19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af\n Reports: ID - Type - Message - Sender - Status\n1 - scam - it's a trap - cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns - open\n2 - violence - it's a trap - cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns - open", repQueryResp.String())
 }
 
 func TestReportsQueryResponse_MarshalJSON(t *testing.T) {
 	creator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
 	postID := posts.PostID("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af")
 	require.NoError(t, err)
+	date := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
 	reports := types.Reports{
-		types.NewReport("scam", "it's a trap", creator),
-		types.NewReport("violence", "it's a trap", creator),
+		{ID: 1, Type: "scam", Message: "it's a trap", User: creator, Created: date, Status: types.ReportStatusOpen},
+		{ID: 2, Type: "violence", Message: "it's a trap", User: creator, Created: date, Status: types.ReportStatusOpen},
 	}
 
 	repQueryResp := types.NewReportResponse(postID, reports)
@@ -43,7 +46,7 @@ func TestReportsQueryResponse_MarshalJSON(t *testing.T) {
 		{
 			name:        "Response with non-empty reports",
 			response:    repQueryResp,
-			expResponse: "{\"post_id\":\"19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af\",\"reports\":[{\"type\":\"scam\",\"message\":\"it's a trap\",\"user\":\"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns\"},{\"type\":\"violence\",\"message\":\"it's a trap\",\"user\":\"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns\"}]}",
+			expResponse: "{\"post_id\":\"19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af\",\"reports\":[{\"id\":1,\"type\":\"scam\",\"message\":\"it's a trap\",\"user\":\"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns\",\"created\":\"2020-01-01T12:00:00Z\",\"status\":\"open\"},{\"id\":2,\"type\":\"violence\",\"message\":\"it's a trap\",\"user\":\"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns\",\"created\":\"2020-01-01T12:00:00Z\",\"status\":\"open\"}]}",
 		},
 		{
 			name:        "Response with empty reports",
@@ -62,3 +65,25 @@ func TestReportsQueryResponse_MarshalJSON(t *testing.T) {
 	}
 
 }
+
+func TestTargetReportsQueryResponse_String(t *testing.T) {
+	creator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	reports := types.Reports{
+		{ID: 1, Type: "scam", Message: "it's a trap", User: creator, Status: types.ReportStatusOpen},
+	}
+
+	response := types.NewTargetReportsResponse(types.NewProfileTarget(creator), reports)
+	require.Equal(t, "Target: profile/cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns\n Reports: ID - Type - Message - Sender - Status\n1 - scam - it's a trap - cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns - open", response.String())
+}
+
+func TestTargetReport_String(t *testing.T) {
+	creator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	postID := posts.PostID("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af")
+
+	report := types.Report{ID: 1, Type: "scam", Message: "it's a trap", User: creator, Status: types.ReportStatusOpen}
+	targetReport := types.NewTargetReport(types.NewPostTarget(postID), report)
+
+	require.Equal(t, "Target: post/19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af\n Report: 1 - scam - it's a trap - cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns - open", targetReport.String())
+}
@@ -3,6 +3,7 @@ package models_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -10,9 +11,66 @@ import (
 	"github.com/desmos-labs/desmos/x/reports/types"
 )
 
+func TestReportStatus_IsValid(t *testing.T) {
+	require.True(t, types.ReportStatusOpen.IsValid())
+	require.True(t, types.ReportStatusDismissed.IsValid())
+	require.True(t, types.ReportStatusActioned.IsValid())
+	require.False(t, types.ReportStatus("closed").IsValid())
+	require.False(t, types.ReportStatus("").IsValid())
+}
+
+func TestReportStatus_IsResolution(t *testing.T) {
+	require.False(t, types.ReportStatusOpen.IsResolution())
+	require.True(t, types.ReportStatusDismissed.IsResolution())
+	require.True(t, types.ReportStatusActioned.IsResolution())
+	require.False(t, types.ReportStatus("closed").IsResolution())
+}
+
+func TestReportResolution_Validate(t *testing.T) {
+	moderator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	date := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		resolution types.ReportResolution
+		expErr     error
+	}{
+		{
+			name:       "invalid moderator returns error",
+			resolution: types.NewReportResolution(nil, "note", date),
+			expErr:     fmt.Errorf("invalid moderator address: "),
+		},
+		{
+			name:       "empty note returns error",
+			resolution: types.NewReportResolution(moderator, "", date),
+			expErr:     fmt.Errorf("resolution note cannot be empty"),
+		},
+		{
+			name:       "invalid resolution date returns error",
+			resolution: types.NewReportResolution(moderator, "note", time.Time{}),
+			expErr:     fmt.Errorf("invalid resolution date"),
+		},
+		{
+			name:       "valid resolution returns no error",
+			resolution: types.NewReportResolution(moderator, "note", date),
+			expErr:     nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.resolution.Validate())
+		})
+	}
+}
+
 func TestReport_Validate(t *testing.T) {
 	creator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
 	require.NoError(t, err)
+	date := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	resolution := types.NewReportResolution(creator, "note", date)
 
 	tests := []struct {
 		name   string
@@ -34,11 +92,39 @@ func TestReport_Validate(t *testing.T) {
 			report: types.NewReport("scam", "message", sdk.AccAddress{}),
 			expErr: fmt.Errorf("invalid user address: "),
 		},
+		{
+			name:   "invalid report status returns error",
+			report: types.Report{ID: 1, Type: "scam", Message: "message", User: creator, Status: "closed"},
+			expErr: fmt.Errorf("invalid report status: closed"),
+		},
+		{
+			name:   "resolved report without resolution returns error",
+			report: types.Report{ID: 1, Type: "scam", Message: "message", User: creator, Status: types.ReportStatusActioned},
+			expErr: fmt.Errorf("resolved report must have a resolution"),
+		},
+		{
+			name: "open report with resolution returns error",
+			report: types.Report{ID: 1, Type: "scam", Message: "message", User: creator, Status: types.ReportStatusOpen,
+				Resolution: &resolution},
+			expErr: fmt.Errorf("only resolved reports can have a resolution"),
+		},
+		{
+			name: "invalid resolution returns error",
+			report: types.Report{ID: 1, Type: "scam", Message: "message", User: creator, Status: types.ReportStatusDismissed,
+				Resolution: &types.ReportResolution{Moderator: creator, Note: " ", Resolved: date}},
+			expErr: fmt.Errorf("resolution note cannot be empty"),
+		},
 		{
 			name:   "valid reports returns no error",
 			report: types.NewReport("scam", "message", creator),
 			expErr: nil,
 		},
+		{
+			name: "valid resolved reports returns no error",
+			report: types.Report{ID: 1, Type: "scam", Message: "message", User: creator, Status: types.ReportStatusDismissed,
+				Resolution: &resolution},
+			expErr: nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -0,0 +1,156 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	posts "github.com/desmos-labs/desmos/x/posts/types"
+)
+
+// TargetKind represents the kind of entity that can be reported
+type TargetKind string
+
+const (
+	TargetKindPost     TargetKind = "post"
+	TargetKindProfile  TargetKind = "profile"
+	TargetKindReaction TargetKind = "reaction"
+	TargetKindSubspace TargetKind = "subspace"
+
+	// targetSeparator separates the kind of a target from its id, and the subspace of a reaction from its shortcode
+	targetSeparator = "/"
+)
+
+// IsValid tells whether the target kind is one of the supported ones
+func (kind TargetKind) IsValid() bool {
+	switch kind {
+	case TargetKindPost, TargetKindProfile, TargetKindReaction, TargetKindSubspace:
+		return true
+	default:
+		return false
+	}
+}
+
+// ReportTarget identifies the entity a report refers to.
+// The id of the target depends on its kind:
+// - posts are identified by their id;
+// - profiles are identified by the Bech32 address of their creator;
+// - registered reactions are identified by their subspace and shortcode, separated by a slash;
+// - subspaces are identified by their id.
+type ReportTarget struct {
+	Kind TargetKind `json:"kind" yaml:"kind"`
+	ID   string     `json:"id" yaml:"id"`
+}
+
+// NewReportTarget is a constructor function for ReportTarget
+func NewReportTarget(kind TargetKind, id string) ReportTarget {
+	return ReportTarget{
+		Kind: kind,
+		ID:   id,
+	}
+}
+
+// NewPostTarget returns the ReportTarget identifying the post having the given id
+func NewPostTarget(postID posts.PostID) ReportTarget {
+	return NewReportTarget(TargetKindPost, postID.String())
+}
+
+// NewProfileTarget returns the ReportTarget identifying the profile of the given user
+func NewProfileTarget(user sdk.AccAddress) ReportTarget {
+	return NewReportTarget(TargetKindProfile, user.String())
+}
+
+// NewReactionTarget returns the ReportTarget identifying the reaction registered
+// with the given shortcode inside the given subspace
+func NewReactionTarget(shortcode, subspace string) ReportTarget {
+	return NewReportTarget(TargetKindReaction, subspace+targetSeparator+shortcode)
+}
+
+// NewSubspaceTarget returns the ReportTarget identifying the given subspace
+func NewSubspaceTarget(subspace string) ReportTarget {
+	return NewReportTarget(TargetKindSubspace, subspace)
+}
+
+// splitReportTarget splits the given value into the kind and the id of a ReportTarget, without validating them
+func splitReportTarget(value string) ReportTarget {
+	parts := strings.SplitN(value, targetSeparator, 2)
+	if len(parts) != 2 {
+		return NewReportTarget(TargetKind(value), "")
+	}
+	return NewReportTarget(TargetKind(parts[0]), parts[1])
+}
+
+// ParseReportTarget parses the given value, made of the target kind and id separated by a slash, into a ReportTarget
+func ParseReportTarget(value string) (ReportTarget, error) {
+	target := splitReportTarget(value)
+	if err := target.Validate(); err != nil {
+		return ReportTarget{}, err
+	}
+
+	return target, nil
+}
+
+// String implements fmt.Stringer
+func (target ReportTarget) String() string {
+	return string(target.Kind) + targetSeparator + target.ID
+}
+
+// Equals tells whether the given target identifies the same entity of this one
+func (target ReportTarget) Equals(other ReportTarget) bool {
+	return target.Kind == other.Kind && target.ID == other.ID
+}
+
+// PostID returns the id of the reported post.
+// If the target is not a post, false is returned instead
+func (target ReportTarget) PostID() (posts.PostID, bool) {
+	if target.Kind != TargetKindPost {
+		return "", false
+	}
+	return posts.PostID(target.ID), true
+}
+
+// Reaction returns the shortcode and the subspace of the reported reaction.
+// If the target is not a reaction, false is returned instead
+func (target ReportTarget) Reaction() (shortcode string, subspace string, ok bool) {
+	if target.Kind != TargetKindReaction {
+		return "", "", false
+	}
+
+	parts := strings.SplitN(target.ID, targetSeparator, 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+	return parts[1], parts[0], true
+}
+
+// Validate implements validator
+func (target ReportTarget) Validate() error {
+	switch target.Kind {
+	case TargetKindPost:
+		if !posts.PostID(target.ID).Valid() {
+			return fmt.Errorf("invalid post target id: %s", target.ID)
+		}
+
+	case TargetKindProfile:
+		if _, err := sdk.AccAddressFromBech32(target.ID); err != nil {
+			return fmt.Errorf("invalid profile target id: %s", target.ID)
+		}
+
+	case TargetKindReaction:
+		shortcode, subspace, _ := target.Reaction()
+		if !posts.IsValidSubspace(subspace) || !posts.IsValidReactionCode(shortcode) ||
+			strings.Contains(shortcode, targetSeparator) {
+			return fmt.Errorf("invalid reaction target id: %s", target.ID)
+		}
+
+	case TargetKindSubspace:
+		if !posts.IsValidSubspace(target.ID) {
+			return fmt.Errorf("invalid subspace target id: %s", target.ID)
+		}
+
+	default:
+		return fmt.Errorf("invalid report target kind: %s", target.Kind)
+	}
+
+	return nil
+}
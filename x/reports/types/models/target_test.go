@@ -0,0 +1,138 @@
+package models_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	posts "github.com/desmos-labs/desmos/x/posts/types"
+	"github.com/desmos-labs/desmos/x/reports/types"
+)
+
+func TestTargetKind_IsValid(t *testing.T) {
+	require.True(t, types.TargetKindPost.IsValid())
+	require.True(t, types.TargetKindProfile.IsValid())
+	require.True(t, types.TargetKindReaction.IsValid())
+	require.True(t, types.TargetKindSubspace.IsValid())
+	require.False(t, types.TargetKind("comment").IsValid())
+	require.False(t, types.TargetKind("").IsValid())
+}
+
+func TestReportTarget_String(t *testing.T) {
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	target := types.NewReactionTarget(":smile:", subspace)
+	require.Equal(t, "reaction/"+subspace+"/:smile:", target.String())
+}
+
+func TestReportTarget_PostID(t *testing.T) {
+	postID := posts.PostID("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af")
+
+	id, ok := types.NewPostTarget(postID).PostID()
+	require.True(t, ok)
+	require.Equal(t, postID, id)
+
+	_, ok = types.NewSubspaceTarget(postID.String()).PostID()
+	require.False(t, ok)
+}
+
+func TestReportTarget_Reaction(t *testing.T) {
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+
+	shortcode, reactionSubspace, ok := types.NewReactionTarget(":smile:", subspace).Reaction()
+	require.True(t, ok)
+	require.Equal(t, ":smile:", shortcode)
+	require.Equal(t, subspace, reactionSubspace)
+
+	_, _, ok = types.NewReportTarget(types.TargetKindReaction, ":smile:").Reaction()
+	require.False(t, ok)
+
+	_, _, ok = types.NewSubspaceTarget(subspace).Reaction()
+	require.False(t, ok)
+}
+
+func TestReportTarget_Validate(t *testing.T) {
+	user, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	postID := posts.PostID("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af")
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+
+	tests := []struct {
+		name   string
+		target types.ReportTarget
+		expErr error
+	}{
+		{
+			name:   "invalid kind returns error",
+			target: types.NewReportTarget("comment", postID.String()),
+			expErr: fmt.Errorf("invalid report target kind: comment"),
+		},
+		{
+			name:   "invalid post id returns error",
+			target: types.NewReportTarget(types.TargetKindPost, "123"),
+			expErr: fmt.Errorf("invalid post target id: 123"),
+		},
+		{
+			name:   "invalid profile address returns error",
+			target: types.NewReportTarget(types.TargetKindProfile, "cosmos1"),
+			expErr: fmt.Errorf("invalid profile target id: cosmos1"),
+		},
+		{
+			name:   "reaction without subspace returns error",
+			target: types.NewReportTarget(types.TargetKindReaction, ":smile:"),
+			expErr: fmt.Errorf("invalid reaction target id: :smile:"),
+		},
+		{
+			name:   "reaction with invalid shortcode returns error",
+			target: types.NewReactionTarget("smile", subspace),
+			expErr: fmt.Errorf("invalid reaction target id: %s/smile", subspace),
+		},
+		{
+			name:   "invalid subspace returns error",
+			target: types.NewSubspaceTarget("subspace"),
+			expErr: fmt.Errorf("invalid subspace target id: subspace"),
+		},
+		{
+			name:   "valid post returns no error",
+			target: types.NewPostTarget(postID),
+			expErr: nil,
+		},
+		{
+			name:   "valid profile returns no error",
+			target: types.NewProfileTarget(user),
+			expErr: nil,
+		},
+		{
+			name:   "valid reaction returns no error",
+			target: types.NewReactionTarget(":smile:", subspace),
+			expErr: nil,
+		},
+		{
+			name:   "valid subspace returns no error",
+			target: types.NewSubspaceTarget(subspace),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.target.Validate())
+		})
+	}
+}
+
+func TestParseReportTarget(t *testing.T) {
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+
+	target, err := types.ParseReportTarget("reaction/" + subspace + "/:smile:")
+	require.NoError(t, err)
+	require.True(t, target.Equals(types.NewReactionTarget(":smile:", subspace)))
+
+	_, err = types.ParseReportTarget(subspace)
+	require.Error(t, err)
+
+	_, err = types.ParseReportTarget("post/123")
+	require.Error(t, err)
+}
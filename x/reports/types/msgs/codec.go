@@ -12,4 +12,7 @@ func init() {
 // RegisterMessagesCodec registers concrete types on the Amino codec
 func RegisterMessagesCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgReportPost{}, "desmos/MsgReportPost", nil)
+	cdc.RegisterConcrete(MsgReport{}, "desmos/MsgReport", nil)
+	cdc.RegisterConcrete(MsgResolveReport{}, "desmos/MsgResolveReport", nil)
+	cdc.RegisterConcrete(MsgUnhidePost{}, "desmos/MsgUnhidePost", nil)
 }
@@ -1,6 +1,9 @@
 package msgs
 
 import (
+	"fmt"
+	"strings"
+
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	postserrors "github.com/desmos-labs/desmos/x/posts/types/errors"
@@ -17,8 +20,9 @@ import (
 
 // MsgReportPost defines a ReportPost message
 type MsgReportPost struct {
-	PostID posts.PostID  `json:"post_id" yaml:"post_id"`
-	Report models.Report `json:"report" yaml:"report"`
+	PostID   posts.PostID   `json:"post_id" yaml:"post_id"`
+	Report   models.Report  `json:"report" yaml:"report"`
+	ActingAs sdk.AccAddress `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
 }
 
 // NewMsgReportPost returns a MsgReportPost object
@@ -29,6 +33,13 @@ func NewMsgReportPost(id posts.PostID, repType, message string, user sdk.AccAddr
 	}
 }
 
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgReportPost) WithActingAs(owner sdk.AccAddress) MsgReportPost {
+	msg.ActingAs = owner
+	return msg
+}
+
 // Route should return the name of the module
 func (msg MsgReportPost) Route() string { return models.RouterKey }
 
@@ -45,6 +56,10 @@ func (msg MsgReportPost) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
+	if !msg.ActingAs.Empty() && msg.ActingAs.Equals(msg.Report.User) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the reporter")
+	}
+
 	return nil
 }
 
@@ -57,3 +72,175 @@ func (msg MsgReportPost) GetSignBytes() []byte {
 func (msg MsgReportPost) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Report.User}
 }
+
+// ----------------------
+// --- MsgReport
+// ----------------------
+
+// MsgReport defines the message used to report a post, a profile, a registered reaction or a subspace
+type MsgReport struct {
+	Target   models.ReportTarget `json:"target" yaml:"target"`
+	Report   models.Report       `json:"report" yaml:"report"`
+	ActingAs sdk.AccAddress      `json:"acting_as,omitempty" yaml:"acting_as,omitempty"`
+}
+
+// NewMsgReport returns a MsgReport object
+func NewMsgReport(target models.ReportTarget, repType, message string, user sdk.AccAddress) MsgReport {
+	return MsgReport{
+		Target: target,
+		Report: models.NewReport(repType, message, user),
+	}
+}
+
+// WithActingAs returns a copy of msg that performs the action on behalf of the given profile owner,
+// who must have authorized the signer to do so
+func (msg MsgReport) WithActingAs(owner sdk.AccAddress) MsgReport {
+	msg.ActingAs = owner
+	return msg
+}
+
+// Route should return the name of the module
+func (msg MsgReport) Route() string { return models.RouterKey }
+
+// Type should return the action
+func (msg MsgReport) Type() string { return models.ActionReport }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgReport) ValidateBasic() error {
+	if err := msg.Target.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := msg.Report.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if !msg.ActingAs.Empty() && msg.ActingAs.Equals(msg.Report.User) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the reporter")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgReport) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgsCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgReport) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Report.User}
+}
+
+// ----------------------
+// --- MsgResolveReport
+// ----------------------
+
+// MsgResolveReport defines the message used by a moderator to resolve a report
+type MsgResolveReport struct {
+	ReportID  uint64              `json:"report_id" yaml:"report_id"`
+	Status    models.ReportStatus `json:"status" yaml:"status"`
+	Note      string              `json:"note" yaml:"note"`
+	Moderator sdk.AccAddress      `json:"moderator" yaml:"moderator"`
+}
+
+// NewMsgResolveReport returns a MsgResolveReport object
+func NewMsgResolveReport(reportID uint64, status models.ReportStatus, note string, moderator sdk.AccAddress) MsgResolveReport {
+	return MsgResolveReport{
+		ReportID:  reportID,
+		Status:    status,
+		Note:      note,
+		Moderator: moderator,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgResolveReport) Route() string { return models.RouterKey }
+
+// Type should return the action
+func (msg MsgResolveReport) Type() string { return models.ActionResolveReport }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgResolveReport) ValidateBasic() error {
+	if msg.ReportID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid report id: 0")
+	}
+
+	if !msg.Status.IsResolution() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid resolution status: %s", msg.Status))
+	}
+
+	if len(strings.TrimSpace(msg.Note)) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "resolution note cannot be empty")
+	}
+
+	if msg.Moderator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid moderator address: %s", msg.Moderator))
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgResolveReport) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgsCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgResolveReport) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Moderator}
+}
+
+// ----------------------
+// --- MsgUnhidePost
+// ----------------------
+
+// MsgUnhidePost defines the message used by a moderator to clear a post that has been
+// automatically hidden, dismissing all its open reports
+type MsgUnhidePost struct {
+	PostID    posts.PostID   `json:"post_id" yaml:"post_id"`
+	Note      string         `json:"note" yaml:"note"`
+	Moderator sdk.AccAddress `json:"moderator" yaml:"moderator"`
+}
+
+// NewMsgUnhidePost returns a MsgUnhidePost object
+func NewMsgUnhidePost(postID posts.PostID, note string, moderator sdk.AccAddress) MsgUnhidePost {
+	return MsgUnhidePost{
+		PostID:    postID,
+		Note:      note,
+		Moderator: moderator,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgUnhidePost) Route() string { return models.RouterKey }
+
+// Type should return the action
+func (msg MsgUnhidePost) Type() string { return models.ActionUnhidePost }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUnhidePost) ValidateBasic() error {
+	if !msg.PostID.Valid() {
+		return sdkerrors.Wrap(postserrors.ErrInvalidPostID, msg.PostID.String())
+	}
+
+	if len(strings.TrimSpace(msg.Note)) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "resolution note cannot be empty")
+	}
+
+	if msg.Moderator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid moderator address: %s", msg.Moderator))
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUnhidePost) GetSignBytes() []byte {
+	return sdk.MustSortJSON(MsgsCodec.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUnhidePost) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Moderator}
+}
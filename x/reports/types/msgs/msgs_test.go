@@ -87,3 +87,249 @@ func TestNewMsgReportPost_GetSigners(t *testing.T) {
 	require.Equal(t, 1, len(actual))
 	require.Equal(t, msgReport.Report.User, actual[0])
 }
+
+func TestMsgReport_Route(t *testing.T) {
+	creator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	msg := types.NewMsgReport(types.NewProfileTarget(creator), "type", "message", creator)
+	require.Equal(t, "reports", msg.Route())
+}
+
+func TestMsgReport_Type(t *testing.T) {
+	creator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	msg := types.NewMsgReport(types.NewProfileTarget(creator), "type", "message", creator)
+	require.Equal(t, "report", msg.Type())
+}
+
+func TestMsgReport_ValidateBasic(t *testing.T) {
+	creator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	profile, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	require.NoError(t, err)
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	tests := []struct {
+		name  string
+		msg   types.MsgReport
+		error error
+	}{
+		{
+			name:  "invalid target kind returns error",
+			msg:   types.NewMsgReport(types.NewReportTarget("comment", "123"), "type", "message", creator),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid report target kind: comment"),
+		},
+		{
+			name:  "invalid profile target returns error",
+			msg:   types.NewMsgReport(types.NewReportTarget(types.TargetKindProfile, "profile"), "type", "message", creator),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid profile target id: profile"),
+		},
+		{
+			name:  "invalid reports returns error",
+			msg:   types.NewMsgReport(types.NewProfileTarget(profile), "scam", "", creator),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "report message cannot be empty"),
+		},
+		{
+			name:  "acting as the reporter returns error",
+			msg:   types.NewMsgReport(types.NewProfileTarget(profile), "scam", "message", creator).WithActingAs(creator),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "acting as address must be different from the reporter"),
+		},
+		{
+			name:  "valid profile report returns no error",
+			msg:   types.NewMsgReport(types.NewProfileTarget(profile), "scam", "message", creator),
+			error: nil,
+		},
+		{
+			name:  "valid reaction report returns no error",
+			msg:   types.NewMsgReport(types.NewReactionTarget(":smile:", subspace), "scam", "message", creator),
+			error: nil,
+		},
+		{
+			name:  "valid subspace report returns no error",
+			msg:   types.NewMsgReport(types.NewSubspaceTarget(subspace), "scam", "message", creator),
+			error: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			returnedError := test.msg.ValidateBasic()
+			if test.error == nil {
+				require.Nil(t, returnedError)
+			} else {
+				require.NotNil(t, returnedError)
+				require.Equal(t, test.error.Error(), returnedError.Error())
+			}
+		})
+	}
+}
+
+func TestMsgReport_GetSignBytes(t *testing.T) {
+	creator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	msg := types.NewMsgReport(types.NewProfileTarget(creator), "type", "message", creator)
+
+	expected := `{"type":"desmos/MsgReport","value":{"report":{"message":"message","type":"type","user":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns"},"target":{"id":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","kind":"profile"}}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
+func TestMsgReport_GetSigners(t *testing.T) {
+	creator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	msg := types.NewMsgReport(types.NewProfileTarget(creator), "type", "message", creator)
+	require.Equal(t, []sdk.AccAddress{creator}, msg.GetSigners())
+}
+
+func TestMsgResolveReport_Route(t *testing.T) {
+	moderator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	msg := types.NewMsgResolveReport(1, types.ReportStatusDismissed, "note", moderator)
+	require.Equal(t, "reports", msg.Route())
+}
+
+func TestMsgResolveReport_Type(t *testing.T) {
+	moderator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	msg := types.NewMsgResolveReport(1, types.ReportStatusDismissed, "note", moderator)
+	require.Equal(t, "resolve_report", msg.Type())
+}
+
+func TestMsgResolveReport_ValidateBasic(t *testing.T) {
+	moderator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	tests := []struct {
+		name  string
+		msg   types.MsgResolveReport
+		error error
+	}{
+		{
+			name:  "invalid report ID returns error",
+			msg:   types.NewMsgResolveReport(0, types.ReportStatusDismissed, "note", moderator),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid report id: 0"),
+		},
+		{
+			name:  "open status returns error",
+			msg:   types.NewMsgResolveReport(1, types.ReportStatusOpen, "note", moderator),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid resolution status: open"),
+		},
+		{
+			name:  "empty note returns error",
+			msg:   types.NewMsgResolveReport(1, types.ReportStatusActioned, " ", moderator),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "resolution note cannot be empty"),
+		},
+		{
+			name:  "invalid moderator returns error",
+			msg:   types.NewMsgResolveReport(1, types.ReportStatusActioned, "note", nil),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid moderator address: "),
+		},
+		{
+			name:  "valid message returns no error",
+			msg:   types.NewMsgResolveReport(1, types.ReportStatusActioned, "note", moderator),
+			error: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			returnedError := test.msg.ValidateBasic()
+			if test.error == nil {
+				require.Nil(t, returnedError)
+			} else {
+				require.NotNil(t, returnedError)
+				require.Equal(t, test.error.Error(), returnedError.Error())
+			}
+		})
+	}
+}
+
+func TestMsgResolveReport_GetSignBytes(t *testing.T) {
+	moderator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	msg := types.NewMsgResolveReport(1, types.ReportStatusDismissed, "note", moderator)
+
+	expected := `{"type":"desmos/MsgResolveReport","value":{"moderator":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","note":"note","report_id":"1","status":"dismissed"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
+func TestMsgResolveReport_GetSigners(t *testing.T) {
+	moderator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	msg := types.NewMsgResolveReport(1, types.ReportStatusDismissed, "note", moderator)
+	require.Equal(t, []sdk.AccAddress{moderator}, msg.GetSigners())
+}
+
+func TestMsgUnhidePost_Route(t *testing.T) {
+	moderator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	msg := types.NewMsgUnhidePost("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af", "note", moderator)
+	require.Equal(t, "reports", msg.Route())
+}
+
+func TestMsgUnhidePost_Type(t *testing.T) {
+	moderator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	msg := types.NewMsgUnhidePost("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af", "note", moderator)
+	require.Equal(t, "unhide_post", msg.Type())
+}
+
+func TestMsgUnhidePost_ValidateBasic(t *testing.T) {
+	moderator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	postID := posts.PostID("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af")
+	tests := []struct {
+		name  string
+		msg   types.MsgUnhidePost
+		error error
+	}{
+		{
+			name:  "invalid post ID returns error",
+			msg:   types.NewMsgUnhidePost("1234", "note", moderator),
+			error: sdkerrors.Wrap(postserrors.ErrInvalidPostID, "1234"),
+		},
+		{
+			name:  "empty note returns error",
+			msg:   types.NewMsgUnhidePost(postID, " ", moderator),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "resolution note cannot be empty"),
+		},
+		{
+			name:  "invalid moderator returns error",
+			msg:   types.NewMsgUnhidePost(postID, "note", nil),
+			error: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid moderator address: "),
+		},
+		{
+			name:  "valid message returns no error",
+			msg:   types.NewMsgUnhidePost(postID, "note", moderator),
+			error: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			returnedError := test.msg.ValidateBasic()
+			if test.error == nil {
+				require.Nil(t, returnedError)
+			} else {
+				require.NotNil(t, returnedError)
+				require.Equal(t, test.error.Error(), returnedError.Error())
+			}
+		})
+	}
+}
+
+func TestMsgUnhidePost_GetSignBytes(t *testing.T) {
+	moderator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	msg := types.NewMsgUnhidePost("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af", "note", moderator)
+
+	expected := `{"type":"desmos/MsgUnhidePost","value":{"moderator":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","note":"note","post_id":"19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
+func TestMsgUnhidePost_GetSigners(t *testing.T) {
+	moderator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	msg := types.NewMsgUnhidePost("19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af", "note", moderator)
+	require.Equal(t, []sdk.AccAddress{moderator}, msg.GetSigners())
+}
@@ -0,0 +1,322 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramsModule "github.com/cosmos/cosmos-sdk/x/params/subspace"
+)
+
+const (
+	// default paramspace for paramsModule keeper
+	DefaultParamspace = ModuleName
+
+	// Reporters weighting strategies
+	WeightingNone       = "none"
+	WeightingStake      = "stake"
+	WeightingAccountAge = "account_age"
+)
+
+// Default reports params
+var (
+	DefaultReasons = Reasons{
+		NewReason("nudity", "The post contains nudity"),
+		NewReason("violence", "The post contains or incites violence"),
+		NewReason("intimidation", "The post intimidates or threatens someone"),
+		NewReason("suicide_or_self_harm", "The post promotes suicide or self-harm"),
+		NewReason("fake_news", "The post spreads false information"),
+		NewReason("spam", "The post is spam"),
+		NewReason("unauthorized_sale", "The post sells unauthorized goods"),
+		NewReason("hatred_incitement", "The post incites hatred"),
+		NewReason("promotion_of_drug_use", "The post promotes drug use"),
+		NewReason("non_consensual_intimate_images", "The post contains non-consensual intimate images"),
+		NewReason("pornography", "The post contains pornography"),
+		NewReason("children_abuse", "The post contains children abuse"),
+		NewReason("animals_abuse", "The post contains animals abuse"),
+		NewReason("bullying", "The post bullies someone"),
+		NewReason("scam", "The post is a scam"),
+		NewReason(ReasonOther, "The post violates the rules for a reason not listed"),
+	}
+
+	DefaultHidingThreshold  = sdk.NewDec(5)
+	DefaultHidingTimeWindow = time.Hour * 24
+	DefaultMinStake         = sdk.NewInt(1000000)
+	DefaultMinAccountAge    = time.Hour * 24 * 30
+
+	DefaultMaxReports      = sdk.NewInt(10)
+	DefaultRateLimitPeriod = time.Hour * 24
+	DefaultReportDeposit   = sdk.Coins(nil)
+	DefaultModerators      = []sdk.AccAddress(nil)
+)
+
+// Parameters store keys
+var (
+	ReasonsParamsKey = []byte("Reasons")
+	HidingParamsKey  = []byte("HidingParams")
+	RateLimitKey     = []byte("RateLimit")
+	ReportDepositKey = []byte("ReportDeposit")
+	ModeratorsKey    = []byte("Moderators")
+)
+
+// ParamKeyTable Key declaration for parameters
+func ParamKeyTable() paramsModule.KeyTable {
+	return paramsModule.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params contains the parameters of the reports module
+type Params struct {
+	Reasons       Reasons          `json:"reasons" yaml:"reasons"`
+	HidingParams  HidingParams     `json:"hiding_params" yaml:"hiding_params"`
+	RateLimit     RateLimitParams  `json:"rate_limit" yaml:"rate_limit"`
+	ReportDeposit sdk.Coins        `json:"report_deposit" yaml:"report_deposit"`
+	Moderators    []sdk.AccAddress `json:"moderators" yaml:"moderators"`
+}
+
+// NewParams creates a new Params obj
+func NewParams(
+	reasons Reasons, hidingParams HidingParams, rateLimit RateLimitParams, reportDeposit sdk.Coins,
+	moderators []sdk.AccAddress,
+) Params {
+	return Params{
+		Reasons:       reasons,
+		HidingParams:  hidingParams,
+		RateLimit:     rateLimit,
+		ReportDeposit: reportDeposit,
+		Moderators:    moderators,
+	}
+}
+
+// DefaultParams return default params object
+func DefaultParams() Params {
+	return Params{
+		Reasons:       DefaultReasons,
+		HidingParams:  DefaultHidingParams(),
+		RateLimit:     DefaultRateLimitParams(),
+		ReportDeposit: DefaultReportDeposit,
+		Moderators:    DefaultModerators,
+	}
+}
+
+// String implements Stringer
+func (params Params) String() string {
+	out := "Reports parameters:\n"
+	out += fmt.Sprintf("Reasons:\n%s\n%s\n%s\nReport deposit: %s\nModerators: %s\n",
+		params.Reasons,
+		params.HidingParams,
+		params.RateLimit,
+		params.ReportDeposit,
+		params.Moderators,
+	)
+
+	return strings.TrimSpace(out)
+}
+
+// ParamSetPairs implements the ParamSet interface and returns the key/value pairs
+// of reports module's parameters.
+func (params *Params) ParamSetPairs() paramsModule.ParamSetPairs {
+	return paramsModule.ParamSetPairs{
+		paramsModule.NewParamSetPair(ReasonsParamsKey, &params.Reasons, ValidateReasonsParam),
+		paramsModule.NewParamSetPair(HidingParamsKey, &params.HidingParams, ValidateHidingParams),
+		paramsModule.NewParamSetPair(RateLimitKey, &params.RateLimit, ValidateRateLimitParams),
+		paramsModule.NewParamSetPair(ReportDepositKey, &params.ReportDeposit, ValidateReportDepositParam),
+		paramsModule.NewParamSetPair(ModeratorsKey, &params.Moderators, ValidateModeratorsParam),
+	}
+}
+
+// Validate perform basic checks on all parameters to ensure they are correct
+func (params Params) Validate() error {
+	if err := ValidateReasonsParam(params.Reasons); err != nil {
+		return err
+	}
+
+	if err := ValidateHidingParams(params.HidingParams); err != nil {
+		return err
+	}
+
+	if err := ValidateRateLimitParams(params.RateLimit); err != nil {
+		return err
+	}
+
+	if err := ValidateReportDepositParam(params.ReportDeposit); err != nil {
+		return err
+	}
+
+	return ValidateModeratorsParam(params.Moderators)
+}
+
+func ValidateReasonsParam(i interface{}) error {
+	reasons, isCorrectParam := i.(Reasons)
+
+	if !isCorrectParam {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	if err := reasons.Validate(); err != nil {
+		return fmt.Errorf("invalid reasons param: %s", err)
+	}
+
+	return nil
+}
+
+// HidingParams defines the parameters used to automatically hide the posts that
+// have been reported by too many users within a time window.
+// Each distinct reporter has a weight between 0 and 1, which depends on the weighting strategy:
+// - none: all the reporters have weight 1
+// - stake: the weight is the ratio between the reporter's delegated tokens and MinStake
+// - account_age: the weight is the ratio between the reporter's profile age and MinAccountAge
+type HidingParams struct {
+	Threshold     sdk.Dec       `json:"threshold" yaml:"threshold"`
+	TimeWindow    time.Duration `json:"time_window" yaml:"time_window"`
+	Weighting     string        `json:"weighting" yaml:"weighting"`
+	MinStake      sdk.Int       `json:"min_stake" yaml:"min_stake"`
+	MinAccountAge time.Duration `json:"min_account_age" yaml:"min_account_age"`
+}
+
+// NewHidingParams creates a new HidingParams obj
+func NewHidingParams(
+	threshold sdk.Dec, timeWindow time.Duration, weighting string, minStake sdk.Int, minAccountAge time.Duration,
+) HidingParams {
+	return HidingParams{
+		Threshold:     threshold,
+		TimeWindow:    timeWindow,
+		Weighting:     weighting,
+		MinStake:      minStake,
+		MinAccountAge: minAccountAge,
+	}
+}
+
+// DefaultHidingParams return default hiding params
+func DefaultHidingParams() HidingParams {
+	return NewHidingParams(DefaultHidingThreshold, DefaultHidingTimeWindow,
+		WeightingNone, DefaultMinStake, DefaultMinAccountAge)
+}
+
+// IsEnabled tells whether the automatic hiding of the posts is enabled or not
+func (params HidingParams) IsEnabled() bool {
+	return params.Threshold.IsPositive()
+}
+
+// String implements Stringer
+func (params HidingParams) String() string {
+	out := "Hiding params:\n"
+	out += fmt.Sprintf("Threshold: %s\nTime window: %s\nWeighting: %s\nMin stake: %s\nMin account age: %s",
+		params.Threshold,
+		params.TimeWindow,
+		params.Weighting,
+		params.MinStake,
+		params.MinAccountAge,
+	)
+
+	return strings.TrimSpace(out)
+}
+
+func ValidateHidingParams(i interface{}) error {
+	params, isHidingParams := i.(HidingParams)
+	if !isHidingParams {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	if params.Threshold.IsNil() || params.Threshold.IsNegative() {
+		return fmt.Errorf("invalid hiding threshold param: %s", params.Threshold)
+	}
+
+	if params.TimeWindow <= 0 {
+		return fmt.Errorf("invalid hiding time window param: %s", params.TimeWindow)
+	}
+
+	switch params.Weighting {
+	case WeightingNone:
+	case WeightingStake:
+		if !params.MinStake.IsPositive() {
+			return fmt.Errorf("invalid hiding min stake param: %s", params.MinStake)
+		}
+	case WeightingAccountAge:
+		if params.MinAccountAge <= 0 {
+			return fmt.Errorf("invalid hiding min account age param: %s", params.MinAccountAge)
+		}
+	default:
+		return fmt.Errorf("invalid hiding weighting param: %s", params.Weighting)
+	}
+
+	return nil
+}
+
+// RateLimitParams defines the maximum number of reports that each account can create within a period
+type RateLimitParams struct {
+	MaxReports sdk.Int       `json:"max_reports" yaml:"max_reports"`
+	Period     time.Duration `json:"period" yaml:"period"`
+}
+
+// NewRateLimitParams creates a new RateLimitParams obj
+func NewRateLimitParams(maxReports sdk.Int, period time.Duration) RateLimitParams {
+	return RateLimitParams{
+		MaxReports: maxReports,
+		Period:     period,
+	}
+}
+
+// DefaultRateLimitParams return default rate limit params
+func DefaultRateLimitParams() RateLimitParams {
+	return NewRateLimitParams(DefaultMaxReports, DefaultRateLimitPeriod)
+}
+
+// String implements Stringer
+func (params RateLimitParams) String() string {
+	out := "Rate limit params:\n"
+	out += fmt.Sprintf("Max reports: %s\nPeriod: %s", params.MaxReports, params.Period)
+
+	return strings.TrimSpace(out)
+}
+
+func ValidateRateLimitParams(i interface{}) error {
+	params, isRateLimitParams := i.(RateLimitParams)
+	if !isRateLimitParams {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	if !params.MaxReports.IsPositive() {
+		return fmt.Errorf("invalid rate limit max reports param: %s", params.MaxReports)
+	}
+
+	if params.Period <= 0 {
+		return fmt.Errorf("invalid rate limit period param: %s", params.Period)
+	}
+
+	return nil
+}
+
+func ValidateReportDepositParam(i interface{}) error {
+	deposit, isCoins := i.(sdk.Coins)
+	if !isCoins {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	if !deposit.IsValid() {
+		return fmt.Errorf("invalid report deposit param: %s", deposit)
+	}
+
+	return nil
+}
+
+func ValidateModeratorsParam(i interface{}) error {
+	moderators, isAddresses := i.([]sdk.AccAddress)
+	if !isAddresses {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	seen := map[string]bool{}
+	for _, moderator := range moderators {
+		if moderator.Empty() {
+			return fmt.Errorf("invalid moderator address: %s", moderator)
+		}
+
+		if seen[moderator.String()] {
+			return fmt.Errorf("duplicated moderator address: %s", moderator)
+		}
+		seen[moderator.String()] = true
+	}
+
+	return nil
+}
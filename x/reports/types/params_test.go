@@ -0,0 +1,224 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/reports/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultParams(t *testing.T) {
+	params := types.NewParams(
+		types.DefaultReasons,
+		types.DefaultHidingParams(),
+		types.DefaultRateLimitParams(),
+		types.DefaultReportDeposit,
+		types.DefaultModerators,
+	)
+	require.Equal(t, params, types.DefaultParams())
+}
+
+func TestParams_String(t *testing.T) {
+	params := types.NewParams(types.Reasons{
+		types.NewReason("spam", "The post is spam"),
+		types.NewReason(types.ReasonOther, "Other"),
+	}, types.DefaultHidingParams(), types.DefaultRateLimitParams(), sdk.NewCoins(sdk.NewInt64Coin("desmos", 10)), nil)
+	require.Equal(t, "Reports parameters:\nReasons:\nID - Description\nspam - The post is spam\nother - Other\n"+
+		"Hiding params:\nThreshold: 5.000000000000000000\nTime window: 24h0m0s\nWeighting: none\nMin stake: 1000000\nMin account age: 720h0m0s\n"+
+		"Rate limit params:\nMax reports: 10\nPeriod: 24h0m0s\nReport deposit: 10desmos\nModerators: []",
+		params.String())
+}
+
+func TestValidateParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params types.Params
+		expErr error
+	}{
+		{
+			name: "invalid reason returns error",
+			params: types.NewParams(
+				types.Reasons{types.NewReason("Spam", "The post is spam")},
+				types.DefaultHidingParams(), types.DefaultRateLimitParams(), types.DefaultReportDeposit,
+				types.DefaultModerators,
+			),
+			expErr: fmt.Errorf("invalid reasons param: invalid reason id: Spam"),
+		},
+		{
+			name: "missing other reason returns error",
+			params: types.NewParams(
+				types.Reasons{types.NewReason("spam", "The post is spam")},
+				types.DefaultHidingParams(), types.DefaultRateLimitParams(), types.DefaultReportDeposit,
+				types.DefaultModerators,
+			),
+			expErr: fmt.Errorf("invalid reasons param: reasons must contain the other reason"),
+		},
+		{
+			name: "invalid hiding params returns error",
+			params: types.NewParams(
+				types.DefaultReasons, types.HidingParams{}, types.DefaultRateLimitParams(), types.DefaultReportDeposit,
+				types.DefaultModerators,
+			),
+			expErr: fmt.Errorf("invalid hiding threshold param: <nil>"),
+		},
+		{
+			name: "invalid rate limit params returns error",
+			params: types.NewParams(
+				types.DefaultReasons, types.DefaultHidingParams(),
+				types.NewRateLimitParams(sdk.ZeroInt(), time.Hour), types.DefaultReportDeposit,
+				types.DefaultModerators,
+			),
+			expErr: fmt.Errorf("invalid rate limit max reports param: 0"),
+		},
+		{
+			name: "invalid report deposit returns error",
+			params: types.NewParams(
+				types.DefaultReasons, types.DefaultHidingParams(),
+				types.DefaultRateLimitParams(), sdk.Coins{sdk.Coin{Denom: "desmos", Amount: sdk.ZeroInt()}},
+				types.DefaultModerators,
+			),
+			expErr: fmt.Errorf("invalid report deposit param: 0desmos"),
+		},
+		{
+			name: "invalid moderators returns error",
+			params: types.NewParams(
+				types.DefaultReasons, types.DefaultHidingParams(),
+				types.DefaultRateLimitParams(), types.DefaultReportDeposit, []sdk.AccAddress{{}},
+			),
+			expErr: fmt.Errorf("invalid moderator address: "),
+		},
+		{
+			name:   "valid params returns no error",
+			params: types.DefaultParams(),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.params.Validate())
+		})
+	}
+}
+
+func TestValidateReasonsParam(t *testing.T) {
+	require.Equal(t, fmt.Errorf("invalid parameters type: %s", "reasons"), types.ValidateReasonsParam("reasons"))
+	require.NoError(t, types.ValidateReasonsParam(types.DefaultReasons))
+}
+
+func TestValidateHidingParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params types.HidingParams
+		expErr error
+	}{
+		{
+			name:   "negative threshold returns error",
+			params: types.NewHidingParams(sdk.NewDec(-1), time.Hour, types.WeightingNone, sdk.NewInt(1), time.Hour),
+			expErr: fmt.Errorf("invalid hiding threshold param: -1.000000000000000000"),
+		},
+		{
+			name:   "invalid time window returns error",
+			params: types.NewHidingParams(sdk.NewDec(5), 0, types.WeightingNone, sdk.NewInt(1), time.Hour),
+			expErr: fmt.Errorf("invalid hiding time window param: 0s"),
+		},
+		{
+			name:   "invalid weighting returns error",
+			params: types.NewHidingParams(sdk.NewDec(5), time.Hour, "karma", sdk.NewInt(1), time.Hour),
+			expErr: fmt.Errorf("invalid hiding weighting param: karma"),
+		},
+		{
+			name:   "invalid min stake returns error",
+			params: types.NewHidingParams(sdk.NewDec(5), time.Hour, types.WeightingStake, sdk.ZeroInt(), time.Hour),
+			expErr: fmt.Errorf("invalid hiding min stake param: 0"),
+		},
+		{
+			name:   "invalid min account age returns error",
+			params: types.NewHidingParams(sdk.NewDec(5), time.Hour, types.WeightingAccountAge, sdk.NewInt(1), 0),
+			expErr: fmt.Errorf("invalid hiding min account age param: 0s"),
+		},
+		{
+			name:   "zero threshold returns no error",
+			params: types.NewHidingParams(sdk.ZeroDec(), time.Hour, types.WeightingNone, sdk.NewInt(1), time.Hour),
+			expErr: nil,
+		},
+		{
+			name:   "valid params returns no error",
+			params: types.NewHidingParams(sdk.NewDec(5), time.Hour, types.WeightingStake, sdk.NewInt(1), time.Hour),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, types.ValidateHidingParams(test.params))
+		})
+	}
+
+	require.Equal(t, fmt.Errorf("invalid parameters type: %s", "params"), types.ValidateHidingParams("params"))
+}
+
+func TestValidateRateLimitParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params types.RateLimitParams
+		expErr error
+	}{
+		{
+			name:   "invalid max reports returns error",
+			params: types.NewRateLimitParams(sdk.NewInt(-1), time.Hour),
+			expErr: fmt.Errorf("invalid rate limit max reports param: -1"),
+		},
+		{
+			name:   "invalid period returns error",
+			params: types.NewRateLimitParams(sdk.NewInt(10), 0),
+			expErr: fmt.Errorf("invalid rate limit period param: 0s"),
+		},
+		{
+			name:   "valid params returns no error",
+			params: types.NewRateLimitParams(sdk.NewInt(10), time.Hour),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, types.ValidateRateLimitParams(test.params))
+		})
+	}
+
+	require.Equal(t, fmt.Errorf("invalid parameters type: %s", "params"), types.ValidateRateLimitParams("params"))
+}
+
+func TestValidateReportDepositParam(t *testing.T) {
+	require.NoError(t, types.ValidateReportDepositParam(sdk.Coins(nil)))
+	require.NoError(t, types.ValidateReportDepositParam(sdk.NewCoins(sdk.NewInt64Coin("desmos", 10))))
+	require.Equal(t,
+		fmt.Errorf("invalid report deposit param: -10desmos"),
+		types.ValidateReportDepositParam(sdk.Coins{sdk.Coin{Denom: "desmos", Amount: sdk.NewInt(-10)}}),
+	)
+	require.Equal(t, fmt.Errorf("invalid parameters type: %s", "params"), types.ValidateReportDepositParam("params"))
+}
+
+func TestValidateModeratorsParam(t *testing.T) {
+	moderator, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+
+	require.NoError(t, types.ValidateModeratorsParam([]sdk.AccAddress(nil)))
+	require.NoError(t, types.ValidateModeratorsParam([]sdk.AccAddress{moderator}))
+	require.Equal(t,
+		fmt.Errorf("invalid moderator address: "),
+		types.ValidateModeratorsParam([]sdk.AccAddress{moderator, {}}),
+	)
+	require.Equal(t,
+		fmt.Errorf("duplicated moderator address: %s", moderator),
+		types.ValidateModeratorsParam([]sdk.AccAddress{moderator, moderator}),
+	)
+	require.Equal(t, fmt.Errorf("invalid parameters type: %s", "params"), types.ValidateModeratorsParam("params"))
+}
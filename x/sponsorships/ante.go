@@ -0,0 +1,96 @@
+package sponsorships
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/keeper"
+)
+
+// SponsoredFeeDecorator deducts the fees of the transactions, replacing the standard DeductFeeDecorator.
+// When all the messages of a transaction are allowed to be sponsored and the fee payer has been granted a usable
+// fee allowance, the fees are paid using the sponsorship funds deposited by the granter.
+// Otherwise, the fees are deducted from the fee payer account as usual
+type SponsoredFeeDecorator struct {
+	keeper       keeper.Keeper
+	ak           auth.AccountKeeper
+	supplyKeeper supply.Keeper
+	allowedMsgs  map[string]bool
+}
+
+// NewSponsoredFeeDecorator returns a new SponsoredFeeDecorator allowing to sponsor the fees of the messages
+// having the same route and type of the given ones
+func NewSponsoredFeeDecorator(
+	k keeper.Keeper, ak auth.AccountKeeper, sk supply.Keeper, allowedMsgs ...sdk.Msg,
+) SponsoredFeeDecorator {
+	allowed := make(map[string]bool, len(allowedMsgs))
+	for _, msg := range allowedMsgs {
+		allowed[msgKey(msg)] = true
+	}
+
+	return SponsoredFeeDecorator{
+		keeper:       k,
+		ak:           ak,
+		supplyKeeper: sk,
+		allowedMsgs:  allowed,
+	}
+}
+
+// msgKey returns the key identifying the kind of the given message
+func msgKey(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+// canBeSponsored tells whether all the given messages are allowed to be sponsored
+func (sfd SponsoredFeeDecorator) canBeSponsored(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		if !sfd.allowedMsgs[msgKey(msg)] {
+			return false
+		}
+	}
+	return true
+}
+
+func (sfd SponsoredFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(ante.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if addr := sfd.supplyKeeper.GetModuleAddress(auth.FeeCollectorName); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", auth.FeeCollectorName))
+	}
+
+	feePayer := feeTx.FeePayer()
+	feePayerAcc := sfd.ak.GetAccount(ctx, feePayer)
+	if feePayerAcc == nil {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrUnknownAddress,
+			fmt.Sprintf("fee payer address: %s does not exist", feePayer))
+	}
+
+	fees := feeTx.GetFee()
+	if fees.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	// Pay the fees using a fee allowance, if possible
+	if sfd.canBeSponsored(feeTx.GetMsgs()) {
+		if allowance, found := sfd.keeper.GetUsableFeeAllowance(ctx, feePayer, fees); found {
+			if err := sfd.keeper.UseFeeAllowance(ctx, allowance, fees); err != nil {
+				return ctx, err
+			}
+			return next(ctx, tx, simulate)
+		}
+	}
+
+	if err := ante.DeductFees(sfd.supplyKeeper, ctx, feePayerAcc, fees); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
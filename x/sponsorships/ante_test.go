@@ -0,0 +1,197 @@
+package sponsorships_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+
+	"github.com/desmos-labs/desmos/x/sponsorships"
+	"github.com/desmos-labs/desmos/x/sponsorships/keeper"
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+type anteTestData struct {
+	ctx          sdk.Context
+	keeper       keeper.Keeper
+	ak           auth.AccountKeeper
+	supplyKeeper supply.Keeper
+}
+
+func setupAnteTest(t *testing.T) anteTestData {
+	sponsorshipsKey := sdk.NewKVStoreKey(types.StoreKey)
+	authKey := sdk.NewKVStoreKey(auth.StoreKey)
+	supplyKey := sdk.NewKVStoreKey(supply.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	memDB := db.NewMemDB()
+	ms := store.NewCommitMultiStore(memDB)
+	ms.MountStoreWithDB(sponsorshipsKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, memDB)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, memDB)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	cdc.Seal()
+
+	header := abci.Header{ChainID: "test-chain-id", Height: 10, Time: time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)}
+	ctx := sdk.NewContext(ms, header, false, log.NewNopLogger())
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, paramsTKey)
+	ak := auth.NewAccountKeeper(cdc, authKey, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	ak.SetParams(ctx, auth.DefaultParams())
+	bankKeeper := bank.NewBaseKeeper(ak, paramsKeeper.Subspace(bank.DefaultParamspace), nil)
+	supplyKeeper := supply.NewKeeper(cdc, supplyKey, ak, bankKeeper, map[string][]string{
+		auth.FeeCollectorName: nil,
+		types.ModuleName:      nil,
+	})
+	supplyKeeper.SetModuleAccount(ctx, supply.NewEmptyModuleAccount(auth.FeeCollectorName))
+	supplyKeeper.SetModuleAccount(ctx, supply.NewEmptyModuleAccount(types.ModuleName))
+
+	return anteTestData{
+		ctx:          ctx,
+		keeper:       keeper.NewKeeper(ak, supplyKeeper, cdc, sponsorshipsKey),
+		ak:           ak,
+		supplyKeeper: supplyKeeper,
+	}
+}
+
+type nextCalledKey struct{}
+
+// terminalAnteHandler marks the context so that the tests can tell whether the next decorator has been called
+func terminalAnteHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx.WithValue(nextCalledKey{}, true), nil
+}
+
+func TestSponsoredFeeDecorator_AnteHandle(t *testing.T) {
+	subspace := "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	owner, err := sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	require.NoError(t, err)
+	grantee, err := sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	require.NoError(t, err)
+
+	sponsorship := types.NewSponsorship(subspace, owner, sdk.NewCoins(sdk.NewInt64Coin("udaric", 1000)))
+	allowance := types.NewFeeAllowance(subspace, owner, grantee, sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)),
+		time.Date(2020, 1, 2, 12, 0, 0, 0, time.UTC))
+
+	// MsgRevokeFeeAllowance is allowed to be sponsored, while MsgWithdrawSponsorship is not
+	allowedMsg := types.NewMsgRevokeFeeAllowance(subspace, grantee, owner)
+	notAllowedMsg := types.NewMsgWithdrawSponsorship(subspace, grantee, sdk.NewCoins(sdk.NewInt64Coin("udaric", 1)))
+
+	fee := auth.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("udaric", 10)))
+	newTx := func(fee auth.StdFee, msgs ...sdk.Msg) sdk.Tx {
+		return auth.NewStdTx(msgs, fee, []auth.StdSignature{{PubKey: secp256k1.GenPrivKey().PubKey()}}, "")
+	}
+
+	tests := []struct {
+		name              string
+		tx                sdk.Tx
+		storedAllowance   bool
+		expErr            error
+		expGranteeBal     sdk.Coins
+		expSponsorshipBal sdk.Coins
+		expSpent          sdk.Coins
+	}{
+		{
+			name:              "Zero fees are not deducted",
+			tx:                newTx(auth.NewStdFee(200000, nil), allowedMsg),
+			storedAllowance:   true,
+			expGranteeBal:     sdk.NewCoins(sdk.NewInt64Coin("udaric", 50)),
+			expSponsorshipBal: sponsorship.Balance,
+		},
+		{
+			name:              "Fees are deducted from the fee payer without an allowance",
+			tx:                newTx(fee, allowedMsg),
+			expGranteeBal:     sdk.NewCoins(sdk.NewInt64Coin("udaric", 40)),
+			expSponsorshipBal: sponsorship.Balance,
+		},
+		{
+			name:              "Fees of not allowed messages are deducted from the fee payer",
+			tx:                newTx(fee, allowedMsg, notAllowedMsg),
+			storedAllowance:   true,
+			expGranteeBal:     sdk.NewCoins(sdk.NewInt64Coin("udaric", 40)),
+			expSponsorshipBal: sponsorship.Balance,
+		},
+		{
+			name:              "Fees exceeding the spend limit are deducted from the fee payer",
+			tx:                newTx(auth.NewStdFee(200000, sdk.NewCoins(sdk.NewInt64Coin("udaric", 101))), allowedMsg),
+			storedAllowance:   true,
+			expErr:            sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "insufficient funds to pay for fees; 50udaric < 101udaric"),
+			expSponsorshipBal: sponsorship.Balance,
+		},
+		{
+			name:              "Fees are paid by the sponsorship with a usable allowance",
+			tx:                newTx(fee, allowedMsg),
+			storedAllowance:   true,
+			expGranteeBal:     sdk.NewCoins(sdk.NewInt64Coin("udaric", 50)),
+			expSponsorshipBal: sdk.NewCoins(sdk.NewInt64Coin("udaric", 990)),
+			expSpent:          fee.Amount,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			data := setupAnteTest(t)
+			ctx := data.ctx
+
+			granteeAcc := data.ak.NewAccountWithAddress(ctx, grantee)
+			require.NoError(t, granteeAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin("udaric", 50))))
+			data.ak.SetAccount(ctx, granteeAcc)
+
+			moduleAcc := data.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+			require.NoError(t, moduleAcc.SetCoins(sponsorship.Balance))
+			data.supplyKeeper.SetModuleAccount(ctx, moduleAcc)
+			data.keeper.SaveSponsorship(ctx, sponsorship)
+
+			if test.storedAllowance {
+				data.keeper.SaveFeeAllowance(ctx, allowance)
+			}
+
+			decorator := sponsorships.NewSponsoredFeeDecorator(data.keeper, data.ak, data.supplyKeeper,
+				types.MsgRevokeFeeAllowance{})
+			newCtx, err := decorator.AnteHandle(ctx, test.tx, false, terminalAnteHandler)
+
+			if test.expErr != nil {
+				require.Error(t, err)
+				require.Equal(t, test.expErr.Error(), err.Error())
+				return
+			}
+
+			require.NoError(t, err)
+			require.NotNil(t, newCtx.Value(nextCalledKey{}))
+			require.Equal(t, test.expGranteeBal, data.ak.GetAccount(ctx, grantee).GetCoins())
+
+			stored, found := data.keeper.GetSponsorship(ctx, subspace)
+			require.True(t, found)
+			require.Equal(t, test.expSponsorshipBal, stored.Balance)
+
+			if test.storedAllowance {
+				storedAllowance, found := data.keeper.GetFeeAllowance(ctx, subspace, grantee)
+				require.True(t, found)
+				require.Equal(t, test.expSpent, storedAllowance.Spent)
+			}
+		})
+	}
+}
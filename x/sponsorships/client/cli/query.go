@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+// GetQueryCmd adds the query commands
+func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	sponsorshipsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the sponsorships module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	sponsorshipsQueryCmd.AddCommand(flags.GetCommands(
+		GetCmdSponsorship(storeKey, cdc),
+		GetCmdSubspaceAllowances(storeKey, cdc),
+		GetCmdGranteeAllowances(storeKey, cdc),
+	)...)
+	return sponsorshipsQueryCmd
+}
+
+// GetCmdSponsorship queries the sponsorship of a subspace
+func GetCmdSponsorship(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sponsorship [subspace]",
+		Short: "Returns the sponsorship of the given subspace, if any.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QuerySponsorship, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf("Could not find the sponsorship of subspace %s \n", args[0])
+				return nil
+			}
+
+			var out types.Sponsorship
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdSubspaceAllowances queries the fee allowances granted inside a subspace
+func GetCmdSubspaceAllowances(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "subspace-allowances [subspace]",
+		Short: "Returns the fee allowances granted inside the given subspace",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QuerySubspaceAllowances, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.FeeAllowances
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGranteeAllowances queries the fee allowances granted to an address
+func GetCmdGranteeAllowances(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grantee-allowances [address]",
+		Short: "Returns the fee allowances granted to the given address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryGranteeAllowances, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.FeeAllowances
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
@@ -0,0 +1,149 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+// GetTxCmd set the tx commands
+func GetTxCmd(_ string, cdc *codec.Codec) *cobra.Command {
+	sponsorshipsTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Sponsorships transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	sponsorshipsTxCmd.AddCommand(flags.PostCommands(
+		GetCmdDepositSponsorship(cdc),
+		GetCmdWithdrawSponsorship(cdc),
+		GetCmdGrantFeeAllowance(cdc),
+		GetCmdRevokeFeeAllowance(cdc),
+	)...)
+
+	return sponsorshipsTxCmd
+}
+
+// GetCmdDepositSponsorship is the CLI command for depositing funds inside the sponsorship of a subspace
+func GetCmdDepositSponsorship(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit [subspace] [amount]",
+		Short: "Deposits the given amount inside the sponsorship of the subspace",
+		Long: `Deposits the given amount inside the sponsorship of the subspace, which is used to pay the fees
+of the users to which a fee allowance has been granted.
+The first account depositing inside the sponsorship of a subspace becomes its owner, and is the only one
+that can deposit, withdraw and grant fee allowances afterwards.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositSponsorship(args[0], cliCtx.FromAddress, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdWithdrawSponsorship is the CLI command for withdrawing funds from the sponsorship of a subspace
+func GetCmdWithdrawSponsorship(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw [subspace] [amount]",
+		Short: "Withdraws the given amount from the sponsorship of the subspace, which must be owned by the signer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawSponsorship(args[0], cliCtx.FromAddress, amount)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdGrantFeeAllowance is the CLI command for granting a fee allowance inside a subspace
+func GetCmdGrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grant [subspace] [grantee] [spend limit] [expiration]",
+		Short: "Grants a fee allowance to the grantee using the sponsorship of the subspace",
+		Long: fmt.Sprintf(`Grants a fee allowance to the grantee using the sponsorship of the subspace, which must be owned by the signer.
+The grantee will have the fees of its Desmos transactions paid by the sponsorship, up to the given spend limit
+and until the given expiration, which must be a RFC3339 date.
+Granting a new allowance to the same grantee replaces the existing one.
+
+E.g.
+%s tx sponsorships grant 4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e desmos1w3fe8zq5jrxd4nz49hllg75sw7m24qyc7tnaax 10000udaric 2020-12-31T00:00:00Z
+`, version.ClientName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			spendLimit, err := sdk.ParseCoins(args[2])
+			if err != nil {
+				return err
+			}
+
+			expiration, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return fmt.Errorf("invalid expiration: %s", args[3])
+			}
+
+			msg := types.NewMsgGrantFeeAllowance(args[0], cliCtx.FromAddress, grantee, spendLimit, expiration)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRevokeFeeAllowance is the CLI command for revoking a fee allowance previously granted inside a subspace
+func GetCmdRevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [subspace] [grantee]",
+		Short: "Revokes the fee allowance granted by the signer to the grantee inside the subspace",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(args[0], cliCtx.FromAddress, grantee)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,215 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/gorilla/mux"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
+	r.HandleFunc("/sponsorships/allowances/{address}", getAllowancesHandler(cliCtx, storeName, types.QueryGranteeAllowances, "address")).Methods("GET")
+	r.HandleFunc("/sponsorships/{subspace}", getSponsorshipHandler(cliCtx, storeName)).Methods("GET")
+	r.HandleFunc("/sponsorships/{subspace}/allowances", getAllowancesHandler(cliCtx, storeName, types.QuerySubspaceAllowances, "subspace")).Methods("GET")
+	r.HandleFunc("/sponsorships/{subspace}/deposit", depositSponsorshipHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/sponsorships/{subspace}/withdraw", withdrawSponsorshipHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/sponsorships/{subspace}/allowances", grantFeeAllowanceHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/sponsorships/{subspace}/allowances/{grantee}/revoke", revokeFeeAllowanceHandler(cliCtx)).Methods("POST")
+}
+
+// --------------------------------------------------------------------------------------
+// Tx Handler
+
+type sponsorshipAmountReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Owner   string       `json:"owner"`
+	Amount  sdk.Coins    `json:"amount"`
+}
+
+// readSponsorshipAmountReq reads the request used to deposit or withdraw funds from a sponsorship,
+// returning false if the request is not valid
+func readSponsorshipAmountReq(
+	w http.ResponseWriter, r *http.Request, cliCtx context.CLIContext,
+) (sponsorshipAmountReq, rest.BaseReq, sdk.AccAddress, bool) {
+	var req sponsorshipAmountReq
+
+	if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+		return req, rest.BaseReq{}, nil, false
+	}
+
+	baseReq := req.BaseReq.Sanitize()
+	if !baseReq.ValidateBasic(w) {
+		return req, baseReq, nil, false
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return req, baseReq, nil, false
+	}
+
+	return req, baseReq, addr, true
+}
+
+func depositSponsorshipHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, baseReq, addr, ok := readSponsorshipAmountReq(w, r, cliCtx)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgDepositSponsorship(mux.Vars(r)["subspace"], addr, req.Amount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+func withdrawSponsorshipHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		req, baseReq, addr, ok := readSponsorshipAmountReq(w, r, cliCtx)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgWithdrawSponsorship(mux.Vars(r)["subspace"], addr, req.Amount)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type grantFeeAllowanceReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	Granter    string       `json:"granter"`
+	Grantee    string       `json:"grantee"`
+	SpendLimit sdk.Coins    `json:"spend_limit"`
+	Expiration time.Time    `json:"expiration"`
+}
+
+func grantFeeAllowanceHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req grantFeeAllowanceReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.Granter)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgGrantFeeAllowance(mux.Vars(r)["subspace"], granter, grantee, req.SpendLimit, req.Expiration)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type revokeFeeAllowanceReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Granter string       `json:"granter"`
+}
+
+func revokeFeeAllowanceHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req revokeFeeAllowanceReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		granter, err := sdk.AccAddressFromBech32(req.Granter)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		vars := mux.Vars(r)
+		grantee, err := sdk.AccAddressFromBech32(vars["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgRevokeFeeAllowance(vars["subspace"], granter, grantee)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+// --------------------------------------------------------------------------------------
+// Query Handlers
+
+func getSponsorshipHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		subspace := mux.Vars(r)["subspace"]
+
+		route := fmt.Sprintf("custom/%s/%s/%s", storeName, types.QuerySponsorship, subspace)
+		res, _, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// getAllowancesHandler returns the handler used to query the list of fee allowances using the given query path,
+// whose parameter is read from the given route variable
+func getAllowancesHandler(cliCtx context.CLIContext, storeName, query, variable string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s/%s", storeName, query, mux.Vars(r)[variable])
+		res, _, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
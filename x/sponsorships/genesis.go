@@ -0,0 +1,33 @@
+package sponsorships
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/keeper"
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+// ExportGenesis returns the GenesisState associated with the given context
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.GenesisState{
+		Sponsorships: k.GetSponsorships(ctx),
+		Allowances:   k.GetFeeAllowances(ctx),
+	}
+}
+
+// InitGenesis initializes the chain state based on the given GenesisState
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) []abci.ValidatorUpdate {
+	// Make sure the module account exists
+	keeper.SupplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	for _, sponsorship := range data.Sponsorships {
+		keeper.SaveSponsorship(ctx, sponsorship)
+	}
+
+	for _, allowance := range data.Allowances {
+		keeper.SaveFeeAllowance(ctx, allowance)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/desmos-labs/desmos/x/sponsorships/keeper"
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	cdc          *codec.Codec
+	ctx          sdk.Context
+	keeper       keeper.Keeper
+	ak           auth.AccountKeeper
+	supplyKeeper supply.Keeper
+	ms           store.CommitMultiStore
+	testData     TestData
+}
+
+type TestData struct {
+	subspace    string
+	owner       sdk.AccAddress
+	grantee     sdk.AccAddress
+	sponsorship types.Sponsorship
+	allowance   types.FeeAllowance
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	// define store store keys
+	sponsorshipsKey := sdk.NewKVStoreKey(types.StoreKey)
+	authKey := sdk.NewKVStoreKey(auth.StoreKey)
+	supplyKey := sdk.NewKVStoreKey(supply.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	// create an in-memory db
+	memDB := db.NewMemDB()
+	suite.ms = store.NewCommitMultiStore(memDB)
+	suite.ms.MountStoreWithDB(sponsorshipsKey, sdk.StoreTypeIAVL, memDB)
+	suite.ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, memDB)
+	suite.ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, memDB)
+	suite.ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, memDB)
+	suite.ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, memDB)
+	if err := suite.ms.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	blockTime := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	suite.ctx = sdk.NewContext(suite.ms, abci.Header{ChainID: "test-chain-id", Time: blockTime}, false, log.NewNopLogger())
+	suite.cdc = testCodec()
+
+	paramsKeeper := params.NewKeeper(suite.cdc, paramsKey, paramsTKey)
+	suite.ak = auth.NewAccountKeeper(suite.cdc, authKey, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(suite.ak, paramsKeeper.Subspace(bank.DefaultParamspace), nil)
+	suite.supplyKeeper = supply.NewKeeper(suite.cdc, supplyKey, suite.ak, bankKeeper, map[string][]string{
+		auth.FeeCollectorName: nil,
+		types.ModuleName:      nil,
+	})
+	suite.supplyKeeper.SetModuleAccount(suite.ctx, supply.NewEmptyModuleAccount(auth.FeeCollectorName))
+	suite.supplyKeeper.SetModuleAccount(suite.ctx, supply.NewEmptyModuleAccount(types.ModuleName))
+	suite.keeper = keeper.NewKeeper(suite.ak, suite.supplyKeeper, suite.cdc, sponsorshipsKey)
+
+	// setup Data
+	// nolint - errcheck
+	suite.testData.owner, _ = sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	// nolint - errcheck
+	suite.testData.grantee, _ = sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	suite.testData.subspace = "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	suite.testData.sponsorship = types.NewSponsorship(
+		suite.testData.subspace,
+		suite.testData.owner,
+		sdk.NewCoins(sdk.NewInt64Coin("udaric", 1000)),
+	)
+	suite.testData.allowance = types.NewFeeAllowance(
+		suite.testData.subspace,
+		suite.testData.owner,
+		suite.testData.grantee,
+		sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)),
+		blockTime.Add(time.Hour),
+	)
+}
+
+// fundSponsorship stores the given sponsorship, sending its balance to the module account
+func (suite *KeeperTestSuite) fundSponsorship(sponsorship types.Sponsorship) {
+	moduleAcc := suite.supplyKeeper.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.NoError(moduleAcc.SetCoins(moduleAcc.GetCoins().Add(sponsorship.Balance...)))
+	suite.supplyKeeper.SetModuleAccount(suite.ctx, moduleAcc)
+	suite.keeper.SaveSponsorship(suite.ctx, sponsorship)
+}
+
+// setBalance sets the coins of the account having the given address, creating it if needed
+func (suite *KeeperTestSuite) setBalance(address sdk.AccAddress, coins sdk.Coins) {
+	acc := suite.ak.NewAccountWithAddress(suite.ctx, address)
+	suite.NoError(acc.SetCoins(coins))
+	suite.ak.SetAccount(suite.ctx, acc)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func testCodec() *codec.Codec {
+	var cdc = codec.New()
+
+	// register the different types
+	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+
+	cdc.Seal()
+	return cdc
+}
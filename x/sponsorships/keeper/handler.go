@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+// NewHandler returns a handler for "sponsorships" type messages.
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgDepositSponsorship:
+			return handleMsgDepositSponsorship(ctx, keeper, msg)
+		case types.MsgWithdrawSponsorship:
+			return handleMsgWithdrawSponsorship(ctx, keeper, msg)
+		case types.MsgGrantFeeAllowance:
+			return handleMsgGrantFeeAllowance(ctx, keeper, msg)
+		case types.MsgRevokeFeeAllowance:
+			return handleMsgRevokeFeeAllowance(ctx, keeper, msg)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
+				fmt.Sprintf("unrecognized sponsorships message type: %v", msg.Type()))
+		}
+	}
+}
+
+// getOwnedSponsorship returns the sponsorship of the given subspace, making sure it is owned by the given address
+func getOwnedSponsorship(ctx sdk.Context, keeper Keeper, subspace string, owner sdk.AccAddress) (types.Sponsorship, error) {
+	sponsorship, found := keeper.GetSponsorship(ctx, subspace)
+	if !found {
+		return types.Sponsorship{}, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("sponsorship of subspace %s not found", subspace))
+	}
+
+	if !sponsorship.Owner.Equals(owner) {
+		return types.Sponsorship{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("%s is not the owner of the sponsorship of subspace %s", owner, subspace))
+	}
+
+	return sponsorship, nil
+}
+
+// handleMsgDepositSponsorship handles the deposit of funds inside the sponsorship of a subspace,
+// creating the sponsorship if it does not exist yet
+func handleMsgDepositSponsorship(ctx sdk.Context, keeper Keeper, msg types.MsgDepositSponsorship) (*sdk.Result, error) {
+	sponsorship, found := keeper.GetSponsorship(ctx, msg.Subspace)
+	if !found {
+		sponsorship = types.NewSponsorship(msg.Subspace, msg.Owner, nil)
+	}
+
+	if !sponsorship.Owner.Equals(msg.Owner) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("%s is not the owner of the sponsorship of subspace %s", msg.Owner, msg.Subspace))
+	}
+
+	if err := keeper.SupplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Owner, types.ModuleName, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	sponsorship.Balance = sponsorship.Balance.Add(msg.Amount...)
+	keeper.SaveSponsorship(ctx, sponsorship)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDepositSponsorship,
+		sdk.NewAttribute(types.AttributeKeySubspace, msg.Subspace),
+		sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgWithdrawSponsorship handles the withdrawal of the unused funds of a sponsorship by its owner
+func handleMsgWithdrawSponsorship(ctx sdk.Context, keeper Keeper, msg types.MsgWithdrawSponsorship) (*sdk.Result, error) {
+	sponsorship, err := getOwnedSponsorship(ctx, keeper, msg.Subspace, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, negative := sponsorship.Balance.SafeSub(msg.Amount)
+	if negative {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds,
+			fmt.Sprintf("withdraw amount %s exceeds the sponsorship balance %s", msg.Amount, sponsorship.Balance))
+	}
+
+	if err := keeper.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, msg.Owner, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	sponsorship.Balance = balance
+	keeper.SaveSponsorship(ctx, sponsorship)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeWithdrawSponsorship,
+		sdk.NewAttribute(types.AttributeKeySubspace, msg.Subspace),
+		sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgGrantFeeAllowance handles the grant of a fee allowance by the owner of the sponsorship of a subspace.
+// As the grantee might have never received any coin, its account is created if it does not exist yet,
+// so that it can sign its first transactions
+func handleMsgGrantFeeAllowance(ctx sdk.Context, keeper Keeper, msg types.MsgGrantFeeAllowance) (*sdk.Result, error) {
+	if _, err := getOwnedSponsorship(ctx, keeper, msg.Subspace, msg.Granter); err != nil {
+		return nil, err
+	}
+
+	if !ctx.BlockTime().Before(msg.Expiration) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance expiration must be in the future")
+	}
+
+	if keeper.AccountKeeper.GetAccount(ctx, msg.Grantee) == nil {
+		keeper.AccountKeeper.SetAccount(ctx, keeper.AccountKeeper.NewAccountWithAddress(ctx, msg.Grantee))
+	}
+
+	allowance := types.NewFeeAllowance(msg.Subspace, msg.Granter, msg.Grantee, msg.SpendLimit, msg.Expiration)
+	keeper.SaveFeeAllowance(ctx, allowance)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeGrantFeeAllowance,
+		sdk.NewAttribute(types.AttributeKeySubspace, msg.Subspace),
+		sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+		sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+		sdk.NewAttribute(types.AttributeKeySpendLimit, msg.SpendLimit.String()),
+		sdk.NewAttribute(types.AttributeKeyExpiration, msg.Expiration.Format(time.RFC3339)),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgRevokeFeeAllowance handles the revocation of a fee allowance by its granter
+func handleMsgRevokeFeeAllowance(ctx sdk.Context, keeper Keeper, msg types.MsgRevokeFeeAllowance) (*sdk.Result, error) {
+	allowance, found := keeper.GetFeeAllowance(ctx, msg.Subspace, msg.Grantee)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("fee allowance of %s inside subspace %s not found", msg.Grantee, msg.Subspace))
+	}
+
+	if !allowance.Granter.Equals(msg.Granter) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("%s is not the granter of the fee allowance of %s inside subspace %s",
+				msg.Granter, msg.Grantee, msg.Subspace))
+	}
+
+	keeper.DeleteFeeAllowance(ctx, allowance)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRevokeFeeAllowance,
+		sdk.NewAttribute(types.AttributeKeySubspace, msg.Subspace),
+		sdk.NewAttribute(types.AttributeKeyGranter, msg.Granter.String()),
+		sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,306 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/keeper"
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+func (suite *KeeperTestSuite) Test_handleMsgDepositSponsorship() {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))
+
+	tests := []struct {
+		name           string
+		stored         *types.Sponsorship
+		msg            types.MsgDepositSponsorship
+		expErr         error
+		expSponsorship types.Sponsorship
+	}{
+		{
+			name:   "Deposit inside a sponsorship owned by another account returns error",
+			stored: &suite.testData.sponsorship,
+			msg:    types.NewMsgDepositSponsorship(suite.testData.subspace, suite.testData.grantee, amount),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn "+
+				"is not the owner of the sponsorship of subspace "+suite.testData.subspace),
+		},
+		{
+			name: "Deposit exceeding the owner balance returns error",
+			msg: types.NewMsgDepositSponsorship(suite.testData.subspace, suite.testData.owner,
+				sdk.NewCoins(sdk.NewInt64Coin("udaric", 10001))),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "insufficient account funds; 10000udaric < 10001udaric"),
+		},
+		{
+			name:           "First deposit creates the sponsorship",
+			msg:            types.NewMsgDepositSponsorship(suite.testData.subspace, suite.testData.owner, amount),
+			expSponsorship: types.NewSponsorship(suite.testData.subspace, suite.testData.owner, amount),
+		},
+		{
+			name:   "Deposit increases the sponsorship balance",
+			stored: &suite.testData.sponsorship,
+			msg:    types.NewMsgDepositSponsorship(suite.testData.subspace, suite.testData.owner, amount),
+			expSponsorship: types.NewSponsorship(suite.testData.subspace, suite.testData.owner,
+				sdk.NewCoins(sdk.NewInt64Coin("udaric", 1100))),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.setBalance(suite.testData.owner, sdk.NewCoins(sdk.NewInt64Coin("udaric", 10000)))
+			suite.setBalance(suite.testData.grantee, sdk.NewCoins(sdk.NewInt64Coin("udaric", 10000)))
+			if test.stored != nil {
+				suite.fundSponsorship(*test.stored)
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(res)
+				return
+			}
+
+			suite.NoError(err)
+			suite.Len(res.Events, 3) // the first two events are emitted by the bank transfer
+			suite.Equal(types.EventTypeDepositSponsorship, res.Events[2].Type)
+
+			stored, found := suite.keeper.GetSponsorship(suite.ctx, suite.testData.subspace)
+			suite.True(found)
+			suite.Equal(test.expSponsorship, stored)
+
+			ownerAcc := suite.ak.GetAccount(suite.ctx, suite.testData.owner)
+			suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("udaric", 9900)), ownerAcc.GetCoins())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgWithdrawSponsorship() {
+	amount := sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))
+
+	tests := []struct {
+		name       string
+		stored     *types.Sponsorship
+		msg        types.MsgWithdrawSponsorship
+		expErr     error
+		expBalance sdk.Coins
+	}{
+		{
+			name:   "Not found sponsorship returns error",
+			msg:    types.NewMsgWithdrawSponsorship(suite.testData.subspace, suite.testData.owner, amount),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sponsorship of subspace "+suite.testData.subspace+" not found"),
+		},
+		{
+			name:   "Withdraw from a sponsorship owned by another account returns error",
+			stored: &suite.testData.sponsorship,
+			msg:    types.NewMsgWithdrawSponsorship(suite.testData.subspace, suite.testData.grantee, amount),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn "+
+				"is not the owner of the sponsorship of subspace "+suite.testData.subspace),
+		},
+		{
+			name:   "Withdraw exceeding the sponsorship balance returns error",
+			stored: &suite.testData.sponsorship,
+			msg: types.NewMsgWithdrawSponsorship(suite.testData.subspace, suite.testData.owner,
+				sdk.NewCoins(sdk.NewInt64Coin("udaric", 1001))),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds,
+				"withdraw amount 1001udaric exceeds the sponsorship balance 1000udaric"),
+		},
+		{
+			name:       "Valid withdraw decreases the sponsorship balance",
+			stored:     &suite.testData.sponsorship,
+			msg:        types.NewMsgWithdrawSponsorship(suite.testData.subspace, suite.testData.owner, amount),
+			expBalance: sdk.NewCoins(sdk.NewInt64Coin("udaric", 900)),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			if test.stored != nil {
+				suite.fundSponsorship(*test.stored)
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(res)
+				return
+			}
+
+			suite.NoError(err)
+			suite.Len(res.Events, 3) // the first two events are emitted by the bank transfer
+			suite.Equal(types.EventTypeWithdrawSponsorship, res.Events[2].Type)
+
+			stored, found := suite.keeper.GetSponsorship(suite.ctx, suite.testData.subspace)
+			suite.True(found)
+			suite.Equal(test.expBalance, stored.Balance)
+
+			ownerAcc := suite.ak.GetAccount(suite.ctx, suite.testData.owner)
+			suite.Equal(amount, ownerAcc.GetCoins())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgGrantFeeAllowance() {
+	allowance := suite.testData.allowance
+
+	tests := []struct {
+		name   string
+		stored *types.Sponsorship
+		msg    types.MsgGrantFeeAllowance
+		expErr error
+	}{
+		{
+			name: "Not found sponsorship returns error",
+			msg: types.NewMsgGrantFeeAllowance(allowance.Subspace, allowance.Granter, allowance.Grantee,
+				allowance.SpendLimit, allowance.Expiration),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sponsorship of subspace "+suite.testData.subspace+" not found"),
+		},
+		{
+			name: "Grant by an account that does not own the sponsorship returns error",
+			stored: &types.Sponsorship{
+				Subspace: suite.testData.subspace,
+				Owner:    suite.testData.grantee,
+				Balance:  suite.testData.sponsorship.Balance,
+			},
+			msg: types.NewMsgGrantFeeAllowance(allowance.Subspace, allowance.Granter, allowance.Grantee,
+				allowance.SpendLimit, allowance.Expiration),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns "+
+				"is not the owner of the sponsorship of subspace "+suite.testData.subspace),
+		},
+		{
+			name:   "Past expiration returns error",
+			stored: &suite.testData.sponsorship,
+			msg: types.NewMsgGrantFeeAllowance(allowance.Subspace, allowance.Granter, allowance.Grantee,
+				allowance.SpendLimit, suite.ctx.BlockTime()),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance expiration must be in the future"),
+		},
+		{
+			name:   "Valid grant stores the allowance",
+			stored: &suite.testData.sponsorship,
+			msg: types.NewMsgGrantFeeAllowance(allowance.Subspace, allowance.Granter, allowance.Grantee,
+				allowance.SpendLimit, allowance.Expiration),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			if test.stored != nil {
+				suite.fundSponsorship(*test.stored)
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(res)
+				return
+			}
+
+			suite.NoError(err)
+			suite.Len(res.Events, 1)
+			suite.Equal(types.EventTypeGrantFeeAllowance, res.Events[0].Type)
+
+			stored, found := suite.keeper.GetFeeAllowance(suite.ctx, suite.testData.subspace, suite.testData.grantee)
+			suite.True(found)
+			suite.Equal(allowance, stored)
+
+			// The grantee account is created so that it can sign its first transactions
+			suite.NotNil(suite.ak.GetAccount(suite.ctx, suite.testData.grantee))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgGrantFeeAllowance_ReplacesExisting() {
+	suite.fundSponsorship(suite.testData.sponsorship)
+
+	existing := suite.testData.allowance
+	existing.Spent = sdk.NewCoins(sdk.NewInt64Coin("udaric", 50))
+	suite.keeper.SaveFeeAllowance(suite.ctx, existing)
+
+	expiration := suite.ctx.BlockTime().Add(24 * time.Hour)
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin("udaric", 500))
+	msg := types.NewMsgGrantFeeAllowance(suite.testData.subspace, suite.testData.owner, suite.testData.grantee,
+		spendLimit, expiration)
+
+	_, err := keeper.NewHandler(suite.keeper)(suite.ctx, msg)
+	suite.NoError(err)
+
+	stored, found := suite.keeper.GetFeeAllowance(suite.ctx, suite.testData.subspace, suite.testData.grantee)
+	suite.True(found)
+	suite.Equal(types.NewFeeAllowance(suite.testData.subspace, suite.testData.owner, suite.testData.grantee,
+		spendLimit, expiration), stored)
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgRevokeFeeAllowance() {
+	otherGranter, _ := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+
+	tests := []struct {
+		name   string
+		stored *types.FeeAllowance
+		msg    types.MsgRevokeFeeAllowance
+		expErr error
+	}{
+		{
+			name: "Not found allowance returns error",
+			msg:  types.NewMsgRevokeFeeAllowance(suite.testData.subspace, suite.testData.owner, suite.testData.grantee),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance of cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn "+
+				"inside subspace "+suite.testData.subspace+" not found"),
+		},
+		{
+			name:   "Revoke by a different granter returns error",
+			stored: &suite.testData.allowance,
+			msg:    types.NewMsgRevokeFeeAllowance(suite.testData.subspace, otherGranter, suite.testData.grantee),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47 "+
+				"is not the granter of the fee allowance of cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn "+
+				"inside subspace "+suite.testData.subspace),
+		},
+		{
+			name:   "Valid revoke deletes the allowance",
+			stored: &suite.testData.allowance,
+			msg:    types.NewMsgRevokeFeeAllowance(suite.testData.subspace, suite.testData.owner, suite.testData.grantee),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			if test.stored != nil {
+				suite.keeper.SaveFeeAllowance(suite.ctx, *test.stored)
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(res)
+				return
+			}
+
+			suite.NoError(err)
+			suite.Len(res.Events, 1)
+			suite.Equal(types.EventTypeRevokeFeeAllowance, res.Events[0].Type)
+
+			_, found := suite.keeper.GetFeeAllowance(suite.ctx, suite.testData.subspace, suite.testData.grantee)
+			suite.False(found)
+			suite.Empty(suite.keeper.GetGranteeAllowances(suite.ctx, suite.testData.grantee))
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+// RegisterInvariants registers all sponsorships invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "sponsorships-balances",
+		SponsorshipsBalancesInvariant(keeper))
+}
+
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := SponsorshipsBalancesInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		return "Every invariant condition is fulfilled correctly", false
+	}
+}
+
+// SponsorshipsBalancesInvariant checks that the sponsorships module account holds at least the balances of all the sponsorships
+func SponsorshipsBalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedBalance sdk.Coins
+		for _, sponsorship := range k.GetSponsorships(ctx) {
+			expectedBalance = expectedBalance.Add(sponsorship.Balance...)
+		}
+
+		moduleCoins := k.SupplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+		broken := !moduleCoins.IsAllGTE(expectedBalance)
+
+		return sdk.FormatInvariant(types.ModuleName, "sponsorships balances",
+			fmt.Sprintf("\tsum of sponsorships balances: %s\n\tmodule account coins: %s\n",
+				expectedBalance, moduleCoins)), broken
+	}
+}
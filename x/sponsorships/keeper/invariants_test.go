@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/keeper"
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	tests := []struct {
+		name        string
+		funded      bool
+		expResponse string
+		expBool     bool
+	}{
+		{
+			name:        "Invariants not violated",
+			funded:      true,
+			expResponse: "Every invariant condition is fulfilled correctly",
+			expBool:     false,
+		},
+		{
+			name:   "SponsorshipsBalances invariant violated",
+			funded: false,
+			expResponse: "sponsorships: sponsorships balances invariant\n" +
+				"\tsum of sponsorships balances: 1000udaric\n\tmodule account coins: \n\n",
+			expBool: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() //reset
+
+			if test.funded {
+				suite.fundSponsorship(suite.testData.sponsorship)
+			} else {
+				suite.keeper.SaveSponsorship(suite.ctx, suite.testData.sponsorship)
+			}
+
+			res, stop := keeper.AllInvariants(suite.keeper)(suite.ctx)
+
+			suite.Equal(test.expResponse, res)
+			suite.Equal(test.expBool, stop)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestInvariants_EmptySponsorship() {
+	suite.keeper.SaveSponsorship(suite.ctx, types.NewSponsorship(suite.testData.subspace, suite.testData.owner, sdk.Coins{}))
+
+	_, stop := keeper.AllInvariants(suite.keeper)(suite.ctx)
+	suite.False(stop)
+}
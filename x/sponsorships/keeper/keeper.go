@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+// Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
+type Keeper struct {
+	AccountKeeper auth.AccountKeeper // Account keeper to create the accounts of the grantees
+	SupplyKeeper  supply.Keeper      // Supply keeper to hold the sponsorships funds and pay the fees
+	StoreKey      sdk.StoreKey       // Unexposed key to access store from sdk.Context
+	Cdc           *codec.Codec       // The wire codec for binary encoding/decoding.
+}
+
+// NewKeeper creates new instances of the sponsorships Keeper
+func NewKeeper(ak auth.AccountKeeper, sk supply.Keeper, cdc *codec.Codec, storeKey sdk.StoreKey) Keeper {
+	return Keeper{
+		AccountKeeper: ak,
+		SupplyKeeper:  sk,
+		StoreKey:      storeKey,
+		Cdc:           cdc,
+	}
+}
+
+// -------------
+// --- Sponsorships
+// -------------
+
+// SaveSponsorship allows to save the given sponsorship inside the current context.
+// It assumes the given sponsorship has already been validated.
+func (k Keeper) SaveSponsorship(ctx sdk.Context, sponsorship types.Sponsorship) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.SponsorshipStoreKey(sponsorship.Subspace), k.Cdc.MustMarshalBinaryBare(sponsorship))
+}
+
+// GetSponsorship returns the sponsorship of the given subspace, and whether it has been found or not
+func (k Keeper) GetSponsorship(ctx sdk.Context, subspace string) (sponsorship types.Sponsorship, found bool) {
+	store := ctx.KVStore(k.StoreKey)
+	key := types.SponsorshipStoreKey(subspace)
+	if !store.Has(key) {
+		return types.Sponsorship{}, false
+	}
+
+	k.Cdc.MustUnmarshalBinaryBare(store.Get(key), &sponsorship)
+	return sponsorship, true
+}
+
+// GetSponsorships returns all the stored sponsorships
+func (k Keeper) GetSponsorships(ctx sdk.Context) types.Sponsorships {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SponsorshipStorePrefix)
+	defer iterator.Close()
+
+	sponsorships := make(types.Sponsorships, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var sponsorship types.Sponsorship
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &sponsorship)
+		sponsorships = append(sponsorships, sponsorship)
+	}
+
+	return sponsorships
+}
+
+// -------------
+// --- Fee allowances
+// -------------
+
+// SaveFeeAllowance allows to save the given fee allowance inside the current context, indexing it by grantee.
+// It assumes the given allowance has already been validated.
+func (k Keeper) SaveFeeAllowance(ctx sdk.Context, allowance types.FeeAllowance) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.AllowanceStoreKey(allowance.Subspace, allowance.Grantee), k.Cdc.MustMarshalBinaryBare(allowance))
+	store.Set(types.GranteeAllowanceStoreKey(allowance.Grantee, allowance.Subspace), []byte(allowance.Subspace))
+}
+
+// GetFeeAllowance returns the fee allowance granted to the given grantee inside the given subspace,
+// and whether it has been found or not
+func (k Keeper) GetFeeAllowance(
+	ctx sdk.Context, subspace string, grantee sdk.AccAddress,
+) (allowance types.FeeAllowance, found bool) {
+	store := ctx.KVStore(k.StoreKey)
+	key := types.AllowanceStoreKey(subspace, grantee)
+	if !store.Has(key) {
+		return types.FeeAllowance{}, false
+	}
+
+	k.Cdc.MustUnmarshalBinaryBare(store.Get(key), &allowance)
+	return allowance, true
+}
+
+// DeleteFeeAllowance removes the given fee allowance along with its grantee index entry
+func (k Keeper) DeleteFeeAllowance(ctx sdk.Context, allowance types.FeeAllowance) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Delete(types.AllowanceStoreKey(allowance.Subspace, allowance.Grantee))
+	store.Delete(types.GranteeAllowanceStoreKey(allowance.Grantee, allowance.Subspace))
+}
+
+// getAllowances returns all the fee allowances stored under the given prefix
+func (k Keeper) getAllowances(ctx sdk.Context, prefix []byte) types.FeeAllowances {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	allowances := make(types.FeeAllowances, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var allowance types.FeeAllowance
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &allowance)
+		allowances = append(allowances, allowance)
+	}
+
+	return allowances
+}
+
+// GetFeeAllowances returns all the stored fee allowances
+func (k Keeper) GetFeeAllowances(ctx sdk.Context) types.FeeAllowances {
+	return k.getAllowances(ctx, types.AllowanceStorePrefix)
+}
+
+// GetSubspaceAllowances returns all the fee allowances granted inside the given subspace
+func (k Keeper) GetSubspaceAllowances(ctx sdk.Context, subspace string) types.FeeAllowances {
+	return k.getAllowances(ctx, types.SubspaceAllowancesPrefix(subspace))
+}
+
+// GetGranteeAllowances returns all the fee allowances granted to the given grantee, sorted by subspace
+func (k Keeper) GetGranteeAllowances(ctx sdk.Context, grantee sdk.AccAddress) types.FeeAllowances {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GranteeAllowancesPrefix(grantee))
+	defer iterator.Close()
+
+	allowances := make(types.FeeAllowances, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		if allowance, found := k.GetFeeAllowance(ctx, string(iterator.Value()), grantee); found {
+			allowances = append(allowances, allowance)
+		}
+	}
+
+	return allowances
+}
+
+// GetUsableFeeAllowance returns the first fee allowance of the given grantee, sorted by subspace, that can be used
+// to pay the given fees at the current block time, and whether it has been found or not.
+// An allowance can be used when it is not expired, when the fees do not exceed its spend limit and
+// when the sponsorship of its subspace holds enough funds
+func (k Keeper) GetUsableFeeAllowance(
+	ctx sdk.Context, grantee sdk.AccAddress, fees sdk.Coins,
+) (types.FeeAllowance, bool) {
+	for _, allowance := range k.GetGranteeAllowances(ctx, grantee) {
+		if allowance.IsExpired(ctx.BlockTime()) || !allowance.CanSpend(fees) {
+			continue
+		}
+
+		sponsorship, found := k.GetSponsorship(ctx, allowance.Subspace)
+		if found && sponsorship.Owner.Equals(allowance.Granter) && sponsorship.Balance.IsAllGTE(fees) {
+			return allowance, true
+		}
+	}
+
+	return types.FeeAllowance{}, false
+}
+
+// UseFeeAllowance pays the given fees using the sponsorship funds of the subspace of the given allowance,
+// sending them to the fee collector and updating the amount spent by the allowance
+func (k Keeper) UseFeeAllowance(ctx sdk.Context, allowance types.FeeAllowance, fees sdk.Coins) error {
+	sponsorship, found := k.GetSponsorship(ctx, allowance.Subspace)
+	if !found {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("sponsorship of subspace %s not found", allowance.Subspace))
+	}
+
+	balance, negative := sponsorship.Balance.SafeSub(fees)
+	if negative {
+		return sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds,
+			fmt.Sprintf("the sponsorship of subspace %s cannot pay %s", sponsorship.Subspace, fees))
+	}
+
+	if err := k.SupplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auth.FeeCollectorName, fees); err != nil {
+		return err
+	}
+
+	sponsorship.Balance = balance
+	k.SaveSponsorship(ctx, sponsorship)
+
+	allowance.Spent = allowance.Spent.Add(fees...)
+	k.SaveFeeAllowance(ctx, allowance)
+
+	return nil
+}
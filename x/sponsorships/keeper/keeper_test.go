@@ -0,0 +1,208 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+// -------------
+// --- Sponsorships
+// -------------
+
+func (suite *KeeperTestSuite) TestKeeper_SaveSponsorship() {
+	suite.keeper.SaveSponsorship(suite.ctx, suite.testData.sponsorship)
+
+	stored, found := suite.keeper.GetSponsorship(suite.ctx, suite.testData.subspace)
+	suite.True(found)
+	suite.Equal(suite.testData.sponsorship, stored)
+
+	// Saving the sponsorship again replaces the existing one
+	updated := types.NewSponsorship(suite.testData.subspace, suite.testData.owner, sdk.NewCoins(sdk.NewInt64Coin("udaric", 1)))
+	suite.keeper.SaveSponsorship(suite.ctx, updated)
+
+	stored, found = suite.keeper.GetSponsorship(suite.ctx, suite.testData.subspace)
+	suite.True(found)
+	suite.Equal(updated, stored)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetSponsorship() {
+	_, found := suite.keeper.GetSponsorship(suite.ctx, suite.testData.subspace)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetSponsorships() {
+	suite.Empty(suite.keeper.GetSponsorships(suite.ctx))
+
+	other := types.NewSponsorship(
+		"19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af",
+		suite.testData.grantee,
+		sdk.NewCoins(sdk.NewInt64Coin("udaric", 10)),
+	)
+	suite.keeper.SaveSponsorship(suite.ctx, suite.testData.sponsorship)
+	suite.keeper.SaveSponsorship(suite.ctx, other)
+
+	suite.Equal(types.Sponsorships{other, suite.testData.sponsorship}, suite.keeper.GetSponsorships(suite.ctx))
+}
+
+// -------------
+// --- Fee allowances
+// -------------
+
+func (suite *KeeperTestSuite) TestKeeper_SaveFeeAllowance() {
+	suite.keeper.SaveFeeAllowance(suite.ctx, suite.testData.allowance)
+
+	stored, found := suite.keeper.GetFeeAllowance(suite.ctx, suite.testData.subspace, suite.testData.grantee)
+	suite.True(found)
+	suite.Equal(suite.testData.allowance, stored)
+
+	suite.Equal(types.FeeAllowances{suite.testData.allowance}, suite.keeper.GetFeeAllowances(suite.ctx))
+	suite.Equal(types.FeeAllowances{suite.testData.allowance},
+		suite.keeper.GetSubspaceAllowances(suite.ctx, suite.testData.subspace))
+	suite.Equal(types.FeeAllowances{suite.testData.allowance},
+		suite.keeper.GetGranteeAllowances(suite.ctx, suite.testData.grantee))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteFeeAllowance() {
+	suite.keeper.SaveFeeAllowance(suite.ctx, suite.testData.allowance)
+	suite.keeper.DeleteFeeAllowance(suite.ctx, suite.testData.allowance)
+
+	_, found := suite.keeper.GetFeeAllowance(suite.ctx, suite.testData.subspace, suite.testData.grantee)
+	suite.False(found)
+	suite.Empty(suite.keeper.GetSubspaceAllowances(suite.ctx, suite.testData.subspace))
+	suite.Empty(suite.keeper.GetGranteeAllowances(suite.ctx, suite.testData.grantee))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetAllowancesIndexes() {
+	otherSubspace := "19de02e105c68a60e45c289bff19fde745bca9c63c38f2095b59e8e8090ae1af"
+	otherGrantee, _ := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+
+	first := suite.testData.allowance
+	second := types.NewFeeAllowance(otherSubspace, suite.testData.owner, suite.testData.grantee,
+		first.SpendLimit, first.Expiration)
+	third := types.NewFeeAllowance(suite.testData.subspace, suite.testData.owner, otherGrantee,
+		first.SpendLimit, first.Expiration)
+
+	for _, allowance := range []types.FeeAllowance{first, second, third} {
+		suite.keeper.SaveFeeAllowance(suite.ctx, allowance)
+	}
+
+	suite.ElementsMatch(types.FeeAllowances{first, third},
+		suite.keeper.GetSubspaceAllowances(suite.ctx, suite.testData.subspace))
+	suite.Equal(types.FeeAllowances{second, first},
+		suite.keeper.GetGranteeAllowances(suite.ctx, suite.testData.grantee))
+	suite.Equal(types.FeeAllowances{third}, suite.keeper.GetGranteeAllowances(suite.ctx, otherGrantee))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetUsableFeeAllowance() {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("udaric", 10))
+
+	tests := []struct {
+		name        string
+		sponsorship *types.Sponsorship
+		allowance   types.FeeAllowance
+		blockTime   time.Time
+		fees        sdk.Coins
+		expFound    bool
+	}{
+		{
+			name:      "Allowance without sponsorship is not usable",
+			allowance: suite.testData.allowance,
+			fees:      fees,
+		},
+		{
+			name:        "Expired allowance is not usable",
+			sponsorship: &suite.testData.sponsorship,
+			allowance:   suite.testData.allowance,
+			blockTime:   suite.testData.allowance.Expiration,
+			fees:        fees,
+		},
+		{
+			name:        "Fees exceeding the spend limit are not payable",
+			sponsorship: &suite.testData.sponsorship,
+			allowance:   suite.testData.allowance,
+			fees:        sdk.NewCoins(sdk.NewInt64Coin("udaric", 101)),
+		},
+		{
+			name: "Fees exceeding the sponsorship balance are not payable",
+			sponsorship: &types.Sponsorship{
+				Subspace: suite.testData.subspace,
+				Owner:    suite.testData.owner,
+				Balance:  sdk.NewCoins(sdk.NewInt64Coin("udaric", 5)),
+			},
+			allowance: suite.testData.allowance,
+			fees:      fees,
+		},
+		{
+			name: "Allowance granted by a previous sponsorship owner is not usable",
+			sponsorship: &types.Sponsorship{
+				Subspace: suite.testData.subspace,
+				Owner:    suite.testData.grantee,
+				Balance:  suite.testData.sponsorship.Balance,
+			},
+			allowance: suite.testData.allowance,
+			fees:      fees,
+		},
+		{
+			name:        "Valid allowance is usable",
+			sponsorship: &suite.testData.sponsorship,
+			allowance:   suite.testData.allowance,
+			fees:        fees,
+			expFound:    true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			ctx := suite.ctx
+			if !test.blockTime.IsZero() {
+				ctx = ctx.WithBlockTime(test.blockTime)
+			}
+
+			if test.sponsorship != nil {
+				suite.keeper.SaveSponsorship(ctx, *test.sponsorship)
+			}
+			suite.keeper.SaveFeeAllowance(ctx, test.allowance)
+
+			allowance, found := suite.keeper.GetUsableFeeAllowance(ctx, suite.testData.grantee, test.fees)
+			suite.Equal(test.expFound, found)
+			if test.expFound {
+				suite.Equal(test.allowance, allowance)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestKeeper_UseFeeAllowance() {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("udaric", 10))
+
+	// Not found sponsorship
+	err := suite.keeper.UseFeeAllowance(suite.ctx, suite.testData.allowance, fees)
+	suite.Error(err)
+	suite.Equal(sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+		"sponsorship of subspace "+suite.testData.subspace+" not found").Error(), err.Error())
+
+	// Valid allowance
+	suite.fundSponsorship(suite.testData.sponsorship)
+	suite.keeper.SaveFeeAllowance(suite.ctx, suite.testData.allowance)
+	suite.NoError(suite.keeper.UseFeeAllowance(suite.ctx, suite.testData.allowance, fees))
+
+	sponsorship, found := suite.keeper.GetSponsorship(suite.ctx, suite.testData.subspace)
+	suite.True(found)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("udaric", 990)), sponsorship.Balance)
+
+	allowance, found := suite.keeper.GetFeeAllowance(suite.ctx, suite.testData.subspace, suite.testData.grantee)
+	suite.True(found)
+	suite.Equal(fees, allowance.Spent)
+
+	feeCollector := suite.supplyKeeper.GetModuleAccount(suite.ctx, auth.FeeCollectorName)
+	suite.Equal(fees, feeCollector.GetCoins())
+	moduleAcc := suite.supplyKeeper.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("udaric", 990)), moduleAcc.GetCoins())
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	postsCommon "github.com/desmos-labs/desmos/x/posts/types/models/common"
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+// NewQuerier is the module level router for state queries
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err error) {
+		switch path[0] {
+		case types.QuerySponsorship:
+			return querySponsorship(ctx, path[1:], req, keeper)
+		case types.QuerySubspaceAllowances:
+			return querySubspaceAllowances(ctx, path[1:], req, keeper)
+		case types.QueryGranteeAllowances:
+			return queryGranteeAllowances(ctx, path[1:], req, keeper)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown sponsorships query endpoint")
+		}
+	}
+}
+
+// querySponsorship allows to return the sponsorship of a subspace
+// Query path: custom/sponsorships/sponsorship/{subspace}
+func querySponsorship(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if !postsCommon.IsValidSubspace(path[0]) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid subspace: %s", path[0]))
+	}
+
+	sponsorship, found := keeper.GetSponsorship(ctx, path[0])
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("sponsorship of subspace %s not found", path[0]))
+	}
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc, &sponsorship)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// querySubspaceAllowances allows to return the fee allowances granted inside a subspace
+// Query path: custom/sponsorships/subspace_allowances/{subspace}
+func querySubspaceAllowances(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if !postsCommon.IsValidSubspace(path[0]) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid subspace: %s", path[0]))
+	}
+
+	allowances := keeper.GetSubspaceAllowances(ctx, path[0])
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc, &allowances)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryGranteeAllowances allows to return the fee allowances granted to an address
+// Query path: custom/sponsorships/grantee_allowances/{address}
+func queryGranteeAllowances(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	grantee, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid grantee address: %s", path[0]))
+	}
+
+	allowances := keeper.GetGranteeAllowances(ctx, grantee)
+
+	res, err := codec.MarshalJSONIndent(keeper.Cdc, &allowances)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
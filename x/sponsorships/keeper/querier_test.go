@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/keeper"
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+var request abci.RequestQuery
+
+func (suite *KeeperTestSuite) Test_querySponsorship() {
+	tests := []struct {
+		name   string
+		stored bool
+		query  []string
+		expErr error
+	}{
+		{
+			name:   "Invalid subspace returns error",
+			query:  []string{types.QuerySponsorship, "subspace"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid subspace: subspace"),
+		},
+		{
+			name:  "Not found sponsorship returns error",
+			query: []string{types.QuerySponsorship, suite.testData.subspace},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				"sponsorship of subspace "+suite.testData.subspace+" not found"),
+		},
+		{
+			name:   "Existing sponsorship is returned",
+			stored: true,
+			query:  []string{types.QuerySponsorship, suite.testData.subspace},
+		},
+		{
+			name:   "Unknown endpoint",
+			query:  []string{"endpoint"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown sponsorships query endpoint"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			if test.stored {
+				suite.keeper.SaveSponsorship(suite.ctx, suite.testData.sponsorship)
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.query, request)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
+				return
+			}
+
+			suite.NoError(err)
+			expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &suite.testData.sponsorship)
+			suite.NoError(err)
+			suite.Equal(string(expectedIndented), string(result))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_queryAllowances() {
+	tests := []struct {
+		name   string
+		query  []string
+		expErr error
+	}{
+		{
+			name:   "Invalid subspace returns error",
+			query:  []string{types.QuerySubspaceAllowances, "subspace"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid subspace: subspace"),
+		},
+		{
+			name:   "Invalid grantee returns error",
+			query:  []string{types.QueryGranteeAllowances, "grantee"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid grantee address: grantee"),
+		},
+		{
+			name:  "Subspace allowances are returned",
+			query: []string{types.QuerySubspaceAllowances, suite.testData.subspace},
+		},
+		{
+			name:  "Grantee allowances are returned",
+			query: []string{types.QueryGranteeAllowances, suite.testData.grantee.String()},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.keeper.SaveFeeAllowance(suite.ctx, suite.testData.allowance)
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.query, request)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
+				return
+			}
+
+			suite.NoError(err)
+			expected := types.FeeAllowances{suite.testData.allowance}
+			expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &expected)
+			suite.NoError(err)
+			suite.Equal(string(expectedIndented), string(result))
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding sponsorships type
+func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
+	switch {
+	case bytes.HasPrefix(kvA.Key, types.SponsorshipStorePrefix):
+		var sponsorshipA, sponsorshipB types.Sponsorship
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &sponsorshipA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &sponsorshipB)
+		return fmt.Sprintf("SponsorshipA: %s\nSponsorshipB: %s\n", sponsorshipA, sponsorshipB)
+	case bytes.HasPrefix(kvA.Key, types.AllowanceStorePrefix):
+		var allowanceA, allowanceB types.FeeAllowance
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &allowanceA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &allowanceB)
+		return fmt.Sprintf("FeeAllowanceA: %s\nFeeAllowanceB: %s\n", allowanceA, allowanceB)
+	case bytes.HasPrefix(kvA.Key, types.GranteeAllowancesStorePrefix):
+		return fmt.Sprintf("SubspaceA: %s\nSubspaceB: %s\n", kvA.Value, kvB.Value)
+	default:
+		panic(fmt.Sprintf("invalid sponsorships key %X", kvA.Key))
+	}
+}
@@ -0,0 +1,17 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+// RandomizedGenState generates a random GenesisState for sponsorships.
+// As the sponsorships balances must be held by the module account, the genesis starts without any sponsorship,
+// which are created by the simulated deposits instead
+func RandomizedGenState(simState *module.SimulationState) {
+	genState := types.NewGenesisState(nil, nil)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genState)
+}
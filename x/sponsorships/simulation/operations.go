@@ -0,0 +1,165 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	sim "github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/desmos-labs/desmos/app/params"
+	postsSim "github.com/desmos-labs/desmos/x/posts/simulation"
+	"github.com/desmos-labs/desmos/x/sponsorships/keeper"
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+const (
+	OpWeightMsgDepositSponsorship = "op_weight_msg_deposit_sponsorship"
+	OpWeightMsgGrantFeeAllowance  = "op_weight_msg_grant_fee_allowance"
+
+	DefaultGasValue = 200000
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams sim.AppParams, cdc *codec.Codec, k keeper.Keeper, ak auth.AccountKeeper) sim.WeightedOperations {
+	var weightMsgDepositSponsorship int
+	appParams.GetOrGenerate(cdc, OpWeightMsgDepositSponsorship, &weightMsgDepositSponsorship, nil,
+		func(_ *rand.Rand) {
+			weightMsgDepositSponsorship = params.DefaultWeightMsgDepositSponsorship
+		},
+	)
+
+	var weightMsgGrantFeeAllowance int
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrantFeeAllowance, &weightMsgGrantFeeAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrantFeeAllowance = params.DefaultWeightMsgGrantFeeAllowance
+		},
+	)
+
+	return sim.WeightedOperations{
+		sim.NewWeightedOperation(
+			weightMsgDepositSponsorship,
+			SimulateMsgDepositSponsorship(ak, k),
+		),
+		sim.NewWeightedOperation(
+			weightMsgGrantFeeAllowance,
+			SimulateMsgGrantFeeAllowance(ak, k),
+		),
+	}
+}
+
+// SimulateMsgDepositSponsorship tests and runs a single deposit inside the sponsorship of a random subspace
+// made by a random account
+func SimulateMsgDepositSponsorship(ak auth.AccountKeeper, k keeper.Keeper) sim.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string,
+	) (sim.OperationMsg, []sim.FutureOperation, error) {
+		owner, _ := sim.RandomAcc(r, accs)
+		subspace := postsSim.RandomSubspace(r)
+
+		// Only the owner can deposit inside an existing sponsorship
+		if sponsorship, found := k.GetSponsorship(ctx, subspace); found {
+			owner, found = sim.FindAccount(accs, sponsorship.Owner)
+			if !found {
+				return sim.NoOpMsg(types.ModuleName), nil, nil
+			}
+		}
+
+		account := ak.GetAccount(ctx, owner.Address)
+		if account == nil {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Deposit up to half of the spendable coins, so that the fees can still be paid
+		spendable := account.SpendableCoins(ctx.BlockTime())
+		amount := sim.RandSubsetCoins(r, spendable)
+		for index := range amount {
+			amount[index].Amount = amount[index].Amount.QuoRaw(2)
+		}
+		amount = sdk.NewCoins(amount...)
+		if amount.IsZero() {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgDepositSponsorship(subspace, owner.Address, amount)
+		if err := sendMsg(r, app, ak, msg, spendable.Sub(amount), ctx, chainID, owner); err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgGrantFeeAllowance tests and runs a single fee allowance grant made by the owner of a random sponsorship
+// to a random account
+func SimulateMsgGrantFeeAllowance(ak auth.AccountKeeper, k keeper.Keeper) sim.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string,
+	) (sim.OperationMsg, []sim.FutureOperation, error) {
+		sponsorships := k.GetSponsorships(ctx)
+		if len(sponsorships) == 0 {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		sponsorship := sponsorships[r.Intn(len(sponsorships))]
+		granter, found := sim.FindAccount(accs, sponsorship.Owner)
+		if !found {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		grantee, _ := sim.RandomAcc(r, accs)
+		spendLimit := sim.RandSubsetCoins(r, sponsorship.Balance)
+		if grantee.Address.Equals(granter.Address) || spendLimit.IsZero() {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+		if account == nil {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		expiration := ctx.BlockTime().Add(time.Duration(r.Intn(72)+1) * time.Hour)
+		msg := types.NewMsgGrantFeeAllowance(sponsorship.Subspace, granter.Address, grantee.Address, spendLimit, expiration)
+		if err := sendMsg(r, app, ak, msg, account.SpendableCoins(ctx.BlockTime()), ctx, chainID, granter); err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// sendMsg sends a transaction with the given message from the given account, paying random fees
+// taken from the given coins
+func sendMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ak auth.AccountKeeper, msg sdk.Msg, coins sdk.Coins,
+	ctx sdk.Context, chainID string, sender sim.Account,
+) error {
+	account := ak.GetAccount(ctx, sender.Address)
+
+	fees, err := sim.RandomFees(r, ctx, coins)
+	if err != nil {
+		return err
+	}
+
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		DefaultGasValue,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		[]crypto.PrivKey{sender.PrivKey}...,
+	)
+
+	_, _, err = app.Deliver(tx)
+	return err
+}
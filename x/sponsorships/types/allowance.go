@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	postsCommon "github.com/desmos-labs/desmos/x/posts/types/models/common"
+)
+
+// FeeAllowance allows the grantee to have the fees of its transactions paid using the sponsorship
+// of a subspace, up to the spend limit and until the expiration
+type FeeAllowance struct {
+	Subspace   string         `json:"subspace" yaml:"subspace"`
+	Granter    sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	SpendLimit sdk.Coins      `json:"spend_limit" yaml:"spend_limit"`
+	Spent      sdk.Coins      `json:"spent,omitempty" yaml:"spent,omitempty"`
+	Expiration time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+// NewFeeAllowance is a constructor function for FeeAllowance
+func NewFeeAllowance(
+	subspace string, granter, grantee sdk.AccAddress, spendLimit sdk.Coins, expiration time.Time,
+) FeeAllowance {
+	return FeeAllowance{
+		Subspace:   subspace,
+		Granter:    granter,
+		Grantee:    grantee,
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// String implements fmt.Stringer
+func (allowance FeeAllowance) String() string {
+	return fmt.Sprintf("Subspace: %s\nGranter: %s\nGrantee: %s\nSpend limit: %s\nSpent: %s\nExpiration: %s",
+		allowance.Subspace, allowance.Granter, allowance.Grantee, allowance.SpendLimit, allowance.Spent,
+		allowance.Expiration.Format(time.RFC3339))
+}
+
+// IsExpired tells whether the allowance is expired at the given time
+func (allowance FeeAllowance) IsExpired(now time.Time) bool {
+	return !now.Before(allowance.Expiration)
+}
+
+// CanSpend tells whether the given fees can be paid without exceeding the spend limit of the allowance
+func (allowance FeeAllowance) CanSpend(fees sdk.Coins) bool {
+	return allowance.Spent.Add(fees...).IsAllLTE(allowance.SpendLimit)
+}
+
+// Validate checks the validity of the FeeAllowance
+func (allowance FeeAllowance) Validate() error {
+	if !postsCommon.IsValidSubspace(allowance.Subspace) {
+		return fmt.Errorf("fee allowance subspace must be a valid sha-256 hash")
+	}
+
+	if allowance.Granter.Empty() {
+		return fmt.Errorf("invalid fee allowance granter: %s", allowance.Granter)
+	}
+
+	if allowance.Grantee.Empty() {
+		return fmt.Errorf("invalid fee allowance grantee: %s", allowance.Grantee)
+	}
+
+	if allowance.Granter.Equals(allowance.Grantee) {
+		return fmt.Errorf("fee allowance granter and grantee must be different")
+	}
+
+	if !allowance.SpendLimit.IsValid() || allowance.SpendLimit.IsZero() {
+		return fmt.Errorf("invalid fee allowance spend limit: %s", allowance.SpendLimit)
+	}
+
+	if !allowance.Spent.IsValid() {
+		return fmt.Errorf("invalid fee allowance spent amount: %s", allowance.Spent)
+	}
+
+	if allowance.Expiration.IsZero() {
+		return fmt.Errorf("invalid fee allowance expiration: %s", allowance.Expiration)
+	}
+
+	return nil
+}
+
+// FeeAllowances represents a slice of FeeAllowance objects
+type FeeAllowances []FeeAllowance
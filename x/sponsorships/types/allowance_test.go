@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+func TestFeeAllowance_IsExpired(t *testing.T) {
+	allowance := types.NewFeeAllowance(testSubspace, testOwner, testGrantee, testAmount, testExpiration)
+
+	require.False(t, allowance.IsExpired(testExpiration.Add(-time.Second)))
+	require.True(t, allowance.IsExpired(testExpiration))
+	require.True(t, allowance.IsExpired(testExpiration.Add(time.Second)))
+}
+
+func TestFeeAllowance_CanSpend(t *testing.T) {
+	allowance := types.NewFeeAllowance(testSubspace, testOwner, testGrantee, testAmount, testExpiration)
+	allowance.Spent = sdk.NewCoins(sdk.NewInt64Coin("udaric", 900))
+
+	require.True(t, allowance.CanSpend(sdk.NewCoins(sdk.NewInt64Coin("udaric", 100))))
+	require.False(t, allowance.CanSpend(sdk.NewCoins(sdk.NewInt64Coin("udaric", 101))))
+	require.False(t, allowance.CanSpend(sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))))
+}
+
+func TestFeeAllowance_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		allowance types.FeeAllowance
+		expErr    string
+	}{
+		{
+			name:      "Invalid subspace",
+			allowance: types.NewFeeAllowance("", testOwner, testGrantee, testAmount, testExpiration),
+			expErr:    "fee allowance subspace must be a valid sha-256 hash",
+		},
+		{
+			name:      "Invalid granter",
+			allowance: types.NewFeeAllowance(testSubspace, nil, testGrantee, testAmount, testExpiration),
+			expErr:    "invalid fee allowance granter: ",
+		},
+		{
+			name:      "Invalid grantee",
+			allowance: types.NewFeeAllowance(testSubspace, testOwner, nil, testAmount, testExpiration),
+			expErr:    "invalid fee allowance grantee: ",
+		},
+		{
+			name:      "Same granter and grantee",
+			allowance: types.NewFeeAllowance(testSubspace, testOwner, testOwner, testAmount, testExpiration),
+			expErr:    "fee allowance granter and grantee must be different",
+		},
+		{
+			name: "Zero spend limit",
+			allowance: types.NewFeeAllowance(testSubspace, testOwner, testGrantee,
+				sdk.NewCoins(sdk.NewInt64Coin("udaric", 0)), testExpiration),
+			expErr: "invalid fee allowance spend limit: ",
+		},
+		{
+			name: "Invalid spent amount",
+			allowance: types.FeeAllowance{
+				Subspace:   testSubspace,
+				Granter:    testOwner,
+				Grantee:    testGrantee,
+				SpendLimit: testAmount,
+				Spent:      sdk.Coins{sdk.Coin{Denom: "udaric", Amount: sdk.NewInt(-1)}},
+				Expiration: testExpiration,
+			},
+			expErr: "invalid fee allowance spent amount: -1udaric",
+		},
+		{
+			name:      "Invalid expiration",
+			allowance: types.NewFeeAllowance(testSubspace, testOwner, testGrantee, testAmount, time.Time{}),
+			expErr:    "invalid fee allowance expiration: 0001-01-01 00:00:00 +0000 UTC",
+		},
+		{
+			name:      "Valid allowance",
+			allowance: types.NewFeeAllowance(testSubspace, testOwner, testGrantee, testAmount, testExpiration),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.allowance.Validate()
+			if test.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc is the codec
+var ModuleCdc = codec.New()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+}
+
+// RegisterCodec registers concrete types on the Amino codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgDepositSponsorship{}, "desmos/MsgDepositSponsorship", nil)
+	cdc.RegisterConcrete(MsgWithdrawSponsorship{}, "desmos/MsgWithdrawSponsorship", nil)
+	cdc.RegisterConcrete(MsgGrantFeeAllowance{}, "desmos/MsgGrantFeeAllowance", nil)
+	cdc.RegisterConcrete(MsgRevokeFeeAllowance{}, "desmos/MsgRevokeFeeAllowance", nil)
+}
@@ -0,0 +1,19 @@
+package types
+
+// Sponsorships module event types
+const (
+	EventTypeDepositSponsorship  = "deposit_sponsorship"
+	EventTypeWithdrawSponsorship = "withdraw_sponsorship"
+	EventTypeGrantFeeAllowance   = "grant_fee_allowance"
+	EventTypeRevokeFeeAllowance  = "revoke_fee_allowance"
+
+	AttributeKeySubspace   = "subspace"
+	AttributeKeyOwner      = "owner"
+	AttributeKeyGranter    = "granter"
+	AttributeKeyGrantee    = "grantee"
+	AttributeKeyAmount     = "amount"
+	AttributeKeySpendLimit = "spend_limit"
+	AttributeKeyExpiration = "expiration"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState represents the genesis state for the sponsorships module
+type GenesisState struct {
+	Sponsorships Sponsorships  `json:"sponsorships"`
+	Allowances   FeeAllowances `json:"allowances"`
+}
+
+// NewGenesisState allows to create a new genesis state containing the given sponsorships and fee allowances
+func NewGenesisState(sponsorships Sponsorships, allowances FeeAllowances) GenesisState {
+	return GenesisState{
+		Sponsorships: sponsorships,
+		Allowances:   allowances,
+	}
+}
+
+// DefaultGenesisState returns a default GenesisState
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+// ValidateGenesis validates the given genesis state and returns an error if something is invalid
+func ValidateGenesis(state GenesisState) error {
+	owners := make(map[string]string, len(state.Sponsorships))
+	for _, sponsorship := range state.Sponsorships {
+		if err := sponsorship.Validate(); err != nil {
+			return err
+		}
+
+		if _, found := owners[sponsorship.Subspace]; found {
+			return fmt.Errorf("duplicated sponsorship for subspace %s", sponsorship.Subspace)
+		}
+		owners[sponsorship.Subspace] = sponsorship.Owner.String()
+	}
+
+	allowances := make(map[string]bool, len(state.Allowances))
+	for _, allowance := range state.Allowances {
+		if err := allowance.Validate(); err != nil {
+			return err
+		}
+
+		if owner, found := owners[allowance.Subspace]; !found || owner != allowance.Granter.String() {
+			return fmt.Errorf("fee allowance granter %s is not the owner of the sponsorship of subspace %s",
+				allowance.Granter, allowance.Subspace)
+		}
+
+		key := string(AllowanceStoreKey(allowance.Subspace, allowance.Grantee))
+		if allowances[key] {
+			return fmt.Errorf("duplicated fee allowance for grantee %s inside subspace %s",
+				allowance.Grantee, allowance.Subspace)
+		}
+		allowances[key] = true
+	}
+
+	return nil
+}
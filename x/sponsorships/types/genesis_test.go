@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	sponsorship := types.NewSponsorship(testSubspace, testOwner, testAmount)
+	allowance := types.NewFeeAllowance(testSubspace, testOwner, testGrantee, testAmount, testExpiration)
+
+	tests := []struct {
+		name    string
+		genesis types.GenesisState
+		expErr  string
+	}{
+		{
+			name:    "Default genesis is valid",
+			genesis: types.DefaultGenesisState(),
+		},
+		{
+			name: "Invalid sponsorship",
+			genesis: types.NewGenesisState(
+				types.Sponsorships{types.NewSponsorship(testSubspace, nil, testAmount)},
+				nil,
+			),
+			expErr: "invalid sponsorship owner: ",
+		},
+		{
+			name:    "Duplicated sponsorship",
+			genesis: types.NewGenesisState(types.Sponsorships{sponsorship, sponsorship}, nil),
+			expErr:  "duplicated sponsorship for subspace " + testSubspace,
+		},
+		{
+			name:    "Allowance without sponsorship",
+			genesis: types.NewGenesisState(nil, types.FeeAllowances{allowance}),
+			expErr: "fee allowance granter cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns " +
+				"is not the owner of the sponsorship of subspace " + testSubspace,
+		},
+		{
+			name: "Allowance granted by a different account",
+			genesis: types.NewGenesisState(
+				types.Sponsorships{types.NewSponsorship(testSubspace, testGrantee, testAmount)},
+				types.FeeAllowances{types.NewFeeAllowance(testSubspace, testOwner, testGrantee, testAmount, testExpiration)},
+			),
+			expErr: "fee allowance granter cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns " +
+				"is not the owner of the sponsorship of subspace " + testSubspace,
+		},
+		{
+			name:    "Duplicated allowance",
+			genesis: types.NewGenesisState(types.Sponsorships{sponsorship}, types.FeeAllowances{allowance, allowance}),
+			expErr: "duplicated fee allowance for grantee cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn " +
+				"inside subspace " + testSubspace,
+		},
+		{
+			name:    "Valid genesis",
+			genesis: types.NewGenesisState(types.Sponsorships{sponsorship}, types.FeeAllowances{allowance}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := types.ValidateGenesis(test.genesis)
+			if test.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName   = "sponsorships"
+	RouterKey    = ModuleName
+	StoreKey     = ModuleName
+	QuerierRoute = ModuleName
+
+	ActionDepositSponsorship  = "deposit_sponsorship"
+	ActionWithdrawSponsorship = "withdraw_sponsorship"
+	ActionGrantFeeAllowance   = "grant_fee_allowance"
+	ActionRevokeFeeAllowance  = "revoke_fee_allowance"
+)
+
+var (
+	SponsorshipStorePrefix       = []byte("sponsorship")
+	AllowanceStorePrefix         = []byte("allowance")
+	GranteeAllowancesStorePrefix = []byte("grantee_allowances")
+)
+
+// SponsorshipStoreKey returns the key used to store the sponsorship of the given subspace
+func SponsorshipStoreKey(subspace string) []byte {
+	return append(SponsorshipStorePrefix, []byte(subspace)...)
+}
+
+// SubspaceAllowancesPrefix returns the prefix used to store all the fee allowances granted inside the given subspace
+func SubspaceAllowancesPrefix(subspace string) []byte {
+	return append(AllowanceStorePrefix, []byte(subspace)...)
+}
+
+// AllowanceStoreKey returns the key used to store the fee allowance granted to the given grantee inside the given subspace
+func AllowanceStoreKey(subspace string, grantee sdk.AccAddress) []byte {
+	return append(SubspaceAllowancesPrefix(subspace), grantee...)
+}
+
+// GranteeAllowancesPrefix returns the prefix used to index all the fee allowances granted to the given grantee
+func GranteeAllowancesPrefix(grantee sdk.AccAddress) []byte {
+	return append(GranteeAllowancesStorePrefix, grantee...)
+}
+
+// GranteeAllowanceStoreKey returns the key used to index the fee allowance granted to the given grantee
+// inside the given subspace
+func GranteeAllowanceStoreKey(grantee sdk.AccAddress, subspace string) []byte {
+	return append(GranteeAllowancesPrefix(grantee), []byte(subspace)...)
+}
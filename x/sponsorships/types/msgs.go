@@ -0,0 +1,226 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	postsCommon "github.com/desmos-labs/desmos/x/posts/types/models/common"
+)
+
+// ----------------------------
+// --- MsgDepositSponsorship
+// ----------------------------
+
+// MsgDepositSponsorship defines the message used to deposit funds inside the sponsorship of a subspace.
+// The first account depositing inside the sponsorship of a subspace becomes its owner
+type MsgDepositSponsorship struct {
+	Subspace string         `json:"subspace" yaml:"subspace"`
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount   sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgDepositSponsorship is the constructor of MsgDepositSponsorship
+func NewMsgDepositSponsorship(subspace string, owner sdk.AccAddress, amount sdk.Coins) MsgDepositSponsorship {
+	return MsgDepositSponsorship{
+		Subspace: subspace,
+		Owner:    owner,
+		Amount:   amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgDepositSponsorship) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgDepositSponsorship) Type() string { return ActionDepositSponsorship }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDepositSponsorship) ValidateBasic() error {
+	if !postsCommon.IsValidSubspace(msg.Subspace) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sponsorship subspace must be a valid sha-256 hash")
+	}
+
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid sponsorship owner: %s", msg.Owner))
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, fmt.Sprintf("invalid sponsorship amount: %s", msg.Amount))
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDepositSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDepositSponsorship) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ----------------------------
+// --- MsgWithdrawSponsorship
+// ----------------------------
+
+// MsgWithdrawSponsorship defines the message used by the owner of a sponsorship to withdraw
+// the funds that have not been used yet
+type MsgWithdrawSponsorship struct {
+	Subspace string         `json:"subspace" yaml:"subspace"`
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Amount   sdk.Coins      `json:"amount" yaml:"amount"`
+}
+
+// NewMsgWithdrawSponsorship is the constructor of MsgWithdrawSponsorship
+func NewMsgWithdrawSponsorship(subspace string, owner sdk.AccAddress, amount sdk.Coins) MsgWithdrawSponsorship {
+	return MsgWithdrawSponsorship{
+		Subspace: subspace,
+		Owner:    owner,
+		Amount:   amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgWithdrawSponsorship) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgWithdrawSponsorship) Type() string { return ActionWithdrawSponsorship }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWithdrawSponsorship) ValidateBasic() error {
+	if !postsCommon.IsValidSubspace(msg.Subspace) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sponsorship subspace must be a valid sha-256 hash")
+	}
+
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid sponsorship owner: %s", msg.Owner))
+	}
+
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, fmt.Sprintf("invalid withdraw amount: %s", msg.Amount))
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgWithdrawSponsorship) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgWithdrawSponsorship) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// ----------------------------
+// --- MsgGrantFeeAllowance
+// ----------------------------
+
+// MsgGrantFeeAllowance defines the message used by the owner of the sponsorship of a subspace to allow
+// the grantee to have its fees paid using the sponsorship funds, up to the spend limit and until the expiration.
+// Granting a new allowance to the same grantee replaces the existing one
+type MsgGrantFeeAllowance struct {
+	Subspace   string         `json:"subspace" yaml:"subspace"`
+	Granter    sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	SpendLimit sdk.Coins      `json:"spend_limit" yaml:"spend_limit"`
+	Expiration time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+// NewMsgGrantFeeAllowance is the constructor of MsgGrantFeeAllowance
+func NewMsgGrantFeeAllowance(
+	subspace string, granter, grantee sdk.AccAddress, spendLimit sdk.Coins, expiration time.Time,
+) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{
+		Subspace:   subspace,
+		Granter:    granter,
+		Grantee:    grantee,
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgGrantFeeAllowance) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgGrantFeeAllowance) Type() string { return ActionGrantFeeAllowance }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgGrantFeeAllowance) ValidateBasic() error {
+	allowance := NewFeeAllowance(msg.Subspace, msg.Granter, msg.Grantee, msg.SpendLimit, msg.Expiration)
+	if err := allowance.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// ----------------------------
+// --- MsgRevokeFeeAllowance
+// ----------------------------
+
+// MsgRevokeFeeAllowance defines the message used by the owner of the sponsorship of a subspace
+// to revoke the fee allowance previously granted to the grantee
+type MsgRevokeFeeAllowance struct {
+	Subspace string         `json:"subspace" yaml:"subspace"`
+	Granter  sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee  sdk.AccAddress `json:"grantee" yaml:"grantee"`
+}
+
+// NewMsgRevokeFeeAllowance is the constructor of MsgRevokeFeeAllowance
+func NewMsgRevokeFeeAllowance(subspace string, granter, grantee sdk.AccAddress) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{
+		Subspace: subspace,
+		Granter:  granter,
+		Grantee:  grantee,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRevokeFeeAllowance) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRevokeFeeAllowance) Type() string { return ActionRevokeFeeAllowance }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRevokeFeeAllowance) ValidateBasic() error {
+	if !postsCommon.IsValidSubspace(msg.Subspace) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance subspace must be a valid sha-256 hash")
+	}
+
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid fee allowance granter: %s", msg.Granter))
+	}
+
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid fee allowance grantee: %s", msg.Grantee))
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
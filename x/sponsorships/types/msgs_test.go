@@ -0,0 +1,287 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+var (
+	testSubspace   = "4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"
+	testOwner, _   = sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	testGrantee, _ = sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	testAmount     = sdk.NewCoins(sdk.NewInt64Coin("udaric", 1000))
+	testExpiration = time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+)
+
+// ----------------------------
+// --- MsgDepositSponsorship
+// ----------------------------
+
+var msgDepositSponsorship = types.NewMsgDepositSponsorship(testSubspace, testOwner, testAmount)
+
+func TestMsgDepositSponsorship_Route(t *testing.T) {
+	require.Equal(t, "sponsorships", msgDepositSponsorship.Route())
+}
+
+func TestMsgDepositSponsorship_Type(t *testing.T) {
+	require.Equal(t, "deposit_sponsorship", msgDepositSponsorship.Type())
+}
+
+func TestMsgDepositSponsorship_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    types.MsgDepositSponsorship
+		expErr error
+	}{
+		{
+			name:   "Invalid subspace",
+			msg:    types.NewMsgDepositSponsorship("subspace", testOwner, testAmount),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sponsorship subspace must be a valid sha-256 hash"),
+		},
+		{
+			name:   "Invalid owner",
+			msg:    types.NewMsgDepositSponsorship(testSubspace, nil, testAmount),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sponsorship owner: "),
+		},
+		{
+			name:   "Empty amount",
+			msg:    types.NewMsgDepositSponsorship(testSubspace, testOwner, nil),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid sponsorship amount: "),
+		},
+		{
+			name: "Invalid amount",
+			msg: types.NewMsgDepositSponsorship(testSubspace, testOwner,
+				sdk.Coins{sdk.Coin{Denom: "udaric", Amount: sdk.NewInt(-1)}}),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid sponsorship amount: -1udaric"),
+		},
+		{
+			name: "Valid message",
+			msg:  msgDepositSponsorship,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, test.expErr.Error(), err.Error())
+			}
+		})
+	}
+}
+
+func TestMsgDepositSponsorship_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgDepositSponsorship","value":{"amount":[{"amount":"1000","denom":"udaric"}],"owner":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","subspace":"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"}}`
+	require.Equal(t, expected, string(msgDepositSponsorship.GetSignBytes()))
+}
+
+func TestMsgDepositSponsorship_GetSigners(t *testing.T) {
+	require.Equal(t, []sdk.AccAddress{testOwner}, msgDepositSponsorship.GetSigners())
+}
+
+// ----------------------------
+// --- MsgWithdrawSponsorship
+// ----------------------------
+
+var msgWithdrawSponsorship = types.NewMsgWithdrawSponsorship(testSubspace, testOwner, testAmount)
+
+func TestMsgWithdrawSponsorship_Route(t *testing.T) {
+	require.Equal(t, "sponsorships", msgWithdrawSponsorship.Route())
+}
+
+func TestMsgWithdrawSponsorship_Type(t *testing.T) {
+	require.Equal(t, "withdraw_sponsorship", msgWithdrawSponsorship.Type())
+}
+
+func TestMsgWithdrawSponsorship_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    types.MsgWithdrawSponsorship
+		expErr error
+	}{
+		{
+			name:   "Invalid subspace",
+			msg:    types.NewMsgWithdrawSponsorship("subspace", testOwner, testAmount),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "sponsorship subspace must be a valid sha-256 hash"),
+		},
+		{
+			name:   "Invalid owner",
+			msg:    types.NewMsgWithdrawSponsorship(testSubspace, nil, testAmount),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid sponsorship owner: "),
+		},
+		{
+			name:   "Empty amount",
+			msg:    types.NewMsgWithdrawSponsorship(testSubspace, testOwner, nil),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid withdraw amount: "),
+		},
+		{
+			name: "Valid message",
+			msg:  msgWithdrawSponsorship,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, test.expErr.Error(), err.Error())
+			}
+		})
+	}
+}
+
+func TestMsgWithdrawSponsorship_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgWithdrawSponsorship","value":{"amount":[{"amount":"1000","denom":"udaric"}],"owner":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","subspace":"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"}}`
+	require.Equal(t, expected, string(msgWithdrawSponsorship.GetSignBytes()))
+}
+
+func TestMsgWithdrawSponsorship_GetSigners(t *testing.T) {
+	require.Equal(t, []sdk.AccAddress{testOwner}, msgWithdrawSponsorship.GetSigners())
+}
+
+// ----------------------------
+// --- MsgGrantFeeAllowance
+// ----------------------------
+
+var msgGrantFeeAllowance = types.NewMsgGrantFeeAllowance(testSubspace, testOwner, testGrantee, testAmount, testExpiration)
+
+func TestMsgGrantFeeAllowance_Route(t *testing.T) {
+	require.Equal(t, "sponsorships", msgGrantFeeAllowance.Route())
+}
+
+func TestMsgGrantFeeAllowance_Type(t *testing.T) {
+	require.Equal(t, "grant_fee_allowance", msgGrantFeeAllowance.Type())
+}
+
+func TestMsgGrantFeeAllowance_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    types.MsgGrantFeeAllowance
+		expErr error
+	}{
+		{
+			name:   "Invalid subspace",
+			msg:    types.NewMsgGrantFeeAllowance("subspace", testOwner, testGrantee, testAmount, testExpiration),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance subspace must be a valid sha-256 hash"),
+		},
+		{
+			name:   "Same granter and grantee",
+			msg:    types.NewMsgGrantFeeAllowance(testSubspace, testOwner, testOwner, testAmount, testExpiration),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance granter and grantee must be different"),
+		},
+		{
+			name:   "Empty spend limit",
+			msg:    types.NewMsgGrantFeeAllowance(testSubspace, testOwner, testGrantee, nil, testExpiration),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid fee allowance spend limit: "),
+		},
+		{
+			name:   "Empty expiration",
+			msg:    types.NewMsgGrantFeeAllowance(testSubspace, testOwner, testGrantee, testAmount, time.Time{}),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid fee allowance expiration: 0001-01-01 00:00:00 +0000 UTC"),
+		},
+		{
+			name: "Valid message",
+			msg:  msgGrantFeeAllowance,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, test.expErr.Error(), err.Error())
+			}
+		})
+	}
+}
+
+func TestMsgGrantFeeAllowance_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgGrantFeeAllowance","value":{"expiration":"2020-01-01T12:00:00Z","grantee":"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn","granter":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","spend_limit":[{"amount":"1000","denom":"udaric"}],"subspace":"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"}}`
+	require.Equal(t, expected, string(msgGrantFeeAllowance.GetSignBytes()))
+}
+
+func TestMsgGrantFeeAllowance_GetSigners(t *testing.T) {
+	require.Equal(t, []sdk.AccAddress{testOwner}, msgGrantFeeAllowance.GetSigners())
+}
+
+// ----------------------------
+// --- MsgRevokeFeeAllowance
+// ----------------------------
+
+var msgRevokeFeeAllowance = types.NewMsgRevokeFeeAllowance(testSubspace, testOwner, testGrantee)
+
+func TestMsgRevokeFeeAllowance_Route(t *testing.T) {
+	require.Equal(t, "sponsorships", msgRevokeFeeAllowance.Route())
+}
+
+func TestMsgRevokeFeeAllowance_Type(t *testing.T) {
+	require.Equal(t, "revoke_fee_allowance", msgRevokeFeeAllowance.Type())
+}
+
+func TestMsgRevokeFeeAllowance_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    types.MsgRevokeFeeAllowance
+		expErr error
+	}{
+		{
+			name:   "Invalid subspace",
+			msg:    types.NewMsgRevokeFeeAllowance("subspace", testOwner, testGrantee),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "fee allowance subspace must be a valid sha-256 hash"),
+		},
+		{
+			name:   "Invalid granter",
+			msg:    types.NewMsgRevokeFeeAllowance(testSubspace, nil, testGrantee),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid fee allowance granter: "),
+		},
+		{
+			name:   "Invalid grantee",
+			msg:    types.NewMsgRevokeFeeAllowance(testSubspace, testOwner, nil),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid fee allowance grantee: "),
+		},
+		{
+			name: "Valid message",
+			msg:  msgRevokeFeeAllowance,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, test.expErr.Error(), err.Error())
+			}
+		})
+	}
+}
+
+func TestMsgRevokeFeeAllowance_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgRevokeFeeAllowance","value":{"grantee":"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn","granter":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","subspace":"4e188d9c17150037d5199bbdb91ae1eb2a78a15aca04cb35530cccb81494b36e"}}`
+	require.Equal(t, expected, string(msgRevokeFeeAllowance.GetSignBytes()))
+}
+
+func TestMsgRevokeFeeAllowance_GetSigners(t *testing.T) {
+	require.Equal(t, []sdk.AccAddress{testOwner}, msgRevokeFeeAllowance.GetSigners())
+}
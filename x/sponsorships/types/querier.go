@@ -0,0 +1,8 @@
+package types
+
+// query endpoints supported by the sponsorships Querier
+const (
+	QuerySponsorship        = "sponsorship"
+	QuerySubspaceAllowances = "subspace_allowances"
+	QueryGranteeAllowances  = "grantee_allowances"
+)
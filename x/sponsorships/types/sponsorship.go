@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	postsCommon "github.com/desmos-labs/desmos/x/posts/types/models/common"
+)
+
+// Sponsorship contains the funds deposited by the owner of a subspace to pay the fees
+// of the users to which a fee allowance has been granted inside that subspace
+type Sponsorship struct {
+	Subspace string         `json:"subspace" yaml:"subspace"`
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Balance  sdk.Coins      `json:"balance" yaml:"balance"`
+}
+
+// NewSponsorship is a constructor function for Sponsorship
+func NewSponsorship(subspace string, owner sdk.AccAddress, balance sdk.Coins) Sponsorship {
+	return Sponsorship{
+		Subspace: subspace,
+		Owner:    owner,
+		Balance:  balance,
+	}
+}
+
+// String implements fmt.Stringer
+func (sponsorship Sponsorship) String() string {
+	return fmt.Sprintf("Subspace: %s\nOwner: %s\nBalance: %s",
+		sponsorship.Subspace, sponsorship.Owner, sponsorship.Balance)
+}
+
+// Validate checks the validity of the Sponsorship
+func (sponsorship Sponsorship) Validate() error {
+	if !postsCommon.IsValidSubspace(sponsorship.Subspace) {
+		return fmt.Errorf("sponsorship subspace must be a valid sha-256 hash")
+	}
+
+	if sponsorship.Owner.Empty() {
+		return fmt.Errorf("invalid sponsorship owner: %s", sponsorship.Owner)
+	}
+
+	if !sponsorship.Balance.IsValid() {
+		return fmt.Errorf("invalid sponsorship balance: %s", sponsorship.Balance)
+	}
+
+	return nil
+}
+
+// Sponsorships represents a slice of Sponsorship objects
+type Sponsorships []Sponsorship
@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/sponsorships/types"
+)
+
+func TestSponsorship_Validate(t *testing.T) {
+	tests := []struct {
+		name        string
+		sponsorship types.Sponsorship
+		expErr      string
+	}{
+		{
+			name:        "Invalid subspace",
+			sponsorship: types.NewSponsorship("subspace", testOwner, testAmount),
+			expErr:      "sponsorship subspace must be a valid sha-256 hash",
+		},
+		{
+			name:        "Invalid owner",
+			sponsorship: types.NewSponsorship(testSubspace, nil, testAmount),
+			expErr:      "invalid sponsorship owner: ",
+		},
+		{
+			name: "Invalid balance",
+			sponsorship: types.NewSponsorship(testSubspace, testOwner,
+				sdk.Coins{sdk.Coin{Denom: "udaric", Amount: sdk.NewInt(-1)}}),
+			expErr: "invalid sponsorship balance: -1udaric",
+		},
+		{
+			name:        "Empty balance is valid",
+			sponsorship: types.NewSponsorship(testSubspace, testOwner, nil),
+		},
+		{
+			name:        "Valid sponsorship",
+			sponsorship: types.NewSponsorship(testSubspace, testOwner, testAmount),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.sponsorship.Validate()
+			if test.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package subscriptions
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/keeper"
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+// EndBlocker releases to the creators the payments of the subscriptions periods ending at the current block height,
+// emitting an event for each of them and deleting the subscriptions that have no remaining period
+// or whose payment could not be released
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	paid, expired := k.ProcessDueSubscriptions(ctx, ctx.BlockHeight())
+	for _, subscription := range paid {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSubscriptionPayment,
+			sdk.NewAttribute(types.AttributeKeyCreator, subscription.Creator.String()),
+			sdk.NewAttribute(types.AttributeKeySubscriber, subscription.Subscriber.String()),
+			sdk.NewAttribute(types.AttributeKeyLevel, strconv.FormatUint(uint64(subscription.Level), 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, subscription.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyRemainingPeriods, strconv.FormatUint(subscription.RemainingPeriods, 10)),
+		))
+
+		if subscription.RemainingPeriods == 0 {
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeSubscriptionExpired,
+				sdk.NewAttribute(types.AttributeKeyCreator, subscription.Creator.String()),
+				sdk.NewAttribute(types.AttributeKeySubscriber, subscription.Subscriber.String()),
+				sdk.NewAttribute(types.AttributeKeyLevel, strconv.FormatUint(uint64(subscription.Level), 10)),
+			))
+		}
+	}
+
+	for _, subscription := range expired {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSubscriptionExpired,
+			sdk.NewAttribute(types.AttributeKeyCreator, subscription.Creator.String()),
+			sdk.NewAttribute(types.AttributeKeySubscriber, subscription.Subscriber.String()),
+			sdk.NewAttribute(types.AttributeKeyLevel, strconv.FormatUint(uint64(subscription.Level), 10)),
+		))
+	}
+}
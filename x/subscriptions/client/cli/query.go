@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+// GetQueryCmd adds the query commands
+func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	subscriptionsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the subscriptions module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	subscriptionsQueryCmd.AddCommand(flags.GetCommands(
+		GetCmdTiers(storeKey, cdc),
+		GetCmdSubscription(storeKey, cdc),
+		GetCmdSubscriptionsList(storeKey, cdc, "creator-subscriptions [creator]",
+			"Returns the subscriptions to the given creator", types.QueryCreatorSubscriptions),
+		GetCmdSubscriptionsList(storeKey, cdc, "subscriber-subscriptions [address]",
+			"Returns the subscriptions of the given address", types.QuerySubscriberSubscriptions),
+		GetCmdParams(storeKey, cdc),
+	)...)
+	return subscriptionsQueryCmd
+}
+
+// GetCmdTiers queries the tiers defined by a creator
+func GetCmdTiers(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "tiers [creator]",
+		Short: "Returns the subscription tiers defined by the given creator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryTiers, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.Tiers
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdSubscription queries the subscription of an address to a creator
+func GetCmdSubscription(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "subscription [creator] [subscriber]",
+		Short: "Returns the subscription of the subscriber to the creator, if any.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s/%s", queryRoute, types.QuerySubscription, args[0], args[1])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf("Could not find the subscription of %s to %s \n", args[1], args[0])
+				return nil
+			}
+
+			var out types.Subscription
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdSubscriptionsList returns the command used to query the list of subscriptions using the given query path,
+// whose parameter is the only argument of the command
+func GetCmdSubscriptionsList(queryRoute string, cdc *codec.Codec, use, short, query string) *cobra.Command {
+	return &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, query, args[0])
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var out types.Subscriptions
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdParams queries the subscriptions module parameters
+func GetCmdParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "parameters",
+		Short: "Retrieve all the subscriptions module parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParams)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf("Could not find subscriptions parameters")
+				return nil
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
@@ -0,0 +1,174 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+// GetTxCmd set the tx commands
+func GetTxCmd(_ string, cdc *codec.Codec) *cobra.Command {
+	subscriptionsTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Subscriptions transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	subscriptionsTxCmd.AddCommand(flags.PostCommands(
+		GetCmdCreateTier(cdc),
+		GetCmdDeleteTier(cdc),
+		GetCmdSubscribe(cdc),
+		GetCmdCancelSubscription(cdc),
+	)...)
+
+	return subscriptionsTxCmd
+}
+
+// parseLevel parses the given tier level
+func parseLevel(value string) (uint32, error) {
+	level, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid tier level: %s", value)
+	}
+	return uint32(level), nil
+}
+
+// GetCmdCreateTier is the CLI command for creating a new subscription tier
+func GetCmdCreateTier(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "create-tier [level] [name] [price] [period]",
+		Short: "Creates a new subscription tier having the given level",
+		Long: fmt.Sprintf(`Creates a new subscription tier having the given level, name and price.
+The price is paid by the subscribers for each period, expressed in number of blocks.
+Higher levels give access to the posts reserved to the lower ones.
+
+E.g.
+%s tx subscriptions create-tier 1 "Supporter" 1000udaric 14400
+`, version.ClientName),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			level, err := parseLevel(args[0])
+			if err != nil {
+				return err
+			}
+
+			price, err := sdk.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+
+			period, err := strconv.ParseInt(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid tier period: %s", args[3])
+			}
+
+			msg := types.NewMsgCreateTier(cliCtx.FromAddress, level, args[1], price, period)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdDeleteTier is the CLI command for deleting a subscription tier
+func GetCmdDeleteTier(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete-tier [level]",
+		Short: "Deletes the subscription tier of the signer having the given level",
+		Long: `Deletes the subscription tier of the signer having the given level.
+The existing subscriptions to the tier are kept until all their prepaid periods have been paid.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			level, err := parseLevel(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteTier(cliCtx.FromAddress, level)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubscribe is the CLI command for subscribing to a tier of a creator
+func GetCmdSubscribe(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "subscribe [creator] [level] [periods]",
+		Short: "Subscribes to the tier of the creator, prepaying the given number of periods",
+		Long: fmt.Sprintf(`Subscribes to the tier of the creator having the given level, prepaying the given number of periods.
+The payment of each period is released to the creator at the end of the period.
+Subscribing again to the same tier extends the existing subscription.
+
+E.g.
+%s tx subscriptions subscribe desmos1w3fe8zq5jrxd4nz49hllg75sw7m24qyc7tnaax 1 3
+`, version.ClientName),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			creator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			level, err := parseLevel(args[1])
+			if err != nil {
+				return err
+			}
+
+			periods, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid periods: %s", args[2])
+			}
+
+			msg := types.NewMsgSubscribe(cliCtx.FromAddress, creator, level, periods)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCancelSubscription is the CLI command for cancelling the subscription to a creator
+func GetCmdCancelSubscription(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel [creator]",
+		Short: "Cancels the subscription of the signer to the creator",
+		Long: `Cancels the subscription of the signer to the creator.
+The payment of the current period is released to the creator, while the remaining ones are refunded.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			creator, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelSubscription(cliCtx.FromAddress, creator)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
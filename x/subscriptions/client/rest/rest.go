@@ -0,0 +1,224 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/gorilla/mux"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
+	r.HandleFunc("/subscriptions/params", queryHandler(cliCtx, storeName, types.QueryParams)).Methods("GET")
+	r.HandleFunc("/subscriptions/tiers", createTierHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/subscriptions/tiers/{level}/delete", deleteTierHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/subscriptions/creators/{creator}/tiers", queryHandler(cliCtx, storeName, types.QueryTiers, "creator")).Methods("GET")
+	r.HandleFunc("/subscriptions/creators/{creator}/subscriptions", queryHandler(cliCtx, storeName, types.QueryCreatorSubscriptions, "creator")).Methods("GET")
+	r.HandleFunc("/subscriptions/creators/{creator}/subscriptions/{subscriber}", queryHandler(cliCtx, storeName, types.QuerySubscription, "creator", "subscriber")).Methods("GET")
+	r.HandleFunc("/subscriptions/creators/{creator}/subscribe", subscribeHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/subscriptions/creators/{creator}/cancel", cancelSubscriptionHandler(cliCtx)).Methods("POST")
+	r.HandleFunc("/subscriptions/subscribers/{address}", queryHandler(cliCtx, storeName, types.QuerySubscriberSubscriptions, "address")).Methods("GET")
+}
+
+// --------------------------------------------------------------------------------------
+// Tx Handler
+
+type createTierReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Creator string       `json:"creator"`
+	Level   uint32       `json:"level"`
+	Name    string       `json:"name"`
+	Price   sdk.Coin     `json:"price"`
+	Period  int64        `json:"period"`
+}
+
+func createTierHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req createTierReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		creator, err := sdk.AccAddressFromBech32(req.Creator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgCreateTier(creator, req.Level, req.Name, req.Price, req.Period)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type deleteTierReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Creator string       `json:"creator"`
+}
+
+func deleteTierHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req deleteTierReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		creator, err := sdk.AccAddressFromBech32(req.Creator)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		level, err := strconv.ParseUint(mux.Vars(r)["level"], 10, 32)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid tier level: %s", mux.Vars(r)["level"]))
+			return
+		}
+
+		msg := types.NewMsgDeleteTier(creator, uint32(level))
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type subscribeReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	Subscriber string       `json:"subscriber"`
+	Level      uint32       `json:"level"`
+	Periods    uint64       `json:"periods"`
+}
+
+// readCreatorAndSubscriber reads the creator from the route variables and the given subscriber,
+// returning false if any of them is not valid
+func readCreatorAndSubscriber(
+	w http.ResponseWriter, r *http.Request, subscriber string,
+) (sdk.AccAddress, sdk.AccAddress, bool) {
+	creatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["creator"])
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, nil, false
+	}
+
+	subscriberAddr, err := sdk.AccAddressFromBech32(subscriber)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, nil, false
+	}
+
+	return creatorAddr, subscriberAddr, true
+}
+
+func subscribeHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req subscribeReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		creator, subscriber, ok := readCreatorAndSubscriber(w, r, req.Subscriber)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgSubscribe(subscriber, creator, req.Level, req.Periods)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+type cancelSubscriptionReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	Subscriber string       `json:"subscriber"`
+}
+
+func cancelSubscriptionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req cancelSubscriptionReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		creator, subscriber, ok := readCreatorAndSubscriber(w, r, req.Subscriber)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCancelSubscription(subscriber, creator)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
+// --------------------------------------------------------------------------------------
+// Query Handlers
+
+// queryHandler returns the handler used to perform the given query,
+// whose path parameters are read from the given route variables
+func queryHandler(cliCtx context.CLIContext, storeName, query string, variables ...string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", storeName, query)
+		for _, variable := range variables {
+			route = fmt.Sprintf("%s/%s", route, mux.Vars(r)[variable])
+		}
+
+		res, _, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
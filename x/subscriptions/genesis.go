@@ -0,0 +1,36 @@
+package subscriptions
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/keeper"
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+// ExportGenesis returns the GenesisState associated with the given context
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	return types.GenesisState{
+		Params:        k.GetParams(ctx),
+		Tiers:         k.GetTiers(ctx),
+		Subscriptions: k.GetSubscriptions(ctx),
+	}
+}
+
+// InitGenesis initializes the chain state based on the given GenesisState
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState) []abci.ValidatorUpdate {
+	// Make sure the module account exists
+	keeper.SupplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	keeper.SetParams(ctx, data.Params)
+
+	for _, tier := range data.Tiers {
+		keeper.SaveTier(ctx, tier)
+	}
+
+	for _, subscription := range data.Subscriptions {
+		keeper.SaveSubscription(ctx, subscription)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
@@ -0,0 +1,152 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	profilesK "github.com/desmos-labs/desmos/x/profiles/keeper"
+	profilesT "github.com/desmos-labs/desmos/x/profiles/types"
+	relationshipsK "github.com/desmos-labs/desmos/x/relationships/keeper"
+	relationshipsT "github.com/desmos-labs/desmos/x/relationships/types"
+	"github.com/desmos-labs/desmos/x/subscriptions/keeper"
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	db "github.com/tendermint/tm-db"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	cdc                 *codec.Codec
+	ctx                 sdk.Context
+	keeper              keeper.Keeper
+	ak                  auth.AccountKeeper
+	supplyKeeper        supply.Keeper
+	relationshipsKeeper relationshipsK.Keeper
+	ms                  store.CommitMultiStore
+	testData            TestData
+}
+
+type TestData struct {
+	creator      sdk.AccAddress
+	subscriber   sdk.AccAddress
+	tier         types.Tier
+	subscription types.Subscription
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	// define store store keys
+	subscriptionsKey := sdk.NewKVStoreKey(types.StoreKey)
+	relationshipsKey := sdk.NewKVStoreKey(relationshipsT.StoreKey)
+	profilesKey := sdk.NewKVStoreKey(profilesT.StoreKey)
+	authKey := sdk.NewKVStoreKey(auth.StoreKey)
+	supplyKey := sdk.NewKVStoreKey(supply.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	// create an in-memory db
+	memDB := db.NewMemDB()
+	suite.ms = store.NewCommitMultiStore(memDB)
+	suite.ms.MountStoreWithDB(subscriptionsKey, sdk.StoreTypeIAVL, memDB)
+	suite.ms.MountStoreWithDB(relationshipsKey, sdk.StoreTypeIAVL, memDB)
+	suite.ms.MountStoreWithDB(profilesKey, sdk.StoreTypeIAVL, memDB)
+	suite.ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, memDB)
+	suite.ms.MountStoreWithDB(supplyKey, sdk.StoreTypeIAVL, memDB)
+	suite.ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, memDB)
+	suite.ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, memDB)
+	if err := suite.ms.LoadLatestVersion(); err != nil {
+		panic(err)
+	}
+
+	blockTime := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	header := abci.Header{ChainID: "test-chain-id", Height: 100, Time: blockTime}
+	suite.ctx = sdk.NewContext(suite.ms, header, false, log.NewNopLogger())
+	suite.cdc = testCodec()
+
+	paramsKeeper := params.NewKeeper(suite.cdc, paramsKey, paramsTKey)
+	suite.ak = auth.NewAccountKeeper(suite.cdc, authKey, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(suite.ak, paramsKeeper.Subspace(bank.DefaultParamspace), nil)
+	suite.supplyKeeper = supply.NewKeeper(suite.cdc, supplyKey, suite.ak, bankKeeper, map[string][]string{
+		types.ModuleName: nil,
+	})
+	suite.supplyKeeper.SetModuleAccount(suite.ctx, supply.NewEmptyModuleAccount(types.ModuleName))
+
+	profilesKeeper := profilesK.NewKeeper(suite.cdc, profilesKey, paramsKeeper.Subspace(profilesT.DefaultParamspace))
+	suite.relationshipsKeeper = relationshipsK.NewKeeper(profilesKeeper, suite.cdc, relationshipsKey)
+	suite.keeper = keeper.NewKeeper(suite.relationshipsKeeper, suite.supplyKeeper, suite.cdc, subscriptionsKey,
+		paramsKeeper.Subspace(types.DefaultParamspace))
+	suite.keeper.SetParams(suite.ctx, types.NewParams(10, 12))
+
+	// setup Data
+	// nolint - errcheck
+	suite.testData.creator, _ = sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	// nolint - errcheck
+	suite.testData.subscriber, _ = sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	suite.testData.tier = types.NewTier(
+		suite.testData.creator,
+		1,
+		"Supporter",
+		sdk.NewInt64Coin("udaric", 100),
+		10,
+	)
+	suite.testData.subscription = types.NewSubscription(
+		suite.testData.creator,
+		suite.testData.subscriber,
+		1,
+		sdk.NewInt64Coin("udaric", 100),
+		10,
+		3,
+		110,
+	)
+}
+
+// fundSubscription stores the given subscription, sending its escrow to the module account
+func (suite *KeeperTestSuite) fundSubscription(subscription types.Subscription) {
+	moduleAcc := suite.supplyKeeper.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.NoError(moduleAcc.SetCoins(moduleAcc.GetCoins().Add(subscription.Escrow()...)))
+	suite.supplyKeeper.SetModuleAccount(suite.ctx, moduleAcc)
+	suite.keeper.SaveSubscription(suite.ctx, subscription)
+}
+
+// setBalance sets the coins of the account having the given address, creating it if needed
+func (suite *KeeperTestSuite) setBalance(address sdk.AccAddress, coins sdk.Coins) {
+	acc := suite.ak.NewAccountWithAddress(suite.ctx, address)
+	suite.NoError(acc.SetCoins(coins))
+	suite.ak.SetAccount(suite.ctx, acc)
+}
+
+// getBalance returns the coins of the account having the given address
+func (suite *KeeperTestSuite) getBalance(address sdk.AccAddress) sdk.Coins {
+	acc := suite.ak.GetAccount(suite.ctx, address)
+	if acc == nil {
+		return nil
+	}
+	return acc.GetCoins()
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func testCodec() *codec.Codec {
+	var cdc = codec.New()
+
+	// register the different types
+	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	supply.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+
+	cdc.Seal()
+	return cdc
+}
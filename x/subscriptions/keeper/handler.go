@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+// NewHandler returns a handler for "subscriptions" type messages.
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgCreateTier:
+			return handleMsgCreateTier(ctx, keeper, msg)
+		case types.MsgDeleteTier:
+			return handleMsgDeleteTier(ctx, keeper, msg)
+		case types.MsgSubscribe:
+			return handleMsgSubscribe(ctx, keeper, msg)
+		case types.MsgCancelSubscription:
+			return handleMsgCancelSubscription(ctx, keeper, msg)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest,
+				fmt.Sprintf("unrecognized subscriptions message type: %v", msg.Type()))
+		}
+	}
+}
+
+// handleMsgCreateTier handles the creation of a new subscription tier
+func handleMsgCreateTier(ctx sdk.Context, keeper Keeper, msg types.MsgCreateTier) (*sdk.Result, error) {
+	if _, found := keeper.GetTier(ctx, msg.Creator, msg.Level); found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("tier %d of creator %s already exists", msg.Level, msg.Creator))
+	}
+
+	minPeriod := keeper.GetParams(ctx).MinTierPeriod
+	if msg.Period < minPeriod {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("tier period cannot be shorter than %d blocks", minPeriod))
+	}
+
+	tier := types.NewTier(msg.Creator, msg.Level, msg.Name, msg.Price, msg.Period)
+	keeper.SaveTier(ctx, tier)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCreateTier,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator.String()),
+		sdk.NewAttribute(types.AttributeKeyLevel, strconv.FormatUint(uint64(msg.Level), 10)),
+		sdk.NewAttribute(types.AttributeKeyPrice, msg.Price.String()),
+		sdk.NewAttribute(types.AttributeKeyPeriod, strconv.FormatInt(msg.Period, 10)),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgDeleteTier handles the deletion of a subscription tier by its creator.
+// The existing subscriptions are not affected, and keep paying the locked price until they expire
+func handleMsgDeleteTier(ctx sdk.Context, keeper Keeper, msg types.MsgDeleteTier) (*sdk.Result, error) {
+	if _, found := keeper.GetTier(ctx, msg.Creator, msg.Level); !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("tier %d of creator %s not found", msg.Level, msg.Creator))
+	}
+
+	keeper.DeleteTier(ctx, msg.Creator, msg.Level)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeleteTier,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator.String()),
+		sdk.NewAttribute(types.AttributeKeyLevel, strconv.FormatUint(uint64(msg.Level), 10)),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgSubscribe handles the subscription to a tier, moving the payments of the prepaid periods
+// to the module account. Subscribing again to the same tier extends the existing subscription
+func handleMsgSubscribe(ctx sdk.Context, keeper Keeper, msg types.MsgSubscribe) (*sdk.Result, error) {
+	tier, found := keeper.GetTier(ctx, msg.Creator, msg.Level)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("tier %d of creator %s not found", msg.Level, msg.Creator))
+	}
+
+	if keeper.RelationshipsKeeper.IsUserBlocked(ctx, msg.Creator, msg.Subscriber) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+			fmt.Sprintf("the creator %s has blocked you", msg.Creator))
+	}
+
+	subscription, found := keeper.GetSubscription(ctx, msg.Creator, msg.Subscriber)
+	if found {
+		if subscription.Level != msg.Level {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("already subscribed to tier %d of creator %s, cancel the subscription first",
+					subscription.Level, msg.Creator))
+		}
+
+		if !subscription.MatchesTier(tier) {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				fmt.Sprintf("tier %d of creator %s has changed since the subscription, cancel the subscription first",
+					msg.Level, msg.Creator))
+		}
+
+		subscription.RemainingPeriods += msg.Periods
+	} else {
+		subscription = types.NewSubscription(msg.Creator, msg.Subscriber, msg.Level, tier.Price, tier.Period,
+			msg.Periods, ctx.BlockHeight()+tier.Period)
+	}
+
+	maxPeriods := keeper.GetParams(ctx).MaxPrepaidPeriods
+	if subscription.RemainingPeriods > maxPeriods {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("cannot prepay more than %d periods", maxPeriods))
+	}
+
+	amount := types.PeriodsPrice(tier.Price, msg.Periods)
+	if err := keeper.SupplyKeeper.SendCoinsFromAccountToModule(ctx, msg.Subscriber, types.ModuleName, amount); err != nil {
+		return nil, err
+	}
+
+	keeper.SaveSubscription(ctx, subscription)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSubscribe,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator.String()),
+		sdk.NewAttribute(types.AttributeKeySubscriber, msg.Subscriber.String()),
+		sdk.NewAttribute(types.AttributeKeyLevel, strconv.FormatUint(uint64(msg.Level), 10)),
+		sdk.NewAttribute(types.AttributeKeyPeriods, strconv.FormatUint(msg.Periods, 10)),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// handleMsgCancelSubscription handles the cancellation of a subscription by its subscriber.
+// As the current period has already started, its payment is released to the creator,
+// while the payments of the following periods are refunded to the subscriber
+func handleMsgCancelSubscription(
+	ctx sdk.Context, keeper Keeper, msg types.MsgCancelSubscription,
+) (*sdk.Result, error) {
+	subscription, found := keeper.GetSubscription(ctx, msg.Creator, msg.Subscriber)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("subscription of %s to creator %s not found", msg.Subscriber, msg.Creator))
+	}
+
+	updated, err := keeper.PaySubscriptionPeriod(ctx, subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	refund := sdk.NewCoins()
+	if updated.RemainingPeriods > 0 {
+		refund, err = keeper.RefundSubscription(ctx, updated)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelSubscription,
+		sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator.String()),
+		sdk.NewAttribute(types.AttributeKeySubscriber, msg.Subscriber.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, subscription.Price.String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	))
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,320 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	relationshipsT "github.com/desmos-labs/desmos/x/relationships/types"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/keeper"
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+func (suite *KeeperTestSuite) Test_handleMsgCreateTier() {
+	tier := suite.testData.tier
+
+	tests := []struct {
+		name   string
+		stored bool
+		msg    types.MsgCreateTier
+		expErr error
+	}{
+		{
+			name:   "Existing tier returns error",
+			stored: true,
+			msg:    types.NewMsgCreateTier(tier.Creator, tier.Level, "Other", tier.Price, tier.Period),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				"tier 1 of creator cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns already exists"),
+		},
+		{
+			name:   "Period shorter than the min one returns error",
+			msg:    types.NewMsgCreateTier(tier.Creator, tier.Level, tier.Name, tier.Price, 9),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "tier period cannot be shorter than 10 blocks"),
+		},
+		{
+			name: "Valid tier is stored",
+			msg:  types.NewMsgCreateTier(tier.Creator, tier.Level, tier.Name, tier.Price, tier.Period),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			if test.stored {
+				suite.keeper.SaveTier(suite.ctx, tier)
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(res)
+				return
+			}
+
+			suite.NoError(err)
+			suite.Len(res.Events, 1)
+			suite.Equal(types.EventTypeCreateTier, res.Events[0].Type)
+
+			stored, found := suite.keeper.GetTier(suite.ctx, tier.Creator, tier.Level)
+			suite.True(found)
+			suite.Equal(tier, stored)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgDeleteTier() {
+	tests := []struct {
+		name   string
+		stored bool
+		expErr error
+	}{
+		{
+			name: "Not found tier returns error",
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				"tier 1 of creator cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns not found"),
+		},
+		{
+			name:   "Existing tier is deleted keeping its subscriptions",
+			stored: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.keeper.SaveSubscription(suite.ctx, suite.testData.subscription)
+			if test.stored {
+				suite.keeper.SaveTier(suite.ctx, suite.testData.tier)
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, types.NewMsgDeleteTier(suite.testData.creator, 1))
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(res)
+				return
+			}
+
+			suite.NoError(err)
+			suite.Len(res.Events, 1)
+			suite.Equal(types.EventTypeDeleteTier, res.Events[0].Type)
+
+			_, found := suite.keeper.GetTier(suite.ctx, suite.testData.creator, 1)
+			suite.False(found)
+
+			_, found = suite.keeper.GetSubscription(suite.ctx, suite.testData.creator, suite.testData.subscriber)
+			suite.True(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgSubscribe() {
+	creator := suite.testData.creator
+	subscriber := suite.testData.subscriber
+	tier := suite.testData.tier
+
+	tests := []struct {
+		name            string
+		storedTiers     types.Tiers
+		stored          *types.Subscription
+		blocked         bool
+		msg             types.MsgSubscribe
+		expErr          error
+		expSubscription types.Subscription
+		expBalance      sdk.Coins
+	}{
+		{
+			name: "Not found tier returns error",
+			msg:  types.NewMsgSubscribe(subscriber, creator, 1, 3),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				"tier 1 of creator cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns not found"),
+		},
+		{
+			name:        "Blocked subscriber returns error",
+			storedTiers: types.Tiers{tier},
+			blocked:     true,
+			msg:         types.NewMsgSubscribe(subscriber, creator, 1, 3),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnauthorized,
+				"the creator cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns has blocked you"),
+		},
+		{
+			name: "Subscription to a different level returns error",
+			storedTiers: types.Tiers{tier,
+				types.NewTier(creator, 2, "Patron", sdk.NewInt64Coin("udaric", 500), 10)},
+			stored: &suite.testData.subscription,
+			msg:    types.NewMsgSubscribe(subscriber, creator, 2, 3),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "already subscribed to tier 1 of creator "+
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns, cancel the subscription first"),
+		},
+		{
+			name:        "Extension of a subscription whose tier has changed returns error",
+			storedTiers: types.Tiers{types.NewTier(creator, 1, "Supporter", sdk.NewInt64Coin("udaric", 200), 10)},
+			stored:      &suite.testData.subscription,
+			msg:         types.NewMsgSubscribe(subscriber, creator, 1, 3),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "tier 1 of creator "+
+				"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns has changed since the subscription, "+
+				"cancel the subscription first"),
+		},
+		{
+			name:        "Too many prepaid periods returns error",
+			storedTiers: types.Tiers{tier},
+			stored:      &suite.testData.subscription,
+			msg:         types.NewMsgSubscribe(subscriber, creator, 1, 10),
+			expErr:      sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot prepay more than 12 periods"),
+		},
+		{
+			name:        "Insufficient funds returns error",
+			storedTiers: types.Tiers{tier},
+			msg:         types.NewMsgSubscribe(subscriber, creator, 1, 11),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds,
+				"insufficient account funds; 1000udaric < 1100udaric"),
+		},
+		{
+			name:        "New subscription is stored",
+			storedTiers: types.Tiers{tier},
+			msg:         types.NewMsgSubscribe(subscriber, creator, 1, 3),
+			expSubscription: types.NewSubscription(creator, subscriber, 1, tier.Price, tier.Period,
+				3, 110),
+			expBalance: sdk.NewCoins(sdk.NewInt64Coin("udaric", 700)),
+		},
+		{
+			name:        "Existing subscription is extended",
+			storedTiers: types.Tiers{tier},
+			stored: &types.Subscription{
+				Creator:          creator,
+				Subscriber:       subscriber,
+				Level:            1,
+				Price:            tier.Price,
+				Period:           tier.Period,
+				RemainingPeriods: 2,
+				NextPayment:      105,
+			},
+			msg: types.NewMsgSubscribe(subscriber, creator, 1, 3),
+			expSubscription: types.NewSubscription(creator, subscriber, 1, tier.Price, tier.Period,
+				5, 105),
+			expBalance: sdk.NewCoins(sdk.NewInt64Coin("udaric", 700)),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.setBalance(subscriber, sdk.NewCoins(sdk.NewInt64Coin("udaric", 1000)))
+			for _, tier := range test.storedTiers {
+				suite.keeper.SaveTier(suite.ctx, tier)
+			}
+			if test.stored != nil {
+				suite.fundSubscription(*test.stored)
+			}
+			if test.blocked {
+				suite.NoError(suite.relationshipsKeeper.SaveUserBlock(suite.ctx,
+					relationshipsT.NewUserBlock(creator, subscriber, "reason")))
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, test.msg)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(res)
+				return
+			}
+
+			suite.NoError(err)
+			suite.Len(res.Events, 3) // the first two events are emitted by the bank transfer
+			suite.Equal(types.EventTypeSubscribe, res.Events[2].Type)
+
+			stored, found := suite.keeper.GetSubscription(suite.ctx, creator, subscriber)
+			suite.True(found)
+			suite.Equal(test.expSubscription, stored)
+			suite.Equal(test.expBalance, suite.getBalance(subscriber))
+
+			moduleAcc := suite.supplyKeeper.GetModuleAccount(suite.ctx, types.ModuleName)
+			suite.Equal(test.expSubscription.Escrow(), moduleAcc.GetCoins())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_handleMsgCancelSubscription() {
+	creator := suite.testData.creator
+	subscriber := suite.testData.subscriber
+	subscription := suite.testData.subscription
+
+	tests := []struct {
+		name          string
+		stored        *types.Subscription
+		expErr        error
+		expEvents     int
+		expRefund     sdk.Coins
+		expCreatorPay sdk.Coins
+	}{
+		{
+			name: "Not found subscription returns error",
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				"subscription of cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn to creator "+
+					"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns not found"),
+		},
+		{
+			name:          "Remaining periods are refunded",
+			stored:        &subscription,
+			expEvents:     5, // the first four events are emitted by the bank transfers
+			expRefund:     sdk.NewCoins(sdk.NewInt64Coin("udaric", 200)),
+			expCreatorPay: sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)),
+		},
+		{
+			name: "Last period is paid to the creator",
+			stored: &types.Subscription{
+				Creator:          creator,
+				Subscriber:       subscriber,
+				Level:            1,
+				Price:            subscription.Price,
+				Period:           subscription.Period,
+				RemainingPeriods: 1,
+				NextPayment:      105,
+			},
+			expEvents:     3, // the first two events are emitted by the bank transfer
+			expRefund:     nil,
+			expCreatorPay: sdk.NewCoins(sdk.NewInt64Coin("udaric", 100)),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			if test.stored != nil {
+				suite.fundSubscription(*test.stored)
+			}
+
+			handler := keeper.NewHandler(suite.keeper)
+			res, err := handler(suite.ctx, types.NewMsgCancelSubscription(subscriber, creator))
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(res)
+				return
+			}
+
+			suite.NoError(err)
+			suite.Len(res.Events, test.expEvents)
+			suite.Equal(types.EventTypeCancelSubscription, res.Events[test.expEvents-1].Type)
+
+			_, found := suite.keeper.GetSubscription(suite.ctx, creator, subscriber)
+			suite.False(found)
+			suite.Empty(suite.keeper.GetDueSubscriptions(suite.ctx, 1000))
+
+			suite.Equal(test.expRefund, suite.getBalance(subscriber))
+			suite.Equal(test.expCreatorPay, suite.getBalance(creator))
+			suite.True(suite.supplyKeeper.GetModuleAccount(suite.ctx, types.ModuleName).GetCoins().IsZero())
+		})
+	}
+}
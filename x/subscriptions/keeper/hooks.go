@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	relationshipsTypes "github.com/desmos-labs/desmos/x/relationships/types"
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+// Hooks wraps the subscriptions Keeper to react to the relationships events
+type Hooks struct {
+	k Keeper
+}
+
+var _ relationshipsTypes.RelationshipsHooks = Hooks{}
+
+// Hooks returns the relationships hooks implemented by the subscriptions module
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterUserBlocked cancels the subscription of the blocked user to the tiers of the blocker,
+// refunding all its remaining periods since the subscriber can no longer access the creator content
+func (h Hooks) AfterUserBlocked(ctx sdk.Context, blocker, blocked sdk.AccAddress) {
+	subscription, found := h.k.GetSubscription(ctx, blocker, blocked)
+	if !found {
+		return
+	}
+
+	refund, err := h.k.RefundSubscription(ctx, subscription)
+	if err != nil {
+		ctx.Logger().Error("could not refund the subscription of a blocked user, deleting the subscription",
+			"creator", blocker.String(), "subscriber", blocked.String(), "error", err.Error())
+
+		h.k.DeleteSubscription(ctx, subscription)
+		refund = sdk.NewCoins()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelSubscription,
+		sdk.NewAttribute(types.AttributeKeyCreator, blocker.String()),
+		sdk.NewAttribute(types.AttributeKeySubscriber, blocked.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, sdk.NewCoins().String()),
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+	))
+}
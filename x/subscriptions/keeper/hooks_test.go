@@ -0,0 +1,35 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	relationshipsK "github.com/desmos-labs/desmos/x/relationships/keeper"
+	relationshipsT "github.com/desmos-labs/desmos/x/relationships/types"
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+func (suite *KeeperTestSuite) TestHooks_AfterUserBlocked() {
+	otherCreator, _ := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+
+	subscription := suite.testData.subscription
+	other := types.NewSubscription(otherCreator, suite.testData.subscriber, 1, subscription.Price, 10, 2, 110)
+	reverse := types.NewSubscription(suite.testData.subscriber, suite.testData.creator, 1, subscription.Price, 10, 1, 110)
+	for _, sub := range []types.Subscription{subscription, other, reverse} {
+		suite.fundSubscription(sub)
+	}
+
+	suite.relationshipsKeeper.SetHooks(suite.keeper.Hooks())
+	handler := relationshipsK.NewHandler(suite.relationshipsKeeper)
+	_, err := handler(suite.ctx, relationshipsT.NewMsgBlockUser(suite.testData.creator, suite.testData.subscriber, "spam"))
+	suite.NoError(err)
+
+	// Only the subscription of the blocked user to the blocker should be cancelled and refunded
+	_, found := suite.keeper.GetSubscription(suite.ctx, suite.testData.creator, suite.testData.subscriber)
+	suite.False(found)
+	suite.ElementsMatch(types.Subscriptions{other, reverse}, suite.keeper.GetSubscriptions(suite.ctx))
+	suite.Equal(subscription.Escrow(), suite.getBalance(suite.testData.subscriber))
+	suite.Empty(suite.getBalance(suite.testData.creator))
+
+	moduleAcc := suite.supplyKeeper.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(other.Escrow().Add(reverse.Escrow()...), moduleAcc.GetCoins())
+}
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+// RegisterInvariants registers all subscriptions invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, "subscriptions-escrow",
+		SubscriptionsEscrowInvariant(keeper))
+}
+
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := SubscriptionsEscrowInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		return "Every invariant condition is fulfilled correctly", false
+	}
+}
+
+// SubscriptionsEscrowInvariant checks that the subscriptions module account holds at least the payments
+// of the remaining periods of all the subscriptions
+func SubscriptionsEscrowInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expectedBalance sdk.Coins
+		for _, subscription := range k.GetSubscriptions(ctx) {
+			expectedBalance = expectedBalance.Add(subscription.Escrow()...)
+		}
+
+		moduleCoins := k.SupplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+		broken := !moduleCoins.IsAllGTE(expectedBalance)
+
+		return sdk.FormatInvariant(types.ModuleName, "subscriptions escrow",
+			fmt.Sprintf("\tsum of subscriptions escrows: %s\n\tmodule account coins: %s\n",
+				expectedBalance, moduleCoins)), broken
+	}
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"github.com/desmos-labs/desmos/x/subscriptions/keeper"
+)
+
+func (suite *KeeperTestSuite) TestInvariants() {
+	tests := []struct {
+		name        string
+		funded      bool
+		expResponse string
+		expBool     bool
+	}{
+		{
+			name:        "Invariants not violated",
+			funded:      true,
+			expResponse: "Every invariant condition is fulfilled correctly",
+			expBool:     false,
+		},
+		{
+			name:   "SubscriptionsEscrow invariant violated",
+			funded: false,
+			expResponse: "subscriptions: subscriptions escrow invariant\n" +
+				"\tsum of subscriptions escrows: 300udaric\n\tmodule account coins: \n\n",
+			expBool: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() //reset
+
+			if test.funded {
+				suite.fundSubscription(suite.testData.subscription)
+			} else {
+				suite.keeper.SaveSubscription(suite.ctx, suite.testData.subscription)
+			}
+
+			res, stop := keeper.AllInvariants(suite.keeper)(suite.ctx)
+
+			suite.Equal(test.expResponse, res)
+			suite.Equal(test.expBool, stop)
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/subspace"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	relationshipsKeeper "github.com/desmos-labs/desmos/x/relationships/keeper"
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+// Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
+type Keeper struct {
+	RelationshipsKeeper relationshipsKeeper.Keeper // Relationships keeper to make sure blocked users cannot subscribe
+	SupplyKeeper        supply.Keeper              // Supply keeper to hold the prepaid subscriptions and pay the creators
+	StoreKey            sdk.StoreKey               // Unexposed key to access store from sdk.Context
+	Cdc                 *codec.Codec               // The wire codec for binary encoding/decoding.
+
+	// The reference to the ParamsStore to get and set subscriptions specific params
+	paramSubspace params.Subspace
+}
+
+// NewKeeper creates new instances of the subscriptions Keeper
+func NewKeeper(
+	rk relationshipsKeeper.Keeper, sk supply.Keeper, cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace,
+) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		RelationshipsKeeper: rk,
+		SupplyKeeper:        sk,
+		StoreKey:            storeKey,
+		Cdc:                 cdc,
+		paramSubspace:       paramSpace,
+	}
+}
+
+// -------------
+// --- Tiers
+// -------------
+
+// SaveTier allows to save the given tier inside the current context.
+// It assumes the given tier has already been validated.
+func (k Keeper) SaveTier(ctx sdk.Context, tier types.Tier) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Set(types.TierStoreKey(tier.Creator, tier.Level), k.Cdc.MustMarshalBinaryBare(tier))
+}
+
+// GetTier returns the tier having the given level defined by the given creator, and whether it has been found or not
+func (k Keeper) GetTier(ctx sdk.Context, creator sdk.AccAddress, level uint32) (tier types.Tier, found bool) {
+	store := ctx.KVStore(k.StoreKey)
+	key := types.TierStoreKey(creator, level)
+	if !store.Has(key) {
+		return types.Tier{}, false
+	}
+
+	k.Cdc.MustUnmarshalBinaryBare(store.Get(key), &tier)
+	return tier, true
+}
+
+// DeleteTier removes the tier having the given level defined by the given creator
+func (k Keeper) DeleteTier(ctx sdk.Context, creator sdk.AccAddress, level uint32) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Delete(types.TierStoreKey(creator, level))
+}
+
+// getTiers returns all the tiers stored under the given prefix
+func (k Keeper) getTiers(ctx sdk.Context, prefix []byte) types.Tiers {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	tiers := make(types.Tiers, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var tier types.Tier
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &tier)
+		tiers = append(tiers, tier)
+	}
+
+	return tiers
+}
+
+// GetTiers returns all the stored tiers
+func (k Keeper) GetTiers(ctx sdk.Context) types.Tiers {
+	return k.getTiers(ctx, types.TierStorePrefix)
+}
+
+// GetCreatorTiers returns all the tiers defined by the given creator, sorted by level
+func (k Keeper) GetCreatorTiers(ctx sdk.Context, creator sdk.AccAddress) types.Tiers {
+	return k.getTiers(ctx, types.CreatorTiersPrefix(creator))
+}
+
+// -------------
+// --- Subscriptions
+// -------------
+
+// SaveSubscription allows to save the given subscription inside the current context, inserting it inside
+// the payment queue and the subscriber index.
+// It assumes the given subscription has already been validated.
+func (k Keeper) SaveSubscription(ctx sdk.Context, subscription types.Subscription) {
+	store := ctx.KVStore(k.StoreKey)
+
+	// Remove the existing payment queue entry, as the next payment height might have changed
+	if existing, found := k.GetSubscription(ctx, subscription.Creator, subscription.Subscriber); found {
+		store.Delete(types.PaymentQueueStoreKey(existing.NextPayment, existing.Creator, existing.Subscriber))
+	}
+
+	key := types.SubscriptionStoreKey(subscription.Creator, subscription.Subscriber)
+	store.Set(key, k.Cdc.MustMarshalBinaryBare(subscription))
+	store.Set(types.PaymentQueueStoreKey(subscription.NextPayment, subscription.Creator, subscription.Subscriber), key)
+	store.Set(types.SubscriberSubscriptionStoreKey(subscription.Subscriber, subscription.Creator), key)
+}
+
+// getSubscriptionByKey returns the subscription stored using the given key, and whether it has been found or not
+func (k Keeper) getSubscriptionByKey(ctx sdk.Context, key []byte) (subscription types.Subscription, found bool) {
+	store := ctx.KVStore(k.StoreKey)
+	if !store.Has(key) {
+		return types.Subscription{}, false
+	}
+
+	k.Cdc.MustUnmarshalBinaryBare(store.Get(key), &subscription)
+	return subscription, true
+}
+
+// GetSubscription returns the subscription of the given subscriber to the given creator,
+// and whether it has been found or not
+func (k Keeper) GetSubscription(
+	ctx sdk.Context, creator, subscriber sdk.AccAddress,
+) (subscription types.Subscription, found bool) {
+	return k.getSubscriptionByKey(ctx, types.SubscriptionStoreKey(creator, subscriber))
+}
+
+// DeleteSubscription removes the given subscription along with its payment queue and subscriber index entries
+func (k Keeper) DeleteSubscription(ctx sdk.Context, subscription types.Subscription) {
+	store := ctx.KVStore(k.StoreKey)
+	store.Delete(types.SubscriptionStoreKey(subscription.Creator, subscription.Subscriber))
+	store.Delete(types.PaymentQueueStoreKey(subscription.NextPayment, subscription.Creator, subscription.Subscriber))
+	store.Delete(types.SubscriberSubscriptionStoreKey(subscription.Subscriber, subscription.Creator))
+}
+
+// getSubscriptions returns all the subscriptions stored under the given prefix
+func (k Keeper) getSubscriptions(ctx sdk.Context, prefix []byte) types.Subscriptions {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	subscriptions := make(types.Subscriptions, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var subscription types.Subscription
+		k.Cdc.MustUnmarshalBinaryBare(iterator.Value(), &subscription)
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions
+}
+
+// getIndexedSubscriptions returns the subscriptions referenced by the index entries stored between the given keys
+func (k Keeper) getIndexedSubscriptions(ctx sdk.Context, start, end []byte) types.Subscriptions {
+	store := ctx.KVStore(k.StoreKey)
+	iterator := store.Iterator(start, end)
+	defer iterator.Close()
+
+	subscriptions := make(types.Subscriptions, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		if subscription, found := k.getSubscriptionByKey(ctx, iterator.Value()); found {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+
+	return subscriptions
+}
+
+// GetSubscriptions returns all the stored subscriptions
+func (k Keeper) GetSubscriptions(ctx sdk.Context) types.Subscriptions {
+	return k.getSubscriptions(ctx, types.SubscriptionStorePrefix)
+}
+
+// GetCreatorSubscriptions returns all the subscriptions to the given creator
+func (k Keeper) GetCreatorSubscriptions(ctx sdk.Context, creator sdk.AccAddress) types.Subscriptions {
+	return k.getSubscriptions(ctx, types.CreatorSubscriptionsPrefix(creator))
+}
+
+// GetSubscriberSubscriptions returns all the subscriptions of the given subscriber, sorted by creator
+func (k Keeper) GetSubscriberSubscriptions(ctx sdk.Context, subscriber sdk.AccAddress) types.Subscriptions {
+	prefix := types.SubscriberSubscriptionsPrefix(subscriber)
+	return k.getIndexedSubscriptions(ctx, prefix, sdk.PrefixEndBytes(prefix))
+}
+
+// GetDueSubscriptions returns the subscriptions whose next payment is due at or before the given block height,
+// sorted by next payment height
+func (k Keeper) GetDueSubscriptions(ctx sdk.Context, height int64) types.Subscriptions {
+	end := sdk.PrefixEndBytes(types.PaymentQueueHeightPrefix(height))
+	return k.getIndexedSubscriptions(ctx, types.PaymentQueuePrefix, end)
+}
+
+// PaySubscriptionPeriod releases the payment of the current period of the given subscription to the creator.
+// The subscription is then moved to its next period, or deleted if no period remains to be paid.
+// It returns the updated subscription
+func (k Keeper) PaySubscriptionPeriod(ctx sdk.Context, subscription types.Subscription) (types.Subscription, error) {
+	payment := sdk.NewCoins(subscription.Price)
+	err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, subscription.Creator, payment)
+	if err != nil {
+		return subscription, err
+	}
+
+	updated := subscription
+	updated.RemainingPeriods--
+	updated.NextPayment += subscription.Period
+
+	if updated.RemainingPeriods == 0 {
+		k.DeleteSubscription(ctx, subscription)
+	} else {
+		k.SaveSubscription(ctx, updated)
+	}
+
+	return updated, nil
+}
+
+// RefundSubscription deletes the given subscription, refunding to its subscriber the payments
+// of all its remaining periods. It returns the refunded amount
+func (k Keeper) RefundSubscription(ctx sdk.Context, subscription types.Subscription) (sdk.Coins, error) {
+	refund := subscription.Escrow()
+	err := k.SupplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, subscription.Subscriber, refund)
+	if err != nil {
+		return nil, err
+	}
+
+	k.DeleteSubscription(ctx, subscription)
+	return refund, nil
+}
+
+// ProcessDueSubscriptions pays the current period of all the subscriptions whose payment is due at or before
+// the given block height, returning the paid subscriptions updated after their payment.
+// The subscriptions whose payment fails are deleted and returned as expired, so that they do not halt the chain
+func (k Keeper) ProcessDueSubscriptions(ctx sdk.Context, height int64) (paid, expired types.Subscriptions) {
+	paid, expired = make(types.Subscriptions, 0), make(types.Subscriptions, 0)
+	for _, subscription := range k.GetDueSubscriptions(ctx, height) {
+		updated, err := k.PaySubscriptionPeriod(ctx, subscription)
+		if err != nil {
+			ctx.Logger().Error("could not pay the subscription period, expiring the subscription",
+				"creator", subscription.Creator.String(), "subscriber", subscription.Subscriber.String(),
+				"error", err.Error())
+
+			k.DeleteSubscription(ctx, subscription)
+			expired = append(expired, subscription)
+			continue
+		}
+		paid = append(paid, updated)
+	}
+
+	return paid, expired
+}
+
+// HasAccess tells whether the given viewer can access the content that the given creator reserved to the subscribers
+// having at least the given tier level. Creators can always access their own content
+func (k Keeper) HasAccess(ctx sdk.Context, creator, viewer sdk.AccAddress, minLevel uint32) bool {
+	if minLevel == 0 || creator.Equals(viewer) {
+		return true
+	}
+
+	if viewer.Empty() {
+		return false
+	}
+
+	subscription, found := k.GetSubscription(ctx, creator, viewer)
+	return found && subscription.Level >= minLevel
+}
@@ -0,0 +1,272 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+// -------------
+// --- Tiers
+// -------------
+
+func (suite *KeeperTestSuite) TestKeeper_SaveTier() {
+	suite.keeper.SaveTier(suite.ctx, suite.testData.tier)
+
+	stored, found := suite.keeper.GetTier(suite.ctx, suite.testData.creator, 1)
+	suite.True(found)
+	suite.Equal(suite.testData.tier, stored)
+
+	_, found = suite.keeper.GetTier(suite.ctx, suite.testData.creator, 2)
+	suite.False(found)
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteTier() {
+	suite.keeper.SaveTier(suite.ctx, suite.testData.tier)
+	suite.keeper.DeleteTier(suite.ctx, suite.testData.creator, 1)
+
+	_, found := suite.keeper.GetTier(suite.ctx, suite.testData.creator, 1)
+	suite.False(found)
+	suite.Empty(suite.keeper.GetTiers(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetCreatorTiers() {
+	first := suite.testData.tier
+	second := types.NewTier(suite.testData.creator, 2, "Patron", sdk.NewInt64Coin("udaric", 500), 10)
+	other := types.NewTier(suite.testData.subscriber, 1, "Fan", sdk.NewInt64Coin("udaric", 10), 10)
+
+	for _, tier := range []types.Tier{second, other, first} {
+		suite.keeper.SaveTier(suite.ctx, tier)
+	}
+
+	suite.Equal(types.Tiers{first, second}, suite.keeper.GetCreatorTiers(suite.ctx, suite.testData.creator))
+	suite.Equal(types.Tiers{other}, suite.keeper.GetCreatorTiers(suite.ctx, suite.testData.subscriber))
+	suite.Len(suite.keeper.GetTiers(suite.ctx), 3)
+}
+
+// -------------
+// --- Subscriptions
+// -------------
+
+func (suite *KeeperTestSuite) TestKeeper_SaveSubscription() {
+	suite.keeper.SaveSubscription(suite.ctx, suite.testData.subscription)
+
+	stored, found := suite.keeper.GetSubscription(suite.ctx, suite.testData.creator, suite.testData.subscriber)
+	suite.True(found)
+	suite.Equal(suite.testData.subscription, stored)
+
+	suite.Equal(types.Subscriptions{suite.testData.subscription}, suite.keeper.GetSubscriptions(suite.ctx))
+	suite.Equal(types.Subscriptions{suite.testData.subscription},
+		suite.keeper.GetCreatorSubscriptions(suite.ctx, suite.testData.creator))
+	suite.Equal(types.Subscriptions{suite.testData.subscription},
+		suite.keeper.GetSubscriberSubscriptions(suite.ctx, suite.testData.subscriber))
+	suite.Equal(types.Subscriptions{suite.testData.subscription}, suite.keeper.GetDueSubscriptions(suite.ctx, 110))
+
+	// Saving the subscription again moves it inside the payment queue
+	updated := suite.testData.subscription
+	updated.NextPayment = 120
+	suite.keeper.SaveSubscription(suite.ctx, updated)
+
+	suite.Empty(suite.keeper.GetDueSubscriptions(suite.ctx, 119))
+	suite.Equal(types.Subscriptions{updated}, suite.keeper.GetDueSubscriptions(suite.ctx, 120))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_DeleteSubscription() {
+	suite.keeper.SaveSubscription(suite.ctx, suite.testData.subscription)
+	suite.keeper.DeleteSubscription(suite.ctx, suite.testData.subscription)
+
+	_, found := suite.keeper.GetSubscription(suite.ctx, suite.testData.creator, suite.testData.subscriber)
+	suite.False(found)
+	suite.Empty(suite.keeper.GetSubscriberSubscriptions(suite.ctx, suite.testData.subscriber))
+	suite.Empty(suite.keeper.GetDueSubscriptions(suite.ctx, 1000))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_GetSubscriptionsIndexes() {
+	otherCreator, _ := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+
+	first := suite.testData.subscription
+	second := types.NewSubscription(otherCreator, suite.testData.subscriber, 2, first.Price, 5, 1, 105)
+	third := types.NewSubscription(otherCreator, suite.testData.creator, 1, first.Price, 20, 2, 120)
+
+	for _, subscription := range []types.Subscription{first, second, third} {
+		suite.keeper.SaveSubscription(suite.ctx, subscription)
+	}
+
+	suite.ElementsMatch(types.Subscriptions{second, third}, suite.keeper.GetCreatorSubscriptions(suite.ctx, otherCreator))
+	suite.ElementsMatch(types.Subscriptions{first, second},
+		suite.keeper.GetSubscriberSubscriptions(suite.ctx, suite.testData.subscriber))
+	suite.Equal(types.Subscriptions{second, first}, suite.keeper.GetDueSubscriptions(suite.ctx, 110))
+	suite.Equal(types.Subscriptions{second, first, third}, suite.keeper.GetDueSubscriptions(suite.ctx, 120))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_PaySubscriptionPeriod() {
+	price := suite.testData.subscription.Price
+
+	// Insufficient module funds
+	suite.keeper.SaveSubscription(suite.ctx, suite.testData.subscription)
+	_, err := suite.keeper.PaySubscriptionPeriod(suite.ctx, suite.testData.subscription)
+	suite.Error(err)
+
+	// Subscription having more periods to pay
+	suite.SetupTest()
+	suite.fundSubscription(suite.testData.subscription)
+
+	updated, err := suite.keeper.PaySubscriptionPeriod(suite.ctx, suite.testData.subscription)
+	suite.NoError(err)
+	suite.Equal(uint64(2), updated.RemainingPeriods)
+	suite.Equal(int64(120), updated.NextPayment)
+
+	stored, found := suite.keeper.GetSubscription(suite.ctx, suite.testData.creator, suite.testData.subscriber)
+	suite.True(found)
+	suite.Equal(updated, stored)
+	suite.Empty(suite.keeper.GetDueSubscriptions(suite.ctx, 119))
+	suite.Equal(sdk.NewCoins(price), suite.getBalance(suite.testData.creator))
+
+	// Last period
+	last := updated
+	last.RemainingPeriods = 1
+	suite.keeper.SaveSubscription(suite.ctx, last)
+
+	updated, err = suite.keeper.PaySubscriptionPeriod(suite.ctx, last)
+	suite.NoError(err)
+	suite.Equal(uint64(0), updated.RemainingPeriods)
+
+	_, found = suite.keeper.GetSubscription(suite.ctx, suite.testData.creator, suite.testData.subscriber)
+	suite.False(found)
+	suite.Empty(suite.keeper.GetDueSubscriptions(suite.ctx, 1000))
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("udaric", 200)), suite.getBalance(suite.testData.creator))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ProcessDueSubscriptions() {
+	otherCreator, _ := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+
+	due := suite.testData.subscription
+	expiring := types.NewSubscription(otherCreator, suite.testData.subscriber, 1, due.Price, 5, 1, 105)
+	future := types.NewSubscription(otherCreator, suite.testData.creator, 1, due.Price, 20, 2, 120)
+	for _, subscription := range []types.Subscription{due, expiring, future} {
+		suite.fundSubscription(subscription)
+	}
+
+	paid, expired := suite.keeper.ProcessDueSubscriptions(suite.ctx, 110)
+
+	expectedDue := due
+	expectedDue.RemainingPeriods = 2
+	expectedDue.NextPayment = 120
+	expectedExpiring := expiring
+	expectedExpiring.RemainingPeriods = 0
+	expectedExpiring.NextPayment = 110
+	suite.Equal(types.Subscriptions{expectedExpiring, expectedDue}, paid)
+	suite.Empty(expired)
+
+	suite.ElementsMatch(types.Subscriptions{expectedDue, future}, suite.keeper.GetSubscriptions(suite.ctx))
+	suite.Equal(sdk.NewCoins(due.Price), suite.getBalance(suite.testData.creator))
+	suite.Equal(sdk.NewCoins(due.Price), suite.getBalance(otherCreator))
+
+	moduleAcc := suite.supplyKeeper.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("udaric", 400)), moduleAcc.GetCoins())
+}
+
+func (suite *KeeperTestSuite) TestKeeper_ProcessDueSubscriptions_failedPayment() {
+	otherCreator, _ := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+
+	funded := suite.testData.subscription
+	unfunded := types.NewSubscription(otherCreator, suite.testData.subscriber, 1,
+		sdk.NewInt64Coin("udaric", 1000), 10, 2, 105)
+	suite.fundSubscription(funded)
+	suite.keeper.SaveSubscription(suite.ctx, unfunded)
+
+	// Leave inside the module account only the price of a single period of the funded subscription
+	moduleAcc := suite.supplyKeeper.GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.NoError(moduleAcc.SetCoins(sdk.NewCoins(funded.Price)))
+	suite.supplyKeeper.SetModuleAccount(suite.ctx, moduleAcc)
+
+	var paid, expired types.Subscriptions
+	suite.NotPanics(func() {
+		paid, expired = suite.keeper.ProcessDueSubscriptions(suite.ctx, 110)
+	})
+
+	suite.Equal(types.Subscriptions{unfunded}, expired)
+	suite.Len(paid, 1)
+	suite.Equal(funded.Creator, paid[0].Creator)
+
+	_, found := suite.keeper.GetSubscription(suite.ctx, otherCreator, suite.testData.subscriber)
+	suite.False(found)
+	suite.Empty(suite.keeper.GetDueSubscriptions(suite.ctx, 110))
+	suite.Empty(suite.getBalance(otherCreator))
+	suite.Equal(sdk.NewCoins(funded.Price), suite.getBalance(suite.testData.creator))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RefundSubscription() {
+	// Insufficient module funds
+	suite.keeper.SaveSubscription(suite.ctx, suite.testData.subscription)
+	_, err := suite.keeper.RefundSubscription(suite.ctx, suite.testData.subscription)
+	suite.Error(err)
+
+	// Funded subscription
+	suite.SetupTest()
+	suite.fundSubscription(suite.testData.subscription)
+
+	refund, err := suite.keeper.RefundSubscription(suite.ctx, suite.testData.subscription)
+	suite.NoError(err)
+	suite.Equal(suite.testData.subscription.Escrow(), refund)
+	suite.Equal(refund, suite.getBalance(suite.testData.subscriber))
+	suite.Empty(suite.keeper.GetSubscriptions(suite.ctx))
+	suite.Empty(suite.keeper.GetDueSubscriptions(suite.ctx, 1000))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_HasAccess() {
+	otherViewer, _ := sdk.AccAddressFromBech32("cosmos1y54exmx84cqtasvjnskf9f63djuuj68p7hqf47")
+	suite.keeper.SaveSubscription(suite.ctx, suite.testData.subscription)
+
+	tests := []struct {
+		name     string
+		viewer   sdk.AccAddress
+		minLevel uint32
+		expected bool
+	}{
+		{
+			name:     "Public content is accessible by anyone",
+			viewer:   nil,
+			minLevel: 0,
+			expected: true,
+		},
+		{
+			name:     "Creator can access its own content",
+			viewer:   suite.testData.creator,
+			minLevel: 5,
+			expected: true,
+		},
+		{
+			name:     "Anonymous viewer cannot access reserved content",
+			viewer:   nil,
+			minLevel: 1,
+			expected: false,
+		},
+		{
+			name:     "Viewer without subscription cannot access reserved content",
+			viewer:   otherViewer,
+			minLevel: 1,
+			expected: false,
+		},
+		{
+			name:     "Subscriber cannot access the content of higher levels",
+			viewer:   suite.testData.subscriber,
+			minLevel: 2,
+			expected: false,
+		},
+		{
+			name:     "Subscriber can access the content of its level",
+			viewer:   suite.testData.subscriber,
+			minLevel: 1,
+			expected: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.Equal(test.expected,
+				suite.keeper.HasAccess(suite.ctx, suite.testData.creator, test.viewer, test.minLevel))
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package keeper_test
+
+import (
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+func (suite *KeeperTestSuite) TestKeeper_SetParams() {
+	params := types.NewParams(100, 6)
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.Equal(params, suite.keeper.GetParams(suite.ctx))
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+// NewQuerier is the module level router for state queries
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err error) {
+		switch path[0] {
+		case types.QueryTiers:
+			return queryTiers(ctx, path[1:], req, keeper)
+		case types.QuerySubscription:
+			return querySubscription(ctx, path[1:], req, keeper)
+		case types.QueryCreatorSubscriptions:
+			return queryCreatorSubscriptions(ctx, path[1:], req, keeper)
+		case types.QuerySubscriberSubscriptions:
+			return querySubscriberSubscriptions(ctx, path[1:], req, keeper)
+		case types.QueryParams:
+			return queryParams(ctx, req, keeper)
+		default:
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown subscriptions query endpoint")
+		}
+	}
+}
+
+// marshal returns the indented JSON representation of the given object
+func marshal(keeper Keeper, o interface{}) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(keeper.Cdc, o)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryTiers allows to return the tiers defined by a creator
+// Query path: custom/subscriptions/tiers/{creator}
+func queryTiers(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	creator, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", path[0]))
+	}
+
+	tiers := keeper.GetCreatorTiers(ctx, creator)
+	return marshal(keeper, &tiers)
+}
+
+// querySubscription allows to return the subscription of a subscriber to a creator
+// Query path: custom/subscriptions/subscription/{creator}/{subscriber}
+func querySubscription(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "both the creator and the subscriber must be provided")
+	}
+
+	creator, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", path[0]))
+	}
+
+	subscriber, err := sdk.AccAddressFromBech32(path[1])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid subscriber address: %s", path[1]))
+	}
+
+	subscription, found := keeper.GetSubscription(ctx, creator, subscriber)
+	if !found {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+			fmt.Sprintf("subscription of %s to creator %s not found", subscriber, creator))
+	}
+
+	return marshal(keeper, &subscription)
+}
+
+// queryCreatorSubscriptions allows to return the subscriptions to a creator
+// Query path: custom/subscriptions/creator_subscriptions/{creator}
+func queryCreatorSubscriptions(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	creator, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", path[0]))
+	}
+
+	subscriptions := keeper.GetCreatorSubscriptions(ctx, creator)
+	return marshal(keeper, &subscriptions)
+}
+
+// querySubscriberSubscriptions allows to return the subscriptions of a subscriber
+// Query path: custom/subscriptions/subscriber_subscriptions/{subscriber}
+func querySubscriberSubscriptions(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	subscriber, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid subscriber address: %s", path[0]))
+	}
+
+	subscriptions := keeper.GetSubscriberSubscriptions(ctx, subscriber)
+	return marshal(keeper, &subscriptions)
+}
+
+// queryParams handles the request of listing the subscriptions params
+// Query path: custom/subscriptions/params
+func queryParams(ctx sdk.Context, _ abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	params := keeper.GetParams(ctx)
+	return marshal(keeper, &params)
+}
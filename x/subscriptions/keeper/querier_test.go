@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/keeper"
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+var request abci.RequestQuery
+
+func (suite *KeeperTestSuite) Test_queryTiers() {
+	tests := []struct {
+		name   string
+		query  []string
+		expErr error
+		expRes types.Tiers
+	}{
+		{
+			name:   "Invalid creator returns error",
+			query:  []string{types.QueryTiers, "creator"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address: creator"),
+		},
+		{
+			name:   "Creator tiers are returned",
+			query:  []string{types.QueryTiers, suite.testData.creator.String()},
+			expRes: types.Tiers{suite.testData.tier},
+		},
+		{
+			name:   "Unknown endpoint",
+			query:  []string{"endpoint"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown subscriptions query endpoint"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.keeper.SaveTier(suite.ctx, suite.testData.tier)
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.query, request)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
+				return
+			}
+
+			suite.NoError(err)
+			expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expRes)
+			suite.NoError(err)
+			suite.Equal(string(expectedIndented), string(result))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_querySubscription() {
+	creator := suite.testData.creator.String()
+	subscriber := suite.testData.subscriber.String()
+
+	tests := []struct {
+		name   string
+		stored bool
+		query  []string
+		expErr error
+	}{
+		{
+			name:   "Missing subscriber returns error",
+			query:  []string{types.QuerySubscription, creator},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "both the creator and the subscriber must be provided"),
+		},
+		{
+			name:   "Invalid creator returns error",
+			query:  []string{types.QuerySubscription, "creator", subscriber},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address: creator"),
+		},
+		{
+			name:   "Invalid subscriber returns error",
+			query:  []string{types.QuerySubscription, creator, "subscriber"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid subscriber address: subscriber"),
+		},
+		{
+			name:  "Not found subscription returns error",
+			query: []string{types.QuerySubscription, creator, subscriber},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest,
+				"subscription of "+subscriber+" to creator "+creator+" not found"),
+		},
+		{
+			name:   "Existing subscription is returned",
+			stored: true,
+			query:  []string{types.QuerySubscription, creator, subscriber},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			if test.stored {
+				suite.keeper.SaveSubscription(suite.ctx, suite.testData.subscription)
+			}
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.query, request)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
+				return
+			}
+
+			suite.NoError(err)
+			expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &suite.testData.subscription)
+			suite.NoError(err)
+			suite.Equal(string(expectedIndented), string(result))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_querySubscriptionsLists() {
+	tests := []struct {
+		name   string
+		query  []string
+		expErr error
+		expRes types.Subscriptions
+	}{
+		{
+			name:   "Invalid creator returns error",
+			query:  []string{types.QueryCreatorSubscriptions, "creator"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid creator address: creator"),
+		},
+		{
+			name:   "Creator subscriptions are returned",
+			query:  []string{types.QueryCreatorSubscriptions, suite.testData.creator.String()},
+			expRes: types.Subscriptions{suite.testData.subscription},
+		},
+		{
+			name:   "Invalid subscriber returns error",
+			query:  []string{types.QuerySubscriberSubscriptions, "subscriber"},
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid subscriber address: subscriber"),
+		},
+		{
+			name:   "Subscriber subscriptions are returned",
+			query:  []string{types.QuerySubscriberSubscriptions, suite.testData.subscriber.String()},
+			expRes: types.Subscriptions{suite.testData.subscription},
+		},
+		{
+			name:   "Empty subscriptions are returned",
+			query:  []string{types.QuerySubscriberSubscriptions, suite.testData.creator.String()},
+			expRes: types.Subscriptions{},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		suite.Run(test.name, func() {
+			suite.SetupTest() // reset
+			suite.keeper.SaveSubscription(suite.ctx, suite.testData.subscription)
+
+			querier := keeper.NewQuerier(suite.keeper)
+			result, err := querier(suite.ctx, test.query, request)
+
+			if test.expErr != nil {
+				suite.Error(err)
+				suite.Equal(test.expErr.Error(), err.Error())
+				suite.Nil(result)
+				return
+			}
+
+			suite.NoError(err)
+			expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &test.expRes)
+			suite.NoError(err)
+			suite.Equal(string(expectedIndented), string(result))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) Test_queryParams() {
+	querier := keeper.NewQuerier(suite.keeper)
+	result, err := querier(suite.ctx, []string{types.QueryParams}, request)
+	suite.NoError(err)
+
+	params := types.NewParams(10, 12)
+	expectedIndented, err := codec.MarshalJSONIndent(suite.keeper.Cdc, &params)
+	suite.NoError(err)
+	suite.Equal(string(expectedIndented), string(result))
+}
@@ -0,0 +1,32 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding subscriptions type
+func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
+	switch {
+	case bytes.HasPrefix(kvA.Key, types.TierStorePrefix):
+		var tierA, tierB types.Tier
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &tierA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &tierB)
+		return fmt.Sprintf("TierA: %s\nTierB: %s\n", tierA, tierB)
+	case bytes.HasPrefix(kvA.Key, types.SubscriptionStorePrefix):
+		var subscriptionA, subscriptionB types.Subscription
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &subscriptionA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &subscriptionB)
+		return fmt.Sprintf("SubscriptionA: %s\nSubscriptionB: %s\n", subscriptionA, subscriptionB)
+	case bytes.HasPrefix(kvA.Key, types.SubscriberSubscriptionsStorePrefix),
+		bytes.HasPrefix(kvA.Key, types.PaymentQueuePrefix):
+		return fmt.Sprintf("SubscriptionKeyA: %X\nSubscriptionKeyB: %X\n", kvA.Value, kvB.Value)
+	default:
+		panic(fmt.Sprintf("invalid subscriptions key %X", kvA.Key))
+	}
+}
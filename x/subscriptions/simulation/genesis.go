@@ -0,0 +1,20 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+// RandomizedGenState generates a random GenesisState for subscriptions.
+// As the prepaid periods must be held by the module account, the genesis starts without any subscription,
+// which are created by the simulated subscribe operations instead
+func RandomizedGenState(simState *module.SimulationState) {
+	params := RandomParams(simState.Rand)
+	tiers := randomTiers(simState.Rand, simState.Accounts, params.MinTierPeriod)
+
+	genState := types.NewGenesisState(params, tiers, nil)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genState)
+}
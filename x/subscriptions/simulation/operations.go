@@ -0,0 +1,206 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	sim "github.com/cosmos/cosmos-sdk/x/simulation"
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/desmos-labs/desmos/app/params"
+	"github.com/desmos-labs/desmos/x/subscriptions/keeper"
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+const (
+	OpWeightMsgCreateTier         = "op_weight_msg_create_tier"
+	OpWeightMsgSubscribe          = "op_weight_msg_subscribe"
+	OpWeightMsgCancelSubscription = "op_weight_msg_cancel_subscription"
+
+	DefaultGasValue = 200000
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams sim.AppParams, cdc *codec.Codec, k keeper.Keeper, ak auth.AccountKeeper) sim.WeightedOperations {
+	var weightMsgCreateTier int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateTier, &weightMsgCreateTier, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateTier = params.DefaultWeightMsgCreateTier
+		},
+	)
+
+	var weightMsgSubscribe int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSubscribe, &weightMsgSubscribe, nil,
+		func(_ *rand.Rand) {
+			weightMsgSubscribe = params.DefaultWeightMsgSubscribe
+		},
+	)
+
+	var weightMsgCancelSubscription int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCancelSubscription, &weightMsgCancelSubscription, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelSubscription = params.DefaultWeightMsgCancelSubscription
+		},
+	)
+
+	return sim.WeightedOperations{
+		sim.NewWeightedOperation(
+			weightMsgCreateTier,
+			SimulateMsgCreateTier(ak, k),
+		),
+		sim.NewWeightedOperation(
+			weightMsgSubscribe,
+			SimulateMsgSubscribe(ak, k),
+		),
+		sim.NewWeightedOperation(
+			weightMsgCancelSubscription,
+			SimulateMsgCancelSubscription(ak, k),
+		),
+	}
+}
+
+// SimulateMsgCreateTier tests and runs a single tier creation made by a random account
+func SimulateMsgCreateTier(ak auth.AccountKeeper, k keeper.Keeper) sim.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string,
+	) (sim.OperationMsg, []sim.FutureOperation, error) {
+		creator, _ := sim.RandomAcc(r, accs)
+		tier := RandomTier(r, creator.Address, k.GetParams(ctx).MinTierPeriod)
+
+		account := ak.GetAccount(ctx, creator.Address)
+		if account == nil {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		if _, found := k.GetTier(ctx, tier.Creator, tier.Level); found {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgCreateTier(tier.Creator, tier.Level, tier.Name, tier.Price, tier.Period)
+		if err := sendMsg(r, app, ak, msg, account.SpendableCoins(ctx.BlockTime()), ctx, chainID, creator); err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgSubscribe tests and runs a single subscription made by a random account to a random tier
+func SimulateMsgSubscribe(ak auth.AccountKeeper, k keeper.Keeper) sim.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string,
+	) (sim.OperationMsg, []sim.FutureOperation, error) {
+		tiers := k.GetTiers(ctx)
+		if len(tiers) == 0 {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		tier := RandomTierFromList(r, tiers)
+		subscriber, _ := sim.RandomAcc(r, accs)
+		if subscriber.Address.Equals(tier.Creator) ||
+			k.RelationshipsKeeper.IsUserBlocked(ctx, tier.Creator, subscriber.Address) {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, subscriber.Address)
+		if account == nil {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Skip the subscriptions that cannot be extended with the chosen tier
+		remaining := uint64(0)
+		if subscription, found := k.GetSubscription(ctx, tier.Creator, subscriber.Address); found {
+			if !subscription.MatchesTier(tier) {
+				return sim.NoOpMsg(types.ModuleName), nil, nil
+			}
+			remaining = subscription.RemainingPeriods
+		}
+
+		maxPeriods := k.GetParams(ctx).MaxPrepaidPeriods
+		if remaining >= maxPeriods {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// Prepay only what can be afforded while leaving some coins to pay the fees
+		periods := uint64(r.Int63n(int64(maxPeriods-remaining))) + 1
+		spendable := account.SpendableCoins(ctx.BlockTime())
+		amount := types.PeriodsPrice(tier.Price, periods)
+		coins, negative := spendable.SafeSub(amount)
+		if negative || coins.IsZero() {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgSubscribe(subscriber.Address, tier.Creator, tier.Level, periods)
+		if err := sendMsg(r, app, ak, msg, coins, ctx, chainID, subscriber); err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgCancelSubscription tests and runs a single cancellation of a random subscription made by its subscriber
+func SimulateMsgCancelSubscription(ak auth.AccountKeeper, k keeper.Keeper) sim.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []sim.Account, chainID string,
+	) (sim.OperationMsg, []sim.FutureOperation, error) {
+		subscriptions := k.GetSubscriptions(ctx)
+		if len(subscriptions) == 0 {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		subscription := subscriptions[r.Intn(len(subscriptions))]
+		subscriber, found := sim.FindAccount(accs, subscription.Subscriber)
+		if !found {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, subscriber.Address)
+		if account == nil {
+			return sim.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgCancelSubscription(subscription.Subscriber, subscription.Creator)
+		if err := sendMsg(r, app, ak, msg, account.SpendableCoins(ctx.BlockTime()), ctx, chainID, subscriber); err != nil {
+			return sim.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return sim.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// sendMsg sends a transaction with the given message from the given account, paying random fees
+// taken from the given coins
+func sendMsg(
+	r *rand.Rand, app *baseapp.BaseApp, ak auth.AccountKeeper, msg sdk.Msg, coins sdk.Coins,
+	ctx sdk.Context, chainID string, sender sim.Account,
+) error {
+	account := ak.GetAccount(ctx, sender.Address)
+
+	fees, err := sim.RandomFees(r, ctx, coins)
+	if err != nil {
+		return err
+	}
+
+	tx := helpers.GenTx(
+		[]sdk.Msg{msg},
+		fees,
+		DefaultGasValue,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		[]crypto.PrivKey{sender.PrivKey}...,
+	)
+
+	_, _, err = app.Deliver(tx)
+	return err
+}
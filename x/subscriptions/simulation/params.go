@@ -0,0 +1,26 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.MinTierPeriodKey),
+			func(r *rand.Rand) string {
+				return string(types.ModuleCdc.MustMarshalJSON(RandomMinTierPeriod(r)))
+			},
+		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.MaxPrepaidPeriodsKey),
+			func(r *rand.Rand) string {
+				return string(types.ModuleCdc.MustMarshalJSON(RandomMaxPrepaidPeriods(r)))
+			},
+		),
+	}
+}
@@ -0,0 +1,67 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sim "github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+var tierNames = []string{"Supporter", "Fan", "Patron", "Insider", "Backstage"}
+
+// RandomMinTierPeriod returns a random min tier period, which is never zero.
+// It is kept short so that the payments of the subscriptions happen during the simulation
+func RandomMinTierPeriod(r *rand.Rand) int64 {
+	return r.Int63n(10) + 1
+}
+
+// RandomMaxPrepaidPeriods returns a random max number of prepaid periods, which is never zero
+func RandomMaxPrepaidPeriods(r *rand.Rand) uint64 {
+	return uint64(r.Intn(24) + 1)
+}
+
+// RandomParams returns a random set of subscriptions params
+func RandomParams(r *rand.Rand) types.Params {
+	return types.NewParams(
+		RandomMinTierPeriod(r),
+		RandomMaxPrepaidPeriods(r),
+	)
+}
+
+// RandomTier returns a random tier defined by the given creator, whose period is not shorter than the given one
+func RandomTier(r *rand.Rand, creator sdk.AccAddress, minPeriod int64) types.Tier {
+	return types.NewTier(
+		creator,
+		uint32(r.Intn(3)+1),
+		tierNames[r.Intn(len(tierNames))],
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, r.Int63n(1000)+1),
+		minPeriod+r.Int63n(20),
+	)
+}
+
+// RandomTierFromList returns a random tier from the given list
+func RandomTierFromList(r *rand.Rand, tiers types.Tiers) types.Tier {
+	return tiers[r.Intn(len(tiers))]
+}
+
+// randomTiers returns a random list of tiers defined by the given accounts
+func randomTiers(r *rand.Rand, accs []sim.Account, minPeriod int64) types.Tiers {
+	tiers := make(types.Tiers, 0)
+	levels := make(map[string]bool)
+	for i := 0; i < r.Intn(20); i++ {
+		creator, _ := sim.RandomAcc(r, accs)
+		tier := RandomTier(r, creator.Address, minPeriod)
+
+		key := string(types.TierStoreKey(tier.Creator, tier.Level))
+		if levels[key] {
+			continue
+		}
+		levels[key] = true
+		tiers = append(tiers, tier)
+	}
+	return tiers
+}
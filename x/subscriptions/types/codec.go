@@ -0,0 +1,20 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc is the codec
+var ModuleCdc = codec.New()
+
+func init() {
+	RegisterCodec(ModuleCdc)
+}
+
+// RegisterCodec registers concrete types on the Amino codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgCreateTier{}, "desmos/MsgCreateTier", nil)
+	cdc.RegisterConcrete(MsgDeleteTier{}, "desmos/MsgDeleteTier", nil)
+	cdc.RegisterConcrete(MsgSubscribe{}, "desmos/MsgSubscribe", nil)
+	cdc.RegisterConcrete(MsgCancelSubscription{}, "desmos/MsgCancelSubscription", nil)
+}
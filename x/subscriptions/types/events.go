@@ -0,0 +1,23 @@
+package types
+
+// Subscriptions module event types
+const (
+	EventTypeCreateTier          = "create_tier"
+	EventTypeDeleteTier          = "delete_tier"
+	EventTypeSubscribe           = "subscribe"
+	EventTypeCancelSubscription  = "cancel_subscription"
+	EventTypeSubscriptionPayment = "subscription_payment"
+	EventTypeSubscriptionExpired = "subscription_expired"
+
+	AttributeKeyCreator          = "creator"
+	AttributeKeySubscriber       = "subscriber"
+	AttributeKeyLevel            = "level"
+	AttributeKeyPrice            = "price"
+	AttributeKeyPeriod           = "period"
+	AttributeKeyPeriods          = "periods"
+	AttributeKeyAmount           = "amount"
+	AttributeKeyRefund           = "refund"
+	AttributeKeyRemainingPeriods = "remaining_periods"
+
+	AttributeValueCategory = ModuleName
+)
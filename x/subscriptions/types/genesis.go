@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState represents the genesis state for the subscriptions module
+type GenesisState struct {
+	Params        Params        `json:"params"`
+	Tiers         Tiers         `json:"tiers"`
+	Subscriptions Subscriptions `json:"subscriptions"`
+}
+
+// NewGenesisState allows to create a new genesis state containing the given params, tiers and subscriptions
+func NewGenesisState(params Params, tiers Tiers, subscriptions Subscriptions) GenesisState {
+	return GenesisState{
+		Params:        params,
+		Tiers:         tiers,
+		Subscriptions: subscriptions,
+	}
+}
+
+// DefaultGenesisState returns a default GenesisState
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis validates the given genesis state and returns an error if something is invalid
+func ValidateGenesis(state GenesisState) error {
+	if err := state.Params.Validate(); err != nil {
+		return err
+	}
+
+	tiers := make(map[string]bool, len(state.Tiers))
+	for _, tier := range state.Tiers {
+		if err := tier.Validate(); err != nil {
+			return err
+		}
+
+		key := string(TierStoreKey(tier.Creator, tier.Level))
+		if tiers[key] {
+			return fmt.Errorf("duplicated tier %d of creator %s", tier.Level, tier.Creator)
+		}
+		tiers[key] = true
+	}
+
+	subscriptions := make(map[string]bool, len(state.Subscriptions))
+	for _, subscription := range state.Subscriptions {
+		if err := subscription.Validate(); err != nil {
+			return err
+		}
+
+		key := string(SubscriptionStoreKey(subscription.Creator, subscription.Subscriber))
+		if subscriptions[key] {
+			return fmt.Errorf("duplicated subscription of %s to creator %s", subscription.Subscriber, subscription.Creator)
+		}
+		subscriptions[key] = true
+	}
+
+	return nil
+}
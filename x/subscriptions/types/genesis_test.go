@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	tier := types.NewTier(testCreator, 1, "Supporter", testPrice, 100)
+	subscription := types.NewSubscription(testCreator, testSubscriber, 1, testPrice, 100, 3, 150)
+
+	tests := []struct {
+		name    string
+		genesis types.GenesisState
+		expErr  string
+	}{
+		{
+			name:    "Default genesis is valid",
+			genesis: types.DefaultGenesisState(),
+		},
+		{
+			name:    "Invalid params",
+			genesis: types.NewGenesisState(types.NewParams(0, 12), nil, nil),
+			expErr:  "invalid min tier period param: 0",
+		},
+		{
+			name: "Invalid tier",
+			genesis: types.NewGenesisState(types.DefaultParams(),
+				types.Tiers{types.NewTier(testCreator, 0, "Supporter", testPrice, 100)}, nil),
+			expErr: "tier level must be greater than zero",
+		},
+		{
+			name:    "Duplicated tier",
+			genesis: types.NewGenesisState(types.DefaultParams(), types.Tiers{tier, tier}, nil),
+			expErr:  "duplicated tier 1 of creator cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+		},
+		{
+			name: "Invalid subscription",
+			genesis: types.NewGenesisState(types.DefaultParams(), nil,
+				types.Subscriptions{types.NewSubscription(testCreator, testSubscriber, 1, testPrice, 100, 0, 150)}),
+			expErr: "subscription remaining periods must be greater than zero",
+		},
+		{
+			name:    "Duplicated subscription",
+			genesis: types.NewGenesisState(types.DefaultParams(), nil, types.Subscriptions{subscription, subscription}),
+			expErr: "duplicated subscription of cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn " +
+				"to creator cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns",
+		},
+		{
+			name:    "Valid genesis",
+			genesis: types.NewGenesisState(types.DefaultParams(), types.Tiers{tier}, types.Subscriptions{subscription}),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := types.ValidateGenesis(test.genesis)
+			if test.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, test.expErr)
+			}
+		})
+	}
+}
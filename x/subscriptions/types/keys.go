@@ -0,0 +1,67 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName   = "subscriptions"
+	RouterKey    = ModuleName
+	StoreKey     = ModuleName
+	QuerierRoute = ModuleName
+
+	ActionCreateTier         = "create_tier"
+	ActionDeleteTier         = "delete_tier"
+	ActionSubscribe          = "subscribe"
+	ActionCancelSubscription = "cancel_subscription"
+)
+
+var (
+	TierStorePrefix                    = []byte("tier")
+	SubscriptionStorePrefix            = []byte("subscription")
+	SubscriberSubscriptionsStorePrefix = []byte("subscriber_subscriptions")
+	PaymentQueuePrefix                 = []byte("payment_queue")
+)
+
+// CreatorTiersPrefix returns the prefix used to store all the tiers defined by the given creator
+func CreatorTiersPrefix(creator sdk.AccAddress) []byte {
+	return append(TierStorePrefix, creator...)
+}
+
+// TierStoreKey returns the key used to store the tier having the given level defined by the given creator
+func TierStoreKey(creator sdk.AccAddress, level uint32) []byte {
+	return append(CreatorTiersPrefix(creator), sdk.Uint64ToBigEndian(uint64(level))...)
+}
+
+// CreatorSubscriptionsPrefix returns the prefix used to store all the subscriptions to the given creator
+func CreatorSubscriptionsPrefix(creator sdk.AccAddress) []byte {
+	return append(SubscriptionStorePrefix, creator...)
+}
+
+// SubscriptionStoreKey returns the key used to store the subscription of the given subscriber to the given creator
+func SubscriptionStoreKey(creator, subscriber sdk.AccAddress) []byte {
+	return append(CreatorSubscriptionsPrefix(creator), subscriber...)
+}
+
+// SubscriberSubscriptionsPrefix returns the prefix used to index all the subscriptions of the given subscriber
+func SubscriberSubscriptionsPrefix(subscriber sdk.AccAddress) []byte {
+	return append(SubscriberSubscriptionsStorePrefix, subscriber...)
+}
+
+// SubscriberSubscriptionStoreKey returns the key used to index the subscription of the given subscriber
+// to the given creator
+func SubscriberSubscriptionStoreKey(subscriber, creator sdk.AccAddress) []byte {
+	return append(SubscriberSubscriptionsPrefix(subscriber), creator...)
+}
+
+// PaymentQueueHeightPrefix returns the prefix of the payment queue entries of the subscriptions
+// whose next payment is due at the given height
+func PaymentQueueHeightPrefix(height int64) []byte {
+	return append(PaymentQueuePrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// PaymentQueueStoreKey returns the key used to store the payment queue entry of the subscription of
+// the given subscriber to the given creator, whose next payment is due at the given height
+func PaymentQueueStoreKey(height int64, creator, subscriber sdk.AccAddress) []byte {
+	return append(PaymentQueueHeightPrefix(height), SubscriptionStoreKey(creator, subscriber)...)
+}
@@ -0,0 +1,219 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ----------------------------
+// --- MsgCreateTier
+// ----------------------------
+
+// MsgCreateTier defines the message used by a creator to define a new subscription tier.
+// The price is paid for each period, expressed in number of blocks
+type MsgCreateTier struct {
+	Creator sdk.AccAddress `json:"creator" yaml:"creator"`
+	Level   uint32         `json:"level" yaml:"level"`
+	Name    string         `json:"name" yaml:"name"`
+	Price   sdk.Coin       `json:"price" yaml:"price"`
+	Period  int64          `json:"period" yaml:"period"`
+}
+
+// NewMsgCreateTier is the constructor of MsgCreateTier
+func NewMsgCreateTier(creator sdk.AccAddress, level uint32, name string, price sdk.Coin, period int64) MsgCreateTier {
+	return MsgCreateTier{
+		Creator: creator,
+		Level:   level,
+		Name:    name,
+		Price:   price,
+		Period:  period,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCreateTier) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCreateTier) Type() string { return ActionCreateTier }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCreateTier) ValidateBasic() error {
+	tier := NewTier(msg.Creator, msg.Level, msg.Name, msg.Price, msg.Period)
+	if err := tier.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCreateTier) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateTier) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+// ----------------------------
+// --- MsgDeleteTier
+// ----------------------------
+
+// MsgDeleteTier defines the message used by a creator to delete one of its tiers.
+// The existing subscriptions to the tier are kept until all their prepaid periods have been paid
+type MsgDeleteTier struct {
+	Creator sdk.AccAddress `json:"creator" yaml:"creator"`
+	Level   uint32         `json:"level" yaml:"level"`
+}
+
+// NewMsgDeleteTier is the constructor of MsgDeleteTier
+func NewMsgDeleteTier(creator sdk.AccAddress, level uint32) MsgDeleteTier {
+	return MsgDeleteTier{
+		Creator: creator,
+		Level:   level,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgDeleteTier) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgDeleteTier) Type() string { return ActionDeleteTier }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgDeleteTier) ValidateBasic() error {
+	if msg.Creator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid tier creator: %s", msg.Creator))
+	}
+
+	if msg.Level == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "tier level must be greater than zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDeleteTier) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeleteTier) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Creator}
+}
+
+// ----------------------------
+// --- MsgSubscribe
+// ----------------------------
+
+// MsgSubscribe defines the message used to subscribe to a tier of a creator, prepaying the given number of periods.
+// Subscribing again to the same tier extends the existing subscription by the given number of periods
+type MsgSubscribe struct {
+	Subscriber sdk.AccAddress `json:"subscriber" yaml:"subscriber"`
+	Creator    sdk.AccAddress `json:"creator" yaml:"creator"`
+	Level      uint32         `json:"level" yaml:"level"`
+	Periods    uint64         `json:"periods" yaml:"periods"`
+}
+
+// NewMsgSubscribe is the constructor of MsgSubscribe
+func NewMsgSubscribe(subscriber, creator sdk.AccAddress, level uint32, periods uint64) MsgSubscribe {
+	return MsgSubscribe{
+		Subscriber: subscriber,
+		Creator:    creator,
+		Level:      level,
+		Periods:    periods,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSubscribe) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSubscribe) Type() string { return ActionSubscribe }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSubscribe) ValidateBasic() error {
+	if msg.Subscriber.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid subscriber: %s", msg.Subscriber))
+	}
+
+	if msg.Creator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid tier creator: %s", msg.Creator))
+	}
+
+	if msg.Subscriber.Equals(msg.Creator) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "creators cannot subscribe to their own tiers")
+	}
+
+	if msg.Level == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "tier level must be greater than zero")
+	}
+
+	if msg.Periods == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "subscription periods must be greater than zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSubscribe) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSubscribe) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Subscriber}
+}
+
+// ----------------------------
+// --- MsgCancelSubscription
+// ----------------------------
+
+// MsgCancelSubscription defines the message used by a subscriber to cancel its subscription to a creator.
+// The payment of the current period is released to the creator, while the remaining ones are refunded
+type MsgCancelSubscription struct {
+	Subscriber sdk.AccAddress `json:"subscriber" yaml:"subscriber"`
+	Creator    sdk.AccAddress `json:"creator" yaml:"creator"`
+}
+
+// NewMsgCancelSubscription is the constructor of MsgCancelSubscription
+func NewMsgCancelSubscription(subscriber, creator sdk.AccAddress) MsgCancelSubscription {
+	return MsgCancelSubscription{
+		Subscriber: subscriber,
+		Creator:    creator,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelSubscription) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelSubscription) Type() string { return ActionCancelSubscription }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelSubscription) ValidateBasic() error {
+	if msg.Subscriber.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid subscriber: %s", msg.Subscriber))
+	}
+
+	if msg.Creator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid tier creator: %s", msg.Creator))
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelSubscription) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelSubscription) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Subscriber}
+}
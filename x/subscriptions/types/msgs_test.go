@@ -0,0 +1,273 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+var (
+	testCreator, _    = sdk.AccAddressFromBech32("cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns")
+	testSubscriber, _ = sdk.AccAddressFromBech32("cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn")
+	testPrice         = sdk.NewInt64Coin("udaric", 100)
+)
+
+// ----------------------------
+// --- MsgCreateTier
+// ----------------------------
+
+var msgCreateTier = types.NewMsgCreateTier(testCreator, 1, "Supporter", testPrice, 100)
+
+func TestMsgCreateTier_Route(t *testing.T) {
+	require.Equal(t, "subscriptions", msgCreateTier.Route())
+}
+
+func TestMsgCreateTier_Type(t *testing.T) {
+	require.Equal(t, "create_tier", msgCreateTier.Type())
+}
+
+func TestMsgCreateTier_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    types.MsgCreateTier
+		expErr error
+	}{
+		{
+			name:   "Invalid creator",
+			msg:    types.NewMsgCreateTier(nil, 1, "Supporter", testPrice, 100),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid tier creator: "),
+		},
+		{
+			name:   "Invalid level",
+			msg:    types.NewMsgCreateTier(testCreator, 0, "Supporter", testPrice, 100),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "tier level must be greater than zero"),
+		},
+		{
+			name:   "Invalid price",
+			msg:    types.NewMsgCreateTier(testCreator, 1, "Supporter", sdk.NewInt64Coin("udaric", 0), 100),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "invalid tier price: 0udaric"),
+		},
+		{
+			name: "Valid message",
+			msg:  msgCreateTier,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, test.expErr.Error(), err.Error())
+			}
+		})
+	}
+}
+
+func TestMsgCreateTier_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgCreateTier","value":{"creator":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","level":1,"name":"Supporter","period":"100","price":{"amount":"100","denom":"udaric"}}}`
+	require.Equal(t, expected, string(msgCreateTier.GetSignBytes()))
+}
+
+func TestMsgCreateTier_GetSigners(t *testing.T) {
+	require.Equal(t, []sdk.AccAddress{testCreator}, msgCreateTier.GetSigners())
+}
+
+// ----------------------------
+// --- MsgDeleteTier
+// ----------------------------
+
+var msgDeleteTier = types.NewMsgDeleteTier(testCreator, 1)
+
+func TestMsgDeleteTier_Route(t *testing.T) {
+	require.Equal(t, "subscriptions", msgDeleteTier.Route())
+}
+
+func TestMsgDeleteTier_Type(t *testing.T) {
+	require.Equal(t, "delete_tier", msgDeleteTier.Type())
+}
+
+func TestMsgDeleteTier_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    types.MsgDeleteTier
+		expErr error
+	}{
+		{
+			name:   "Invalid creator",
+			msg:    types.NewMsgDeleteTier(nil, 1),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid tier creator: "),
+		},
+		{
+			name:   "Invalid level",
+			msg:    types.NewMsgDeleteTier(testCreator, 0),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "tier level must be greater than zero"),
+		},
+		{
+			name: "Valid message",
+			msg:  msgDeleteTier,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, test.expErr.Error(), err.Error())
+			}
+		})
+	}
+}
+
+func TestMsgDeleteTier_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgDeleteTier","value":{"creator":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","level":1}}`
+	require.Equal(t, expected, string(msgDeleteTier.GetSignBytes()))
+}
+
+func TestMsgDeleteTier_GetSigners(t *testing.T) {
+	require.Equal(t, []sdk.AccAddress{testCreator}, msgDeleteTier.GetSigners())
+}
+
+// ----------------------------
+// --- MsgSubscribe
+// ----------------------------
+
+var msgSubscribe = types.NewMsgSubscribe(testSubscriber, testCreator, 1, 3)
+
+func TestMsgSubscribe_Route(t *testing.T) {
+	require.Equal(t, "subscriptions", msgSubscribe.Route())
+}
+
+func TestMsgSubscribe_Type(t *testing.T) {
+	require.Equal(t, "subscribe", msgSubscribe.Type())
+}
+
+func TestMsgSubscribe_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    types.MsgSubscribe
+		expErr error
+	}{
+		{
+			name:   "Invalid subscriber",
+			msg:    types.NewMsgSubscribe(nil, testCreator, 1, 3),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid subscriber: "),
+		},
+		{
+			name:   "Invalid creator",
+			msg:    types.NewMsgSubscribe(testSubscriber, nil, 1, 3),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid tier creator: "),
+		},
+		{
+			name:   "Subscription to own tier",
+			msg:    types.NewMsgSubscribe(testCreator, testCreator, 1, 3),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "creators cannot subscribe to their own tiers"),
+		},
+		{
+			name:   "Invalid level",
+			msg:    types.NewMsgSubscribe(testSubscriber, testCreator, 0, 3),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "tier level must be greater than zero"),
+		},
+		{
+			name:   "Invalid periods",
+			msg:    types.NewMsgSubscribe(testSubscriber, testCreator, 1, 0),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "subscription periods must be greater than zero"),
+		},
+		{
+			name: "Valid message",
+			msg:  msgSubscribe,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, test.expErr.Error(), err.Error())
+			}
+		})
+	}
+}
+
+func TestMsgSubscribe_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgSubscribe","value":{"creator":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","level":1,"periods":"3","subscriber":"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn"}}`
+	require.Equal(t, expected, string(msgSubscribe.GetSignBytes()))
+}
+
+func TestMsgSubscribe_GetSigners(t *testing.T) {
+	require.Equal(t, []sdk.AccAddress{testSubscriber}, msgSubscribe.GetSigners())
+}
+
+// ----------------------------
+// --- MsgCancelSubscription
+// ----------------------------
+
+var msgCancelSubscription = types.NewMsgCancelSubscription(testSubscriber, testCreator)
+
+func TestMsgCancelSubscription_Route(t *testing.T) {
+	require.Equal(t, "subscriptions", msgCancelSubscription.Route())
+}
+
+func TestMsgCancelSubscription_Type(t *testing.T) {
+	require.Equal(t, "cancel_subscription", msgCancelSubscription.Type())
+}
+
+func TestMsgCancelSubscription_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    types.MsgCancelSubscription
+		expErr error
+	}{
+		{
+			name:   "Invalid subscriber",
+			msg:    types.NewMsgCancelSubscription(nil, testCreator),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid subscriber: "),
+		},
+		{
+			name:   "Invalid creator",
+			msg:    types.NewMsgCancelSubscription(testSubscriber, nil),
+			expErr: sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "invalid tier creator: "),
+		},
+		{
+			name: "Valid message",
+			msg:  msgCancelSubscription,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := test.msg.ValidateBasic()
+			if test.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Equal(t, test.expErr.Error(), err.Error())
+			}
+		})
+	}
+}
+
+func TestMsgCancelSubscription_GetSignBytes(t *testing.T) {
+	expected := `{"type":"desmos/MsgCancelSubscription","value":{"creator":"cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns","subscriber":"cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn"}}`
+	require.Equal(t, expected, string(msgCancelSubscription.GetSignBytes()))
+}
+
+func TestMsgCancelSubscription_GetSigners(t *testing.T) {
+	require.Equal(t, []sdk.AccAddress{testSubscriber}, msgCancelSubscription.GetSigners())
+}
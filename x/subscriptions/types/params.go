@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	paramsModule "github.com/cosmos/cosmos-sdk/x/params/subspace"
+)
+
+const (
+	// default paramspace for paramsModule keeper
+	DefaultParamspace = ModuleName
+)
+
+// Default subscriptions params
+var (
+	DefaultMinTierPeriod     = int64(14400) // 24 hours, counting a 6 secs block interval
+	DefaultMaxPrepaidPeriods = uint64(12)
+)
+
+// Parameters store keys
+var (
+	MinTierPeriodKey     = []byte("MinTierPeriod")
+	MaxPrepaidPeriodsKey = []byte("MaxPrepaidPeriods")
+)
+
+// ParamKeyTable Key declaration for parameters
+func ParamKeyTable() paramsModule.KeyTable {
+	return paramsModule.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params contains the parameters of the subscriptions module
+type Params struct {
+	MinTierPeriod     int64  `json:"min_tier_period" yaml:"min_tier_period"`
+	MaxPrepaidPeriods uint64 `json:"max_prepaid_periods" yaml:"max_prepaid_periods"`
+}
+
+// NewParams creates a new Params obj
+func NewParams(minTierPeriod int64, maxPrepaidPeriods uint64) Params {
+	return Params{
+		MinTierPeriod:     minTierPeriod,
+		MaxPrepaidPeriods: maxPrepaidPeriods,
+	}
+}
+
+// DefaultParams return default params object
+func DefaultParams() Params {
+	return Params{
+		MinTierPeriod:     DefaultMinTierPeriod,
+		MaxPrepaidPeriods: DefaultMaxPrepaidPeriods,
+	}
+}
+
+// String implements Stringer
+func (params Params) String() string {
+	out := "Subscriptions parameters:\n"
+	out += fmt.Sprintf("Min tier period: %d\nMax prepaid periods: %d\n",
+		params.MinTierPeriod,
+		params.MaxPrepaidPeriods,
+	)
+
+	return strings.TrimSpace(out)
+}
+
+// ParamSetPairs implements the ParamSet interface and returns the key/value pairs
+// of subscriptions module's parameters.
+func (params *Params) ParamSetPairs() paramsModule.ParamSetPairs {
+	return paramsModule.ParamSetPairs{
+		paramsModule.NewParamSetPair(MinTierPeriodKey, &params.MinTierPeriod, ValidateMinTierPeriodParam),
+		paramsModule.NewParamSetPair(MaxPrepaidPeriodsKey, &params.MaxPrepaidPeriods, ValidateMaxPrepaidPeriodsParam),
+	}
+}
+
+// Validate perform basic checks on all parameters to ensure they are correct
+func (params Params) Validate() error {
+	if err := ValidateMinTierPeriodParam(params.MinTierPeriod); err != nil {
+		return err
+	}
+
+	return ValidateMaxPrepaidPeriodsParam(params.MaxPrepaidPeriods)
+}
+
+func ValidateMinTierPeriodParam(i interface{}) error {
+	period, isCorrectParam := i.(int64)
+	if !isCorrectParam {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	if period <= 0 {
+		return fmt.Errorf("invalid min tier period param: %d", period)
+	}
+
+	return nil
+}
+
+func ValidateMaxPrepaidPeriodsParam(i interface{}) error {
+	maxPeriods, isCorrectParam := i.(uint64)
+	if !isCorrectParam {
+		return fmt.Errorf("invalid parameters type: %s", i)
+	}
+
+	if maxPeriods == 0 {
+		return fmt.Errorf("invalid max prepaid periods param: %d", maxPeriods)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+func TestDefaultParams(t *testing.T) {
+	params := types.NewParams(types.DefaultMinTierPeriod, types.DefaultMaxPrepaidPeriods)
+	require.Equal(t, params, types.DefaultParams())
+}
+
+func TestParams_String(t *testing.T) {
+	params := types.NewParams(100, 12)
+	require.Equal(t, "Subscriptions parameters:\nMin tier period: 100\nMax prepaid periods: 12", params.String())
+}
+
+func TestValidateParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params types.Params
+		expErr error
+	}{
+		{
+			name:   "invalid min tier period returns error",
+			params: types.NewParams(0, 12),
+			expErr: fmt.Errorf("invalid min tier period param: 0"),
+		},
+		{
+			name:   "invalid max prepaid periods returns error",
+			params: types.NewParams(100, 0),
+			expErr: fmt.Errorf("invalid max prepaid periods param: 0"),
+		},
+		{
+			name:   "valid params returns no error",
+			params: types.NewParams(100, 12),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.params.Validate())
+		})
+	}
+}
+
+func TestValidateMinTierPeriodParam(t *testing.T) {
+	require.Equal(t, fmt.Errorf("invalid parameters type: %s", "100"), types.ValidateMinTierPeriodParam("100"))
+	require.NoError(t, types.ValidateMinTierPeriodParam(int64(100)))
+}
+
+func TestValidateMaxPrepaidPeriodsParam(t *testing.T) {
+	require.Equal(t, fmt.Errorf("invalid parameters type: %s", "12"), types.ValidateMaxPrepaidPeriodsParam("12"))
+	require.NoError(t, types.ValidateMaxPrepaidPeriodsParam(uint64(12)))
+}
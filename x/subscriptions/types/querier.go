@@ -0,0 +1,10 @@
+package types
+
+// query endpoints supported by the subscriptions Querier
+const (
+	QueryTiers                   = "tiers"
+	QuerySubscription            = "subscription"
+	QueryCreatorSubscriptions    = "creator_subscriptions"
+	QuerySubscriberSubscriptions = "subscriber_subscriptions"
+	QueryParams                  = "params"
+)
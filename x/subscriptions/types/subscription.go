@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Subscription represents the paid subscription of a user to a tier of a creator.
+// The price and the period of the tier are locked when subscribing, and the payments of the remaining periods
+// are held by the module account until each of them is released to the creator at the end of its period
+type Subscription struct {
+	Creator          sdk.AccAddress `json:"creator" yaml:"creator"`
+	Subscriber       sdk.AccAddress `json:"subscriber" yaml:"subscriber"`
+	Level            uint32         `json:"level" yaml:"level"`
+	Price            sdk.Coin       `json:"price" yaml:"price"`
+	Period           int64          `json:"period" yaml:"period"`
+	RemainingPeriods uint64         `json:"remaining_periods" yaml:"remaining_periods"`
+	NextPayment      int64          `json:"next_payment" yaml:"next_payment"`
+}
+
+// NewSubscription is a constructor function for Subscription
+func NewSubscription(
+	creator, subscriber sdk.AccAddress, level uint32, price sdk.Coin, period int64,
+	remainingPeriods uint64, nextPayment int64,
+) Subscription {
+	return Subscription{
+		Creator:          creator,
+		Subscriber:       subscriber,
+		Level:            level,
+		Price:            price,
+		Period:           period,
+		RemainingPeriods: remainingPeriods,
+		NextPayment:      nextPayment,
+	}
+}
+
+// String implements fmt.Stringer
+func (subscription Subscription) String() string {
+	return fmt.Sprintf("Creator: %s\nSubscriber: %s\nLevel: %d\nPrice: %s\nPeriod: %d\n"+
+		"Remaining periods: %d\nNext payment: %d",
+		subscription.Creator, subscription.Subscriber, subscription.Level, subscription.Price, subscription.Period,
+		subscription.RemainingPeriods, subscription.NextPayment)
+}
+
+// Escrow returns the amount held by the module account to pay the remaining periods of the subscription
+func (subscription Subscription) Escrow() sdk.Coins {
+	return PeriodsPrice(subscription.Price, subscription.RemainingPeriods)
+}
+
+// PeriodsPrice returns the amount to be paid for the given number of periods having the given price
+func PeriodsPrice(price sdk.Coin, periods uint64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(price.Denom, price.Amount.Mul(sdk.NewIntFromUint64(periods))))
+}
+
+// MatchesTier tells whether the level, price and period of the subscription are the ones of the given tier
+func (subscription Subscription) MatchesTier(tier Tier) bool {
+	return subscription.Creator.Equals(tier.Creator) &&
+		subscription.Level == tier.Level &&
+		subscription.Price.Denom == tier.Price.Denom &&
+		subscription.Price.Amount.Equal(tier.Price.Amount) &&
+		subscription.Period == tier.Period
+}
+
+// Validate checks the validity of the Subscription
+func (subscription Subscription) Validate() error {
+	if subscription.Creator.Empty() {
+		return fmt.Errorf("invalid subscription creator: %s", subscription.Creator)
+	}
+
+	if subscription.Subscriber.Empty() {
+		return fmt.Errorf("invalid subscriber: %s", subscription.Subscriber)
+	}
+
+	if subscription.Creator.Equals(subscription.Subscriber) {
+		return fmt.Errorf("creator and subscriber must be different")
+	}
+
+	if subscription.Level == 0 {
+		return fmt.Errorf("subscription level must be greater than zero")
+	}
+
+	if !subscription.Price.IsValid() || subscription.Price.IsZero() {
+		return fmt.Errorf("invalid subscription price: %s", subscription.Price)
+	}
+
+	if subscription.Period <= 0 {
+		return fmt.Errorf("invalid subscription period: %d", subscription.Period)
+	}
+
+	if subscription.RemainingPeriods == 0 {
+		return fmt.Errorf("subscription remaining periods must be greater than zero")
+	}
+
+	if subscription.NextPayment <= 0 {
+		return fmt.Errorf("invalid subscription next payment height: %d", subscription.NextPayment)
+	}
+
+	return nil
+}
+
+// Subscriptions represents a slice of Subscription objects
+type Subscriptions []Subscription
@@ -0,0 +1,101 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+func TestSubscription_String(t *testing.T) {
+	subscription := types.NewSubscription(testCreator, testSubscriber, 1, testPrice, 100, 3, 150)
+	require.Equal(t, "Creator: cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns\n"+
+		"Subscriber: cosmos1xcy3els9ua75kdm783c3qu0rfa2eplesldfevn\nLevel: 1\nPrice: 100udaric\nPeriod: 100\n"+
+		"Remaining periods: 3\nNext payment: 150", subscription.String())
+}
+
+func TestSubscription_Escrow(t *testing.T) {
+	subscription := types.NewSubscription(testCreator, testSubscriber, 1, testPrice, 100, 3, 150)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udaric", 300)), subscription.Escrow())
+}
+
+func TestPeriodsPrice(t *testing.T) {
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("udaric", 500)), types.PeriodsPrice(testPrice, 5))
+	require.Equal(t, sdk.NewCoins(), types.PeriodsPrice(testPrice, 0))
+}
+
+func TestSubscription_MatchesTier(t *testing.T) {
+	subscription := types.NewSubscription(testCreator, testSubscriber, 1, testPrice, 100, 3, 150)
+
+	require.True(t, subscription.MatchesTier(types.NewTier(testCreator, 1, "Supporter", testPrice, 100)))
+	require.False(t, subscription.MatchesTier(types.NewTier(testSubscriber, 1, "Supporter", testPrice, 100)))
+	require.False(t, subscription.MatchesTier(types.NewTier(testCreator, 2, "Supporter", testPrice, 100)))
+	require.False(t, subscription.MatchesTier(types.NewTier(testCreator, 1, "Supporter", sdk.NewInt64Coin("udaric", 10), 100)))
+	require.False(t, subscription.MatchesTier(types.NewTier(testCreator, 1, "Supporter", sdk.NewInt64Coin("udesmos", 100), 100)))
+	require.False(t, subscription.MatchesTier(types.NewTier(testCreator, 1, "Supporter", testPrice, 50)))
+}
+
+func TestSubscription_Validate(t *testing.T) {
+	tests := []struct {
+		name         string
+		subscription types.Subscription
+		expErr       error
+	}{
+		{
+			name:         "Empty creator returns error",
+			subscription: types.NewSubscription(nil, testSubscriber, 1, testPrice, 100, 3, 150),
+			expErr:       fmt.Errorf("invalid subscription creator: "),
+		},
+		{
+			name:         "Empty subscriber returns error",
+			subscription: types.NewSubscription(testCreator, nil, 1, testPrice, 100, 3, 150),
+			expErr:       fmt.Errorf("invalid subscriber: "),
+		},
+		{
+			name:         "Same creator and subscriber returns error",
+			subscription: types.NewSubscription(testCreator, testCreator, 1, testPrice, 100, 3, 150),
+			expErr:       fmt.Errorf("creator and subscriber must be different"),
+		},
+		{
+			name:         "Zero level returns error",
+			subscription: types.NewSubscription(testCreator, testSubscriber, 0, testPrice, 100, 3, 150),
+			expErr:       fmt.Errorf("subscription level must be greater than zero"),
+		},
+		{
+			name: "Invalid price returns error",
+			subscription: types.NewSubscription(testCreator, testSubscriber, 1,
+				sdk.NewInt64Coin("udaric", 0), 100, 3, 150),
+			expErr: fmt.Errorf("invalid subscription price: 0udaric"),
+		},
+		{
+			name:         "Invalid period returns error",
+			subscription: types.NewSubscription(testCreator, testSubscriber, 1, testPrice, -1, 3, 150),
+			expErr:       fmt.Errorf("invalid subscription period: -1"),
+		},
+		{
+			name:         "No remaining periods returns error",
+			subscription: types.NewSubscription(testCreator, testSubscriber, 1, testPrice, 100, 0, 150),
+			expErr:       fmt.Errorf("subscription remaining periods must be greater than zero"),
+		},
+		{
+			name:         "Invalid next payment returns error",
+			subscription: types.NewSubscription(testCreator, testSubscriber, 1, testPrice, 100, 3, 0),
+			expErr:       fmt.Errorf("invalid subscription next payment height: 0"),
+		},
+		{
+			name:         "Valid subscription returns no error",
+			subscription: types.NewSubscription(testCreator, testSubscriber, 1, testPrice, 100, 3, 150),
+			expErr:       nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.subscription.Validate())
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Tier represents a paid subscription level defined by a creator.
+// Subscribing to a tier costs its price for each period, expressed in number of blocks
+type Tier struct {
+	Creator sdk.AccAddress `json:"creator" yaml:"creator"`
+	Level   uint32         `json:"level" yaml:"level"`
+	Name    string         `json:"name" yaml:"name"`
+	Price   sdk.Coin       `json:"price" yaml:"price"`
+	Period  int64          `json:"period" yaml:"period"`
+}
+
+// NewTier is a constructor function for Tier
+func NewTier(creator sdk.AccAddress, level uint32, name string, price sdk.Coin, period int64) Tier {
+	return Tier{
+		Creator: creator,
+		Level:   level,
+		Name:    name,
+		Price:   price,
+		Period:  period,
+	}
+}
+
+// String implements fmt.Stringer
+func (tier Tier) String() string {
+	return fmt.Sprintf("Creator: %s\nLevel: %d\nName: %s\nPrice: %s\nPeriod: %d",
+		tier.Creator, tier.Level, tier.Name, tier.Price, tier.Period)
+}
+
+// Validate checks the validity of the Tier
+func (tier Tier) Validate() error {
+	if tier.Creator.Empty() {
+		return fmt.Errorf("invalid tier creator: %s", tier.Creator)
+	}
+
+	if tier.Level == 0 {
+		return fmt.Errorf("tier level must be greater than zero")
+	}
+
+	if len(strings.TrimSpace(tier.Name)) == 0 {
+		return fmt.Errorf("tier name cannot be empty")
+	}
+
+	if !tier.Price.IsValid() || tier.Price.IsZero() {
+		return fmt.Errorf("invalid tier price: %s", tier.Price)
+	}
+
+	if tier.Period <= 0 {
+		return fmt.Errorf("invalid tier period: %d", tier.Period)
+	}
+
+	return nil
+}
+
+// Tiers represents a slice of Tier objects
+type Tiers []Tier
@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/desmos-labs/desmos/x/subscriptions/types"
+)
+
+func TestTier_String(t *testing.T) {
+	tier := types.NewTier(testCreator, 1, "Supporter", testPrice, 100)
+	require.Equal(t, "Creator: cosmos1cjf97gpzwmaf30pzvaargfgr884mpp5ak8f7ns\nLevel: 1\nName: Supporter\n"+
+		"Price: 100udaric\nPeriod: 100", tier.String())
+}
+
+func TestTier_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		tier   types.Tier
+		expErr error
+	}{
+		{
+			name:   "Empty creator returns error",
+			tier:   types.NewTier(nil, 1, "Supporter", testPrice, 100),
+			expErr: fmt.Errorf("invalid tier creator: "),
+		},
+		{
+			name:   "Zero level returns error",
+			tier:   types.NewTier(testCreator, 0, "Supporter", testPrice, 100),
+			expErr: fmt.Errorf("tier level must be greater than zero"),
+		},
+		{
+			name:   "Empty name returns error",
+			tier:   types.NewTier(testCreator, 1, " ", testPrice, 100),
+			expErr: fmt.Errorf("tier name cannot be empty"),
+		},
+		{
+			name:   "Invalid price returns error",
+			tier:   types.NewTier(testCreator, 1, "Supporter", sdk.Coin{Denom: "UDARIC", Amount: sdk.NewInt(10)}, 100),
+			expErr: fmt.Errorf("invalid tier price: 10UDARIC"),
+		},
+		{
+			name:   "Invalid period returns error",
+			tier:   types.NewTier(testCreator, 1, "Supporter", testPrice, 0),
+			expErr: fmt.Errorf("invalid tier period: 0"),
+		},
+		{
+			name:   "Valid tier returns no error",
+			tier:   types.NewTier(testCreator, 1, "Supporter", testPrice, 100),
+			expErr: nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expErr, test.tier.Validate())
+		})
+	}
+}